@@ -1,42 +1,60 @@
 package main
 
 import (
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	_ "time/tzdata" // league time zones must resolve in minimal containers
 
 	"github.com/joho/godotenv"
 	"nfl-discord-bot/internal/bot"
 	"nfl-discord-bot/internal/config"
+	"nfl-discord-bot/internal/logging"
 )
 
 func main() {
 	// Load .env file
 	err := godotenv.Load()
 	if err != nil {
-		log.Println("Warning: .env file not found, using environment variables")
+		slog.Warn(".env file not found, using environment variables")
 	}
 
 	// Load configuration
 	cfg, err2 := config.Load()
 	if err2 != nil {
-		log.Fatalf("Error loading config: %v", err2)
+		slog.Error("error loading config", "error", err2)
+		os.Exit(1)
 	}
 
+	// Configure logging
+	logFile, err := logging.Setup(logging.Options{
+		Level:   cfg.LogLevel,
+		Modules: cfg.LogModuleLevels,
+		File:    cfg.LogFile,
+		Format:  cfg.LogFormat,
+	})
+	if err != nil {
+		slog.Error("error configuring logging", "error", err)
+		os.Exit(1)
+	}
+	defer logFile.Close()
+
 	// Create and start the bot
 	discordBot, err := bot.New(cfg)
 	if err != nil {
-		log.Fatalf("Error creating bot: %v", err)
+		slog.Error("error creating bot", "error", err)
+		os.Exit(1)
 	}
 
 	// Start the bot
 	err = discordBot.Start()
 	if err != nil {
-		log.Fatalf("Error starting bot: %v", err)
+		slog.Error("error starting bot", "error", err)
+		os.Exit(1)
 	}
 
-	log.Println("NFL Discord Bot is now running. Press CTRL+C to exit.")
+	slog.Info("NFL Discord Bot is now running. Press CTRL+C to exit.")
 
 	// Wait for interrupt signal to gracefully shutdown
 	sc := make(chan os.Signal, 1)
@@ -45,5 +63,5 @@ func main() {
 
 	// Clean up
 	discordBot.Stop()
-	log.Println("Bot stopped gracefully.")
+	slog.Info("bot stopped gracefully")
 }
@@ -0,0 +1,62 @@
+// Command nfl-cli runs the bot's prefix commands from a terminal and prints the replies as text,
+// so stats, compare, team, schedule, and scores can be exercised without a bot token or guild.
+//
+//	nfl-cli stats Josh Allen      run one command and exit
+//	nfl-cli                       read commands from stdin, one per line
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"nfl-discord-bot/internal/bot"
+	"nfl-discord-bot/internal/config"
+)
+
+func main() {
+	// Load .env file
+	if err := godotenv.Load(); err != nil {
+		slog.Warn(".env file not found, using environment variables")
+	}
+
+	cfg, err := config.LoadLocal()
+	if err != nil {
+		slog.Error("error loading config", "error", err)
+		os.Exit(1)
+	}
+
+	nflBot, err := bot.New(cfg)
+	if err != nil {
+		slog.Error("error creating bot", "error", err)
+		os.Exit(1)
+	}
+
+	out := newTerminal(os.Stdout)
+	run := func(line string) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return
+		}
+		if !strings.HasPrefix(line, cfg.BotPrefix) {
+			line = cfg.BotPrefix + line
+		}
+		nflBot.RunCommand(out, line)
+	}
+
+	if len(os.Args) > 1 {
+		run(strings.Join(os.Args[1:], " "))
+		return
+	}
+
+	fmt.Printf("NFL bot commands without Discord (e.g. \"stats Josh Allen\", \"help\"). Ctrl-D to quit.\n> ")
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		run(scanner.Text())
+		fmt.Print("> ")
+	}
+	fmt.Println()
+}
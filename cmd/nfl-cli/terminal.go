@@ -0,0 +1,116 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// errNoInteractions is returned for slash command responses, which the terminal can't receive
+var errNoInteractions = errors.New("interactions aren't available outside Discord")
+
+// terminal is a bot.Responder that prints channel messages and embeds as plain text
+type terminal struct {
+	mu     sync.Mutex
+	out    io.Writer
+	nextID int
+}
+
+// newTerminal creates a terminal that writes to out
+func newTerminal(out io.Writer) *terminal {
+	return &terminal{out: out}
+}
+
+// print writes one message's content and embeds, returning the message as Discord would
+func (t *terminal) print(channelID, content string, embeds []*discordgo.MessageEmbed) *discordgo.Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if content != "" {
+		fmt.Fprintln(t.out, content)
+	}
+	for _, embed := range embeds {
+		fmt.Fprint(t.out, renderEmbed(embed))
+	}
+
+	t.nextID++
+	return &discordgo.Message{ID: strconv.Itoa(t.nextID), ChannelID: channelID, Content: content, Embeds: embeds}
+}
+
+func (t *terminal) ChannelMessageSend(channelID string, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return t.print(channelID, content, nil), nil
+}
+
+func (t *terminal) ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return t.print(channelID, "", []*discordgo.MessageEmbed{embed}), nil
+}
+
+func (t *terminal) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return t.print(channelID, data.Content, data.Embeds), nil
+}
+
+func (t *terminal) ChannelMessageEditComplex(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	var content string
+	if m.Content != nil {
+		content = *m.Content
+	}
+	var embeds []*discordgo.MessageEmbed
+	if m.Embeds != nil {
+		embeds = *m.Embeds
+	}
+	return t.print(m.Channel, content, embeds), nil
+}
+
+// ChannelMessageDelete is a no-op: printed lines stay, so "fetching" notices remain visible
+func (t *terminal) ChannelMessageDelete(channelID, messageID string, options ...discordgo.RequestOption) error {
+	return nil
+}
+
+func (t *terminal) UserChannelCreate(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	return &discordgo.Channel{ID: "dm-" + recipientID, Type: discordgo.ChannelTypeDM}, nil
+}
+
+func (t *terminal) MessageThreadStart(channelID, messageID string, name string, archiveDuration int, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	return &discordgo.Channel{ID: "thread-" + messageID, ParentID: channelID, Name: name, Type: discordgo.ChannelTypeGuildPublicThread}, nil
+}
+
+func (t *terminal) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+	return errNoInteractions
+}
+
+func (t *terminal) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return nil, errNoInteractions
+}
+
+func (t *terminal) FollowupMessageCreate(interaction *discordgo.Interaction, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return nil, errNoInteractions
+}
+
+// renderEmbed lays out an embed as text: title, description, each field, then the footer
+func renderEmbed(embed *discordgo.MessageEmbed) string {
+	var text strings.Builder
+	rule := strings.Repeat("─", 60)
+	text.WriteString(rule + "\n")
+	if embed.Author != nil && embed.Author.Name != "" {
+		text.WriteString(embed.Author.Name + "\n")
+	}
+	if embed.Title != "" {
+		text.WriteString(embed.Title + "\n")
+	}
+	if embed.Description != "" {
+		text.WriteString(embed.Description + "\n")
+	}
+	for _, field := range embed.Fields {
+		text.WriteString("\n" + field.Name + "\n" + field.Value + "\n")
+	}
+	if embed.Footer != nil && embed.Footer.Text != "" {
+		text.WriteString("\n" + embed.Footer.Text + "\n")
+	}
+	text.WriteString(rule + "\n")
+	return text.String()
+}
@@ -0,0 +1,31 @@
+// Command nfl-fixtures serves the nfltest fixtures as a stand-in SportsData.io API. Point the
+// bot at it with NFL_API_BASE_URL=http://localhost:8089 (any NFL_API_KEY works) to preview
+// embeds without an API key.
+package main
+
+import (
+	"flag"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"nfl-discord-bot/internal/nfl/nfltest"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8089", "address to listen on")
+	dir := flag.String("fixtures", "", "directory of fixture JSON to serve instead of the built-in set")
+	flag.Parse()
+
+	var fixtures fs.FS = nfltest.Fixtures
+	if *dir != "" {
+		fixtures = os.DirFS(*dir)
+	}
+
+	slog.Info("serving NFL fixtures", "addr", "http://"+*addr)
+	if err := http.ListenAndServe(*addr, nfltest.NewProvider(fixtures)); err != nil {
+		slog.Error("fixture server stopped", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,134 @@
+// Package analysis aggregates a game's play-by-play into summaries like a drive chart.
+package analysis
+
+import (
+	"strings"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// Drive results
+const (
+	ResultTouchdown    = "Touchdown"
+	ResultFieldGoal    = "Field Goal"
+	ResultMissedFG     = "Missed FG"
+	ResultPunt         = "Punt"
+	ResultInterception = "Interception"
+	ResultFumble       = "Fumble"
+	ResultDowns        = "Downs"
+	ResultTurnover     = "Turnover"
+	ResultSafety       = "Safety"
+	ResultEndOfHalf    = "End of Half"
+	ResultEndOfGame    = "End of Game"
+	ResultInProgress   = "In Progress"
+)
+
+// nonDrivePlays are play types that don't belong to either team's drive: kickoffs and conversions
+// are special teams snaps between drives, and the rest aren't plays at all
+var nonDrivePlays = map[string]bool{
+	"Kickoff":            true,
+	"ExtraPoint":         true,
+	"TwoPointConversion": true,
+	"Timeout":            true,
+	"TwoMinuteWarning":   true,
+	"Period":             true,
+}
+
+// Drive is one possession: where it started, how far it went, and how it ended
+type Drive struct {
+	Team         string
+	Quarter      string // quarter the drive started in
+	Clock        string // time left in the quarter when it started
+	Start        string // starting field position, e.g. "KC 25"
+	Plays        int
+	Yards        int
+	Result       string
+	ScoringDrive bool
+}
+
+// Drives groups a game's plays into drives by the team with the ball. live marks the last drive as
+// still in progress rather than ending the game.
+func Drives(plays []*models.LoggedPlay, live bool) []*Drive {
+	var drives []*Drive
+	var current *Drive
+	var last *models.LoggedPlay
+
+	for _, play := range plays {
+		if play.Offense == "" || nonDrivePlays[play.Type] {
+			continue
+		}
+		if current != nil && current.Result == "" && halfEnded(last, play) {
+			current.Result = ResultEndOfHalf
+		}
+		if current == nil || play.Offense != current.Team || current.Result != "" {
+			if current != nil && current.Result == "" {
+				current.Result = possessionChange(last)
+			}
+			current = &Drive{
+				Team:    play.Offense,
+				Quarter: play.Quarter,
+				Clock:   play.Clock,
+				Start:   play.BallOn,
+			}
+			drives = append(drives, current)
+		}
+
+		current.Plays++
+		current.Yards += play.Yards
+		current.Result = playResult(play)
+		current.ScoringDrive = current.Result == ResultTouchdown || current.Result == ResultFieldGoal
+		last = play
+	}
+
+	if current != nil && current.Result == "" {
+		current.Result = ResultEndOfGame
+		if live {
+			current.Result = ResultInProgress
+		}
+	}
+	return drives
+}
+
+// playResult returns how a drive ends on a play, or "" when the drive goes on
+func playResult(play *models.LoggedPlay) string {
+	hasKind := func(kind string) bool {
+		for _, playKind := range play.Kinds {
+			if playKind == kind {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case play.Type == "Punt":
+		return ResultPunt
+	case play.Type == "FieldGoal" && hasKind(models.PlayFieldGoal):
+		return ResultFieldGoal
+	case play.Type == "FieldGoal":
+		return ResultMissedFG
+	case hasKind(models.PlayTurnover) && (play.Type == "PassIntercepted" || strings.Contains(strings.ToUpper(play.Description), "INTERCEPTED")):
+		return ResultInterception
+	case hasKind(models.PlayTurnover):
+		return ResultFumble
+	case hasKind(models.PlaySafety):
+		return ResultSafety
+	case hasKind(models.PlayTouchdown):
+		return ResultTouchdown
+	}
+	return ""
+}
+
+// halfEnded reports whether the second and fourth quarters (or overtime) ran out between two plays
+func halfEnded(last, next *models.LoggedPlay) bool {
+	return last.Quarter != next.Quarter && last.Quarter != "1" && last.Quarter != "3"
+}
+
+// possessionChange names how a drive ended when the other team got the ball without a kick,
+// score, or recognized turnover
+func possessionChange(last *models.LoggedPlay) string {
+	if last.Down == 4 {
+		return ResultDowns
+	}
+	return ResultTurnover
+}
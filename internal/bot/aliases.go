@@ -0,0 +1,216 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Alias limits keep /alias list within one message and aliases usable as a single prefix command word
+const (
+	aliasesPerGuild = 50
+	aliasMaxLength  = 24
+)
+
+// aliasOptions are the slash command options that name a player or team, so a guild's aliases
+// are expanded in them; options holding a comma-separated list are expanded entry by entry
+var aliasOptions = map[string]bool{
+	"player":  false,
+	"player1": false,
+	"player2": false,
+	"team":    false,
+	"players": true,
+}
+
+// validAlias reports whether an alias is a single word of letters, digits, dots, or apostrophes
+// with at least one letter, so it can't be mistaken for a week number or prefix command flag
+func validAlias(alias string) bool {
+	if alias == "" || len(alias) > aliasMaxLength {
+		return false
+	}
+	hasLetter := false
+	for _, r := range alias {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r), r == '.', r == '\'':
+		default:
+			return false
+		}
+	}
+	return hasLetter
+}
+
+// expandAlias returns the name a guild's alias stands for, or name unchanged when it isn't one
+func (b *Bot) expandAlias(guildID, name string) string {
+	if guildID == "" {
+		return name
+	}
+	if expanded, ok := b.settings.Get(guildID).Aliases[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return expanded
+	}
+	return name
+}
+
+// expandInteractionAliases rewrites player and team options that match a guild alias to the
+// full name before the command's handler (and the client's fuzzy matching) sees them
+func (b *Bot) expandInteractionAliases(i *discordgo.InteractionCreate) {
+	if i.GuildID == "" || len(b.settings.Get(i.GuildID).Aliases) == 0 {
+		return
+	}
+	b.expandOptionAliases(i.GuildID, i.ApplicationCommandData().Options)
+}
+
+// expandOptionAliases expands aliases in options, descending into subcommands
+func (b *Bot) expandOptionAliases(guildID string, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	for _, option := range options {
+		if option.Type == discordgo.ApplicationCommandOptionSubCommand || option.Type == discordgo.ApplicationCommandOptionSubCommandGroup {
+			b.expandOptionAliases(guildID, option.Options)
+			continue
+		}
+		list, named := aliasOptions[option.Name]
+		if !named || option.Type != discordgo.ApplicationCommandOptionString {
+			continue
+		}
+		if !list {
+			option.Value = b.expandAlias(guildID, option.StringValue())
+			continue
+		}
+		entries := strings.Split(option.StringValue(), ",")
+		for index, entry := range entries {
+			entries[index] = b.expandAlias(guildID, entry)
+		}
+		option.Value = strings.Join(entries, ",")
+	}
+}
+
+// expandArgAliases expands aliases in a prefix command's arguments word by word
+func (b *Bot) expandArgAliases(guildID string, args []string) []string {
+	if guildID == "" || len(b.settings.Get(guildID).Aliases) == 0 {
+		return args
+	}
+	var expanded []string
+	for _, arg := range args {
+		expanded = append(expanded, strings.Fields(b.expandAlias(guildID, arg))...)
+	}
+	return expanded
+}
+
+// handleSlashAlias handles the /alias slash command
+func (b *Bot) handleSlashAlias(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	subcommand := options[0]
+	var alias, name string
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "alias":
+			alias = strings.ToLower(strings.TrimSpace(option.StringValue()))
+		case "name":
+			name = strings.TrimSpace(option.StringValue())
+		}
+	}
+
+	switch subcommand.Name {
+	case "add":
+		b.respondAliasAdd(s, i, alias, name)
+	case "remove":
+		b.respondAliasRemove(s, i, alias)
+	case "list":
+		respondEphemeral(s, i, b.aliasSummary(i.GuildID))
+	}
+}
+
+// respondAliasAdd saves an alias for the team or player name matches
+func (b *Bot) respondAliasAdd(s Responder, i *discordgo.InteractionCreate, alias, name string) {
+	if !canManageGuild(i) {
+		respondEphemeral(s, i, b.tr(i, "permission.alias_add"))
+		return
+	}
+	if !validAlias(alias) {
+		respondEphemeral(s, i, b.tr(i, "alias.invalid", aliasMaxLength))
+		return
+	}
+
+	aliases := b.settings.Get(i.GuildID).Aliases
+	if _, exists := aliases[alias]; !exists && len(aliases) >= aliasesPerGuild {
+		respondEphemeral(s, i, b.tr(i, "alias.full", aliasesPerGuild))
+		return
+	}
+
+	// Teams resolve to their abbreviation; anything else has to be a player in recent stats
+	expanded := ""
+	if teamInfo, err := b.nflClient.GetTeamInfo(name); err == nil {
+		expanded = teamInfo.Key
+	} else {
+		player, err := b.resolveWatchPlayer(name)
+		if err != nil {
+			respondEphemeral(s, i, b.tr(i, "alias.unknown", name))
+			return
+		}
+		expanded = player
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		if settings.Aliases == nil {
+			settings.Aliases = make(map[string]string)
+		}
+		settings.Aliases[alias] = expanded
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "alias.save_failed"))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "alias.added", alias, expanded))
+}
+
+// respondAliasRemove deletes an alias
+func (b *Bot) respondAliasRemove(s Responder, i *discordgo.InteractionCreate, alias string) {
+	if !canManageGuild(i) {
+		respondEphemeral(s, i, b.tr(i, "permission.alias_remove"))
+		return
+	}
+	if _, exists := b.settings.Get(i.GuildID).Aliases[alias]; !exists {
+		respondEphemeral(s, i, b.tr(i, "alias.not_found", alias))
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		delete(settings.Aliases, alias)
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "alias.save_failed"))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "alias.removed", alias))
+}
+
+// aliasSummary lists a guild's aliases alphabetically
+func (b *Bot) aliasSummary(guildID string) string {
+	aliases := b.settings.Get(guildID).Aliases
+	if len(aliases) == 0 {
+		return "🏷️ This server has no aliases. Add one with `/alias add alias:cmc name:Christian McCaffrey`."
+	}
+
+	keys := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		keys = append(keys, alias)
+	}
+	sort.Strings(keys)
+
+	lines := []string{fmt.Sprintf("🏷️ **Aliases in this server** (%d/%d)", len(keys), aliasesPerGuild)}
+	for _, alias := range keys {
+		lines = append(lines, fmt.Sprintf("`%s` → %s", alias, aliases[alias]))
+	}
+	return strings.Join(lines, "\n")
+}
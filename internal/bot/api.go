@@ -0,0 +1,176 @@
+package bot
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"nfl-discord-bot/internal/nfl"
+	"nfl-discord-bot/pkg/models"
+)
+
+// apiError is the JSON body of a failed API request
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// registerAPIRoutes adds the JSON API mirroring /stats, /scores, and /schedule to mux. Every
+// route requires the configured token as a bearer token.
+func (b *Bot) registerAPIRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/stats", b.requireAPIToken(b.serveAPIStats))
+	mux.HandleFunc("GET /api/scores", b.requireAPIToken(b.serveAPIScores))
+	mux.HandleFunc("GET /api/schedule", b.requireAPIToken(b.serveAPISchedule))
+}
+
+// requireAPIToken rejects requests without "Authorization: Bearer <WEB_API_TOKEN>"
+func (b *Bot) requireAPIToken(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(token), []byte(b.config.WebAPIToken)) != 1 {
+			writeAPIJSON(w, http.StatusUnauthorized, apiError{Error: "missing or invalid API token"})
+			return
+		}
+		next(w, r)
+	}
+}
+
+// serveAPIStats returns a player's stat line: ?player=<name> with optional type=season, or
+// week=<#> with optional season=<year> and season_type=<PRE|REG|POST>
+func (b *Bot) serveAPIStats(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	player := strings.TrimSpace(query.Get("player"))
+	if player == "" {
+		writeAPIJSON(w, http.StatusBadRequest, apiError{Error: "player is required"})
+		return
+	}
+
+	var stats *models.PlayerStats
+	var err error
+	switch {
+	case query.Get("type") == "season":
+		stats, err = b.nflClient.GetPlayerSeasonStats(player)
+	case query.Has("week"):
+		season, seasonType, week, parseErr := b.apiWeek(query.Get("season"), query.Get("season_type"), query.Get("week"))
+		if parseErr != nil {
+			writeAPIJSON(w, http.StatusBadRequest, apiError{Error: parseErr.Error()})
+			return
+		}
+		stats, err = b.nflClient.GetPlayerWeekStatsForType(player, season, seasonType, week)
+	default:
+		stats, err = b.nflClient.GetPlayerStats(player)
+	}
+	if err != nil {
+		writeAPIClientError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, stats)
+}
+
+// serveAPIScores returns the current week's games, or a week's with week=<#> and optional
+// season=<year> and season_type=<PRE|REG|POST>
+func (b *Bot) serveAPIScores(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var scores []*models.LiveScore
+	var err error
+	if query.Has("week") {
+		season, seasonType, week, parseErr := b.apiWeek(query.Get("season"), query.Get("season_type"), query.Get("week"))
+		if parseErr != nil {
+			writeAPIJSON(w, http.StatusBadRequest, apiError{Error: parseErr.Error()})
+			return
+		}
+		scores, err = b.nflClient.GetScoresForWeek(season, seasonType, week)
+	} else {
+		scores, err = b.nflClient.GetLiveScores()
+	}
+	if err != nil {
+		writeAPIClientError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, scores)
+}
+
+// serveAPISchedule returns a team's schedule: ?team=<name> with optional season=<year> and
+// season_type=<PRE|REG|POST>
+func (b *Bot) serveAPISchedule(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	team := strings.TrimSpace(query.Get("team"))
+	if team == "" {
+		writeAPIJSON(w, http.StatusBadRequest, apiError{Error: "team is required"})
+		return
+	}
+
+	var schedule *models.Schedule
+	var err error
+	if query.Has("season") || query.Has("season_type") {
+		season, seasonType, _, parseErr := b.apiWeek(query.Get("season"), query.Get("season_type"), "")
+		if parseErr != nil {
+			writeAPIJSON(w, http.StatusBadRequest, apiError{Error: parseErr.Error()})
+			return
+		}
+		schedule, err = b.nflClient.GetTeamScheduleForSeason(team, season, seasonType)
+	} else {
+		schedule, err = b.nflClient.GetTeamSchedule(team)
+	}
+	if err != nil {
+		writeAPIClientError(w, err)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, schedule)
+}
+
+// apiWeek parses season, season type, and week query values; a missing season or season type
+// is the current one, and a missing week is 0
+func (b *Bot) apiWeek(seasonValue, seasonTypeValue, weekValue string) (int, string, int, error) {
+	current, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return 0, "", 0, err
+	}
+	season, seasonType, week := current.Season, current.SeasonType, 0
+
+	if seasonValue != "" {
+		if season, err = strconv.Atoi(seasonValue); err != nil {
+			return 0, "", 0, errors.New("season must be a year")
+		}
+	}
+	if seasonTypeValue != "" {
+		seasonType = strings.ToUpper(seasonTypeValue)
+		if seasonType != models.SeasonTypePreseason && seasonType != models.SeasonTypeRegular && seasonType != models.SeasonTypePostseason {
+			return 0, "", 0, errors.New("season_type must be PRE, REG, or POST")
+		}
+	}
+	if weekValue != "" {
+		if week, err = strconv.Atoi(weekValue); err != nil {
+			return 0, "", 0, errors.New("week must be a number")
+		}
+	}
+	return season, seasonType, week, nil
+}
+
+// writeAPIClientError maps an NFL client error to an HTTP status
+func writeAPIClientError(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+	switch {
+	case errors.Is(err, nfl.ErrPlayerNotFound), errors.Is(err, nfl.ErrPlayerInactive), errors.Is(err, nfl.ErrTeamNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, nfl.ErrInvalidWeek):
+		status = http.StatusBadRequest
+	case errors.Is(err, nfl.ErrRateLimited):
+		status = http.StatusTooManyRequests
+	case errors.Is(err, nfl.ErrUpstreamUnavailable):
+		status = http.StatusServiceUnavailable
+	}
+	writeAPIJSON(w, status, apiError{Error: err.Error()})
+}
+
+// writeAPIJSON writes body as a JSON response
+func writeAPIJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Error("error writing api response", "error", err)
+	}
+}
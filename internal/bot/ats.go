@@ -0,0 +1,317 @@
+package bot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/odds"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/pkg/models"
+)
+
+// closingLinesDocument is the storage document archiving closing lines for final games
+const closingLinesDocument = "closing_lines"
+
+// closingLineStore archives closing spreads and totals so they outlive the API's data
+type closingLineStore struct {
+	mu    sync.Mutex
+	store *storage.Store
+	lines map[string]odds.ClosingLine // keyed by game ID
+}
+
+// newClosingLineStore loads archived closing lines from storage
+func newClosingLineStore(store *storage.Store) (*closingLineStore, error) {
+	archive := &closingLineStore{
+		store: store,
+		lines: make(map[string]odds.ClosingLine),
+	}
+	if err := store.Load(closingLinesDocument, &archive.lines); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// Archive stores lines for games not yet archived, returning how many were added
+func (cs *closingLineStore) Archive(lines []odds.ClosingLine) (int, error) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	added := 0
+	for _, line := range lines {
+		if _, exists := cs.lines[line.GameID]; exists {
+			continue
+		}
+		cs.lines[line.GameID] = line
+		added++
+	}
+	if added == 0 {
+		return 0, nil
+	}
+
+	if err := cs.store.Save(closingLinesDocument, cs.lines); err != nil {
+		logger.Error("error saving closing lines", "error", err)
+		return added, err
+	}
+	return added, nil
+}
+
+// Get returns a game's archived closing line
+func (cs *closingLineStore) Get(gameID string) (odds.ClosingLine, bool) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	line, exists := cs.lines[gameID]
+	return line, exists
+}
+
+// Season returns a season's archived lines in schedule order
+func (cs *closingLineStore) Season(season int) []odds.ClosingLine {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	var lines []odds.ClosingLine
+	for _, line := range cs.lines {
+		if line.Season == season {
+			lines = append(lines, line)
+		}
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i].SeasonType != lines[j].SeasonType {
+			return lines[i].SeasonType == models.SeasonTypeRegular
+		}
+		if lines[i].Week != lines[j].Week {
+			return lines[i].Week < lines[j].Week
+		}
+		return lines[i].GameID < lines[j].GameID
+	})
+	return lines
+}
+
+// RetireSeasonsBefore hands each season older than cutoff to archive, then drops those lines.
+// Nothing is dropped unless every season archives successfully.
+func (cs *closingLineStore) RetireSeasonsBefore(cutoff int, archive func(season int, lines map[string]odds.ClosingLine) error) (int, error) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	retired := make(map[int]map[string]odds.ClosingLine)
+	count := 0
+	for gameID, line := range cs.lines {
+		if line.Season >= cutoff {
+			continue
+		}
+		if retired[line.Season] == nil {
+			retired[line.Season] = make(map[string]odds.ClosingLine)
+		}
+		retired[line.Season][gameID] = line
+		count++
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	for season, lines := range retired {
+		if err := archive(season, lines); err != nil {
+			return 0, err
+		}
+		for gameID := range lines {
+			delete(cs.lines, gameID)
+		}
+	}
+
+	if err := cs.store.Save(closingLinesDocument, cs.lines); err != nil {
+		logger.Error("error saving closing lines", "error", err)
+		return count, err
+	}
+	return count, nil
+}
+
+// archiveClosingLines records the closing line of every final regular season and playoff game
+func (b *Bot) archiveClosingLines(season int) error {
+	var lines []odds.ClosingLine
+	for _, seasonType := range []string{models.SeasonTypeRegular, models.SeasonTypePostseason} {
+		games, err := b.nflClient.GetSeasonGames(season, seasonType)
+		if err != nil {
+			if seasonType == models.SeasonTypePostseason {
+				continue // the playoff schedule may not be published yet
+			}
+			return err
+		}
+
+		for _, game := range games {
+			if game.PointSpread == nil || !standings.IsFinal(game.Status) {
+				continue
+			}
+			line := odds.ClosingLine{
+				GameID:     game.ID,
+				Season:     season,
+				SeasonType: seasonType,
+				Week:       game.Week,
+				HomeTeam:   game.HomeTeam,
+				AwayTeam:   game.AwayTeam,
+				Spread:     *game.PointSpread,
+				HomeScore:  game.HomeScore,
+				AwayScore:  game.AwayScore,
+			}
+			if game.OverUnder != nil {
+				line.Total = *game.OverUnder
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	added, err := b.closingLines.Archive(lines)
+	if added > 0 {
+		logger.Info("archived closing lines", "season", season, "games", added)
+	}
+	return err
+}
+
+// handleSlashATS handles the /ats slash command
+func (b *Bot) handleSlashATS(s Responder, i *discordgo.InteractionCreate) {
+	teamName := ""
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "team" {
+			teamName = option.StringValue()
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial ats response", "error", err)
+		return
+	}
+
+	// Process ATS request asynchronously
+	go b.processSlashATSRequest(s, i, teamName)
+}
+
+// processSlashATSRequest builds the league ATS table or one team's game-by-game results
+func (b *Bot) processSlashATSRequest(s Responder, i *discordgo.InteractionCreate, teamName string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+
+	if err := b.archiveClosingLines(seasonInfo.Season); err != nil {
+		logger.Warn("closing line archive refresh failed", "error", err)
+	}
+
+	lines := b.closingLines.Season(seasonInfo.Season)
+	if len(lines) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("No closing lines archived for %d yet.", seasonInfo.Season))
+		return
+	}
+
+	var embed *discordgo.MessageEmbed
+	if teamName == "" {
+		embed = atsLeagueEmbed(seasonInfo.Season, lines, b.guildFormat(i.GuildID))
+	} else {
+		teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+		if err != nil {
+			b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding team %s", teamName), err))
+			return
+		}
+		embed = atsTeamEmbed(seasonInfo.Season, teamInfo, lines, b.guildFormat(i.GuildID))
+	}
+
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending ats embed response", "error", err)
+	}
+}
+
+// atsLeagueEmbed renders every team's ATS and over/under record
+func atsLeagueEmbed(season int, lines []odds.ClosingLine, format models.Format) *discordgo.MessageEmbed {
+	var table strings.Builder
+	table.WriteString("```\n")
+	table.WriteString(fmt.Sprintf("%-4s %-8s %6s  %s\n", "TEAM", "ATS", "COVER", "O/U"))
+	for _, record := range odds.Records(lines) {
+		table.WriteString(fmt.Sprintf("%-4s %-8s %5s%%  %s\n",
+			record.Team, record.ATS(), coverPercent(record, format), record.OU()))
+	}
+	table.WriteString("```")
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("💰 %d Against the Spread", season),
+		Color:       0x013369,
+		Description: table.String(),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Graded against closing lines • cover % excludes pushes • /ats team:<name> for game-by-game results",
+		},
+	}
+}
+
+// atsTeamEmbed renders one team's ATS record with each game's result against the line
+func atsTeamEmbed(season int, teamInfo *models.TeamInfo, lines []odds.ClosingLine, format models.Format) *discordgo.MessageEmbed {
+	var teamLines []odds.ClosingLine
+	for _, line := range lines {
+		if line.Involves(teamInfo.Key) {
+			teamLines = append(teamLines, line)
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("💰 %s %s — %d Against the Spread", teamInfo.City, teamInfo.Name, season),
+		Color: 0x013369,
+	}
+	themeEmbed(embed, teamInfo)
+	if len(teamLines) == 0 {
+		embed.Description = "No graded games yet."
+		return embed
+	}
+
+	for _, r := range odds.Records(teamLines) {
+		if r.Team == teamInfo.Key {
+			embed.Description = fmt.Sprintf("**ATS:** %s (%s%% covers) • **O/U:** %s", r.ATS(), coverPercent(r, format), r.OU())
+		}
+	}
+
+	var results []string
+	for _, line := range teamLines {
+		opponent := "vs " + line.AwayTeam
+		if line.AwayTeam == teamInfo.Key {
+			opponent = "@ " + line.HomeTeam
+		}
+
+		outcome := "T"
+		margin := line.Margin(teamInfo.Key)
+		if margin > 0 {
+			outcome = "W"
+		} else if margin < 0 {
+			outcome = "L"
+		}
+		teamScore, opponentScore := line.HomeScore, line.AwayScore
+		if line.AwayTeam == teamInfo.Key {
+			teamScore, opponentScore = opponentScore, teamScore
+		}
+
+		result := fmt.Sprintf("`%-4s` %s — %s (%s %s-%s)",
+			weekShortLabel(line.SeasonType, line.Week), opponent, line.Describe(teamInfo.Key), outcome, format.Int(teamScore), format.Int(opponentScore))
+		if total := line.DescribeTotal(); total != "" {
+			result += " • " + total
+		}
+		results = append(results, result)
+	}
+
+	// A full season of results overflows an embed field, so it goes in the description
+	embed.Description += "\n\n" + strings.Join(results, "\n")
+	return embed
+}
+
+// coverPercent formats how often a team covered, rounded to a whole percent
+func coverPercent(record *odds.Record, format models.Format) string {
+	return format.Int(int(math.Round(record.CoverPct() * 100)))
+}
+
+// weekShortLabel abbreviates a week for compact tables, e.g. "W5" or "P2"
+func weekShortLabel(seasonType string, week int) string {
+	if seasonType == models.SeasonTypePostseason {
+		return fmt.Sprintf("P%d", week)
+	}
+	return fmt.Sprintf("W%d", week)
+}
@@ -0,0 +1,378 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/pkg/models"
+)
+
+// bigGamesDocument is the storage document recording which big-game posts each guild has received
+const bigGamesDocument = "big_games"
+
+// Big-game mode timing and sizes
+const (
+	bigGameCheckInterval  = 2 * time.Minute
+	bigGameHubLeadTime    = 6 * time.Hour  // post the pregame hub this long before kickoff
+	bigGameWatchLength    = 4 * time.Hour  // length of the watch-party event
+	bigGameMVPWindow      = 12 * time.Hour // give up on the MVP poll this long after kickoff
+	bigGameMVPPollHours   = 24
+	bigGameMVPCandidates  = 5
+	bigGameTopPerformers  = 3
+	bigGameFirstRoundWeek = 3 // conference championships; the Super Bowl is postseason week 4
+)
+
+// Big-game post stages
+const (
+	bigGameStageHub      = "hub"
+	bigGameStageHalftime = "halftime"
+	bigGameStageMVP      = "mvp"
+)
+
+// bigGameStore remembers which stages of each big game were posted in each guild so restarts
+// don't repeat them
+type bigGameStore struct {
+	mu     sync.Mutex
+	store  *storage.Store
+	posted map[string]map[string][]string // guild ID -> game ID -> posted stages
+}
+
+// newBigGameStore loads big-game post history from storage
+func newBigGameStore(store *storage.Store) (*bigGameStore, error) {
+	games := &bigGameStore{
+		store:  store,
+		posted: make(map[string]map[string][]string),
+	}
+	if err := store.Load(bigGamesDocument, &games.posted); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+// Claim records a stage as posted for a guild's game, returning false when it already was
+func (gs *bigGameStore) Claim(guildID, gameID, stage string) bool {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	games, exists := gs.posted[guildID]
+	if !exists {
+		games = make(map[string][]string)
+		gs.posted[guildID] = games
+	}
+	for _, posted := range games[gameID] {
+		if posted == stage {
+			return false
+		}
+	}
+	games[gameID] = append(games[gameID], stage)
+
+	if err := gs.store.Save(bigGamesDocument, gs.posted); err != nil {
+		logger.Error("error saving big-game posts", "error", err)
+	}
+	return true
+}
+
+// runBigGameWatcher drives championship weekend and Super Bowl posts until the bot stops
+func (b *Bot) runBigGameWatcher() {
+	ticker := time.NewTicker(bigGameCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.updateBigGames(time.Now())
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// updateBigGames posts whichever hub, halftime, and MVP posts are due for this week's big games
+func (b *Bot) updateBigGames(now time.Time) {
+	channels := b.settings.BigGameChannels()
+	if len(channels) == 0 {
+		return
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		logger.Warn("skipping big-game check", "error", err)
+		return
+	}
+	if seasonInfo.SeasonType != models.SeasonTypePostseason || seasonInfo.Week < bigGameFirstRoundWeek {
+		return
+	}
+
+	scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		logger.Warn("skipping big-game check", "error", err)
+		return
+	}
+
+	for _, game := range scores {
+		var stage string
+		switch {
+		case game.IsCompleted():
+			if now.Before(game.GameTime.Add(bigGameMVPWindow)) {
+				stage = bigGameStageMVP
+			}
+		case game.IsLive():
+			if isHalftime(game) {
+				stage = bigGameStageHalftime
+			}
+		case now.After(game.GameTime.Add(-bigGameHubLeadTime)) && now.Before(game.GameTime):
+			stage = bigGameStageHub
+		}
+		if stage == "" {
+			continue
+		}
+
+		for guildID, channelID := range channels {
+			if !b.bigGames.Claim(guildID, game.GameID, stage) {
+				continue
+			}
+			switch stage {
+			case bigGameStageHub:
+				b.postBigGameHub(guildID, channelID, seasonInfo, game, now)
+			case bigGameStageHalftime:
+				b.postBigGameHalftime(channelID, seasonInfo, game)
+			case bigGameStageMVP:
+				b.postBigGameMVP(channelID, seasonInfo, game)
+			}
+		}
+	}
+}
+
+// isHalftime reports whether a live game is at the half
+func isHalftime(game *models.LiveScore) bool {
+	return strings.HasPrefix(strings.ToLower(game.Quarter), "half")
+}
+
+// postBigGameHub posts the pregame hub: odds, prop and prediction polls, and an optional watch party
+func (b *Bot) postBigGameHub(guildID, channelID string, seasonInfo *models.SeasonInfo, game *models.LiveScore, now time.Time) {
+	var line models.Game
+	if lines, err := b.pickemLines(seasonInfo); err != nil {
+		logger.Warn("big-game hub posted without odds", "game", game.GameID, "error", err)
+	} else {
+		line = lines[game.GameID]
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏆 %s: %s @ %s", seasonInfo.WeekLabel(), game.AwayTeam, game.HomeTeam),
+		Description: "Kickoff " + embeds.FormatKickoff(game.GameTime, b.guildLocation(guildID), "Mon Jan 2, 3:04 PM", b.guildFormat(guildID)),
+		Color:       0xFFD700,
+		Fields:      bigGameOddsFields(game, line),
+		Footer:      &discordgo.MessageEmbedFooter{Text: "Props and prediction polls close at kickoff"},
+	}
+	if line.Stadium != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "🏟️ Stadium", Value: line.Stadium, Inline: true})
+	}
+	b.themeEmbedForTeam(embed, game.HomeTeam)
+	if _, err := b.discord.ChannelMessageSendEmbed(channelID, embed); err != nil {
+		logger.Error("error posting big-game hub", "channel", channelID, "error", err)
+		return
+	}
+
+	// Native polls take whole hours; a poll posted late still runs at least one
+	hours := int(game.GameTime.Sub(now).Hours())
+	if hours < 1 {
+		hours = 1
+	}
+	for _, poll := range bigGamePropPolls(game, line, hours) {
+		if _, err := b.discord.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{Poll: poll}); err != nil {
+			logger.Error("error posting big-game prop poll", "channel", channelID, "error", err)
+		}
+	}
+
+	if err := b.postPrediction(channelID, seasonInfo, game); err != nil {
+		logger.Error("error posting big-game prediction", "channel", channelID, "error", err)
+	}
+
+	if b.settings.Get(guildID).BigGameWatchParty {
+		b.createWatchParty(guildID, channelID, seasonInfo, game)
+	}
+}
+
+// bigGameOddsFields lists a game's spread and total when lines are posted
+func bigGameOddsFields(game *models.LiveScore, line models.Game) []*discordgo.MessageEmbedField {
+	var fields []*discordgo.MessageEmbedField
+	if line.PointSpread != nil {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "📈 Spread",
+			Value:  fmt.Sprintf("%s %s / %s %s", game.AwayTeam, formatSpread(-*line.PointSpread), game.HomeTeam, formatSpread(*line.PointSpread)),
+			Inline: true,
+		})
+	}
+	if line.OverUnder != nil {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "🎯 Total",
+			Value:  fmt.Sprintf("O/U %.1f", *line.OverUnder),
+			Inline: true,
+		})
+	}
+	if len(fields) == 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "📈 Odds", Value: "No lines posted yet"})
+	}
+	return fields
+}
+
+// bigGamePropPolls builds the pregame prop polls; the total poll needs a posted over/under
+func bigGamePropPolls(game *models.LiveScore, line models.Game, hours int) []*discordgo.Poll {
+	polls := []*discordgo.Poll{
+		{
+			Question: discordgo.PollMedia{Text: fmt.Sprintf("Prop: who scores first, %s or %s?", game.AwayTeam, game.HomeTeam)},
+			Answers:  []discordgo.PollAnswer{pollAnswer(game.AwayTeam), pollAnswer(game.HomeTeam)},
+			Duration: hours,
+		},
+	}
+	if line.OverUnder != nil {
+		polls = append(polls, &discordgo.Poll{
+			Question: discordgo.PollMedia{Text: fmt.Sprintf("Prop: over or under %.1f total points?", *line.OverUnder)},
+			Answers:  []discordgo.PollAnswer{pollAnswer("Over"), pollAnswer("Under")},
+			Duration: hours,
+		})
+	}
+	return polls
+}
+
+// pollAnswer builds a native poll answer
+func pollAnswer(text string) discordgo.PollAnswer {
+	return discordgo.PollAnswer{Media: &discordgo.PollMedia{Text: text}}
+}
+
+// createWatchParty schedules a server event spanning the game
+func (b *Bot) createWatchParty(guildID, channelID string, seasonInfo *models.SeasonInfo, game *models.LiveScore) {
+	start := game.GameTime
+	end := start.Add(bigGameWatchLength)
+	location := "Discord"
+	if channel, err := b.discord.State.Channel(channelID); err == nil {
+		location = "#" + channel.Name
+	}
+
+	_, err := b.discord.GuildScheduledEventCreate(guildID, &discordgo.GuildScheduledEventParams{
+		Name:               fmt.Sprintf("%s Watch Party: %s @ %s", seasonInfo.WeekLabel(), game.AwayTeam, game.HomeTeam),
+		Description:        "Watch the game with the server — odds, props, and the prediction poll are up in " + location + ".",
+		ScheduledStartTime: &start,
+		ScheduledEndTime:   &end,
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+		EntityType:         discordgo.GuildScheduledEventEntityTypeExternal,
+		EntityMetadata:     &discordgo.GuildScheduledEventEntityMetadata{Location: location},
+	})
+	if err != nil {
+		logger.Error("error creating watch-party event", "guild", guildID, "error", err)
+	}
+}
+
+// postBigGameHalftime posts the halftime score and the top fantasy performers so far
+func (b *Bot) postBigGameHalftime(channelID string, seasonInfo *models.SeasonInfo, game *models.LiveScore) {
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("⏸️ Halftime: %s %d - %d %s", game.AwayTeam, game.AwayScore, game.HomeScore, game.HomeTeam),
+		Description: seasonInfo.WeekLabel(),
+		Color:       0xFFD700,
+	}
+
+	performers, err := b.bigGamePerformers(seasonInfo, game)
+	if err != nil {
+		logger.Warn("halftime recap posted without performers", "game", game.GameID, "error", err)
+	} else if len(performers) > 0 {
+		if len(performers) > bigGameTopPerformers {
+			performers = performers[:bigGameTopPerformers]
+		}
+		var lines []string
+		for _, player := range performers {
+			lines = append(lines, fmt.Sprintf("**%s** (%s %s) — %s", player.Name, player.Team, player.Position, b.keyStatLine(player, b.guildFormat(b.channelGuildID(channelID)))))
+		}
+		embed.Fields = []*discordgo.MessageEmbedField{{Name: "⭐ First-half standouts", Value: strings.Join(lines, "\n")}}
+	}
+
+	b.postAutomated(channelID, "", embed)
+}
+
+// postBigGameMVP posts a native poll for the game's MVP among its top fantasy performers
+func (b *Bot) postBigGameMVP(channelID string, seasonInfo *models.SeasonInfo, game *models.LiveScore) {
+	performers, err := b.bigGamePerformers(seasonInfo, game)
+	if err != nil || len(performers) < 2 {
+		logger.Warn("skipping MVP poll without player stats", "game", game.GameID, "error", err)
+		return
+	}
+	if len(performers) > bigGameMVPCandidates {
+		performers = performers[:bigGameMVPCandidates]
+	}
+
+	poll := &discordgo.Poll{
+		Question: discordgo.PollMedia{Text: fmt.Sprintf("%s MVP: %s @ %s — who was the best player?", seasonInfo.WeekLabel(), game.AwayTeam, game.HomeTeam)},
+		Duration: bigGameMVPPollHours,
+	}
+	for _, player := range performers {
+		poll.Answers = append(poll.Answers, pollAnswer(truncateName(fmt.Sprintf("%s (%s %s)", player.Name, player.Team, player.Position), 55)))
+	}
+
+	b.sendAutomated(&DelayedPost{
+		ChannelID: channelID,
+		Content:   fmt.Sprintf("🏁 **Final:** %s %d - %d %s", game.AwayTeam, game.AwayScore, game.HomeScore, game.HomeTeam),
+		Poll:      poll,
+	})
+}
+
+// bigGamePerformers returns both teams' players ordered by PPR fantasy points in the game
+func (b *Bot) bigGamePerformers(seasonInfo *models.SeasonInfo, game *models.LiveScore) ([]*models.PlayerStats, error) {
+	players, err := b.nflClient.GetTeamsWeekStats(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week, game.AwayTeam, game.HomeTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make(map[*models.PlayerStats]float64, len(players))
+	var scored []*models.PlayerStats
+	for _, player := range players {
+		if value := b.fantasyPoints(player, 1); value > 0 {
+			points[player] = value
+			scored = append(scored, player)
+		}
+	}
+	sort.SliceStable(scored, func(i, j int) bool { return points[scored[i]] > points[scored[j]] })
+	return scored, nil
+}
+
+// handleSlashBigGames handles the /big-games slash command (admin only)
+func (b *Bot) handleSlashBigGames(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	var channelID string
+	watchParty := false
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "channel":
+			channelID = option.ChannelValue(nil).ID
+		case "watch_party":
+			watchParty = option.BoolValue()
+		}
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.BigGameChannelID = channelID
+		settings.BigGameWatchParty = channelID != "" && watchParty
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "big_games.save_failed"))
+		return
+	}
+
+	if channelID == "" {
+		respondEphemeral(s, i, b.tr(i, "big_games.disabled"))
+		return
+	}
+	message := b.tr(i, "big_games.enabled", channelID)
+	if watchParty {
+		message += b.tr(i, "big_games.watch_party")
+	}
+	respondEphemeral(s, i, message)
+}
@@ -2,18 +2,26 @@ package bot
 
 import (
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/cache"
 	"nfl-discord-bot/internal/config"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/i18n"
+	"nfl-discord-bot/internal/logging"
 	"nfl-discord-bot/internal/nfl"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/internal/trivia"
 	"nfl-discord-bot/pkg/models"
 )
 
+// logger tags bot log entries with their module
+var logger = logging.For("bot")
+
 // Bot represents the Discord bot
 type Bot struct {
 	discord       *discordgo.Session
@@ -22,7 +30,35 @@ type Bot struct {
 	silenceEnd    time.Time
 	allowedRole   string
 	visibilityRole string
+	ownerID       string
 	commands      []*discordgo.ApplicationCommand
+	registrar     *commandRegistrar
+	store         *storage.Store
+	settings      *settingsStore
+	preferences   *preferencesStore
+	reminders     *reminderStore
+	pickem        *pickemStore
+	predictions   *predictionStore
+	triviaBank    *trivia.Bank
+	triviaScores  *triviaScoreStore
+	triviaRounds  *triviaRounds
+	closingLines  *closingLineStore
+	comparisons   *comparisonCache
+	reveals       *revealCache
+	seenPlays     *playTracker
+	outlooks      *outlookCache
+	mockDrafts    *mockDraftManager
+	startedAt     time.Time
+	commandCounts *commandCounter
+	web           *webServer
+	maintenance   *maintenanceLog
+	responses     *responseManager
+	delayedPosts  *delayedPostQueue
+	bigGames      *bigGameStore
+	gameThreads   *gameThreadStore
+	milestones    *milestoneStore
+	elo           *eloStore
+	done          chan struct{}
 }
 
 // New creates a new Discord bot instance
@@ -33,8 +69,79 @@ func New(cfg *config.Config) (*Bot, error) {
 		return nil, fmt.Errorf("error creating Discord session: %v", err)
 	}
 
-	// Create NFL client
-	nflClient := nfl.NewClient(cfg.NFLAPIKey, cfg.NFLAPIBaseURL)
+	// Create response cache and NFL client
+	responseCache, err := cache.New(cfg.CacheBackend, cfg.RedisURL, cfg.RedisKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cache: %v", err)
+	}
+	nflClient := nfl.NewClient(cfg.NFLAPIKey, cfg.NFLAPIBaseURL, responseCache)
+	nflClient.SetCacheTTL(nfl.CacheScores, cfg.CacheTTLScores)
+	nflClient.SetCacheTTL(nfl.CachePlayerStats, cfg.CacheTTLPlayerStats)
+	nflClient.SetCacheTTL(nfl.CacheSchedule, cfg.CacheTTLSchedule)
+	nflClient.SetCacheTTL(nfl.CacheTeams, cfg.CacheTTLTeams)
+	nflClient.SetCacheTTL(nfl.CacheStandings, cfg.CacheTTLStandings)
+	nflClient.SetCacheTTL(nfl.CacheOdds, cfg.CacheTTLOdds)
+	nflClient.SetCacheTTL(nfl.CacheStale, cfg.CacheTTLStale)
+	nflClient.SetLegacyNameMatching(cfg.LegacyNameMatching)
+
+	// Open persistent storage for guild settings and subsystem state
+	store, err := storage.New(cfg.DataDir)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := newSettingsStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading guild settings: %v", err)
+	}
+	preferences, err := newPreferencesStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading user preferences: %v", err)
+	}
+	reminders, err := newReminderStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading reminders: %v", err)
+	}
+	pickem, err := newPickemStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading pick'em pools: %v", err)
+	}
+	predictions, err := newPredictionStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading predictions: %v", err)
+	}
+	triviaBank, err := trivia.Load(cfg.TriviaQuestionsFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading trivia questions: %v", err)
+	}
+	triviaScores, err := newTriviaScoreStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading trivia scores: %v", err)
+	}
+	closingLines, err := newClosingLineStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading closing lines: %v", err)
+	}
+	delayedPosts, err := newDelayedPostQueue(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading delayed posts: %v", err)
+	}
+	bigGames, err := newBigGameStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading big-game posts: %v", err)
+	}
+	gameThreads, err := newGameThreadStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading game threads: %v", err)
+	}
+	milestones, err := newMilestoneStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading milestones: %v", err)
+	}
+
+	eloRatings, err := newEloStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("error loading elo ratings: %v", err)
+	}
 
 	bot := &Bot{
 		discord:       dg,
@@ -43,10 +150,39 @@ func New(cfg *config.Config) (*Bot, error) {
 		silenceEnd:    time.Time{},
 		allowedRole:   os.Getenv("BOT_ALLOWED_ROLE"),
 		visibilityRole: os.Getenv("BOT_VISIBILITY_ROLE"),
+		ownerID:       os.Getenv("BOT_OWNER_ID"),
+		store:         store,
+		settings:      settings,
+		preferences:   preferences,
+		reminders:     reminders,
+		pickem:        pickem,
+		predictions:   predictions,
+		triviaBank:    triviaBank,
+		triviaScores:  triviaScores,
+		triviaRounds:  newTriviaRounds(),
+		closingLines:  closingLines,
+		comparisons:   newComparisonCache(),
+		reveals:       newRevealCache(),
+		seenPlays:     newPlayTracker(),
+		outlooks:      newOutlookCache(),
+		mockDrafts:    newMockDraftManager(),
+		startedAt:     time.Now(),
+		commandCounts: newCommandCounter(),
+		maintenance:   &maintenanceLog{},
+		responses:     newResponseManager(),
+		delayedPosts:  delayedPosts,
+		bigGames:      bigGames,
+		gameThreads:   gameThreads,
+		milestones:    milestones,
+		elo:           eloRatings,
+		done:          make(chan struct{}),
 	}
 
 	// Initialize slash commands after bot creation
 	bot.commands = bot.createSlashCommands()
+	addPublicOptions(bot.commands)
+	localizeCommands(bot.commands)
+	bot.registrar = newCommandRegistrar(dg, cfg.DevGuildID)
 
 	// Register message handler and interaction handler
 	dg.AddHandler(bot.messageCreate)
@@ -62,22 +198,48 @@ func (b *Bot) Start() error {
 		return fmt.Errorf("error opening connection: %v", err)
 	}
 
-	// Register slash commands
-	log.Println("Registering slash commands...")
-	for _, cmd := range b.commands {
-		_, err := b.discord.ApplicationCommandCreate(b.discord.State.User.ID, "", cmd)
-		if err != nil {
-			log.Printf("Cannot create '%v' command: %v", cmd.Name, err)
-		}
+	// Register slash commands, updating only what changed since the last start
+	logger.Info("registering slash commands", "scope", b.registrar.scope())
+	if err := b.registrar.Sync(b.commands); err != nil {
+		logger.Error("error syncing slash commands", "error", err)
+	}
+
+	// Start background jobs
+	go b.runPlayoffAlerts()
+	go b.runPrefetcher(b.config.StatsUpdateInterval)
+	go b.runReminderDispatcher()
+	go b.runPredictionWatcher()
+	go b.runMaintenance()
+	go b.runWatchlistSummaries()
+	go b.runSlowModeWatcher()
+	go b.runDelayedPostDispatcher()
+	go b.runBigGameWatcher()
+	go b.runGameThreadWatcher()
+	go b.runSchemaDriftReporter()
+	go b.runDigestWatcher()
+	go b.runMilestoneWatcher()
+	go b.runDraftFeed()
+
+	// Serve public leaderboard pages when enabled
+	if b.config.WebAddr != "" {
+		b.startWebServer(b.config.WebAddr)
 	}
 
-	log.Println("Discord bot is now running with slash commands")
+	logger.Info("discord bot is now running with slash commands")
 	return nil
 }
 
 // Stop stops the Discord bot
 func (b *Bot) Stop() {
+	close(b.done)
+	b.stopWebServer()
+	if b.config.DeregisterCommandsOnStop {
+		b.registrar.Deregister()
+	}
 	b.discord.Close()
+	if err := b.nflClient.Close(); err != nil {
+		logger.Error("error closing NFL client cache", "error", err)
+	}
 }
 
 // createSlashCommands defines the slash commands for the bot
@@ -94,8 +256,8 @@ func (b *Bot) createSlashCommands() []*discordgo.ApplicationCommand {
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
 					Name:        "player",
-					Description: "Player name",
-					Required:    true,
+					Description: "Player name (default: your /favorite player)",
+					Required:    false,
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
@@ -110,9 +272,9 @@ func (b *Bot) createSlashCommands() []*discordgo.ApplicationCommand {
 				{
 					Type:        discordgo.ApplicationCommandOptionInteger,
 					Name:        "week",
-					Description: "Specific week number (1-18)",
+					Description: "Specific week number (1-18, preseason 0-4)",
 					Required:    false,
-					MinValue:    &[]float64{1}[0],
+					MinValue:    &[]float64{0}[0],
 					MaxValue:    18,
 				},
 				{
@@ -121,75 +283,1455 @@ func (b *Bot) createSlashCommands() []*discordgo.ApplicationCommand {
 					Description: "Year (defaults to current season)",
 					Required:    false,
 				},
+				seasonTypeOption(),
+				perGameOption(),
+			},
+		},
+		{
+			Name:        "multistat",
+			Description: "Check several players' stat lines for a week at once",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "players",
+					Description: "Up to 8 player names separated by commas",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "week",
+					Description: "Specific week number (defaults to the current week)",
+					Required:    false,
+					MinValue:    &[]float64{0}[0],
+					MaxValue:    18,
+				},
+				seasonTypeOption(),
+			},
+		},
+		{
+			Name:        "track",
+			Description: "Get an announcement when a player reaches a season milestone (omit both options to list)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "player",
+					Description: "Player name",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "milestone",
+					Description: "Season total to watch for, e.g. 1000 rushing yards",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "webhook",
+					Description: "Discord or HTTP webhook URL to announce the milestone to instead of this channel",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "trend",
+			Description: "Chart a player's week-by-week season",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "player",
+					Description: "Player name (default: your /favorite player)",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "stat",
+					Description: "Stat to chart (default fantasy)",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Yards", Value: trendStatYards},
+						{Name: "Touchdowns", Value: trendStatTDs},
+						{Name: "Fantasy points (PPR)", Value: trendStatFantasy},
+						{Name: "Target share", Value: trendStatTargetShare},
+						{Name: "Snap share", Value: trendStatSnapShare},
+					},
+				},
 			},
 		},
 		{
 			Name:        "compare",
 			Description: "Compare two players",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "players",
+					Description: "Compare two players",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "player1",
+							Description: "First player name",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "player2",
+							Description: "Second player name",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "type",
+							Description: "Comparison type",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Current Week", Value: "current"},
+								{Name: "Season", Value: "season"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "week",
+							Description: "Specific week number (1-18)",
+							Required:    false,
+							MinValue:    &[]float64{1}[0],
+							MaxValue:    18,
+						},
+						perGameOption(),
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "rematch",
+					Description: "Re-run your last comparison with fresh stats",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "history",
+					Description: "Your recent comparisons, with buttons to re-run them",
+				},
+			},
+		},
+		{
+			Name:        "team",
+			Description: "Get team information",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name, city, or abbreviation (default: your /favorite team)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "coaches",
+			Description: "A team's head coach, coordinators, and schemes",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name, city, or abbreviation (default: your /favorite team)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "history",
+			Description: "Franchise history: titles, all-time record, and retired numbers",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name, city, or abbreviation (default: your /favorite team)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "next",
+			Description: "Who, when, where, and on what channel a team plays next",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name, city, or abbreviation (default: your /favorite team)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "drives",
+			Description: "Drive chart for a game this week: start, plays, yards, and result",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "game",
+					Description: "Matchup or team (e.g., BUF @ KC, Bills)",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "plays",
+			Description: "The latest plays of a team's game as a drive log",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name, city, or abbreviation",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "count",
+					Description: "Number of plays (default 10)",
+					Required:    false,
+					MinValue:    &playLogMinCount,
+					MaxValue:    playLogMaxCount,
+				},
+			},
+		},
+		{
+			Name:        "schedule",
+			Description: "Get team schedule",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name, city, or abbreviation (default: your /favorite team)",
+					Required:    false,
+				},
+				seasonTypeOption(),
+			},
+		},
+		{
+			Name:        "scores",
+			Description: "Get current week's scores",
+			Options: []*discordgo.ApplicationCommandOption{
+				seasonTypeOption(),
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "week",
+					Description: "Specific week number (1-18, preseason 0-4)",
+					Required:    false,
+					MinValue:    &[]float64{0}[0],
+					MaxValue:    18,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "status",
+					Description: "Only show games in progress, finished, or not started",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Live", Value: scoresStatusLive},
+						{Name: "Final", Value: scoresStatusFinal},
+						{Name: "Upcoming", Value: scoresStatusUpcoming},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Only show this team's game",
+					Required:    false,
+				},
+				conferenceChoiceOption(),
+			},
+		},
+		{
+			Name:        "wintotals",
+			Description: "Each team's win pace vs their preseason over/under",
+		},
+		{
+			Name:        "whattowatch",
+			Description: "This week's nationally televised games and where to watch them",
+		},
+		{
+			Name:        "recap",
+			Description: "A completed week's blowout, closest game, shootout, top performers, and upsets",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "week",
+					Description: "Regular season week (default: the most recent completed week)",
+					Required:    false,
+					MinValue:    &[]float64{1}[0],
+					MaxValue:    18,
+				},
+			},
+		},
+		{
+			Name:        "standings",
+			Description: "Division standings with clinch status and magic numbers",
+			Options: []*discordgo.ApplicationCommandOption{
+				conferenceChoiceOption(),
+			},
+		},
+		{
+			Name:        "division",
+			Description: "Division standings, head-to-head results, and remaining division games",
+			Options: []*discordgo.ApplicationCommandOption{
+				divisionChoiceOption(),
+			},
+		},
+		{
+			Name:        "playoffodds",
+			Description: "A team's simulated chances to make the playoffs and win the division",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name, city, or abbreviation",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "powerrankings",
+			Description: "Every team ranked by Elo rating with this week's movement",
+		},
+		{
+			Name:        "playoffpicture",
+			Description: "Current playoff seeds, teams in the hunt, and eliminated teams",
+			Options: []*discordgo.ApplicationCommandOption{
+				conferenceChoiceOption(),
+			},
+		},
+		{
+			Name:        "whatif",
+			Description: "Standings and playoff seeding under hypothetical results for this week",
 			Options: []*discordgo.ApplicationCommandOption{
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "player1",
-					Description: "First player name",
+					Name:        "results",
+					Description: "Comma separated results, e.g. BUF over KC, DAL over PHI",
 					Required:    true,
 				},
+				conferenceChoiceOption(),
+			},
+		},
+		{
+			Name:        "scenarios",
+			Description: "What a team can clinch or be eliminated from this week",
+			Options: []*discordgo.ApplicationCommandOption{
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "player2",
-					Description: "Second player name",
+					Name:        "team",
+					Description: "Team name (e.g. Bills, KC, New England)",
 					Required:    true,
 				},
+			},
+		},
+		{
+			Name:        "safepicks",
+			Description: "A week's games ranked by the model's safest winners, for pick'em and survivor pools",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "week",
+					Description: "Regular season week (defaults to the current week)",
+					Required:    false,
+					MinValue:    &[]float64{1}[0],
+					MaxValue:    18,
+				},
+			},
+		},
+		{
+			Name:        "draftorder",
+			Description: "Projected draft order if the season ended today",
+		},
+		{
+			Name:        "draft",
+			Description: "NFL Draft order, draft classes, and a live pick feed",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "order",
+					Description: "Draft order from the standings (projected until the regular season ends)",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "picks",
+					Description: "A team's draft class",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "team",
+							Description: "Team name (e.g. Bills, KC, New England)",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "year",
+							Description: "Draft year (default: the most recent draft)",
+							Required:    false,
+							MinValue:    &draftMinYear,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "feed",
+					Description: "Post every pick live during the draft (Manage Server only; omit channel to disable)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "channel",
+							Description:  "Channel to post picks in",
+							Required:     false,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "follow",
+			Description: "Follow a team for personalized annotations, or a player for a stat line after each game",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name (e.g. Bills, KC, New England)",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "player",
+					Description: "Player name (e.g. Josh Allen)",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "deliver",
+					Description: "Where the player's stat line goes (default: DM)",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Direct message", Value: "dm"},
+						{Name: "Ping me in this channel", Value: "here"},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "webhook",
+					Description: "Discord or HTTP webhook URL to send the player's stat line to instead",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "unfollow",
+			Description: "Stop following a team or player",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name (e.g. Bills, KC, New England)",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "player",
+					Description: "Player name",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "mockdraft",
+			Description: "Run a fantasy mock draft in a thread",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "start",
+					Description: "Open a mock draft lobby",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "teams",
+							Description: "Number of teams",
+							Required:    true,
+							MinValue:    &mockDraftMinTeams,
+							MaxValue:    14,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "rounds",
+							Description: "Number of rounds (default 15)",
+							Required:    false,
+							MinValue:    &mockDraftMinRounds,
+							MaxValue:    20,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "pick_clock",
+							Description: "Seconds per pick (default 60)",
+							Required:    false,
+							MinValue:    &mockDraftMinClock,
+							MaxValue:    300,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "draftkit",
+			Description: "Positional rankings and auction values for draft season",
+			Options: []*discordgo.ApplicationCommandOption{
+				scoringFormatOption("Scoring format (defaults to this server's format)"),
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "position",
+					Description: "Show one position in full",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "QB", Value: "QB"},
+						{Name: "RB", Value: "RB"},
+						{Name: "WR", Value: "WR"},
+						{Name: "TE", Value: "TE"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "rookies",
+			Description: "This season's top rookies by fantasy points",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "position",
+					Description: "Only rank one position",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "QB", Value: "QB"},
+						{Name: "RB", Value: "RB"},
+						{Name: "WR", Value: "WR"},
+						{Name: "TE", Value: "TE"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "waivers",
+			Description: "Players trending up over the last two weeks who weren't producing before",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "position",
+					Description: "Only suggest one position",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "QB", Value: "QB"},
+						{Name: "RB", Value: "RB"},
+						{Name: "WR", Value: "WR"},
+						{Name: "TE", Value: "TE"},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionNumber,
+					Name:        "rostered_threshold",
+					Description: "Most fantasy points per game before the last two weeks (default 8)",
+					Required:    false,
+					MinValue:    &waiverMinThreshold,
+				},
+			},
+		},
+		{
+			Name:        "value",
+			Description: "The coming week's best DFS values: projected points per $1k of salary",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "position",
+					Description: "Position to rank",
+					Required:    true,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "QB", Value: "QB"},
+						{Name: "RB", Value: "RB"},
+						{Name: "WR", Value: "WR"},
+						{Name: "TE", Value: "TE"},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "site",
+					Description: "DFS site (defaults to DraftKings)",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "DraftKings", Value: models.SiteDraftKings},
+						{Name: "FanDuel", Value: models.SiteFanDuel},
+					},
+				},
+			},
+		},
+		{
+			Name:        "matchup-player",
+			Description: "How a player's next opponent defends their position",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "player",
+					Description: "Player name (e.g. Josh Allen)",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "remind",
+			Description: "Get reminded before kickoff",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "game",
+					Description: "Remind me before a team's next game",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "team",
+							Description: "Team name (e.g. Bills, KC, New England)",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "minutes_before",
+							Description: "Minutes before kickoff (default 30)",
+							Required:    false,
+							MinValue:    &reminderMinMinutes,
+							MaxValue:    1440,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "deliver",
+							Description: "Where to send the reminder (default DM)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Direct message", Value: "dm"},
+								{Name: "Ping me in this channel", Value: "here"},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show your pending reminders",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "cancel",
+					Description: "Cancel a reminder",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "id",
+							Description: "Reminder ID from /remind list",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "botstats",
+			Description: "Bot health: uptime, API usage, cache hit rate, memory (admins only)",
+		},
+		{
+			Name:        "ats",
+			Description: "Against-the-spread records graded on closing lines",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team for game-by-game results (omit for the league table)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "futures",
+			Description: "A team's Super Bowl, conference, and division odds vs the bot's simulation",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "team",
+					Description: "Team name or abbreviation",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "trivia",
+			Description: "NFL trivia for the whole server",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "play",
+					Description: "Post a trivia question",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "difficulty",
+							Description: "Question difficulty (default: any)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Easy (1 pt)", Value: trivia.Easy},
+								{Name: "Medium (2 pts)", Value: trivia.Medium},
+								{Name: "Hard (3 pts)", Value: trivia.Hard},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "leaderboard",
+					Description: "Server trivia standings",
+				},
+			},
+		},
+		{
+			Name:        "predict",
+			Description: "Post a poll on who wins a game this week",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "game",
+					Description: "Matchup or team (e.g., BUF @ KC, Bills)",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "league",
+			Description: "Fantasy league dates and time zone",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "dates",
+					Description: "League deadlines with automatic reminders",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "set",
+							Description: "Set a league date (Manage Server only)",
+							Options: []*discordgo.ApplicationCommandOption{
+								leagueEventOption(),
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "date",
+									Description: "Date as YYYY-MM-DD",
+									Required:    true,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "time",
+									Description: "Time as 24-hour HH:MM in the league time zone (default 12:00)",
+									Required:    false,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "clear",
+							Description: "Remove a league date and its reminders (Manage Server only)",
+							Options: []*discordgo.ApplicationCommandOption{
+								leagueEventOption(),
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "list",
+							Description: "Show upcoming league dates",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "timezone",
+					Description: "Show or set the server time zone for league dates and game times (setting requires Manage Server)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "zone",
+							Description: "IANA time zone, e.g. America/Chicago",
+							Required:    false,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "watchlist",
+			Description: "Players and teams to get a weekly summary of",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Add a player and/or team to your watch list",
+					Options:     watchlistEntryOptions(),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove a player and/or team from your watch list",
+					Options:     watchlistEntryOptions(),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show your watch list",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "summary",
+					Description: "Turn the Monday DM summary of your watch list on or off",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "enabled",
+							Description: "Send the weekly DM",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "today",
+			Description: "Today's games, player statuses, pick'em deadlines, and reminders for what you follow",
+			Options: []*discordgo.ApplicationCommandOption{
+			},
+		},
+		{
+			Name:        "timezone",
+			Description: "Show or set the time zone game times are shown to you in",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "zone",
+					Description: "IANA time zone, e.g. America/Chicago, or reset to use the server's",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "favorite",
+			Description: "Your default team and player for commands where you leave them out",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "set",
+					Description: "Set your favorite team and/or player",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "team",
+							Description: "Team name, city, or abbreviation",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "player",
+							Description: "Player name (e.g. Josh Allen)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show your favorite team and player",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "clear",
+					Description: "Clear your favorites",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "which",
+							Description: "Clear only one favorite (default both)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Team", Value: favoriteTeam},
+								{Name: "Player", Value: favoritePlayer},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "alias",
+			Description: "Server shorthand for players and teams, e.g. cmc for Christian McCaffrey",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Add or replace an alias (Manage Server only)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "alias",
+							Description: "One-word shorthand, e.g. cmc",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "The player or team it stands for",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove an alias (Manage Server only)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "alias",
+							Description: "The alias to remove",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List this server's aliases",
+				},
+			},
+		},
+		{
+			Name:        "pickem",
+			Description: "Weekly pick'em: pick the winner of every game",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "create",
+					Description: "Start a pick'em pool for this server (Manage Server only)",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "picks",
+					Description: "Make or change your picks for this week",
+					Options:     []*discordgo.ApplicationCommandOption{pickemKindOption()},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "leaderboard",
+					Description: "Season and weekly standings",
+					Options:     []*discordgo.ApplicationCommandOption{pickemKindOption()},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "league",
+					Description: "Compete with other servers in a shared pick'em league",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "create",
+							Description: "Start a league other servers can join with its invite code (Manage Server only)",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "name",
+									Description: "League name",
+									Required:    true,
+									MaxLength:   50,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "join",
+							Description: "Join another server's league (Manage Server only)",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "code",
+									Description: "The league's invite code",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "leave",
+							Description: "Take this server out of its league (Manage Server only)",
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "standings",
+							Description: "The league's combined season standings across servers",
+							Options:     []*discordgo.ApplicationCommandOption{pickemKindOption()},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:                     "scoring",
+			Description:              "Set this server's fantasy scoring format",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				func() *discordgo.ApplicationCommandOption {
+					option := scoringFormatOption("Fantasy scoring format")
+					option.Required = true
+					return option
+				}(),
+			},
+		},
+		{
+			Name:                     "leaderboard-page",
+			Description:              "Get a shareable web page of this server's pick'em and trivia leaderboards",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "rotate",
+					Description: "Replace the link so the old one stops working",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "owner",
+			Description: "Bot owner tools",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "storage",
+					Description: "Persisted data",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "stats",
+							Description: "Document sizes, retention settings, and the last maintenance run",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:                     "visibility",
+			Description:              "Set whether command responses are public or private by default in this server",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "default",
+					Description: "Default visibility of command responses",
+					Required:    true,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Public (everyone sees responses)", Value: visibilityPublic},
+						{Name: "Private (only the user sees responses)", Value: visibilityPrivate},
+						{Name: "Reset to the bot default", Value: visibilityReset},
+					},
+				},
+			},
+		},
+		{
+			Name:                     "language",
+			Description:              "Show or set the language of the bot's responses in this server",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "language",
+					Description: "Language of the bot's responses",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: i18n.Name(i18n.English), Value: i18n.English},
+						{Name: i18n.Name(i18n.Spanish), Value: i18n.Spanish},
+						{Name: "Follow the server's Discord language", Value: languageAuto},
+					},
+				},
+			},
+		},
+		{
+			Name:                     "formatting",
+			Description:              "Show or set how numbers, distances, and game times look in this server",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "thousands",
+					Description: "Thousands separator in stats",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Comma (4,306)", Value: models.SeparatorComma},
+						{Name: "Space (4 306)", Value: models.SeparatorSpace},
+						{Name: "None (4306)", Value: models.SeparatorNone},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "distance",
+					Description: "Show yardage in yards or meters",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Yards", Value: distanceYards},
+						{Name: "Meters", Value: distanceMeters},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "clock",
+					Description: "Show game times on a 12-hour or 24-hour clock",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "12-hour (1:00 PM)", Value: clock12},
+						{Name: "24-hour (13:00)", Value: clock24},
+					},
+				},
+			},
+		},
+		{
+			Name:                     "slowmode",
+			Description:              "Automatic slow mode in channels while games are on",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Turn on slow mode in a channel during games (replaces its existing rule)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "channel",
+							Description:  "Channel to slow down on game day",
+							Required:     true,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "teams",
+							Description: "Teams whose games trigger slow mode, comma separated (default: every game)",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "seconds",
+							Description: "Slow mode delay in seconds (default 10)",
+							Required:    false,
+							MinValue:    &slowModeMinSeconds,
+							MaxValue:    slowModeMaxSeconds,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Stop automatic slow mode in a channel",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "channel",
+							Description:  "Channel to remove the rule from",
+							Required:     true,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show this server's game-day slow mode rules",
+				},
+			},
+		},
+		{
+			Name:                     "spoiler-delay",
+			Description:              "Delay automated score posts and alerts for tape-delay viewers (omit minutes to show)",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "minutes",
+					Description: "Minutes to hold posts back (0 turns the delay off)",
+					Required:    false,
+					MinValue:    &spoilerDelayMinMinutes,
+					MaxValue:    maxSpoilerDelayMinutes,
+				},
+			},
+		},
+		{
+			Name:                     "spoiler-mode",
+			Description:              "Hide scores behind spoiler tags or a reveal button (omit mode to show)",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "mode",
+					Description: "How scores are shown in this server",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Off (show scores)", Value: spoilerModeOff},
+						{Name: "Spoiler tags", Value: embeds.SpoilersTagged},
+						{Name: "Hidden (live/final only)", Value: embeds.SpoilersHidden},
+					},
+				},
+			},
+		},
+		{
+			Name:                     "big-games",
+			Description:              "Post conference championship and Super Bowl hubs, recaps, and polls (omit channel to disable)",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "channel",
+					Description:  "Channel for big-game posts",
+					Required:     false,
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "watch_party",
+					Description: "Also schedule a watch-party server event for each game (default false)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:                     "game-threads",
+			Description:              "Open a thread per game at kickoff with score updates (omit channel to disable)",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "channel",
+					Description:  "Channel to open game threads in",
+					Required:     false,
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+				},
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "type",
-					Description: "Comparison type",
+					Name:        "teams",
+					Description: "Teams whose games get threads, comma separated (default: every game)",
 					Required:    false,
-					Choices: []*discordgo.ApplicationCommandOptionChoice{
-						{Name: "Current Week", Value: "current"},
-						{Name: "Season", Value: "season"},
+				},
+			},
+		},
+		{
+			Name:                     "play-alerts",
+			Description:              "Alerts for touchdowns, field goals, safeties, and turnovers as they happen",
+			DefaultMemberPermissions: &manageGuildPermission,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Post play alerts in a channel or to a webhook (replaces its existing rule)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "channel",
+							Description:  "Channel to post play alerts in",
+							Required:     false,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "webhook",
+							Description: "Discord or HTTP webhook URL to send alerts to instead of a channel",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "teams",
+							Description: "Teams whose games to follow, comma separated (default: every game)",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "plays",
+							Description: "Play types, comma separated: touchdown, field goal, safety, turnover (default: all)",
+							Required:    false,
+						},
 					},
 				},
 				{
-					Type:        discordgo.ApplicationCommandOptionInteger,
-					Name:        "week",
-					Description: "Specific week number (1-18)",
-					Required:    false,
-					MinValue:    &[]float64{1}[0],
-					MaxValue:    18,
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Stop play alerts in a channel or to a webhook",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "channel",
+							Description:  "Channel to remove the rule from",
+							Required:     false,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "webhook",
+							Description: "Webhook URL to remove the rule from",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show this server's play alert rules",
 				},
 			},
 		},
 		{
-			Name:        "team",
-			Description: "Get team information",
+			Name:                     "digest",
+			Description:              "Weekly schedule previews and results recaps",
+			DefaultMemberPermissions: &manageGuildPermission,
 			Options: []*discordgo.ApplicationCommandOption{
 				{
-					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "team",
-					Description: "Team name, city, or abbreviation",
-					Required:    true,
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "configure",
+					Description: "Set the channel or webhook for weekly digests (omit both to disable)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "channel",
+							Description:  "Channel to post weekly digests in",
+							Required:     false,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "webhook",
+							Description: "Discord or HTTP webhook URL to send digests to instead of a channel",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "day",
+							Description: "Day the upcoming-week preview is posted (default Tuesday)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Tuesday", Value: "tuesday"},
+								{Name: "Wednesday", Value: "wednesday"},
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			Name:        "schedule",
-			Description: "Get team schedule",
+			Name:                     "alerts",
+			Description:              "Set the channel or webhook for automatic alerts (omit both to disable)",
+			DefaultMemberPermissions: &manageGuildPermission,
 			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "channel",
+					Description:  "Channel to post alerts in",
+					Required:     false,
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+				},
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "team",
-					Description: "Team name, city, or abbreviation",
-					Required:    true,
+					Name:        "webhook",
+					Description: "Discord or HTTP webhook URL to send alerts to instead of a channel",
+					Required:    false,
 				},
 			},
 		},
-		{
-			Name:        "scores",
-			Description: "Get current week's scores",
+	}
+}
+
+// manageGuildPermission restricts admin commands to members who can manage the server
+var manageGuildPermission int64 = discordgo.PermissionManageGuild
+
+// Minimum values for /draft, /mockdraft, /plays, /remind, /slowmode, /spoiler-delay, and /waivers options (discordgo takes these by pointer)
+var (
+	draftMinYear           = 2000.0
+	mockDraftMinTeams      = 4.0
+	mockDraftMinRounds     = 1.0
+	mockDraftMinClock      = 15.0
+	playLogMinCount        = 1.0
+	reminderMinMinutes     = 1.0
+	slowModeMinSeconds     = 1.0
+	spoilerDelayMinMinutes = 0.0
+	waiverMinThreshold     = 0.0
+)
+
+// perGameOption builds the per_game option shared by /stats and /compare
+func perGameOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionBoolean,
+		Name:        "per_game",
+		Description: "Divide season totals by games played (type:Season only)",
+		Required:    false,
+	}
+}
+
+// seasonTypeOption builds the season_type option shared by /stats, /multistat, /scores, and /schedule
+func seasonTypeOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "season_type",
+		Description: "Preseason, regular season, or a playoff round",
+		Required:    false,
+		Choices: []*discordgo.ApplicationCommandOptionChoice{
+			{Name: "Preseason", Value: models.SeasonTypePreseason},
+			{Name: "Regular Season", Value: models.SeasonTypeRegular},
+			{Name: "Postseason", Value: models.SeasonTypePostseason},
+			{Name: "Wild Card Round", Value: "WILDCARD"},
+			{Name: "Divisional Round", Value: "DIVISIONAL"},
+			{Name: "Conference Championships", Value: "CONFERENCE"},
+			{Name: "Super Bowl", Value: "SUPERBOWL"},
 		},
 	}
 }
 
+// resolveSeasonWeek turns a season_type choice and optional week into a concrete season type and week.
+// Playoff rounds imply their week; otherwise the current week is used when the season type is in progress.
+func (b *Bot) resolveSeasonWeek(seasonTypeChoice string, week *int64) (*models.SeasonInfo, error) {
+	current, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return nil, err
+	}
+
+	seasonType := models.SeasonTypeRegular
+	impliedWeek := 0
+	if seasonTypeChoice != "" {
+		seasonType, impliedWeek, err = models.ParseSeasonType(seasonTypeChoice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resolved := &models.SeasonInfo{Season: current.Season, SeasonType: seasonType}
+	switch {
+	case week != nil:
+		resolved.Week = int(*week)
+	case impliedWeek > 0:
+		resolved.Week = impliedWeek
+	case current.SeasonType == seasonType:
+		resolved.Week = current.Week
+	default:
+		return nil, fmt.Errorf("please provide a week number for the %s", seasonTypeName(seasonType))
+	}
+
+	minWeek, maxWeek := models.WeekRange(seasonType)
+	if resolved.Week < minWeek || resolved.Week > maxWeek {
+		return nil, fmt.Errorf("week %d is not valid for the %s (use %d-%d)", resolved.Week, seasonTypeName(seasonType), minWeek, maxWeek)
+	}
+
+	return resolved, nil
+}
+
+// seasonTypeName returns a readable name for a SportsData.io season type
+func seasonTypeName(seasonType string) string {
+	switch seasonType {
+	case models.SeasonTypePreseason:
+		return "preseason"
+	case models.SeasonTypePostseason:
+		return "postseason"
+	default:
+		return "regular season"
+	}
+}
+
 // interactionCreate handles slash command interactions
 func (b *Bot) interactionCreate(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	// Check if bot is silenced
@@ -208,13 +1750,53 @@ func (b *Bot) interactionCreate(s *discordgo.Session, i *discordgo.InteractionCr
 			},
 		})
 		if err != nil {
-			log.Printf("Error responding to interaction: %v", err)
+			logger.Error("error responding to interaction", "error", err)
+		}
+		return
+	}
+
+	// Handle message components (select menus, buttons)
+	if i.Type == discordgo.InteractionMessageComponent {
+		customID := i.MessageComponentData().CustomID
+		switch {
+		case customID == compareViewCustomID:
+			b.handleCompareViewSelect(s, i)
+		case strings.HasPrefix(customID, mockDraftPrefix):
+			b.handleMockDraftComponent(s, i)
+		case strings.HasPrefix(customID, pickemPrefix):
+			b.handlePickemComponent(s, i)
+		case strings.HasPrefix(customID, predictPrefix):
+			b.handlePredictComponent(s, i)
+		case strings.HasPrefix(customID, triviaPrefix):
+			b.handleTriviaComponent(s, i)
+		case strings.HasPrefix(customID, didYouMeanPrefix):
+			b.handleDidYouMeanComponent(s, i)
+		case strings.HasPrefix(customID, compareRerunPrefix):
+			b.handleCompareRerun(s, i)
+		case customID == revealCustomID:
+			b.handleRevealComponent(s, i)
+		case strings.HasPrefix(customID, scoreDetailPrefix):
+			b.handleScoreDetailComponent(s, i)
 		}
 		return
 	}
 
+	if i.Type != discordgo.InteractionApplicationCommand {
+		return
+	}
+
+	command := i.ApplicationCommandData().Name
+	b.commandCounts.Record("/" + command)
+	start := time.Now()
+	defer func() {
+		logger.Info("slash command", "guild", i.GuildID, "user", interactionUserID(i), "command", command, "latency", time.Since(start))
+	}()
+
+	// Expand the guild's aliases before any name reaches the fuzzy matchers
+	b.expandInteractionAliases(i)
+
 	// Handle slash commands
-	switch i.ApplicationCommandData().Name {
+	switch command {
 	case "help":
 		b.handleSlashHelp(s, i)
 	case "stats":
@@ -223,10 +1805,122 @@ func (b *Bot) interactionCreate(s *discordgo.Session, i *discordgo.InteractionCr
 		b.handleSlashCompare(s, i)
 	case "team":
 		b.handleSlashTeam(s, i)
+	case "coaches":
+		b.handleSlashCoaches(s, i)
+	case "history":
+		b.handleSlashHistory(s, i)
+	case "next":
+		b.handleSlashNext(s, i)
+	case "drives":
+		b.handleSlashDrives(s, i)
+	case "plays":
+		b.handleSlashPlays(s, i)
 	case "schedule":
 		b.handleSlashSchedule(s, i)
 	case "scores":
 		b.handleSlashScores(s, i)
+	case "recap":
+		b.handleSlashRecap(s, i)
+	case "whattowatch":
+		b.handleSlashWhatToWatch(s, i)
+	case "wintotals":
+		b.handleSlashWinTotals(s, i)
+	case "standings":
+		b.handleSlashStandings(s, i)
+	case "division":
+		b.handleSlashDivision(s, i)
+	case "playoffodds":
+		b.handleSlashPlayoffOdds(s, i)
+	case "powerrankings":
+		b.handleSlashPowerRankings(s, i)
+	case "playoffpicture":
+		b.handleSlashPlayoffPicture(s, i)
+	case "whatif":
+		b.handleSlashWhatIf(s, i)
+	case "scenarios":
+		b.handleSlashScenarios(s, i)
+	case "safepicks":
+		b.handleSlashSafePicks(s, i)
+	case "alerts":
+		b.handleSlashAlerts(s, i)
+	case "draftorder":
+		b.handleSlashDraftOrder(s, i)
+	case "draft":
+		b.handleSlashDraft(s, i)
+	case "mockdraft":
+		b.handleSlashMockDraft(s, i)
+	case "draftkit":
+		b.handleSlashDraftKit(s, i)
+	case "rookies":
+		b.handleSlashRookies(s, i)
+	case "waivers":
+		b.handleSlashWaivers(s, i)
+	case "value":
+		b.handleSlashValue(s, i)
+	case "matchup-player":
+		b.handleSlashMatchupPlayer(s, i)
+	case "multistat":
+		b.handleSlashMultistat(s, i)
+	case "track":
+		b.handleSlashTrack(s, i)
+	case "trend":
+		b.handleSlashTrend(s, i)
+	case "remind":
+		b.handleSlashRemind(s, i)
+	case "pickem":
+		b.handleSlashPickem(s, i)
+	case "league":
+		b.handleSlashLeague(s, i)
+	case "timezone":
+		b.handleSlashTimezone(s, i)
+	case "favorite":
+		b.handleSlashFavorite(s, i)
+	case "alias":
+		b.handleSlashAlias(s, i)
+	case "today":
+		b.handleSlashToday(s, i)
+	case "watchlist":
+		b.handleSlashWatchlist(s, i)
+	case "slowmode":
+		b.handleSlashSlowMode(s, i)
+	case "spoiler-delay":
+		b.handleSlashSpoilerDelay(s, i)
+	case "spoiler-mode":
+		b.handleSlashSpoilerMode(s, i)
+	case "big-games":
+		b.handleSlashBigGames(s, i)
+	case "game-threads":
+		b.handleSlashGameThreads(s, i)
+	case "play-alerts":
+		b.handleSlashPlayAlerts(s, i)
+	case "digest":
+		b.handleSlashDigest(s, i)
+	case "predict":
+		b.handleSlashPredict(s, i)
+	case "trivia":
+		b.handleSlashTrivia(s, i)
+	case "ats":
+		b.handleSlashATS(s, i)
+	case "futures":
+		b.handleSlashFutures(s, i)
+	case "botstats":
+		b.handleSlashBotStats(s, i)
+	case "scoring":
+		b.handleSlashScoring(s, i)
+	case "leaderboard-page":
+		b.handleSlashLeaderboardPage(s, i)
+	case "owner":
+		b.handleSlashOwner(s, i)
+	case "visibility":
+		b.handleSlashVisibility(s, i)
+	case "language":
+		b.handleSlashLanguage(s, i)
+	case "formatting":
+		b.handleSlashFormatting(s, i)
+	case "follow":
+		b.handleSlashFollow(s, i)
+	case "unfollow":
+		b.handleSlashUnfollow(s, i)
 	}
 }
 
@@ -258,6 +1952,22 @@ func (b *Bot) messageCreate(s *discordgo.Session, m *discordgo.MessageCreate) {
 		return // User doesn't have required role
 	}
 
+	b.routeCommand(s, m)
+}
+
+// RunCommand runs a prefix command such as "!stats Josh Allen" as if it were posted in a channel,
+// sending the replies through r. It lets local tools exercise commands without Discord.
+func (b *Bot) RunCommand(r Responder, content string) {
+	b.routeCommand(r, &discordgo.MessageCreate{Message: &discordgo.Message{
+		ID:        "local",
+		ChannelID: "local",
+		Content:   content,
+		Author:    &discordgo.User{ID: "local", Username: "local"},
+	}})
+}
+
+// routeCommand parses a prefixed message and dispatches it to the command's handler
+func (b *Bot) routeCommand(s Responder, m *discordgo.MessageCreate) {
 	// Remove prefix and split command and arguments
 	content := strings.TrimPrefix(m.Content, b.config.BotPrefix)
 	args := strings.Fields(content)
@@ -266,6 +1976,12 @@ func (b *Bot) messageCreate(s *discordgo.Session, m *discordgo.MessageCreate) {
 	}
 
 	command := strings.ToLower(args[0])
+	b.commandCounts.Record(b.config.BotPrefix + command)
+	start := time.Now()
+	defer func() {
+		logger.Info("prefix command", "guild", m.GuildID, "user", m.Author.ID, "command", command, "latency", time.Since(start))
+	}()
+	args = append(args[:1], b.expandArgAliases(m.GuildID, args[1:])...)
 
 	// Handle commands
 	switch command {
@@ -287,11 +2003,11 @@ func (b *Bot) messageCreate(s *discordgo.Session, m *discordgo.MessageCreate) {
 }
 
 // handleHelp shows comprehensive command documentation
-func (b *Bot) handleHelp(s *discordgo.Session, m *discordgo.MessageCreate) {
+func (b *Bot) handleHelp(s Responder, m *discordgo.MessageCreate) {
 	embed := &discordgo.MessageEmbed{
 		Title: "🏈 NFL Discord Bot - Complete Command Guide",
 		Description: "**Intelligent NFL data with real-time stats, schedules, and scores**\n\n" +
-			"*Smart week detection: Wednesday shows previous week, Thursday-Monday shows current week*",
+			"*Smart week detection: follows the official NFL calendar from SportsData.io*",
 		Color: 0x013369,
 		Fields: []*discordgo.MessageEmbedField{
 			{
@@ -335,14 +2051,14 @@ func (b *Bot) handleHelp(s *discordgo.Session, m *discordgo.MessageCreate) {
 			{
 				Name:  "⚡ Smart Features",
 				Value: "• **Auto Week Detection** - Always shows current NFL week\n" +
-					   "• **5-Minute Caching** - Fast responses, reduced API calls\n" +
+					   "• **Smart Caching** - Live scores refresh every minute, static data cached longer\n" +
 					   "• **Flexible Team Names** - Use full names, cities, or abbreviations\n" +
 					   "• **Real-Time Data** - Live stats from SportsData.io",
 				Inline: false,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "🤖 Data updates every 5 minutes | 📡 Powered by SportsData.io | 🔧 Built for Discord",
+			Text: "🤖 Live scores update every minute | 📡 Powered by SportsData.io | 🔧 Built for Discord",
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
 	}
@@ -351,7 +2067,7 @@ func (b *Bot) handleHelp(s *discordgo.Session, m *discordgo.MessageCreate) {
 }
 
 // handleStats handles player statistics requests
-func (b *Bot) handleStats(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+func (b *Bot) handleStats(s Responder, m *discordgo.MessageCreate, args []string) {
 	if len(args) == 0 {
 		b.sendMessage(s, m.ChannelID, "Please provide a player name. Usage: `!stats <player_name>` or `!stats --season <player_name>` for season totals")
 		return
@@ -446,16 +2162,17 @@ func (b *Bot) handleStats(s *discordgo.Session, m *discordgo.MessageCreate, args
 		} else if useSpecificWeek {
 			statsType = fmt.Sprintf("Week %d, %d", specificWeek, specificSeason)
 		}
-		b.sendMessage(s, m.ChannelID, fmt.Sprintf("Error getting %s stats for %s: %v", statsType, playerName, err))
+		b.sendMessage(s, m.ChannelID, userError(fmt.Sprintf("Error getting %s stats for %s", statsType, playerName), err))
 		return
 	}
 
 	// Create embed with player stats
-	statsTitle := "Current Week Stats (2025)"
+	language := b.guildLanguage(m.GuildID, nil)
+	statsTitle := i18n.T(language, "stats.title.current")
 	if isSeasonStats {
-		statsTitle = "2024 Sample Stats (6 games)"
+		statsTitle = i18n.T(language, "stats.title.sample")
 	} else if useSpecificWeek {
-		statsTitle = fmt.Sprintf("Week %d, %d Stats", specificWeek, specificSeason)
+		statsTitle = i18n.T(language, "stats.title.week", i18n.T(language, "week.regular", specificWeek), specificSeason)
 	}
 	
 	// Delete acknowledgment message before sending results
@@ -463,36 +2180,15 @@ func (b *Bot) handleStats(s *discordgo.Session, m *discordgo.MessageCreate, args
 		s.ChannelMessageDelete(m.ChannelID, ack.ID)
 	}
 
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("📊 %s - %s", stats.Name, statsTitle),
-		Color: 0x0099ff,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "Team",
-				Value:  stats.Team,
-				Inline: true,
-			},
-			{
-				Name:   "Position",
-				Value:  stats.Position,
-				Inline: true,
-			},
-			{
-				Name:   "Season Stats",
-				Value:  stats.GetStatsString(),
-				Inline: false,
-			},
-		},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Data from NFL API",
-		},
-	}
+	embed := embeds.PlayerStatsEmbed(stats, statsTitle, b.guildFormat(m.GuildID), language)
+	b.themeEmbedForTeam(embed, stats.Team)
+	addHeadshot(embed, b.playerPhoto(stats))
 
 	b.sendEmbed(s, m.ChannelID, embed)
 }
 
 // handleTeam handles team information requests
-func (b *Bot) handleTeam(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+func (b *Bot) handleTeam(s Responder, m *discordgo.MessageCreate, args []string) {
 	if len(args) == 0 {
 		b.sendMessage(s, m.ChannelID, "Please provide a team name. Usage: `!team <team_name>`")
 		return
@@ -516,7 +2212,7 @@ func (b *Bot) handleTeam(s *discordgo.Session, m *discordgo.MessageCreate, args
 		if ack != nil {
 			s.ChannelMessageDelete(m.ChannelID, ack.ID)
 		}
-		b.sendMessage(s, m.ChannelID, fmt.Sprintf("Error getting team info for %s: %v", teamName, err))
+		b.sendMessage(s, m.ChannelID, userError(fmt.Sprintf("Error getting team info for %s", teamName), err))
 		return
 	}
 
@@ -526,41 +2222,14 @@ func (b *Bot) handleTeam(s *discordgo.Session, m *discordgo.MessageCreate, args
 	}
 
 	// Create embed with team info
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("🏈 %s %s", teamInfo.City, teamInfo.Name),
-		Color: 0xff6600,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "Conference",
-				Value:  teamInfo.Conference,
-				Inline: true,
-			},
-			{
-				Name:   "Division",
-				Value:  teamInfo.Division,
-				Inline: true,
-			},
-			{
-				Name:   "Head Coach",
-				Value:  teamInfo.Coach,
-				Inline: true,
-			},
-			{
-				Name:   "Stadium",
-				Value:  teamInfo.Stadium,
-				Inline: false,
-			},
-		},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Team data from NFL API",
-		},
-	}
+	embed := embeds.TeamEmbed(teamInfo)
+	themeEmbed(embed, teamInfo)
 
 	b.sendEmbed(s, m.ChannelID, embed)
 }
 
 // handleSchedule handles team schedule requests
-func (b *Bot) handleSchedule(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+func (b *Bot) handleSchedule(s Responder, m *discordgo.MessageCreate, args []string) {
 	if len(args) == 0 {
 		b.sendMessage(s, m.ChannelID, "Please provide a team name. Usage: `!schedule <team_name>`")
 		return
@@ -584,56 +2253,36 @@ func (b *Bot) handleSchedule(s *discordgo.Session, m *discordgo.MessageCreate, a
 		if ack != nil {
 			s.ChannelMessageDelete(m.ChannelID, ack.ID)
 		}
-		b.sendMessage(s, m.ChannelID, fmt.Sprintf("Error getting schedule for %s: %v", teamName, err))
+		b.sendMessage(s, m.ChannelID, userError(fmt.Sprintf("Error getting schedule for %s", teamName), err))
 		return
 	}
 
-	// Create embed with schedule (show first 10 games to avoid too long message)
-	var scheduleText string
-	gamesToShow := schedule.Games
-	if len(gamesToShow) > 10 {
-		gamesToShow = gamesToShow[:10]
-	}
-
-	for _, game := range gamesToShow {
-		// Check if this is a BYE week
-		if game.HomeTeam == "BYE" || game.AwayTeam == "BYE" {
-			scheduleText += fmt.Sprintf("**Week %d**: 🛌 **BYE WEEK** - Rest and Recovery\n", game.Week)
-			continue
-		}
-		
-		gameDate := game.GameTime.Format("Jan 2, 3:04 PM")
-		if game.IsCompleted() {
-			scheduleText += fmt.Sprintf("**Week %d**: %s @ %s - %s %d-%d (Final)\n", 
-				game.Week, game.AwayTeam, game.HomeTeam, game.Winner(), game.AwayScore, game.HomeScore)
-		} else if game.IsLive() {
-			scheduleText += fmt.Sprintf("**Week %d**: %s @ %s - %d-%d (LIVE)\n", 
-				game.Week, game.AwayTeam, game.HomeTeam, game.AwayScore, game.HomeScore)
-		} else {
-			scheduleText += fmt.Sprintf("**Week %d**: %s @ %s - %s\n", 
-				game.Week, game.AwayTeam, game.HomeTeam, gameDate)
-		}
-	}
-
 	// Delete acknowledgment message before sending results
 	if ack != nil {
 		s.ChannelMessageDelete(m.ChannelID, ack.ID)
 	}
 
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("📅 %s Schedule (%d Season)", schedule.TeamName, schedule.Season),
-		Color: 0x00ff00,
-		Description: scheduleText,
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("Showing %d of %d games", len(gamesToShow), len(schedule.Games)),
-		},
+	location := b.userLocation(m.GuildID, m.Author.ID)
+	spoilers := b.scoreSpoilers(m.GuildID)
+	language := b.guildLanguage(m.GuildID, nil)
+	seasonLabel := i18n.T(language, "schedule.season_label."+models.SeasonTypeRegular)
+	embed := embeds.ScheduleEmbed(schedule, seasonLabel, location, spoilers, b.guildFormat(m.GuildID), language)
+	var revealed *discordgo.MessageEmbed
+	if spoilers != embeds.SpoilersOff {
+		revealed = embeds.ScheduleEmbed(schedule, seasonLabel, location, embeds.SpoilersOff, b.guildFormat(m.GuildID), language)
+	}
+	if teamInfo, err := b.nflClient.GetTeamInfo(teamName); err == nil {
+		themeEmbed(embed, teamInfo)
+		if revealed != nil {
+			themeEmbed(revealed, teamInfo)
+		}
 	}
 
-	b.sendEmbed(s, m.ChannelID, embed)
+	b.sendScoresEmbed(s, m.ChannelID, embed, revealed)
 }
 
 // handleScores handles live scores requests
-func (b *Bot) handleScores(s *discordgo.Session, m *discordgo.MessageCreate) {
+func (b *Bot) handleScores(s Responder, m *discordgo.MessageCreate) {
 // Send acknowledgment notification
 	ack, _ := s.ChannelMessageSend(m.ChannelID, "⏳ Fetching live scores...")
 	
@@ -650,7 +2299,7 @@ func (b *Bot) handleScores(s *discordgo.Session, m *discordgo.MessageCreate) {
 		if ack != nil {
 			s.ChannelMessageDelete(m.ChannelID, ack.ID)
 		}
-		b.sendMessage(s, m.ChannelID, fmt.Sprintf("Error getting live scores: %v", err))
+		b.sendMessage(s, m.ChannelID, userError("Error getting live scores", err))
 		return
 	}
 
@@ -663,43 +2312,28 @@ func (b *Bot) handleScores(s *discordgo.Session, m *discordgo.MessageCreate) {
 		return
 	}
 
-	// Create embed with live scores
-	var scoresText string
-	liveCount := 0
-	completedCount := 0
-
-	for _, score := range liveScores {
-		if score.IsLive() {
-			scoresText += fmt.Sprintf("🔴 **%s** - %s\n", "LIVE", score.GetScoreString())
-			liveCount++
-		} else if score.IsCompleted() {
-			scoresText += fmt.Sprintf("✅ **FINAL** - %s\n", score.GetScoreString())
-			completedCount++
-		} else {
-			gameTime := score.GameTime.Format("Jan 2, 3:04 PM")
-			scoresText += fmt.Sprintf("📅 **%s** - %s @ %s\n", gameTime, score.AwayTeam, score.HomeTeam)
-		}
-	}
-
 	// Delete acknowledgment message before sending results
 	if ack != nil {
 		s.ChannelMessageDelete(m.ChannelID, ack.ID)
 	}
 
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("🏈 NFL Scores - Week %d", liveScores[0].Week),
-		Color: 0x013369,
-		Description: scoresText,
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("%d live, %d completed, %d total games", liveCount, completedCount, len(liveScores)),
-		},
+	language := b.guildLanguage(m.GuildID, nil)
+	weekLabel := i18n.T(language, "week.regular", liveScores[0].Week)
+	location := b.userLocation(m.GuildID, m.Author.ID)
+	spoilers := b.scoreSpoilers(m.GuildID)
+	embed := embeds.ScoresEmbed(weekLabel, liveScores, location, spoilers, b.guildFormat(m.GuildID), language)
+	b.themeSingleGameScores(embed, liveScores)
+	var revealed *discordgo.MessageEmbed
+	if spoilers != embeds.SpoilersOff {
+		revealed = embeds.ScoresEmbed(weekLabel, liveScores, location, embeds.SpoilersOff, b.guildFormat(m.GuildID), language)
+		b.themeSingleGameScores(revealed, liveScores)
 	}
 
-	b.sendEmbed(s, m.ChannelID, embed)
+	b.sendScoresEmbed(s, m.ChannelID, embed, revealed)
 }
 
 // handleCompare handles player comparison requests
-func (b *Bot) handleCompare(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+func (b *Bot) handleCompare(s Responder, m *discordgo.MessageCreate, args []string) {
 	if len(args) < 3 {
 		b.sendMessage(s, m.ChannelID, "Please provide two players to compare. Usage: `!compare Player1 vs Player2` or `!compare --week 5 Player1 vs Player2`")
 		return
@@ -766,412 +2400,74 @@ func (b *Bot) handleCompare(s *discordgo.Session, m *discordgo.MessageCreate, ar
 
 	// Extract player names
 	player1Name := strings.Join(args[argOffset:vsIndex], " ")
-	player2Name := strings.Join(args[vsIndex+1:], " ")
-
-	if player1Name == "" || player2Name == "" {
-		b.sendMessage(s, m.ChannelID, "Please provide valid player names on both sides of 'vs'.")
-		return
-	}
-
-	// Get stats for both players
-	var stats1, stats2 *models.PlayerStats
-	var err1, err2 error
-
-	if isSeasonStats {
-		stats1, err1 = b.nflClient.GetPlayerSeasonStats(player1Name)
-		stats2, err2 = b.nflClient.GetPlayerSeasonStats(player2Name)
-	} else if useSpecificWeek {
-		stats1, err1 = b.nflClient.GetPlayerWeekStats(player1Name, specificSeason, specificWeek)
-		stats2, err2 = b.nflClient.GetPlayerWeekStats(player2Name, specificSeason, specificWeek)
-	} else {
-		stats1, err1 = b.nflClient.GetPlayerStats(player1Name)
-		stats2, err2 = b.nflClient.GetPlayerStats(player2Name)
-	}
-
-	// Handle errors
-	if err1 != nil {
-		// Delete acknowledgment message
-		if ack != nil {
-			s.ChannelMessageDelete(m.ChannelID, ack.ID)
-		}
-		b.sendMessage(s, m.ChannelID, fmt.Sprintf("Error getting stats for %s: %v", player1Name, err1))
-		return
-	}
-	if err2 != nil {
-		// Delete acknowledgment message
-		if ack != nil {
-			s.ChannelMessageDelete(m.ChannelID, ack.ID)
-		}
-		b.sendMessage(s, m.ChannelID, fmt.Sprintf("Error getting stats for %s: %v", player2Name, err2))
-		return
-	}
-
-	// Create comparison embed
-	comparisonTitle := "Player Comparison"
-	if isSeasonStats {
-		comparisonTitle = "Season Comparison (2024 Sample)"
-	} else if useSpecificWeek {
-		comparisonTitle = fmt.Sprintf("Week %d, %d Comparison", specificWeek, specificSeason)
-	}
-
-	// Delete acknowledgment message before sending results
-	if ack != nil {
-		s.ChannelMessageDelete(m.ChannelID, ack.ID)
-	}
-
-	embed := b.createComparisonEmbed(stats1, stats2, comparisonTitle)
-	b.sendEmbed(s, m.ChannelID, embed)
-}
-
-// createComparisonEmbed creates a side-by-side comparison embed
-func (b *Bot) createComparisonEmbed(stats1, stats2 *models.PlayerStats, title string) *discordgo.MessageEmbed {
-	// Determine if players are same position for relevant comparisons
-	samePosType := b.getSamePositionType(stats1.Position, stats2.Position)
-
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("⚖️ %s", title),
-		Color: 0x9932cc, // Purple color for comparisons
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "Players",
-				Value:  fmt.Sprintf("🔵 **%s** (%s, %s) vs 🔴 **%s** (%s, %s)", 
-					   stats1.Name, stats1.Team, stats1.Position,
-					   stats2.Name, stats2.Team, stats2.Position),
-				Inline: false,
-			},
-		},
-		Timestamp: time.Now().Format(time.RFC3339),
-	}
-
-	// Add position-specific comparisons
-	if samePosType == "QB" && b.hasPassingStats(stats1) && b.hasPassingStats(stats2) {
-		b.addPassingComparison(embed, stats1, stats2)
-	}
-	if samePosType == "RB" || (b.hasRushingStats(stats1) && b.hasRushingStats(stats2)) {
-		b.addRushingComparison(embed, stats1, stats2)
-	}
-	if samePosType == "WR" || samePosType == "TE" || (b.hasReceivingStats(stats1) && b.hasReceivingStats(stats2)) {
-		b.addReceivingComparison(embed, stats1, stats2)
-	}
-
-	// Add footer
-	embed.Footer = &discordgo.MessageEmbedFooter{
-		Text: "🔵 = " + stats1.Name + " | 🔴 = " + stats2.Name + " | ⬆️ Better performance",
-	}
-
-	return embed
-}
-
-// getSamePositionType returns standardized position type for comparison
-func (b *Bot) getSamePositionType(pos1, pos2 string) string {
-	pos1 = strings.ToUpper(pos1)
-	pos2 = strings.ToUpper(pos2)
-	
-	// Group similar positions
-	if pos1 == pos2 {
-		return pos1
-	}
-	
-	// Check if both are similar types
-	if (pos1 == "WR" || pos1 == "WR1" || pos1 == "WR2") && (pos2 == "WR" || pos2 == "WR1" || pos2 == "WR2") {
-		return "WR"
-	}
-	if (pos1 == "RB" || pos1 == "RB1" || pos1 == "RB2") && (pos2 == "RB" || pos2 == "RB1" || pos2 == "RB2") {
-		return "RB"
-	}
-	if (pos1 == "QB" || pos1 == "QB1") && (pos2 == "QB" || pos2 == "QB1") {
-		return "QB"
-	}
-	if (pos1 == "TE" || pos1 == "TE1") && (pos2 == "TE" || pos2 == "TE1") {
-		return "TE"
-	}
-	
-	return "" // Different position types
-}
-
-// hasPassingStats checks if player has meaningful passing stats
-func (b *Bot) hasPassingStats(stats *models.PlayerStats) bool {
-	passingYards := b.getStatFloat(stats, "PassingYards")
-	passingTDs := b.getStatFloat(stats, "PassingTouchdowns")
-	passingAttempts := b.getStatFloat(stats, "PassingAttempts")
-	return passingYards > 0 || passingTDs > 0 || passingAttempts > 0
-}
-
-// hasRushingStats checks if player has meaningful rushing stats
-func (b *Bot) hasRushingStats(stats *models.PlayerStats) bool {
-	rushingYards := b.getStatFloat(stats, "RushingYards")
-	rushingTDs := b.getStatFloat(stats, "RushingTouchdowns")
-	rushingAttempts := b.getStatFloat(stats, "RushingAttempts")
-	return rushingYards > 0 || rushingTDs > 0 || rushingAttempts > 0
-}
-
-// hasReceivingStats checks if player has meaningful receiving stats
-func (b *Bot) hasReceivingStats(stats *models.PlayerStats) bool {
-	receivingYards := b.getStatFloat(stats, "ReceivingYards")
-	receivingTDs := b.getStatFloat(stats, "ReceivingTouchdowns")
-	receptions := b.getStatFloat(stats, "Receptions")
-	return receivingYards > 0 || receivingTDs > 0 || receptions > 0
-}
-
-// addPassingComparison adds passing stats comparison to embed
-func (b *Bot) addPassingComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats) {
-	passingField := &discordgo.MessageEmbedField{
-		Name:   "🏈 Passing Stats",
-		Inline: false,
-	}
-	
-	// Get passing stats
-	yards1 := int(b.getStatFloat(stats1, "PassingYards"))
-	yards2 := int(b.getStatFloat(stats2, "PassingYards"))
-	tds1 := int(b.getStatFloat(stats1, "PassingTouchdowns"))
-	tds2 := int(b.getStatFloat(stats2, "PassingTouchdowns"))
-	ints1 := int(b.getStatFloat(stats1, "Interceptions"))
-	ints2 := int(b.getStatFloat(stats2, "Interceptions"))
-	
-	// Passing yards
-	var yardIcon1, yardIcon2 string
-	if yards1 > yards2 {
-		yardIcon1 = " ⬆️"
-	} else if yards2 > yards1 {
-		yardIcon2 = " ⬆️"
-	}
-	
-	// Passing TDs
-	var tdIcon1, tdIcon2 string
-	if tds1 > tds2 {
-		tdIcon1 = " ⬆️"
-	} else if tds2 > tds1 {
-		tdIcon2 = " ⬆️"
-	}
-	
-	// Completion percentage
-	compPct1 := b.calculateCompletionPct(stats1)
-	compPct2 := b.calculateCompletionPct(stats2)
-	var pctIcon1, pctIcon2 string
-	if compPct1 > compPct2 {
-		pctIcon1 = " ⬆️"
-	} else if compPct2 > compPct1 {
-		pctIcon2 = " ⬆️"
-	}
-	
-	passingField.Value = fmt.Sprintf(
-		"▫ **Yards:** 🔵 %d%s | 🔴 %d%s\n"+
-		"▫ **TDs:** 🔵 %d%s | 🔴 %d%s\n"+
-		"▫ **Comp%%:** 🔵 %.1f%%%s | 🔴 %.1f%%%s\n"+
-		"▫ **INTs:** 🔵 %d | 🔴 %d",
-		yards1, yardIcon1, yards2, yardIcon2,
-		tds1, tdIcon1, tds2, tdIcon2,
-		compPct1, pctIcon1, compPct2, pctIcon2,
-		ints1, ints2,
-	)
-	
-	embed.Fields = append(embed.Fields, passingField)
-}
-
-// addRushingComparison adds rushing stats comparison to embed
-func (b *Bot) addRushingComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats) {
-	rushingField := &discordgo.MessageEmbedField{
-		Name:   "🏃 Rushing Stats",
-		Inline: false,
-	}
-	
-	// Get rushing stats
-	yards1 := int(b.getStatFloat(stats1, "RushingYards"))
-	yards2 := int(b.getStatFloat(stats2, "RushingYards"))
-	tds1 := int(b.getStatFloat(stats1, "RushingTouchdowns"))
-	tds2 := int(b.getStatFloat(stats2, "RushingTouchdowns"))
-	attempts1 := int(b.getStatFloat(stats1, "RushingAttempts"))
-	attempts2 := int(b.getStatFloat(stats2, "RushingAttempts"))
-	
-	// Rushing yards
-	var yardIcon1, yardIcon2 string
-	if yards1 > yards2 {
-		yardIcon1 = " ⬆️"
-	} else if yards2 > yards1 {
-		yardIcon2 = " ⬆️"
-	}
-	
-	// Rushing TDs
-	var tdIcon1, tdIcon2 string
-	if tds1 > tds2 {
-		tdIcon1 = " ⬆️"
-	} else if tds2 > tds1 {
-		tdIcon2 = " ⬆️"
-	}
-	
-	// YPC calculation
-	ypc1 := b.calculateYPC(yards1, attempts1)
-	ypc2 := b.calculateYPC(yards2, attempts2)
-	var ypcIcon1, ypcIcon2 string
-	if ypc1 > ypc2 {
-		ypcIcon1 = " ⬆️"
-	} else if ypc2 > ypc1 {
-		ypcIcon2 = " ⬆️"
-	}
-	
-	rushingField.Value = fmt.Sprintf(
-		"▫ **Yards:** 🔵 %d%s | 🔴 %d%s\n"+
-		"▫ **TDs:** 🔵 %d%s | 🔴 %d%s\n"+
-		"▫ **Attempts:** 🔵 %d | 🔴 %d\n"+
-		"▫ **YPC:** 🔵 %.1f%s | 🔴 %.1f%s",
-		yards1, yardIcon1, yards2, yardIcon2,
-		tds1, tdIcon1, tds2, tdIcon2,
-		attempts1, attempts2,
-		ypc1, ypcIcon1, ypc2, ypcIcon2,
-	)
-	
-	embed.Fields = append(embed.Fields, rushingField)
-}
-
-// addReceivingComparison adds receiving stats comparison to embed
-func (b *Bot) addReceivingComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats) {
-	receivingField := &discordgo.MessageEmbedField{
-		Name:   "👋 Receiving Stats",
-		Inline: false,
-	}
-	
-	// Get receiving stats
-	yards1 := int(b.getStatFloat(stats1, "ReceivingYards"))
-	yards2 := int(b.getStatFloat(stats2, "ReceivingYards"))
-	tds1 := int(b.getStatFloat(stats1, "ReceivingTouchdowns"))
-	tds2 := int(b.getStatFloat(stats2, "ReceivingTouchdowns"))
-	receptions1 := int(b.getStatFloat(stats1, "Receptions"))
-	receptions2 := int(b.getStatFloat(stats2, "Receptions"))
-	
-	// Receiving yards
-	var yardIcon1, yardIcon2 string
-	if yards1 > yards2 {
-		yardIcon1 = " ⬆️"
-	} else if yards2 > yards1 {
-		yardIcon2 = " ⬆️"
-	}
-	
-	// Receiving TDs
-	var tdIcon1, tdIcon2 string
-	if tds1 > tds2 {
-		tdIcon1 = " ⬆️"
-	} else if tds2 > tds1 {
-		tdIcon2 = " ⬆️"
-	}
-	
-	// Receptions
-	var recIcon1, recIcon2 string
-	if receptions1 > receptions2 {
-		recIcon1 = " ⬆️"
-	} else if receptions2 > receptions1 {
-		recIcon2 = " ⬆️"
-	}
-	
-	// YPR calculation
-	ypr1 := b.calculateYPR(yards1, receptions1)
-	ypr2 := b.calculateYPR(yards2, receptions2)
-	var yprIcon1, yprIcon2 string
-	if ypr1 > ypr2 {
-		yprIcon1 = " ⬆️"
-	} else if ypr2 > ypr1 {
-		yprIcon2 = " ⬆️"
-	}
-	
-	receivingField.Value = fmt.Sprintf(
-		"▫ **Yards:** 🔵 %d%s | 🔴 %d%s\n"+
-		"▫ **TDs:** 🔵 %d%s | 🔴 %d%s\n"+
-		"▫ **Receptions:** 🔵 %d%s | 🔴 %d%s\n"+
-		"▫ **YPR:** 🔵 %.1f%s | 🔴 %.1f%s",
-		yards1, yardIcon1, yards2, yardIcon2,
-		tds1, tdIcon1, tds2, tdIcon2,
-		receptions1, recIcon1, receptions2, recIcon2,
-		ypr1, yprIcon1, ypr2, yprIcon2,
-	)
-	
-	embed.Fields = append(embed.Fields, receivingField)
-}
+	player2Name := strings.Join(args[vsIndex+1:], " ")
 
-// calculateCompletionPct calculates completion percentage
-func (b *Bot) calculateCompletionPct(stats *models.PlayerStats) float64 {
-	attempts := b.getStatFloat(stats, "PassingAttempts")
-	completions := b.getStatFloat(stats, "PassingCompletions")
-	if attempts == 0 {
-		return 0.0
+	if player1Name == "" || player2Name == "" {
+		b.sendMessage(s, m.ChannelID, "Please provide valid player names on both sides of 'vs'.")
+		return
 	}
-	return (completions / attempts) * 100
-}
 
-// calculateYPC calculates yards per carry
-func (b *Bot) calculateYPC(yards, attempts int) float64 {
-	if attempts == 0 {
-		return 0.0
-	}
-	return float64(yards) / float64(attempts)
-}
+	// Get stats for both players
+	var stats1, stats2 *models.PlayerStats
+	var err1, err2 error
 
-// calculateYPR calculates yards per reception
-func (b *Bot) calculateYPR(yards, receptions int) float64 {
-	if receptions == 0 {
-		return 0.0
+	if isSeasonStats {
+		stats1, err1 = b.nflClient.GetPlayerSeasonStats(player1Name)
+		stats2, err2 = b.nflClient.GetPlayerSeasonStats(player2Name)
+	} else if useSpecificWeek {
+		stats1, err1 = b.nflClient.GetPlayerWeekStats(player1Name, specificSeason, specificWeek)
+		stats2, err2 = b.nflClient.GetPlayerWeekStats(player2Name, specificSeason, specificWeek)
+	} else {
+		stats1, err1 = b.nflClient.GetPlayerStats(player1Name)
+		stats2, err2 = b.nflClient.GetPlayerStats(player2Name)
 	}
-	return float64(yards) / float64(receptions)
-}
 
-// getStatFloat safely retrieves a stat as float64 from the player stats map
-func (b *Bot) getStatFloat(stats *models.PlayerStats, statName string) float64 {
-	if stats.Stats == nil {
-		return 0.0
-	}
-	
-	// Try direct key first
-	value, exists := stats.Stats[statName]
-	if !exists {
-		// Try alternative field names (season vs week stats may use different keys)
-		altNames := map[string][]string{
-			"PassingYards":         {"passing_yards", "PassingYards"},
-			"PassingTouchdowns":    {"passing_touchdowns", "PassingTouchdowns"},
-			"PassingCompletions":   {"passing_completions", "PassingCompletions", "Completions"},
-			"PassingAttempts":      {"passing_attempts", "PassingAttempts", "Attempts"},
-			"Interceptions":        {"interceptions", "Interceptions"},
-			"RushingYards":         {"rushing_yards", "RushingYards"},
-			"RushingTouchdowns":    {"rushing_touchdowns", "RushingTouchdowns"},
-			"RushingAttempts":      {"rushing_attempts", "RushingAttempts"},
-			"ReceivingYards":       {"receiving_yards", "ReceivingYards"},
-			"ReceivingTouchdowns":  {"receiving_touchdowns", "ReceivingTouchdowns"},
-			"Receptions":           {"receptions", "Receptions"},
+	// Handle errors
+	if err1 != nil {
+		// Delete acknowledgment message
+		if ack != nil {
+			s.ChannelMessageDelete(m.ChannelID, ack.ID)
 		}
-		
-		if alternatives, hasAlts := altNames[statName]; hasAlts {
-			for _, altName := range alternatives {
-				if altValue, altExists := stats.Stats[altName]; altExists {
-					value = altValue
-					exists = true
-					break
-				}
-			}
+		b.sendMessage(s, m.ChannelID, userError(fmt.Sprintf("Error getting stats for %s", player1Name), err1))
+		return
+	}
+	if err2 != nil {
+		// Delete acknowledgment message
+		if ack != nil {
+			s.ChannelMessageDelete(m.ChannelID, ack.ID)
 		}
+		b.sendMessage(s, m.ChannelID, userError(fmt.Sprintf("Error getting stats for %s", player2Name), err2))
+		return
 	}
-	
-	if !exists {
-		return 0.0
+
+	// Create comparison embed
+	comparisonTitle := "Player Comparison"
+	if isSeasonStats {
+		comparisonTitle = "Season Comparison (2024 Sample)"
+	} else if useSpecificWeek {
+		comparisonTitle = fmt.Sprintf("Week %d, %d Comparison", specificWeek, specificSeason)
 	}
-	
-	// Handle different types of numeric values
-	switch v := value.(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int32:
-		return float64(v)
-	case int64:
-		return float64(v)
-	default:
-		return 0.0
+
+	// Delete acknowledgment message before sending results
+	if ack != nil {
+		s.ChannelMessageDelete(m.ChannelID, ack.ID)
 	}
+
+	embed := b.createComparisonViewEmbed(&comparison{
+		stats1: stats1,
+		stats2: stats2,
+		photo1: b.playerPhoto(stats1),
+		photo2: b.playerPhoto(stats2),
+		title:  comparisonTitle,
+		format: b.guildFormat(m.GuildID),
+	}, compareViewOverview)
+	b.sendEmbed(s, m.ChannelID, embed)
 }
 
 // handleSilenceCommand handles the /s silence command
 func (b *Bot) handleSilenceCommand(s *discordgo.Session, m *discordgo.MessageCreate) {
 	b.silenceEnd = time.Now().Add(5 * time.Minute)
-	log.Printf("[BOT] Bot silenced for 5 minutes by %s", m.Author.Username)
+	logger.Info("bot silenced for 5 minutes", "guild", m.GuildID, "user", m.Author.Username)
 	
 	// Delete the original /s command message immediately
 	go func() {
@@ -1182,7 +2478,7 @@ func (b *Bot) handleSilenceCommand(s *discordgo.Session, m *discordgo.MessageCre
 	// Send temporary message that will be deleted after 3 seconds
 	msg, err := s.ChannelMessageSend(m.ChannelID, "🔇 Bot silenced for 5 minutes")
 	if err != nil {
-		log.Printf("Error sending silence message: %v", err)
+		logger.Error("error sending silence message", "error", err)
 		return
 	}
 
@@ -1212,7 +2508,7 @@ func (b *Bot) hasRole(s *discordgo.Session, m *discordgo.MessageCreate, roleName
 	// Get guild member to check roles
 	member, err := s.GuildMember(m.GuildID, m.Author.ID)
 	if err != nil {
-		log.Printf("Error getting guild member: %v", err)
+		logger.Error("error getting guild member", "error", err)
 		return false
 	}
 	
@@ -1252,7 +2548,7 @@ func (b *Bot) hasRoleForInteraction(s *discordgo.Session, i *discordgo.Interacti
 	// Get guild member to check roles
 	member, err := s.GuildMember(i.GuildID, i.Member.User.ID)
 	if err != nil {
-		log.Printf("Error getting guild member: %v", err)
+		logger.Error("error getting guild member", "error", err)
 		return false
 	}
 	
@@ -1273,9 +2569,9 @@ func (b *Bot) hasRoleForInteraction(s *discordgo.Session, i *discordgo.Interacti
 	return false
 }
 
-// respondInteraction sends a response to slash command interaction (always ephemeral if visibility role is configured)
-func (b *Bot) respondInteraction(s *discordgo.Session, i *discordgo.InteractionCreate, content string) error {
-	isEphemeral := b.visibilityRole != ""
+// respondInteraction sends a response to slash command interaction (ephemeral unless the user or server chose public)
+func (b *Bot) respondInteraction(s Responder, i *discordgo.InteractionCreate, content string) error {
+	isEphemeral := b.ephemeral(i)
 	
 	data := &discordgo.InteractionResponseData{
 		Content: content,
@@ -1291,12 +2587,12 @@ func (b *Bot) respondInteraction(s *discordgo.Session, i *discordgo.InteractionC
 	})
 }
 
-// respondInteractionEmbed sends an embed response to slash command interaction (always ephemeral if visibility role is configured)
-func (b *Bot) respondInteractionEmbed(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) error {
-	isEphemeral := b.visibilityRole != ""
+// respondInteractionEmbed sends an embed response to slash command interaction (ephemeral unless the user or server chose public)
+func (b *Bot) respondInteractionEmbed(s Responder, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) error {
+	isEphemeral := b.ephemeral(i)
 	
 	data := &discordgo.InteractionResponseData{
-		Embeds: []*discordgo.MessageEmbed{embed},
+		Embeds: []*discordgo.MessageEmbed{b.markDegraded(embed)},
 	}
 	
 	if isEphemeral {
@@ -1309,135 +2605,248 @@ func (b *Bot) respondInteractionEmbed(s *discordgo.Session, i *discordgo.Interac
 	})
 }
 
-// followupInteraction sends a followup message to slash command interaction (always ephemeral if visibility role is configured)
-func (b *Bot) followupInteraction(s *discordgo.Session, i *discordgo.InteractionCreate, content string) error {
-	isEphemeral := b.visibilityRole != ""
-	
-	data := &discordgo.WebhookParams{
-		Content: content,
-	}
-	
-	if isEphemeral {
+// deferInteraction acknowledges a slash command with Discord's "thinking…" state; the
+// completeInteraction helpers then replace it with the result (ephemeral unless the user or server chose public).
+// An interaction that already expired is not an error: its result is posted as a message instead.
+func (b *Bot) deferInteraction(s Responder, i *discordgo.InteractionCreate) error {
+	data := &discordgo.InteractionResponseData{}
+	if b.ephemeral(i) {
 		data.Flags = discordgo.MessageFlagsEphemeral
 	}
-	
-	_, err := s.FollowupMessageCreate(i.Interaction, true, data)
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: data,
+	})
+	if tokenRejected(err) {
+		logger.Warn("interaction expired before it was acknowledged", "interaction", i.ID)
+		b.responses.markExpired(i.ID)
+		return nil
+	}
 	return err
 }
 
-// followupInteractionEmbed sends a followup embed to slash command interaction (always ephemeral if visibility role is configured)
-func (b *Bot) followupInteractionEmbed(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) error {
-	isEphemeral := b.visibilityRole != ""
-	
-	data := &discordgo.WebhookParams{
-		Embeds: []*discordgo.MessageEmbed{embed},
-	}
-	
-	if isEphemeral {
-		data.Flags = discordgo.MessageFlagsEphemeral
+// completeInteraction replaces a deferred response with a text message
+func (b *Bot) completeInteraction(s Responder, i *discordgo.InteractionCreate, content string) error {
+	if notice := b.degradedNotice(); notice != "" {
+		content = notice + "\n" + content
 	}
-	
-	_, err := s.FollowupMessageCreate(i.Interaction, true, data)
+	_, err := b.finishInteraction(s, i, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	return err
+}
+
+// completeInteractionEmbed replaces a deferred response with an embed
+func (b *Bot) completeInteractionEmbed(s Responder, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) error {
+	_, err := b.finishInteraction(s, i, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{b.markDegraded(embed)},
+	})
+	return err
+}
+
+// completeInteractionComponents replaces a deferred response with an embed and interactive components, returning the message
+func (b *Bot) completeInteractionComponents(s Responder, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed, components []discordgo.MessageComponent) (*discordgo.Message, error) {
+	return b.finishInteraction(s, i, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{b.markDegraded(embed)},
+		Components: &components,
+	})
+}
+
+// completeInteractionFile replaces a deferred response with an embed and a file attachment
+func (b *Bot) completeInteractionFile(s Responder, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed, file *discordgo.File) error {
+	_, err := b.finishInteraction(s, i, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{b.markDegraded(embed)},
+		Files:  []*discordgo.File{file},
+	})
 	return err
 }
 
 // sendMessage sends a text message to a Discord channel
-func (b *Bot) sendMessage(s *discordgo.Session, channelID, message string) {
+func (b *Bot) sendMessage(s Responder, channelID, message string) {
 	_, err := s.ChannelMessageSend(channelID, message)
 	if err != nil {
-		log.Printf("Error sending message: %v", err)
+		logger.Error("error sending message", "error", err)
 	}
 }
 
 // sendEmbed sends an embed message to a Discord channel
-func (b *Bot) sendEmbed(s *discordgo.Session, channelID string, embed *discordgo.MessageEmbed) {
+func (b *Bot) sendEmbed(s Responder, channelID string, embed *discordgo.MessageEmbed) {
 	_, err := s.ChannelMessageSendEmbed(channelID, embed)
 	if err != nil {
-		log.Printf("Error sending embed: %v", err)
+		logger.Error("error sending embed", "error", err)
 	}
 }
 
 // handleSlashHelp handles the /help slash command
-func (b *Bot) handleSlashHelp(s *discordgo.Session, i *discordgo.InteractionCreate) {
+func (b *Bot) handleSlashHelp(s Responder, i *discordgo.InteractionCreate) {
 	embed := &discordgo.MessageEmbed{
 		Title: "🏈 NFL Discord Bot - Slash Commands Guide",
 		Description: "**Intelligent NFL data with real-time stats, schedules, and scores**\n\n" +
-			"*Smart week detection: Wednesday shows previous week, Thursday-Monday shows current week*",
+			"*Smart week detection: follows the official NFL calendar from SportsData.io*",
 		Color: 0x013369,
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name:  "📊 Player Statistics",
 				Value: "`/stats player:<name>` - Current week stats\n" +
-					   "`/stats player:<name> type:Season` - Season totals\n" +
+					   "`/stats player:<name> type:Season` - Season totals (add `per_game:True` for averages)\n" +
 					   "`/stats player:<name> week:<#>` - Specific week\n" +
-					   "*Examples: `/stats player:Josh Allen`, `/stats player:Saquon Barkley week:5`*",
+					   "`/stats player:<name> season_type:<type>` - Preseason week or playoff round\n" +
+					   "`/multistat players:<a, b, ...>` - Up to 8 players' week lines in one table\n" +
+					   "`/trend player:<name> [stat:<stat>]` - Week-by-week chart of yards, TDs, fantasy points, or usage\n" +
+					   "*Examples: `/stats player:Josh Allen`, `/stats player:Saquon Barkley week:5`, `/stats player:Jalen Hurts season_type:Super Bowl`*",
 				Inline: false,
 			},
 			{
 				Name:  "⚖️ Player Comparisons",
-				Value: "`/compare player1:<name> player2:<name>` - Compare current week\n" +
-					   "`/compare player1:<name> player2:<name> type:Season` - Compare season\n" +
-					   "`/compare player1:<name> player2:<name> week:<#>` - Compare specific week\n" +
-					   "*Examples: `/compare player1:Josh Allen player2:Mahomes`*",
+				Value: "`/compare players player1:<name> player2:<name>` - Compare current week\n" +
+					   "`/compare players player1:<name> player2:<name> type:Season` - Compare season (or per game with `per_game:True`)\n" +
+					   "`/compare players player1:<name> player2:<name> week:<#>` - Compare specific week\n" +
+					   "`/compare rematch` / `/compare history` - Re-run your recent comparisons with fresh stats\n" +
+					   "*Examples: `/compare players player1:Josh Allen player2:Mahomes`*",
 				Inline: false,
 			},
 			{
 				Name:  "🏟️ Team Information",
 				Value: "`/team team:<name>` - Complete team details\n" +
-					   "*Shows: Conference, division, coach, stadium*\n" +
-					   "*Examples: `/team team:Bills`, `/team team:Eagles`*",
+					   "`/coaches team:<name>` - Head coach, coordinators, and schemes\n" +
+					   "`/history team:<name>` - Titles, all-time record, and retired numbers",
 				Inline: false,
 			},
 			{
 				Name:  "📅 Team Schedule",
-				Value: "`/schedule team:<name>` - Full season schedule\n" +
-					   "*Shows: Game dates, opponents, scores, BYE weeks*\n" +
-					   "*Examples: `/schedule team:Cowboys`, `/schedule team:Patriots`*",
+				Value: "`/schedule team:<name> [season_type:<type>]` - Full season schedule\n" +
+					   "`/next team:<name>` - The team's next game with a countdown and TV channel",
 				Inline: false,
 			},
 			{
 				Name:  "🔴 Live Scores",
-				Value: "`/scores` - Current week's games and scores\n" +
+				Value: "`/scores` - Current week's games and scores, with per-game line scores\n" +
+					   "`/scores season_type:<type> [week:<#>]` - Preseason weeks and playoff rounds\n" +
+					   "`/scores status:live` - Just the games in progress (also `team:` and `conference:`)\n" +
+					   "`/plays team:<name> [count:<#>]` - The latest plays of a game as a drive log\n" +
+					   "`/drives game:<matchup>` - Drive chart: start, plays, yards, and result\n" +
+					   "`/recap [week:<#>]` - A completed week's highlights, top performers, and upsets\n" +
+					   "`/whattowatch` - This week's national TV games\n" +
 					   "*Shows: Live games, completed games, upcoming games*",
 				Inline: false,
 			},
+			{
+				Name:  "📈 Win Totals",
+				Value: "`/wintotals` - Every team's win pace vs their preseason over/under\n" +
+					   "*Shows: Record, line, projected wins, over/under status*",
+				Inline: false,
+			},
+			{
+				Name:  "💰 Against the Spread",
+				Value: "`/ats [team]` - ATS and over/under records from archived closing lines",
+				Inline: false,
+			},
+			{
+				Name:  "🔮 Futures",
+				Value: "`/futures <team>` - Super Bowl, conference, division, and win total odds vs the bot's simulated probabilities",
+				Inline: false,
+			},
+			{
+				Name:  "🧠 Trivia",
+				Value: "`/trivia play [difficulty]` - Multiple-choice NFL trivia for the channel\n" +
+					   "`/trivia leaderboard` - Server trivia standings",
+				Inline: false,
+			},
+			{
+				Name:  "🗳️ Predictions",
+				Value: "`/predict game:<matchup>` - Community vote on a game; accuracy posted after the final",
+				Inline: false,
+			},
+			{
+				Name:  "📅 League Dates",
+				Value: "`/league dates list` - Keeper, trade, and playoff deadlines\n" +
+					   "`/league dates set` - Register a date with automatic reminders (admins)\n" +
+					   "`/league timezone` - Show or set the server time zone\n" +
+					   "`/timezone [zone]` - Show game times in your own time zone (`reset` to clear)",
+				Inline: false,
+			},
+			{
+				Name:  "🏈 Pick'em",
+				Value: "`/pickem picks [type]` - Pick winners, spreads, or totals (locks at the week's first kickoff)\n" +
+					   "`/pickem leaderboard [type]` - Season and weekly standings\n" +
+					   "`/pickem league standings [type]` - Combined standings with other servers in your league\n" +
+					   "`/pickem create` - Start a pool for the server (admins)",
+				Inline: false,
+			},
+			{
+				Name:  "⏰ Reminders",
+				Value: "`/remind game team:<name> [minutes_before:<n>] [deliver:<dm|here>]` - Reminder before kickoff\n" +
+					   "`/remind list` / `/remind cancel id:<id>` - Manage your reminders",
+				Inline: false,
+			},
+			{
+				Name:  "🎯 Fantasy",
+				Value: "`/mockdraft start teams:<n> [rounds:<n>] [pick_clock:<seconds>]` - Run a mock draft in a thread\n" +
+					   "`/draftkit [scoring] [position]` - Positional rankings and auction values (CSV attached)\n" +
+					   "`/rookies [position]` - This season's top rookies by fantasy points\n" +
+					   "`/waivers [position] [rostered_threshold]` - Low scorers trending up the last two weeks\n" +
+					   "`/value position:<pos> [site]` - The coming week's best DFS values per $1k of salary\n" +
+					   "`/matchup-player player:<name>` - Next opponent's defense vs the player's position",
+				Inline: false,
+			},
+			{
+				Name:  "🏆 Standings & Playoffs",
+				Value: "`/standings [conference:<AFC|NFC>]` - Division standings with clinch markers\n" +
+					   "`/division division:<AFC East etc.>` - Division standings, head-to-head, remaining division games\n" +
+					   "`/playoffpicture [conference:<AFC|NFC>]` - Seeds, teams in the hunt, eliminated teams\n" +
+					   "`/playoffodds team:<name>` - Simulated playoff and division title chances\n" +
+					   "`/powerrankings` - Elo power rankings with weekly movement\n" +
+					   "`/whatif results:<BUF over KC, ...>` - Seeding if this week's games go your way\n" +
+					   "`/scenarios team:<name>` - What a team can clinch or be eliminated from this week\n" +
+					   "`/safepicks [week:<n>]` - The week's safest winners for pick'em and survivor pools\n" +
+					   "`/draftorder` - Projected draft order with tanking watch for your teams\n" +
+					   "`/draft picks team:<name> [year:<n>]` - A team's draft class\n" +
+					   "*Late in the season /standings and /playoffpicture show playoff magic numbers*",
+				Inline: false,
+			},
+			{
+				Name:  "⭐ Your Teams & Players",
+				Value: "`/follow team:<name>` / `/unfollow team:<name>` - Manage the teams you follow\n" +
+					   "`/follow player:<name>` - Get a player's stat line after each game\n" +
+					   "`/watchlist add|remove [player] [team]` - Build a watch list; `/watchlist summary` DMs you its results every Monday\n" +
+					   "`/today` - Your teams' games, players' statuses, pick'em deadlines, and reminders for today\n" +
+					   "`/favorite set [team] [player]` - Defaults for `/stats`, `/trend`, `/team`, `/schedule`, `/next`, and more when you leave out the name\n" +
+					   "`/alias list` - The server's shorthand for players and teams (e.g. `cmc`), usable in any player or team option",
+				Inline: false,
+			},
 			{
 				Name:  "⚡ Smart Features",
 				Value: "• **Ephemeral Responses** - Only you can see responses (if configured)\n" +
 					   "• **Auto Week Detection** - Always shows current NFL week\n" +
-					   "• **5-Minute Caching** - Fast responses, reduced API calls\n" +
+					   "• **Smart Caching** - Live scores refresh every minute, static data cached longer\n" +
 					   "• **Real-Time Data** - Live stats from SportsData.io",
 				Inline: false,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "🤖 Data updates every 5 minutes | 📡 Powered by SportsData.io | ⚡ Slash Commands",
+			Text: "🤖 Live scores update every minute | 📡 Powered by SportsData.io | ⚡ Slash Commands",
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
 	}
 
 	err := b.respondInteractionEmbed(s, i, embed)
 	if err != nil {
-		log.Printf("Error responding to help slash command: %v", err)
+		logger.Error("error responding to help slash command", "error", err)
 	}
 }
 
 // handleSlashStats handles the /stats slash command
-func (b *Bot) handleSlashStats(s *discordgo.Session, i *discordgo.InteractionCreate) {
+func (b *Bot) handleSlashStats(s Responder, i *discordgo.InteractionCreate) {
 	options := i.ApplicationCommandData().Options
-	if len(options) == 0 {
-		err := b.respondInteraction(s, i, "Please provide a player name.")
-		if err != nil {
-			log.Printf("Error responding to stats slash command: %v", err)
-		}
-		return
-	}
 
 	// Parse options
 	var playerName string
 	var statsType string = "current"
 	var week *int64
 	var year *int64
+	var seasonType string
+	var perGame bool
 
 	for _, option := range options {
 		switch option.Name {
@@ -1451,37 +2860,55 @@ func (b *Bot) handleSlashStats(s *discordgo.Session, i *discordgo.InteractionCre
 		case "year":
 			yearVal := option.IntValue()
 			year = &yearVal
+		case "season_type":
+			seasonType = option.StringValue()
+		case "per_game":
+			perGame = option.BoolValue()
 		}
 	}
-
-	// Send initial response
-	var responseMsg string
-	if statsType == "season" {
-		responseMsg = "⏳ Fetching season stats... (this may take a moment)"
-	} else if week != nil {
-		responseMsg = "⏳ Fetching week-specific stats..."
-	} else {
-		responseMsg = "⏳ Fetching current week stats..."
+	playerName = b.playerOrFavorite(i, playerName)
+	if playerName == "" {
+		err := b.respondInteraction(s, i, missingPlayerMessage)
+		if err != nil {
+			logger.Error("error responding to stats slash command", "error", err)
+		}
+		return
+	}
+	if perGame && statsType != "season" {
+		respondEphemeral(s, i, b.tr(i, "stats.per_game_needs_season"))
+		return
 	}
 
-	err := b.respondInteraction(s, i, responseMsg)
+	// Acknowledge with Discord's "thinking…" state while stats load
+	err := b.deferInteraction(s, i)
 	if err != nil {
-		log.Printf("Error sending initial stats response: %v", err)
+		logger.Error("error sending initial stats response", "error", err)
 		return
 	}
 
 	// Process stats request asynchronously
-	go b.processSlashStatsRequest(s, i, playerName, statsType, week, year)
+	go b.processSlashStatsRequest(s, i, playerName, statsType, seasonType, week, year, perGame)
 }
 
 // handleSlashCompare handles the /compare slash command
-func (b *Bot) handleSlashCompare(s *discordgo.Session, i *discordgo.InteractionCreate) {
+func (b *Bot) handleSlashCompare(s Responder, i *discordgo.InteractionCreate) {
 	options := i.ApplicationCommandData().Options
-	if len(options) < 2 {
-		err := b.respondInteraction(s, i, "Please provide both player names for comparison.")
-		if err != nil {
-			log.Printf("Error responding to compare slash command: %v", err)
+	if len(options) == 0 {
+		return
+	}
+
+	subcommand := options[0]
+	switch subcommand.Name {
+	case "rematch":
+		history := b.preferences.Get(interactionUserID(i)).Comparisons
+		if len(history) == 0 {
+			respondEphemeral(s, i, b.tr(i, "compare.no_history"))
+			return
 		}
+		b.rerunComparison(s, i, history[0])
+		return
+	case "history":
+		b.respondCompareHistory(s, i)
 		return
 	}
 
@@ -1489,8 +2916,9 @@ func (b *Bot) handleSlashCompare(s *discordgo.Session, i *discordgo.InteractionC
 	var player1, player2 string
 	var statsType string = "current"
 	var week *int64
+	var perGame bool
 
-	for _, option := range options {
+	for _, option := range subcommand.Options {
 		switch option.Name {
 		case "player1":
 			player1 = option.StringValue()
@@ -1501,35 +2929,52 @@ func (b *Bot) handleSlashCompare(s *discordgo.Session, i *discordgo.InteractionC
 		case "week":
 			weekVal := option.IntValue()
 			week = &weekVal
+		case "per_game":
+			perGame = option.BoolValue()
+		}
+	}
+	if player1 == "" || player2 == "" {
+		err := b.respondInteraction(s, i, "Please provide both player names for comparison.")
+		if err != nil {
+			logger.Error("error responding to compare slash command", "error", err)
 		}
+		return
+	}
+	if perGame && statsType != "season" {
+		respondEphemeral(s, i, b.tr(i, "stats.per_game_needs_season"))
+		return
 	}
 
-	err := b.respondInteraction(s, i, "⏳ Fetching player comparison...")
+	err := b.deferInteraction(s, i)
 	if err != nil {
-		log.Printf("Error sending initial compare response: %v", err)
+		logger.Error("error sending initial compare response", "error", err)
 		return
 	}
 
 	// Process compare request asynchronously
-	go b.processSlashCompareRequest(s, i, player1, player2, statsType, week)
+	go b.processSlashCompareRequest(s, i, player1, player2, statsType, week, perGame)
 }
 
 // handleSlashTeam handles the /team slash command
-func (b *Bot) handleSlashTeam(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	if len(options) == 0 {
-		err := b.respondInteraction(s, i, "Please provide a team name.")
+func (b *Bot) handleSlashTeam(s Responder, i *discordgo.InteractionCreate) {
+	var teamName string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "team" {
+			teamName = option.StringValue()
+		}
+	}
+	teamName = b.teamOrFavorite(i, teamName)
+	if teamName == "" {
+		err := b.respondInteraction(s, i, missingTeamMessage)
 		if err != nil {
-			log.Printf("Error responding to team slash command: %v", err)
+			logger.Error("error responding to team slash command", "error", err)
 		}
 		return
 	}
 
-	teamName := options[0].StringValue()
-
-	err := b.respondInteraction(s, i, "⏳ Fetching team information...")
+	err := b.deferInteraction(s, i)
 	if err != nil {
-		log.Printf("Error sending initial team response: %v", err)
+		logger.Error("error sending initial team response", "error", err)
 		return
 	}
 
@@ -1538,53 +2983,94 @@ func (b *Bot) handleSlashTeam(s *discordgo.Session, i *discordgo.InteractionCrea
 }
 
 // handleSlashSchedule handles the /schedule slash command
-func (b *Bot) handleSlashSchedule(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	if len(options) == 0 {
-		err := b.respondInteraction(s, i, "Please provide a team name.")
+func (b *Bot) handleSlashSchedule(s Responder, i *discordgo.InteractionCreate) {
+	var teamName, seasonType string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "team":
+			teamName = option.StringValue()
+		case "season_type":
+			seasonType = option.StringValue()
+		}
+	}
+	teamName = b.teamOrFavorite(i, teamName)
+	if teamName == "" {
+		err := b.respondInteraction(s, i, missingTeamMessage)
 		if err != nil {
-			log.Printf("Error responding to schedule slash command: %v", err)
+			logger.Error("error responding to schedule slash command", "error", err)
 		}
 		return
 	}
 
-	teamName := options[0].StringValue()
-
-	err := b.respondInteraction(s, i, "⏳ Fetching team schedule...")
+	err := b.deferInteraction(s, i)
 	if err != nil {
-		log.Printf("Error sending initial schedule response: %v", err)
+		logger.Error("error sending initial schedule response", "error", err)
 		return
 	}
 
 	// Process schedule request asynchronously
-	go b.processSlashScheduleRequest(s, i, teamName)
+	go b.processSlashScheduleRequest(s, i, teamName, seasonType)
 }
 
 // handleSlashScores handles the /scores slash command
-func (b *Bot) handleSlashScores(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	err := b.respondInteraction(s, i, "⏳ Fetching current week scores...")
+func (b *Bot) handleSlashScores(s Responder, i *discordgo.InteractionCreate) {
+	var seasonType string
+	var week *int64
+	var filter scoresFilter
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "season_type":
+			seasonType = option.StringValue()
+		case "week":
+			weekVal := option.IntValue()
+			week = &weekVal
+		case "status":
+			filter.Status = option.StringValue()
+		case "team":
+			filter.TeamName = option.StringValue()
+		case "conference":
+			filter.Conference = option.StringValue()
+		}
+	}
+
+	err := b.deferInteraction(s, i)
 	if err != nil {
-		log.Printf("Error sending initial scores response: %v", err)
+		logger.Error("error sending initial scores response", "error", err)
 		return
 	}
 
 	// Process scores request asynchronously
-	go b.processSlashScoresRequest(s, i)
+	go b.processSlashScoresRequest(s, i, seasonType, week, filter)
 }
 
-// processSlashStatsRequest processes the stats request and sends a followup message
-func (b *Bot) processSlashStatsRequest(s *discordgo.Session, i *discordgo.InteractionCreate, playerName, statsType string, week, year *int64) {
+// processSlashStatsRequest processes the stats request and completes the deferred response
+func (b *Bot) processSlashStatsRequest(s Responder, i *discordgo.InteractionCreate, playerName, statsType, seasonTypeChoice string, week, year *int64, perGame bool) {
 	// Determine what type of stats to fetch
 	var isSeasonStats bool
 	var specificWeek int
 	var specificSeason int
+	var specificSeasonType string
 	var useSpecificWeek bool
 	
 	if statsType == "season" {
 		isSeasonStats = true
+	} else if seasonTypeChoice != "" {
+		seasonWeek, err := b.resolveSeasonWeek(seasonTypeChoice, week)
+		if err != nil {
+			b.completeInteraction(s, i, b.userErrorFor(i, fmt.Sprintf("Error getting stats for %s", playerName), err))
+			return
+		}
+		useSpecificWeek = true
+		specificWeek = seasonWeek.Week
+		specificSeason = seasonWeek.Season
+		specificSeasonType = seasonWeek.SeasonType
+		if year != nil {
+			specificSeason = int(*year)
+		}
 	} else if week != nil {
 		useSpecificWeek = true
 		specificWeek = int(*week)
+		specificSeasonType = models.SeasonTypeRegular
 		if year != nil {
 			specificSeason = int(*year)
 		} else {
@@ -1599,64 +3085,65 @@ func (b *Bot) processSlashStatsRequest(s *discordgo.Session, i *discordgo.Intera
 	if isSeasonStats {
 		stats, err = b.nflClient.GetPlayerSeasonStats(playerName)
 	} else if useSpecificWeek {
-		stats, err = b.nflClient.GetPlayerWeekStats(playerName, specificSeason, specificWeek)
+		stats, err = b.nflClient.GetPlayerWeekStatsForType(playerName, specificSeason, specificSeasonType, specificWeek)
 	} else {
 		stats, err = b.nflClient.GetPlayerStats(playerName)
 	}
 	
 	if err != nil {
-		statsType := "current week"
+		statsLabel := "current week"
 		if isSeasonStats {
-			statsType = "season sample"
+			statsLabel = "season sample"
 		} else if useSpecificWeek {
-			statsType = fmt.Sprintf("Week %d, %d", specificWeek, specificSeason)
+			statsLabel = fmt.Sprintf("%s, %d", models.WeekLabel(specificSeasonType, specificWeek), specificSeason)
+		}
+		errorMsg := b.userErrorFor(i, fmt.Sprintf("Error getting %s stats for %s", statsLabel, playerName), err)
+		if buttons := didYouMeanButtons(err, "stats", statsQueryArgs(statsType, seasonTypeChoice, week, year, perGame)); buttons != nil {
+			b.completeInteractionSuggestions(s, i, errorMsg, buttons)
+		} else {
+			b.completeInteraction(s, i, errorMsg)
 		}
-		errorMsg := fmt.Sprintf("Error getting %s stats for %s: %v", statsType, playerName, err)
-		b.followupInteraction(s, i, errorMsg)
 		return
 	}
 	
 	// Create embed with player stats
-	statsTitle := "Current Week Stats (2025)"
+	language := b.interactionLanguage(i)
+	statsTitle := i18n.T(language, "stats.title.current")
 	if isSeasonStats {
-		statsTitle = "2024 Sample Stats (6 games)"
+		statsTitle = i18n.T(language, "stats.title.sample")
 	} else if useSpecificWeek {
-		statsTitle = fmt.Sprintf("Week %d, %d Stats", specificWeek, specificSeason)
+		statsTitle = i18n.T(language, "stats.title.week", embeds.WeekLabel(specificSeasonType, specificWeek, language), specificSeason)
 	}
 	
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("📊 %s - %s", stats.Name, statsTitle),
-		Color: 0x0099ff,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "Team",
-				Value:  stats.Team,
-				Inline: true,
-			},
-			{
-				Name:   "Position",
-				Value:  stats.Position,
-				Inline: true,
-			},
-			{
-				Name:   "Season Stats",
-				Value:  stats.GetStatsString(),
-				Inline: false,
-			},
-		},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Data from NFL API",
-		},
+	var embed *discordgo.MessageEmbed
+	if perGame && isSeasonStats {
+		embed = embeds.PerGameStatsEmbed(stats, statsTitle, b.guildFormat(i.GuildID), language)
+	} else {
+		embed = embeds.PlayerStatsEmbed(stats, statsTitle, b.guildFormat(i.GuildID), language)
+	}
+	b.themeEmbedForTeam(embed, stats.Team)
+	addHeadshot(embed, b.playerPhoto(stats))
+	
+	// DFS salaries are priced per week: show that week's, or the coming week's for current and season stats
+	if useSpecificWeek {
+		b.addSalaryField(embed, stats, &models.SeasonInfo{Season: specificSeason, SeasonType: specificSeasonType, Week: specificWeek})
+	} else if seasonInfo, err := b.nflClient.GetCurrentSeason(); err == nil {
+		b.addSalaryField(embed, stats, seasonInfo)
 	}
 	
-	err = b.followupInteractionEmbed(s, i, embed)
+	// Reference links ride along as buttons when there are any to offer
+	if buttons := b.playerLinkButtons(stats); buttons != nil {
+		_, err = b.completeInteractionComponents(s, i, embed, buttons)
+	} else {
+		err = b.completeInteractionEmbed(s, i, embed)
+	}
 	if err != nil {
-		log.Printf("Error sending stats embed followup: %v", err)
+		logger.Error("error sending stats embed response", "error", err)
 	}
 }
 
-// processSlashCompareRequest processes the compare request and sends a followup message
-func (b *Bot) processSlashCompareRequest(s *discordgo.Session, i *discordgo.InteractionCreate, player1, player2, statsType string, week *int64) {
+// processSlashCompareRequest processes the compare request and completes the deferred response
+func (b *Bot) processSlashCompareRequest(s Responder, i *discordgo.InteractionCreate, player1, player2, statsType string, week *int64, perGame bool) {
 	// Determine what type of stats to fetch
 	var isSeasonStats bool
 	var specificWeek int
@@ -1688,13 +3175,13 @@ func (b *Bot) processSlashCompareRequest(s *discordgo.Session, i *discordgo.Inte
 	
 	// Handle errors
 	if err1 != nil {
-		errorMsg := fmt.Sprintf("Error getting stats for %s: %v", player1, err1)
-		b.followupInteraction(s, i, errorMsg)
+		errorMsg := b.userErrorFor(i, fmt.Sprintf("Error getting stats for %s", player1), err1)
+		b.completeInteraction(s, i, errorMsg)
 		return
 	}
 	if err2 != nil {
-		errorMsg := fmt.Sprintf("Error getting stats for %s: %v", player2, err2)
-		b.followupInteraction(s, i, errorMsg)
+		errorMsg := b.userErrorFor(i, fmt.Sprintf("Error getting stats for %s", player2), err2)
+		b.completeInteraction(s, i, errorMsg)
 		return
 	}
 	
@@ -1702,160 +3189,182 @@ func (b *Bot) processSlashCompareRequest(s *discordgo.Session, i *discordgo.Inte
 	comparisonTitle := "Player Comparison"
 	if isSeasonStats {
 		comparisonTitle = "Season Comparison (2024 Sample)"
+		if perGame {
+			comparisonTitle = "Per Game Comparison (2024 Sample)"
+		}
 	} else if useSpecificWeek {
 		comparisonTitle = fmt.Sprintf("Week %d, %d Comparison", specificWeek, specificSeason)
 	}
 	
-	embed := b.createComparisonEmbed(stats1, stats2, comparisonTitle)
-	err := b.followupInteractionEmbed(s, i, embed)
+	compared := &comparison{
+		stats1:  stats1,
+		stats2:  stats2,
+		photo1:  b.playerPhoto(stats1),
+		photo2:  b.playerPhoto(stats2),
+		title:   comparisonTitle,
+		perGame: perGame && isSeasonStats,
+		format:  b.guildFormat(i.GuildID),
+		expires: time.Now().Add(comparisonLifetime),
+	}
+	embed := b.createComparisonViewEmbed(compared, compareViewOverview)
+	message, err := b.completeInteractionComponents(s, i, embed, compareViewMenu(compareViewOverview))
 	if err != nil {
-		log.Printf("Error sending compare embed followup: %v", err)
+		logger.Error("error sending compare embed response", "error", err)
+		return
+	}
+
+	// Remember the stats so the select menu can switch views without refetching
+	b.comparisons.Put(message.ID, compared)
+
+	saved := SavedComparison{Player1: stats1.Name, Player2: stats2.Name, Type: statsType, PerGame: compared.perGame, At: time.Now()}
+	if useSpecificWeek {
+		saved.Week = specificWeek
 	}
+	b.recordComparison(interactionUserID(i), saved)
 }
 
-// processSlashTeamRequest processes the team request and sends a followup message
-func (b *Bot) processSlashTeamRequest(s *discordgo.Session, i *discordgo.InteractionCreate, teamName string) {
+// processSlashTeamRequest processes the team request and completes the deferred response
+func (b *Bot) processSlashTeamRequest(s Responder, i *discordgo.InteractionCreate, teamName string) {
 	// Get team info from NFL client
 	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
 	if err != nil {
-		errorMsg := fmt.Sprintf("Error getting team info for %s: %v", teamName, err)
-		b.followupInteraction(s, i, errorMsg)
+		errorMsg := b.userErrorFor(i, fmt.Sprintf("Error getting team info for %s", teamName), err)
+		b.completeInteraction(s, i, errorMsg)
 		return
 	}
 	
 	// Create embed with team info
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("🏈 %s %s", teamInfo.City, teamInfo.Name),
-		Color: 0xff6600,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "Conference",
-				Value:  teamInfo.Conference,
-				Inline: true,
-			},
-			{
-				Name:   "Division",
-				Value:  teamInfo.Division,
-				Inline: true,
-			},
-			{
-				Name:   "Head Coach",
-				Value:  teamInfo.Coach,
-				Inline: true,
-			},
-			{
-				Name:   "Stadium",
-				Value:  teamInfo.Stadium,
-				Inline: false,
-			},
-		},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Team data from NFL API",
-		},
-	}
+	embed := embeds.TeamEmbed(teamInfo)
+	themeEmbed(embed, teamInfo)
 	
-	err = b.followupInteractionEmbed(s, i, embed)
+	err = b.completeInteractionEmbed(s, i, embed)
 	if err != nil {
-		log.Printf("Error sending team embed followup: %v", err)
+		logger.Error("error sending team embed response", "error", err)
 	}
 }
 
-// processSlashScheduleRequest processes the schedule request and sends a followup message
-func (b *Bot) processSlashScheduleRequest(s *discordgo.Session, i *discordgo.InteractionCreate, teamName string) {
+// processSlashScheduleRequest processes the schedule request and completes the deferred response
+func (b *Bot) processSlashScheduleRequest(s Responder, i *discordgo.InteractionCreate, teamName, seasonTypeChoice string) {
 	// Get team schedule from NFL client
-	schedule, err := b.nflClient.GetTeamSchedule(teamName)
+	var schedule *models.Schedule
+	var err error
+	var roundWeek int
+	language := b.interactionLanguage(i)
+	seasonLabel := i18n.T(language, "schedule.season_label."+models.SeasonTypeRegular)
+
+	if seasonTypeChoice == "" {
+		schedule, err = b.nflClient.GetTeamSchedule(teamName)
+	} else {
+		var seasonType string
+		seasonType, roundWeek, err = models.ParseSeasonType(seasonTypeChoice)
+		if err == nil {
+			var current *models.SeasonInfo
+			current, err = b.nflClient.GetCurrentSeason()
+			if err == nil {
+				schedule, err = b.nflClient.GetTeamScheduleForSeason(teamName, current.Season, seasonType)
+			}
+		}
+		if seasonType == models.SeasonTypePreseason || seasonType == models.SeasonTypePostseason {
+			seasonLabel = i18n.T(language, "schedule.season_label."+seasonType)
+		}
+	}
 	if err != nil {
-		errorMsg := fmt.Sprintf("Error getting schedule for %s: %v", teamName, err)
-		b.followupInteraction(s, i, errorMsg)
+		errorMsg := b.userErrorFor(i, fmt.Sprintf("Error getting schedule for %s", teamName), err)
+		b.completeInteraction(s, i, errorMsg)
 		return
 	}
-	
-	// Create embed with schedule (show first 10 games to avoid too long message)
-	var scheduleText string
-	gamesToShow := schedule.Games
-	if len(gamesToShow) > 10 {
-		gamesToShow = gamesToShow[:10]
-	}
-	
-	for _, game := range gamesToShow {
-		// Check if this is a BYE week
-		if game.HomeTeam == "BYE" || game.AwayTeam == "BYE" {
-			scheduleText += fmt.Sprintf("**Week %d**: 🛌 **BYE WEEK** - Rest and Recovery\n", game.Week)
-			continue
+
+	// Narrow to a single playoff round when one was requested
+	if roundWeek > 0 {
+		var roundGames []models.Game
+		for _, game := range schedule.Games {
+			if game.Week == roundWeek {
+				roundGames = append(roundGames, game)
+			}
 		}
-		
-		gameDate := game.GameTime.Format("Jan 2, 3:04 PM")
-		if game.IsCompleted() {
-			scheduleText += fmt.Sprintf("**Week %d**: %s @ %s - %s %d-%d (Final)\n", 
-				game.Week, game.AwayTeam, game.HomeTeam, game.Winner(), game.AwayScore, game.HomeScore)
-		} else if game.IsLive() {
-			scheduleText += fmt.Sprintf("**Week %d**: %s @ %s - %d-%d (LIVE)\n", 
-				game.Week, game.AwayTeam, game.HomeTeam, game.AwayScore, game.HomeScore)
-		} else {
-			scheduleText += fmt.Sprintf("**Week %d**: %s @ %s - %s\n", 
-				game.Week, game.AwayTeam, game.HomeTeam, gameDate)
+		if len(roundGames) == 0 {
+			b.completeInteraction(s, i, fmt.Sprintf("No %s game found for %s.", models.PlayoffRounds[roundWeek], teamName))
+			return
 		}
+		schedule = &models.Schedule{TeamName: schedule.TeamName, Season: schedule.Season, Games: roundGames}
+		seasonLabel = embeds.WeekLabel(models.SeasonTypePostseason, roundWeek, language)
 	}
 	
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("📅 %s Schedule (%d Season)", schedule.TeamName, schedule.Season),
-		Color: 0x00ff00,
-		Description: scheduleText,
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("Showing %d of %d games", len(gamesToShow), len(schedule.Games)),
-		},
+	location := b.userLocation(i.GuildID, interactionUserID(i))
+	spoilers := b.scoreSpoilers(i.GuildID)
+	embed := embeds.ScheduleEmbed(schedule, seasonLabel, location, spoilers, b.guildFormat(i.GuildID), language)
+	var revealed *discordgo.MessageEmbed
+	if spoilers != embeds.SpoilersOff {
+		revealed = embeds.ScheduleEmbed(schedule, seasonLabel, location, embeds.SpoilersOff, b.guildFormat(i.GuildID), language)
+	}
+	if teamInfo, err := b.nflClient.GetTeamInfo(teamName); err == nil {
+		themeEmbed(embed, teamInfo)
+		if revealed != nil {
+			themeEmbed(revealed, teamInfo)
+		}
 	}
 	
-	err = b.followupInteractionEmbed(s, i, embed)
+	err = b.completeInteractionScores(s, i, embed, revealed)
 	if err != nil {
-		log.Printf("Error sending schedule embed followup: %v", err)
+		logger.Error("error sending schedule embed response", "error", err)
 	}
 }
 
-// processSlashScoresRequest processes the scores request and sends a followup message
-func (b *Bot) processSlashScoresRequest(s *discordgo.Session, i *discordgo.InteractionCreate) {
+// processSlashScoresRequest processes the scores request and completes the deferred response
+func (b *Bot) processSlashScoresRequest(s Responder, i *discordgo.InteractionCreate, seasonTypeChoice string, week *int64, filter scoresFilter) {
 	// Get live scores from NFL client
-	liveScores, err := b.nflClient.GetLiveScores()
+	var seasonWeek *models.SeasonInfo
+	var err error
+	if seasonTypeChoice != "" || week != nil {
+		seasonWeek, err = b.resolveSeasonWeek(seasonTypeChoice, week)
+	} else {
+		seasonWeek, err = b.nflClient.GetCurrentSeason()
+	}
+	if err != nil {
+		b.completeInteraction(s, i, b.userErrorFor(i, "Error getting live scores", err))
+		return
+	}
+
+	liveScores, err := b.nflClient.GetScoresForWeek(seasonWeek.Season, seasonWeek.SeasonType, seasonWeek.Week)
 	if err != nil {
-		errorMsg := fmt.Sprintf("Error getting live scores: %v", err)
-		b.followupInteraction(s, i, errorMsg)
+		errorMsg := b.userErrorFor(i, "Error getting live scores", err)
+		b.completeInteraction(s, i, errorMsg)
 		return
 	}
 	
 	if len(liveScores) == 0 {
-		b.followupInteraction(s, i, "No games found for this week.")
+		b.completeInteraction(s, i, fmt.Sprintf("No games found for %s.", seasonWeek.WeekLabel()))
 		return
 	}
-	
-	// Create embed with live scores
-	var scoresText string
-	liveCount := 0
-	completedCount := 0
-	
-	for _, score := range liveScores {
-		if score.IsLive() {
-			scoresText += fmt.Sprintf("🔴 **%s** - %s\n", "LIVE", score.GetScoreString())
-			liveCount++
-		} else if score.IsCompleted() {
-			scoresText += fmt.Sprintf("✅ **FINAL** - %s\n", score.GetScoreString())
-			completedCount++
-		} else {
-			gameTime := score.GameTime.Format("Jan 2, 3:04 PM")
-			scoresText += fmt.Sprintf("📅 **%s** - %s @ %s\n", gameTime, score.AwayTeam, score.HomeTeam)
+
+	language := b.interactionLanguage(i)
+	weekLabel := embeds.WeekLabel(seasonWeek.SeasonType, seasonWeek.Week, language)
+	if filter.active() {
+		if err := b.resolveScoresFilter(&filter); err != nil {
+			b.completeInteraction(s, i, b.userErrorFor(i, "Error filtering scores", err))
+			return
+		}
+		liveScores = filter.apply(liveScores)
+		if len(liveScores) == 0 {
+			b.completeInteraction(s, i, filter.emptyMessage(weekLabel))
+			return
 		}
+		weekLabel += " (" + filter.label() + ")"
 	}
 	
-	embed := &discordgo.MessageEmbed{
-		Title: fmt.Sprintf("🏈 NFL Scores - Week %d", liveScores[0].Week),
-		Color: 0x013369,
-		Description: scoresText,
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("%d live, %d completed, %d total games", liveCount, completedCount, len(liveScores)),
-		},
+	location := b.userLocation(i.GuildID, interactionUserID(i))
+	spoilers := b.scoreSpoilers(i.GuildID)
+	embed := embeds.ScoresEmbed(weekLabel, liveScores, location, spoilers, b.guildFormat(i.GuildID), language)
+	b.themeSingleGameScores(embed, liveScores)
+	var revealed *discordgo.MessageEmbed
+	if spoilers != embeds.SpoilersOff {
+		revealed = embeds.ScoresEmbed(weekLabel, liveScores, location, embeds.SpoilersOff, b.guildFormat(i.GuildID), language)
+		b.themeSingleGameScores(revealed, liveScores)
 	}
 	
-	err = b.followupInteractionEmbed(s, i, embed)
+	detailMenu := scoreDetailMenu(seasonWeek.Season, seasonWeek.SeasonType, seasonWeek.Week, liveScores)
+	err = b.completeInteractionScores(s, i, embed, revealed, detailMenu...)
 	if err != nil {
-		log.Printf("Error sending scores embed followup: %v", err)
+		logger.Error("error sending scores embed response", "error", err)
 	}
 }
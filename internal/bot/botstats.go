@@ -0,0 +1,146 @@
+package bot
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// commandCounter counts command invocations since startup
+type commandCounter struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+// newCommandCounter creates an empty counter
+func newCommandCounter() *commandCounter {
+	return &commandCounter{counts: make(map[string]int64)}
+}
+
+// Record counts one invocation of a command
+func (cc *commandCounter) Record(command string) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	cc.counts[command]++
+}
+
+// Top returns the most used commands and the total invocation count
+func (cc *commandCounter) Top(limit int) ([]string, int64) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	var names []string
+	var total int64
+	for name, count := range cc.counts {
+		names = append(names, name)
+		total += count
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if cc.counts[names[i]] != cc.counts[names[j]] {
+			return cc.counts[names[i]] > cc.counts[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	if len(names) > limit {
+		names = names[:limit]
+	}
+
+	lines := make([]string, len(names))
+	for index, name := range names {
+		lines[index] = fmt.Sprintf("`%s` %d", name, cc.counts[name])
+	}
+	return lines, total
+}
+
+// handleSlashBotStats handles the /botstats slash command
+func (b *Bot) handleSlashBotStats(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if !canManageGuild(i) && (b.ownerID == "" || interactionUserID(i) != b.ownerID) {
+		respondEphemeral(s, i, b.tr(i, "permission.botstats"))
+		return
+	}
+
+	usage := b.nflClient.Usage()
+	var memory runtime.MemStats
+	runtime.ReadMemStats(&memory)
+
+	topCommands, totalCommands := b.commandCounts.Top(8)
+	commands := "None yet"
+	if len(topCommands) > 0 {
+		commands = strings.Join(topCommands, "\n")
+	}
+
+	quota := "Set `NFL_API_MONTHLY_QUOTA` to estimate"
+	if b.config.NFLAPIMonthlyQuota > 0 {
+		remaining := int64(b.config.NFLAPIMonthlyQuota) - usage.MonthAPICalls
+		if remaining < 0 {
+			remaining = 0
+		}
+		quota = fmt.Sprintf("~%d of %d left this month", remaining, b.config.NFLAPIMonthlyQuota)
+	}
+
+	schemaStatus := "✅ No drift"
+	if drift := b.nflClient.SchemaDrift(); len(drift) > 0 {
+		schemaStatus = fmt.Sprintf("⚠️ %d issues\n%s", len(drift), driftSummary(drift, 3))
+	}
+	if unknown := models.UnknownStatuses(); len(unknown) > 0 {
+		var statuses []string
+		for _, status := range unknown {
+			statuses = append(statuses, fmt.Sprintf("`%s` ×%d", status.Status, status.Count))
+		}
+		schemaStatus += "\n❓ Unknown game statuses (shown as-is): " + strings.Join(statuses, ", ")
+	}
+
+	apiStatus := "✅ Available"
+	if health := b.nflClient.Health(); !health.Available {
+		apiStatus = fmt.Sprintf("⚠️ Down since <t:%d:R> • %d stale responses served", health.DownSince.Unix(), health.StaleServed)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "🤖 Bot Stats",
+		Color: 0x013369,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Uptime", Value: time.Since(b.startedAt).Round(time.Second).String(), Inline: true},
+			{Name: "Servers", Value: fmt.Sprintf("%d", len(s.State.Guilds)), Inline: true},
+			{Name: "Gateway Latency", Value: s.HeartbeatLatency().Round(time.Millisecond).String(), Inline: true},
+			{
+				Name: "NFL API",
+				Value: fmt.Sprintf("%s\n%d calls • %d errors\nAvg latency %s\nQuota: %s",
+					apiStatus, usage.APICalls, usage.APIErrors, usage.APILatency.Round(time.Millisecond), quota),
+				Inline: true,
+			},
+			{
+				Name: "Cache",
+				Value: fmt.Sprintf("%.0f%% hit rate\n%d hits • %d misses",
+					usage.CacheHitRate()*100, usage.CacheHits, usage.CacheMisses),
+				Inline: true,
+			},
+			{
+				Name: "Memory",
+				Value: fmt.Sprintf("%.1f MB heap • %.1f MB from OS\n%d goroutines",
+					float64(memory.HeapAlloc)/(1<<20), float64(memory.Sys)/(1<<20), runtime.NumGoroutine()),
+				Inline: true,
+			},
+			{Name: "API Schema", Value: schemaStatus, Inline: false},
+			{Name: fmt.Sprintf("Commands (%d total)", totalCommands), Value: commands, Inline: false},
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: "Counts are since the last restart"},
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{embed},
+			Flags:  discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		logger.Error("error responding to botstats slash command", "error", err)
+	}
+}
@@ -0,0 +1,83 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/i18n"
+	"nfl-discord-bot/pkg/models"
+)
+
+// handleSlashCoaches handles the /coaches slash command
+func (b *Bot) handleSlashCoaches(s Responder, i *discordgo.InteractionCreate) {
+	var teamName string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "team" {
+			teamName = option.StringValue()
+		}
+	}
+	teamName = b.teamOrFavorite(i, teamName)
+	if teamName == "" {
+		b.respondInteraction(s, i, missingTeamMessage)
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial coaches response", "error", err)
+		return
+	}
+
+	// Process coaches request asynchronously
+	go b.processSlashCoachesRequest(s, i, teamName)
+}
+
+// processSlashCoachesRequest lists a team's coaching staff and completes the deferred response
+func (b *Bot) processSlashCoachesRequest(s Responder, i *discordgo.InteractionCreate, teamName string) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting team info for %s", teamName), err))
+		return
+	}
+
+	embed := coachesEmbed(teamInfo)
+	themeEmbed(embed, teamInfo)
+
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending coaches embed response", "error", err)
+	}
+}
+
+// coachesEmbed lists a team's head coach, coordinators, and schemes
+func coachesEmbed(teamInfo *models.TeamInfo) *discordgo.MessageEmbed {
+	headCoach := embeds.CoachLabel(teamInfo.Coach, "")
+	if teamInfo.CoachRecord != "" {
+		headCoach += fmt.Sprintf("\n%s this season", teamInfo.CoachRecord)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("📋 %s %s Coaching Staff", teamInfo.City, teamInfo.Name),
+		Color: embeds.ColorTeam,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Head Coach", Value: headCoach, Inline: false},
+			{Name: "Offensive Coordinator", Value: coordinatorLine(teamInfo.OffensiveCoordinator, teamInfo.OffensiveScheme), Inline: true},
+			{Name: "Defensive Coordinator", Value: coordinatorLine(teamInfo.DefensiveCoordinator, teamInfo.DefensiveScheme), Inline: true},
+			{Name: "Special Teams Coordinator", Value: embeds.CoachLabel(teamInfo.SpecialTeamsCoach, ""), Inline: true},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: embeds.FreshnessFooter(teamInfo.Freshness, time.Now(), i18n.English),
+		},
+	}
+}
+
+// coordinatorLine shows a coordinator with the unit's base scheme when the API lists one
+func coordinatorLine(name, scheme string) string {
+	line := embeds.CoachLabel(name, "")
+	if scheme = strings.TrimSpace(scheme); scheme != "" {
+		line += fmt.Sprintf("\nScheme: %s", scheme)
+	}
+	return line
+}
@@ -0,0 +1,391 @@
+package bot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/pkg/models"
+)
+
+// compareViewCustomID identifies the /compare stat category select menu
+const compareViewCustomID = "compare_view"
+
+// comparisonLifetime is how long a /compare message can switch views before it expires
+const comparisonLifetime = 15 * time.Minute
+
+// Comparison views selectable from the /compare menu
+const (
+	compareViewOverview  = "overview"
+	compareViewPassing   = "passing"
+	compareViewRushing   = "rushing"
+	compareViewReceiving = "receiving"
+	compareViewFantasy   = "fantasy"
+	compareViewAdvanced  = "advanced"
+)
+
+// comparison holds the stats behind a /compare message so views can be re-rendered
+type comparison struct {
+	stats1  *models.PlayerStats
+	stats2  *models.PlayerStats
+	photo1  string // headshot URLs, looked up once when the comparison is made
+	photo2  string
+	title   string
+	perGame bool          // the overview compares per-game averages instead of totals
+	format  models.Format // the guild's number and distance formatting
+	expires time.Time
+}
+
+// comparisonCache tracks recent /compare messages by message ID
+type comparisonCache struct {
+	mu          sync.Mutex
+	comparisons map[string]*comparison
+}
+
+// newComparisonCache creates an empty comparison cache
+func newComparisonCache() *comparisonCache {
+	return &comparisonCache{comparisons: make(map[string]*comparison)}
+}
+
+// Put stores a comparison for a message and drops expired entries
+func (cc *comparisonCache) Put(messageID string, c *comparison) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	now := time.Now()
+	for id, existing := range cc.comparisons {
+		if now.After(existing.expires) {
+			delete(cc.comparisons, id)
+		}
+	}
+	cc.comparisons[messageID] = c
+}
+
+// Get returns the comparison for a message if it has not expired
+func (cc *comparisonCache) Get(messageID string) (*comparison, bool) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	c, exists := cc.comparisons[messageID]
+	if !exists || time.Now().After(c.expires) {
+		return nil, false
+	}
+	return c, true
+}
+
+// compareViewMenu builds the stat category select menu with the current view selected
+func compareViewMenu(selected string) []discordgo.MessageComponent {
+	views := []struct {
+		value string
+		label string
+		emoji string
+	}{
+		{compareViewOverview, "Overview", "⚖️"},
+		{compareViewPassing, "Passing", "🏈"},
+		{compareViewRushing, "Rushing", "🏃"},
+		{compareViewReceiving, "Receiving", "👋"},
+		{compareViewFantasy, "Fantasy", "🏆"},
+		{compareViewAdvanced, "Advanced", "📈"},
+	}
+
+	var options []discordgo.SelectMenuOption
+	for _, view := range views {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:   view.label,
+			Value:   view.value,
+			Emoji:   &discordgo.ComponentEmoji{Name: view.emoji},
+			Default: view.value == selected,
+		})
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    compareViewCustomID,
+					Placeholder: "Switch stat category",
+					Options:     options,
+				},
+			},
+		},
+	}
+}
+
+// createComparisonViewEmbed renders one stat category of a comparison
+func (b *Bot) createComparisonViewEmbed(c *comparison, view string) *discordgo.MessageEmbed {
+	if view == compareViewOverview {
+		if c.perGame {
+			embed := embeds.ComparisonHeader(c.stats1, c.stats2, c.title)
+			embeds.AddPerGameComparison(embed, c.stats1, c.stats2, c.format)
+			return addComparisonHeadshots(embed, c)
+		}
+		return addComparisonHeadshots(embeds.ComparisonEmbed(c.stats1, c.stats2, c.title, c.format), c)
+	}
+
+	embed := embeds.ComparisonHeader(c.stats1, c.stats2, c.title)
+	switch view {
+	case compareViewPassing:
+		embeds.AddPassingComparison(embed, c.stats1, c.stats2, c.format)
+	case compareViewRushing:
+		embeds.AddRushingComparison(embed, c.stats1, c.stats2, c.format)
+	case compareViewReceiving:
+		embeds.AddReceivingComparison(embed, c.stats1, c.stats2, c.format)
+	case compareViewFantasy:
+		b.addFantasyComparison(embed, c.stats1, c.stats2)
+	case compareViewAdvanced:
+		b.addAdvancedComparison(embed, c.stats1, c.stats2, c.format)
+	}
+
+	return addComparisonHeadshots(embed, c)
+}
+
+// fantasyPoints returns a player's fantasy points with the given points per reception
+func (b *Bot) fantasyPoints(stats *models.PlayerStats, pointsPerReception float64) float64 {
+	return float64(stats.Passing.Yards)*0.04 +
+		float64(stats.Passing.Touchdowns)*4 -
+		float64(stats.Passing.Interceptions)*2 +
+		float64(stats.Rushing.Yards)*0.1 +
+		float64(stats.Rushing.Touchdowns)*6 +
+		float64(stats.Receiving.Yards)*0.1 +
+		float64(stats.Receiving.Touchdowns)*6 +
+		float64(stats.Receiving.Receptions)*pointsPerReception
+}
+
+// addFantasyComparison adds fantasy points under common scoring formats to embed
+func (b *Bot) addFantasyComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats) {
+	ppr1, ppr2 := b.fantasyPoints(stats1, 1), b.fantasyPoints(stats2, 1)
+	half1, half2 := b.fantasyPoints(stats1, 0.5), b.fantasyPoints(stats2, 0.5)
+	std1, std2 := b.fantasyPoints(stats1, 0), b.fantasyPoints(stats2, 0)
+
+	pprIcon1, pprIcon2 := embeds.BetterIcons(ppr1, ppr2)
+	halfIcon1, halfIcon2 := embeds.BetterIcons(half1, half2)
+	stdIcon1, stdIcon2 := embeds.BetterIcons(std1, std2)
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name: "🏆 Fantasy Points",
+		Value: fmt.Sprintf(
+			"▫ **PPR:** 🔵 %.1f%s | 🔴 %.1f%s\n"+
+				"▫ **Half PPR:** 🔵 %.1f%s | 🔴 %.1f%s\n"+
+				"▫ **Standard:** 🔵 %.1f%s | 🔴 %.1f%s",
+			ppr1, pprIcon1, ppr2, pprIcon2,
+			half1, halfIcon1, half2, halfIcon2,
+			std1, stdIcon1, std2, stdIcon2,
+		),
+		Inline: false,
+	})
+	embed.Footer.Text += " | 4pt pass TD, 6pt rush/rec TD"
+}
+
+// addAdvancedComparison adds efficiency and volume metrics to embed
+func (b *Bot) addAdvancedComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats, format models.Format) {
+	scrimmage := func(stats *models.PlayerStats) float64 {
+		return format.Length(float64(stats.Rushing.Yards + stats.Receiving.Yards))
+	}
+	touchdowns := func(stats *models.PlayerStats) float64 {
+		return float64(stats.TotalTouchdowns())
+	}
+	catchRate := func(stats *models.PlayerStats) float64 {
+		targets := float64(stats.Receiving.Targets)
+		if targets == 0 {
+			return 0
+		}
+		return float64(stats.Receiving.Receptions) / targets * 100
+	}
+	yardsPerTarget := func(stats *models.PlayerStats) float64 {
+		targets := float64(stats.Receiving.Targets)
+		if targets == 0 {
+			return 0
+		}
+		return format.Length(float64(stats.Receiving.Yards)) / targets
+	}
+
+	scrim1, scrim2 := scrimmage(stats1), scrimmage(stats2)
+	tds1, tds2 := touchdowns(stats1), touchdowns(stats2)
+	catch1, catch2 := catchRate(stats1), catchRate(stats2)
+	ypt1, ypt2 := yardsPerTarget(stats1), yardsPerTarget(stats2)
+	comp1, comp2 := stats1.Passing.CompletionPercent(), stats2.Passing.CompletionPercent()
+
+	scrimIcon1, scrimIcon2 := embeds.BetterIcons(scrim1, scrim2)
+	tdIcon1, tdIcon2 := embeds.BetterIcons(tds1, tds2)
+	catchIcon1, catchIcon2 := embeds.BetterIcons(catch1, catch2)
+	yptIcon1, yptIcon2 := embeds.BetterIcons(ypt1, ypt2)
+	compIcon1, compIcon2 := embeds.BetterIcons(comp1, comp2)
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name: "📈 Advanced",
+		Value: fmt.Sprintf(
+			"▫ **Scrimmage %s:** 🔵 %.0f%s | 🔴 %.0f%s\n"+
+				"▫ **Total TDs:** 🔵 %.0f%s | 🔴 %.0f%s\n"+
+				"▫ **Catch Rate:** 🔵 %.1f%%%s | 🔴 %.1f%%%s\n"+
+				"▫ **%s/Target:** 🔵 %.1f%s | 🔴 %.1f%s\n"+
+				"▫ **Comp%%:** 🔵 %.1f%%%s | 🔴 %.1f%%%s",
+			format.UnitName(), scrim1, scrimIcon1, scrim2, scrimIcon2,
+			tds1, tdIcon1, tds2, tdIcon2,
+			catch1, catchIcon1, catch2, catchIcon2,
+			format.UnitName(), ypt1, yptIcon1, ypt2, yptIcon2,
+			comp1, compIcon1, comp2, compIcon2,
+		),
+		Inline: false,
+	})
+}
+
+// handleCompareViewSelect re-renders a /compare message in the selected stat category
+func (b *Bot) handleCompareViewSelect(s Responder, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	if len(data.Values) == 0 {
+		return
+	}
+	view := data.Values[0]
+
+	c, exists := b.comparisons.Get(i.Message.ID)
+	if !exists {
+		err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "⌛ This comparison has expired. Run `/compare rematch` to switch views again with fresh stats.",
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		if err != nil {
+			logger.Error("error responding to expired compare view", "error", err)
+		}
+		return
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{b.createComparisonViewEmbed(c, view)},
+			Components: compareViewMenu(view),
+		},
+	})
+	if err != nil {
+		logger.Error("error updating compare view", "error", err)
+	}
+}
+
+// compareHistoryLimit is how many recent comparisons are kept per user
+const compareHistoryLimit = 5
+
+// compareRerunPrefix prefixes the custom IDs of /compare history buttons
+const compareRerunPrefix = "compare_rerun:"
+
+// sameAs reports whether two saved comparisons are the same pairing and type, in either order
+func (c SavedComparison) sameAs(other SavedComparison) bool {
+	if c.Type != other.Type || c.Week != other.Week || c.PerGame != other.PerGame {
+		return false
+	}
+	return (strings.EqualFold(c.Player1, other.Player1) && strings.EqualFold(c.Player2, other.Player2)) ||
+		(strings.EqualFold(c.Player1, other.Player2) && strings.EqualFold(c.Player2, other.Player1))
+}
+
+// label describes a saved comparison, e.g. "Josh Allen vs Patrick Mahomes (Week 5)"
+func (c SavedComparison) label() string {
+	kind := "Current week"
+	switch {
+	case c.Type == "season" && c.PerGame:
+		kind = "Season, per game"
+	case c.Type == "season":
+		kind = "Season"
+	case c.Week > 0:
+		kind = fmt.Sprintf("Week %d", c.Week)
+	}
+	return fmt.Sprintf("%s vs %s (%s)", c.Player1, c.Player2, kind)
+}
+
+// week returns the saved week as the optional option value processSlashCompareRequest takes
+func (c SavedComparison) week() *int64 {
+	if c.Week == 0 {
+		return nil
+	}
+	week := int64(c.Week)
+	return &week
+}
+
+// recordComparison puts a comparison at the front of the user's history, dropping an older copy
+// of the same pairing
+func (b *Bot) recordComparison(userID string, saved SavedComparison) {
+	b.preferences.Update(userID, func(preferences *UserPreferences) {
+		history := []SavedComparison{saved}
+		for _, previous := range preferences.Comparisons {
+			if !previous.sameAs(saved) && len(history) < compareHistoryLimit {
+				history = append(history, previous)
+			}
+		}
+		preferences.Comparisons = history
+	})
+}
+
+// respondCompareHistory lists the user's recent comparisons with a button to re-run each one
+func (b *Bot) respondCompareHistory(s Responder, i *discordgo.InteractionCreate) {
+	history := b.preferences.Get(interactionUserID(i)).Comparisons
+	if len(history) == 0 {
+		respondEphemeral(s, i, b.tr(i, "compare.no_history"))
+		return
+	}
+
+	var lines []string
+	var buttons []discordgo.MessageComponent
+	for index, saved := range history {
+		lines = append(lines, fmt.Sprintf("**%d.** %s — <t:%d:R>", index+1, saved.label(), saved.At.Unix()))
+
+		customID := fmt.Sprintf("%s%s:%d:%t:%s|%s", compareRerunPrefix, saved.Type, saved.Week, saved.PerGame, saved.Player1, saved.Player2)
+		if len(customID) > customIDLimit {
+			continue
+		}
+		buttons = append(buttons, discordgo.Button{
+			Label:    fmt.Sprintf("%d", index+1),
+			Style:    discordgo.SecondaryButton,
+			CustomID: customID,
+			Emoji:    &discordgo.ComponentEmoji{Name: "🔁"},
+		})
+	}
+
+	data := &discordgo.InteractionResponseData{
+		Content: "⚖️ **Your recent comparisons** — pick one to re-run it with fresh stats\n" + strings.Join(lines, "\n"),
+		Flags:   discordgo.MessageFlagsEphemeral,
+	}
+	if len(buttons) > 0 {
+		data.Components = []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+	}
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: data,
+	})
+	if err != nil {
+		logger.Error("error responding to compare history", "error", err)
+	}
+}
+
+// handleCompareRerun re-runs a comparison picked from /compare history
+func (b *Bot) handleCompareRerun(s Responder, i *discordgo.InteractionCreate) {
+	parts := strings.SplitN(strings.TrimPrefix(i.MessageComponentData().CustomID, compareRerunPrefix), ":", 4)
+	if len(parts) != 4 {
+		return
+	}
+	players := strings.SplitN(parts[3], "|", 2)
+	if len(players) != 2 {
+		return
+	}
+	saved := SavedComparison{Player1: players[0], Player2: players[1], Type: parts[0]}
+	saved.Week, _ = strconv.Atoi(parts[1])
+	saved.PerGame, _ = strconv.ParseBool(parts[2])
+
+	b.rerunComparison(s, i, saved)
+}
+
+// rerunComparison runs a saved comparison again with fresh data
+func (b *Bot) rerunComparison(s Responder, i *discordgo.InteractionCreate, saved SavedComparison) {
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial compare response", "error", err)
+		return
+	}
+
+	// Process compare request asynchronously
+	go b.processSlashCompareRequest(s, i, saved.Player1, saved.Player2, saved.Type, saved.week(), saved.PerGame)
+}
@@ -0,0 +1,43 @@
+package bot
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Degradation while the NFL API is down is driven by the client's circuit breaker:
+//   - command responses are built from stale cached API responses and carry a warning
+//   - history the bot keeps itself (closing lines, pick'em, trivia) is unaffected
+//   - background jobs that act on live data (prefetching, playoff alerts, prediction grading) pause
+
+// upstreamDown reports whether the NFL API circuit is open
+func (b *Bot) upstreamDown() bool {
+	return !b.nflClient.Health().Available
+}
+
+// degradedNotice is the warning shown on responses while the NFL API is down, or "" when it's up
+func (b *Bot) degradedNotice() string {
+	health := b.nflClient.Health()
+	if health.Available {
+		return ""
+	}
+	return fmt.Sprintf("⚠️ The NFL data provider has been unavailable since <t:%d:t>; showing the most recent cached data, which may be out of date.", health.DownSince.Unix())
+}
+
+// markDegraded adds the outage warning to an embed while the NFL API is down
+func (b *Bot) markDegraded(embed *discordgo.MessageEmbed) *discordgo.MessageEmbed {
+	notice := b.degradedNotice()
+	if notice == "" || embed == nil {
+		return embed
+	}
+
+	// Embed footers don't render timestamps, so the warning goes above the description
+	marked := *embed
+	if marked.Description == "" {
+		marked.Description = notice
+	} else {
+		marked.Description = notice + "\n\n" + marked.Description
+	}
+	return &marked
+}
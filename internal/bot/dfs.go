@@ -0,0 +1,132 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// valueLeaderboardSize is how many players /value lists
+const valueLeaderboardSize = 15
+
+// handleSlashValue handles the /value slash command
+func (b *Bot) handleSlashValue(s Responder, i *discordgo.InteractionCreate) {
+	var position string
+	site := models.SiteDraftKings
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "position":
+			position = option.StringValue()
+		case "site":
+			site = option.StringValue()
+		}
+	}
+	if position == "" {
+		respondEphemeral(s, i, b.tr(i, "dfs.no_position"))
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial value response", "error", err)
+		return
+	}
+
+	// Process value request asynchronously
+	go b.processSlashValueRequest(s, i, position, site)
+}
+
+// processSlashValueRequest ranks a position's players by projected points per $1k of salary
+// for the coming week
+func (b *Bot) processSlashValueRequest(s Responder, i *discordgo.InteractionCreate, position, site string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting DFS salaries", err))
+		return
+	}
+
+	salaries, err := b.nflClient.GetWeekSalaries(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting DFS salaries", err))
+		return
+	}
+
+	var priced []*models.DFSSalary
+	for _, salary := range salaries {
+		if salary.Position == position && salary.Salary(site) > 0 && salary.ProjectedPoints(site) > 0 {
+			priced = append(priced, salary)
+		}
+	}
+	if len(priced) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("💵 %s hasn't priced any %ss for %s yet.",
+			models.SiteName(site), position, seasonInfo.WeekLabel()))
+		return
+	}
+
+	sort.SliceStable(priced, func(a, c int) bool {
+		return priced[a].Value(site) > priced[c].Value(site)
+	})
+	if len(priced) > valueLeaderboardSize {
+		priced = priced[:valueLeaderboardSize]
+	}
+
+	if err := b.completeInteractionEmbed(s, i, valueEmbed(priced, position, site, seasonInfo.WeekLabel())); err != nil {
+		logger.Error("error sending value embed response", "error", err)
+	}
+}
+
+// valueEmbed lays out the best values as a table of salary, projection, and points per $1k
+func valueEmbed(priced []*models.DFSSalary, position, site, weekLabel string) *discordgo.MessageEmbed {
+	var table strings.Builder
+	table.WriteString("```\n")
+	table.WriteString(fmt.Sprintf("%-2s %-18s %-3s %-4s %7s %5s %5s\n", "#", "Player", "Tm", "Opp", "Salary", "Proj", "Pt/$K"))
+	for index, salary := range priced {
+		table.WriteString(fmt.Sprintf("%-2d %-18s %-3s %-4s %7s %5.1f %5.2f\n", index+1, truncateName(salary.Name, 18),
+			salary.Team, salary.Opponent, salaryLabel(salary.Salary(site)), salary.ProjectedPoints(site), salary.Value(site)))
+	}
+	table.WriteString("```")
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("💵 %s %s Value: %s", models.SiteName(site), position, weekLabel),
+		Description: table.String(),
+		Color:       0x013369,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Projected %s points per $1,000 of salary • Data provided by SportsData.io", models.SiteName(site)),
+		},
+	}
+}
+
+// addSalaryField adds a player's DFS salaries and projections for a week to a stats embed.
+// Salaries are extra context, so a failed lookup or an unpriced player just leaves the field off.
+func (b *Bot) addSalaryField(embed *discordgo.MessageEmbed, stats *models.PlayerStats, seasonInfo *models.SeasonInfo) {
+	salary, err := b.nflClient.GetPlayerSalary(stats.PlayerID, seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		logger.Warn("dfs salary unavailable", "player", stats.Name, "error", err)
+		return
+	}
+	if salary == nil {
+		return
+	}
+
+	var lines []string
+	for _, site := range []string{models.SiteDraftKings, models.SiteFanDuel} {
+		if salary.Salary(site) == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("▫ **%s:** %s • %.1f proj pts • %.2f pts/$1K",
+			models.SiteName(site), salaryLabel(salary.Salary(site)), salary.ProjectedPoints(site), salary.Value(site)))
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   fmt.Sprintf("💵 DFS Salary (%s vs %s)", seasonInfo.WeekLabel(), salary.Opponent),
+		Value:  strings.Join(lines, "\n"),
+		Inline: false,
+	})
+}
+
+// salaryLabel formats a DFS salary, e.g. "$7,400"
+func salaryLabel(salary int) string {
+	return fmt.Sprintf("$%d,%03d", salary/1000, salary%1000)
+}
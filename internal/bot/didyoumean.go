@@ -0,0 +1,100 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/nfl"
+)
+
+// didYouMeanPrefix prefixes the custom IDs of "did you mean" buttons
+const didYouMeanPrefix = "didyoumean_"
+
+// customIDLimit is the longest custom ID Discord accepts on a component
+const customIDLimit = 100
+
+// statsQueryArgs encodes a /stats query's options (everything but the player) for a button's
+// custom ID; unset numbers are left empty
+func statsQueryArgs(statsType, seasonType string, week, year *int64, perGame bool) string {
+	return strings.Join([]string{statsType, seasonType, optionalInt(week), optionalInt(year), strconv.FormatBool(perGame)}, ":")
+}
+
+// optionalInt formats an optional number, or returns "" when it's unset
+func optionalInt(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatInt(*value, 10)
+}
+
+// parseOptionalInt reverses optionalInt
+func parseOptionalInt(text string) *int64 {
+	value, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// didYouMeanButtons offers each suggested name from a player-not-found error as a button that
+// re-runs the query with that name, or returns nil when there is nothing to suggest
+func didYouMeanButtons(err error, command, queryArgs string) []discordgo.MessageComponent {
+	var notFound *nfl.NotFoundError
+	if !errors.As(err, &notFound) || !errors.Is(err, nfl.ErrPlayerNotFound) {
+		return nil
+	}
+
+	var buttons []discordgo.MessageComponent
+	for _, name := range notFound.Suggestions {
+		customID := fmt.Sprintf("%s%s:%s:%s", didYouMeanPrefix, command, queryArgs, name)
+		if len(customID) > customIDLimit {
+			continue
+		}
+		buttons = append(buttons, discordgo.Button{
+			Label:    name,
+			Style:    discordgo.PrimaryButton,
+			CustomID: customID,
+			Emoji:    &discordgo.ComponentEmoji{Name: "🔎"},
+		})
+	}
+	if len(buttons) == 0 {
+		return nil
+	}
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+}
+
+// completeInteractionSuggestions replaces a deferred response with an error message and the
+// "did you mean" buttons for it
+func (b *Bot) completeInteractionSuggestions(s Responder, i *discordgo.InteractionCreate, content string, components []discordgo.MessageComponent) error {
+	if notice := b.degradedNotice(); notice != "" {
+		content = notice + "\n" + content
+	}
+	_, err := b.finishInteraction(s, i, &discordgo.WebhookEdit{
+		Content:    &content,
+		Components: &components,
+	})
+	return err
+}
+
+// handleDidYouMeanComponent re-runs a lookup with the suggested name the user clicked
+func (b *Bot) handleDidYouMeanComponent(s Responder, i *discordgo.InteractionCreate) {
+	parts := strings.SplitN(strings.TrimPrefix(i.MessageComponentData().CustomID, didYouMeanPrefix), ":", 7)
+	if len(parts) != 7 || parts[0] != "stats" {
+		return
+	}
+	statsType, seasonType, playerName := parts[1], parts[2], parts[6]
+	week, year := parseOptionalInt(parts[3]), parseOptionalInt(parts[4])
+	perGame, _ := strconv.ParseBool(parts[5])
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial stats response", "error", err)
+		return
+	}
+
+	// Process stats request asynchronously
+	go b.processSlashStatsRequest(s, i, playerName, statsType, seasonType, week, year, perGame)
+}
@@ -0,0 +1,336 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/pkg/models"
+)
+
+// Weekly digest timing and sizes
+const (
+	digestCheckInterval   = 15 * time.Minute
+	digestPreviewHour     = 10             // local hour the preview goes out on its day
+	digestPreviewWindow   = 48 * time.Hour // how long after its slot a late preview may still go out
+	digestPrimetimeHour   = 19             // Eastern kickoffs from this hour on are primetime
+	digestMovementsListed = 10
+)
+
+// digestDays are the days an admin can pick for the upcoming-week preview
+var digestDays = map[string]time.Weekday{
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+}
+
+// runDigestWatcher posts weekly previews and results to digest channels until the bot stops
+func (b *Bot) runDigestWatcher() {
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			b.updateDigests(now)
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// updateDigests posts the current week's results once its last game is final, and its preview
+// once the guild's preview day arrives and before the first kickoff
+func (b *Bot) updateDigests(now time.Time) {
+	channels := b.settings.DigestChannels()
+	if len(channels) == 0 {
+		return
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		logger.Warn("skipping digest check", "error", err)
+		return
+	}
+	scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		logger.Warn("skipping digest check", "error", err)
+		return
+	}
+	if len(scores) == 0 {
+		return
+	}
+
+	weekKey := digestWeekKey(seasonInfo)
+	for guildID, channelID := range channels {
+		settings := b.settings.Get(guildID)
+		switch {
+		case settings.DigestResultsWeek != weekKey && digestWeekFinished(scores):
+			b.postDigestResults(guildID, channelID, seasonInfo, scores)
+		case settings.DigestPreviewWeek != weekKey && digestWeekUpcoming(scores) && b.digestPreviewDue(guildID, settings.DigestDay, now):
+			b.postDigestPreview(guildID, channelID, seasonInfo, scores)
+		}
+	}
+}
+
+// digestWeekKey identifies a week in the posted-digest markers
+func digestWeekKey(seasonInfo *models.SeasonInfo) string {
+	return fmt.Sprintf("%d%s-%d", seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+}
+
+// digestWeekFinished reports whether every game of the week is over or off the schedule, with
+// at least one played
+func digestWeekFinished(scores []*models.LiveScore) bool {
+	played := false
+	for _, score := range scores {
+		switch score.State() {
+		case models.StateFinal:
+			played = true
+		case models.StatePostponed, models.StateCanceled:
+		default:
+			return false
+		}
+	}
+	return played
+}
+
+// digestWeekUpcoming reports whether none of the week's games have started
+func digestWeekUpcoming(scores []*models.LiveScore) bool {
+	for _, score := range scores {
+		if score.State() != models.StateScheduled {
+			return false
+		}
+	}
+	return true
+}
+
+// digestPreviewDue reports whether a guild's preview slot has passed within the preview window
+func (b *Bot) digestPreviewDue(guildID, day string, now time.Time) bool {
+	weekday, exists := digestDays[day]
+	if !exists {
+		weekday = time.Tuesday
+	}
+
+	local := now.In(b.guildLocation(guildID))
+	slot := time.Date(local.Year(), local.Month(), local.Day(), digestPreviewHour, 0, 0, 0, local.Location())
+	slot = slot.AddDate(0, 0, -((int(local.Weekday()) - int(weekday) + 7) % 7))
+	if slot.After(local) {
+		slot = slot.AddDate(0, 0, -7)
+	}
+	return local.Sub(slot) < digestPreviewWindow
+}
+
+// postDigestPreview posts the upcoming week's slate with primetime games highlighted and byes listed
+func (b *Bot) postDigestPreview(guildID, channelID string, seasonInfo *models.SeasonInfo, scores []*models.LiveScore) {
+	location := b.guildLocation(guildID)
+	format := b.guildFormat(guildID)
+	games := append([]*models.LiveScore(nil), scores...)
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].GameTime.Before(games[j].GameTime)
+	})
+
+	var lines, primetime []string
+	lastDay := ""
+	for _, game := range games {
+		day := game.GameTime.In(location).Format("Monday, Jan 2")
+		if day != lastDay {
+			lines = append(lines, fmt.Sprintf("**%s**", day))
+			lastDay = day
+		}
+		line := fmt.Sprintf("%s @ %s — %s%s", game.AwayTeam, game.HomeTeam, embeds.FormatKickoff(game.GameTime, location, "3:04 PM", format), embeds.Broadcast(game.Channel))
+		if isPrimetime(game) {
+			line = "🌙 " + line
+			primetime = append(primetime, fmt.Sprintf("%s @ %s — %s%s", game.AwayTeam, game.HomeTeam, game.GameTime.In(location).Format(format.Clock("Mon 3:04 PM")), embeds.Broadcast(game.Channel)))
+		}
+		lines = append(lines, line)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📅 %s Preview", seasonInfo.WeekLabel()),
+		Description: strings.Join(lines, "\n"),
+		Color:       embeds.ColorSchedule,
+		Footer:      &discordgo.MessageEmbedFooter{Text: "🌙 primetime • Results post after the last game goes final"},
+	}
+	if len(primetime) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "🌙 Primetime", Value: strings.Join(primetime, "\n")})
+	}
+	if seasonInfo.SeasonType == models.SeasonTypeRegular {
+		if byes := b.byeTeams(scores); len(byes) > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "😴 Byes", Value: strings.Join(byes, ", ")})
+		}
+	}
+
+	if err := b.sendToTarget(guildID, channelID, "digest_preview", embed); err != nil {
+		logger.Warn("error posting weekly preview", "guild", guildID, "target", targetLabel(channelID), "error", err)
+	}
+	// Mark the week even when the send failed so a missing channel or webhook isn't retried every check
+	b.settings.Update(guildID, func(settings *GuildSettings) {
+		settings.DigestPreviewWeek = digestWeekKey(seasonInfo)
+	})
+}
+
+// isPrimetime reports whether a game kicks off in the evening Eastern time, i.e. Thursday, Sunday,
+// or Monday night football
+func isPrimetime(game *models.LiveScore) bool {
+	eastern, err := time.LoadLocation(defaultGuildTimezone)
+	if err != nil {
+		eastern = time.UTC
+	}
+	return game.GameTime.In(eastern).Hour() >= digestPrimetimeHour
+}
+
+// byeTeams lists the teams without a game in a week's slate
+func (b *Bot) byeTeams(scores []*models.LiveScore) []string {
+	teams, err := b.nflClient.GetTeams()
+	if err != nil {
+		logger.Warn("weekly preview will omit byes", "error", err)
+		return nil
+	}
+
+	playing := make(map[string]bool, 2*len(scores))
+	for _, score := range scores {
+		playing[score.AwayTeam] = true
+		playing[score.HomeTeam] = true
+	}
+	var byes []string
+	for _, team := range teams {
+		if !playing[team.Key] {
+			byes = append(byes, team.Key)
+		}
+	}
+	sort.Strings(byes)
+	return byes
+}
+
+// postDigestResults posts the week's final scores and how the standings moved
+func (b *Bot) postDigestResults(guildID, channelID string, seasonInfo *models.SeasonInfo, scores []*models.LiveScore) {
+	var lines []string
+	for _, score := range scores {
+		if score.State() != models.StateFinal {
+			lines = append(lines, fmt.Sprintf("%s @ %s — %s", score.AwayTeam, score.HomeTeam, score.State()))
+			continue
+		}
+		away := fmt.Sprintf("%s %d", score.AwayTeam, score.AwayScore)
+		home := fmt.Sprintf("%d %s", score.HomeScore, score.HomeTeam)
+		switch {
+		case score.AwayScore > score.HomeScore:
+			away = "**" + away + "**"
+		case score.HomeScore > score.AwayScore:
+			home = "**" + home + "**"
+		}
+		lines = append(lines, away+" - "+home)
+	}
+	sort.Strings(lines)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏁 %s Results", seasonInfo.WeekLabel()),
+		Description: strings.Join(lines, "\n"),
+		Color:       embeds.ColorScores,
+	}
+	if seasonInfo.SeasonType == models.SeasonTypeRegular {
+		if movements := b.standingsMovements(seasonInfo); len(movements) > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "📈 Standings Movement", Value: strings.Join(movements, "\n")})
+		}
+	}
+	b.postToTarget(guildID, channelID, "digest_results", "", embed)
+
+	b.settings.Update(guildID, func(settings *GuildSettings) {
+		settings.DigestResultsWeek = digestWeekKey(seasonInfo)
+	})
+}
+
+// standingsMovements compares the standings before and after a week's games, listing division
+// lead changes and playoff seed changes
+func (b *Bot) standingsMovements(seasonInfo *models.SeasonInfo) []string {
+	standingsTeams, games, err := b.regularSeasonResults(seasonInfo.Season)
+	if err != nil {
+		logger.Warn("weekly results will omit standings movement", "error", err)
+		return nil
+	}
+
+	// Undo the week's results to rebuild the standings as they were going in
+	before := make([]models.Game, len(games))
+	for index, game := range games {
+		if game.Week == seasonInfo.Week {
+			game.Status = "Scheduled"
+		}
+		before[index] = game
+	}
+	previous := standings.Compute(standingsTeams, before)
+	current := standings.Compute(standingsTeams, games)
+
+	keys := make([]string, 0, len(current.Teams))
+	for key := range current.Teams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var movements []string
+	for _, key := range keys {
+		now, was := current.Teams[key], previous.Teams[key]
+		if now.DivisionRank == 1 && was.DivisionRank != 1 {
+			movements = append(movements, fmt.Sprintf("👑 %s takes the %s lead", key, now.Team.DivisionName()))
+		}
+		switch {
+		case now.Seed == was.Seed:
+		case was.Seed == 0:
+			movements = append(movements, fmt.Sprintf("⬆️ %s moves into the playoff picture (%s #%d)", key, now.Team.Conference, now.Seed))
+		case now.Seed == 0:
+			movements = append(movements, fmt.Sprintf("⬇️ %s drops out of the playoff picture", key))
+		case now.Seed < was.Seed:
+			movements = append(movements, fmt.Sprintf("⬆️ %s climbs to %s #%d (from #%d)", key, now.Team.Conference, now.Seed, was.Seed))
+		default:
+			movements = append(movements, fmt.Sprintf("⬇️ %s slips to %s #%d (from #%d)", key, now.Team.Conference, now.Seed, was.Seed))
+		}
+	}
+	if len(movements) > digestMovementsListed {
+		movements = append(movements[:digestMovementsListed], fmt.Sprintf("…and %d more", len(movements)-digestMovementsListed))
+	}
+	return movements
+}
+
+// handleSlashDigest handles the /digest slash command (admin only)
+func (b *Bot) handleSlashDigest(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 || options[0].Name != "configure" {
+		return
+	}
+
+	channelID, ok := b.subscriptionTarget(s, i, options[0].Options)
+	if !ok {
+		return
+	}
+	day := "tuesday"
+	for _, option := range options[0].Options {
+		if option.Name == "day" {
+			day = option.StringValue()
+		}
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.DigestChannelID = channelID
+		settings.DigestDay = day
+		if channelID == "" {
+			settings.DigestDay = ""
+		}
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "digest.save_failed"))
+		return
+	}
+
+	if channelID == "" {
+		respondEphemeral(s, i, b.tr(i, "digest.disabled"))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "digest.enabled",
+		targetLabel(channelID), digestDays[day], digestPreviewHour, b.guildLocation(i.GuildID)))
+}
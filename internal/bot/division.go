@@ -0,0 +1,130 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/standings"
+)
+
+// divisionChoiceOption builds the required division option for /division
+func divisionChoiceOption() *discordgo.ApplicationCommandOption {
+	option := &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "division",
+		Description: "Division, e.g. AFC East",
+		Required:    true,
+	}
+	for _, conference := range []string{"AFC", "NFC"} {
+		for _, division := range []string{"East", "North", "South", "West"} {
+			name := conference + " " + division
+			option.Choices = append(option.Choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: name})
+		}
+	}
+	return option
+}
+
+// handleSlashDivision handles the /division slash command
+func (b *Bot) handleSlashDivision(s Responder, i *discordgo.InteractionCreate) {
+	var division string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "division" {
+			division = option.StringValue()
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial division response", "error", err)
+		return
+	}
+
+	// Process division request asynchronously
+	go b.processSlashDivisionRequest(s, i, division)
+}
+
+// processSlashDivisionRequest builds the division rivalry embed and completes the deferred response
+func (b *Bot) processSlashDivisionRequest(s Responder, i *discordgo.InteractionCreate, division string) {
+	table, seasonInfo, err := b.computeStandings()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting standings", err))
+		return
+	}
+
+	teams := table.Division(division)
+	if len(teams) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("❌ Unknown division: %s", division))
+		return
+	}
+
+	var text strings.Builder
+	text.WriteString("```\n")
+	text.WriteString(fmt.Sprintf("  %-4s %-7s %-5s %-5s %4s %3s\n", "TEAM", "W-L-T", "DIV", "CONF", "PD", "REM"))
+	for _, standing := range teams {
+		marker := standing.StatusMarker()
+		if marker == "" {
+			marker = " "
+		}
+		text.WriteString(fmt.Sprintf("%s %-4s %-7s %-5s %-5s %+4d %3d\n", marker, standing.Team.Key, standing.Overall.String(),
+			standing.Division.String(), standing.Conference.String(), standing.PointDifferential(), standing.Remaining))
+	}
+	text.WriteString("```")
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🏟️ %d %s", seasonInfo.Season, division),
+		Color: 0x013369,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Standings", Value: text.String(), Inline: false},
+			{Name: "Head-to-Head", Value: divisionHeadToHead(table, teams), Inline: false},
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: "Head-to-head is the first division tiebreaker, then division record"},
+	}
+
+	location := b.guildLocation(i.GuildID)
+	format := b.guildFormat(i.GuildID)
+	var played, remaining []string
+	for _, game := range table.DivisionGames(division) {
+		if standings.IsFinal(game.Status) {
+			played = append(played, fmt.Sprintf("Wk %d: %s %d @ %s %d", game.Week, game.AwayTeam, game.AwayScore, game.HomeTeam, game.HomeScore))
+		} else {
+			remaining = append(remaining, fmt.Sprintf("Wk %d: %s @ %s — %s", game.Week, game.AwayTeam, game.HomeTeam, game.GameTime.In(location).Format(format.Clock("Mon Jan 2, 3:04 PM"))))
+		}
+	}
+	if len(played) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Results", Value: strings.Join(played, "\n"), Inline: false})
+	}
+	if len(remaining) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Remaining Division Games", Value: strings.Join(remaining, "\n"), Inline: false})
+	}
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending division embed response", "error", err)
+	}
+}
+
+// divisionHeadToHead summarizes the season series between each pair of division teams that have met
+func divisionHeadToHead(table *standings.Table, teams []*standings.Standing) string {
+	var lines []string
+	for index, team := range teams {
+		for _, opponent := range teams[index+1:] {
+			record := table.HeadToHead(team.Team.Key, opponent.Team.Key)
+			switch {
+			case record.Games() == 0:
+				continue
+			case record.Wins > record.Losses:
+				lines = append(lines, fmt.Sprintf("%s leads %s %s", team.Team.Key, opponent.Team.Key, record.String()))
+			case record.Losses > record.Wins:
+				reversed := standings.Record{Wins: record.Losses, Losses: record.Wins, Ties: record.Ties}
+				lines = append(lines, fmt.Sprintf("%s leads %s %s", opponent.Team.Key, team.Team.Key, reversed.String()))
+			default:
+				lines = append(lines, fmt.Sprintf("%s and %s split %s", team.Team.Key, opponent.Team.Key, record.String()))
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return "No division games played yet."
+	}
+	return strings.Join(lines, "\n")
+}
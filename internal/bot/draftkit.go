@@ -0,0 +1,211 @@
+package bot
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/fantasy"
+)
+
+// draftKitTopPerPosition is how many players per position the draft kit embed lists
+const draftKitTopPerPosition = 10
+
+// scoringFormatOption builds the optional scoring format option shared by fantasy commands
+func scoringFormatOption(description string) *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "scoring",
+		Description: description,
+		Required:    false,
+		Choices: []*discordgo.ApplicationCommandOptionChoice{
+			{Name: "PPR", Value: fantasy.FormatPPR},
+			{Name: "Half PPR", Value: fantasy.FormatHalfPPR},
+			{Name: "Standard", Value: fantasy.FormatStandard},
+		},
+	}
+}
+
+// guildScoringFormat returns the guild's configured scoring format (PPR when unset)
+func (b *Bot) guildScoringFormat(guildID string) string {
+	format, err := fantasy.ParseFormat(b.settings.Get(guildID).ScoringFormat)
+	if err != nil {
+		return fantasy.FormatPPR
+	}
+	return format
+}
+
+// handleSlashScoring handles the /scoring slash command (admin only)
+func (b *Bot) handleSlashScoring(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	var format string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "scoring" {
+			format = option.StringValue()
+		}
+	}
+
+	format, err := fantasy.ParseFormat(format)
+	if err != nil {
+		b.respondInteraction(s, i, fmt.Sprintf("❌ %v", err))
+		return
+	}
+
+	err = b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.ScoringFormat = format
+	})
+	if err != nil {
+		b.respondInteraction(s, i, b.tr(i, "scoring.save_failed"))
+		return
+	}
+
+	if err := b.respondInteraction(s, i, b.tr(i, "scoring.set", fantasy.FormatName(format))); err != nil {
+		logger.Error("error responding to scoring slash command", "error", err)
+	}
+}
+
+// handleSlashDraftKit handles the /draftkit slash command
+func (b *Bot) handleSlashDraftKit(s Responder, i *discordgo.InteractionCreate) {
+	format := b.guildScoringFormat(i.GuildID)
+	var position string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "scoring":
+			if parsed, err := fantasy.ParseFormat(option.StringValue()); err == nil {
+				format = parsed
+			}
+		case "position":
+			position = option.StringValue()
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial draftkit response", "error", err)
+		return
+	}
+
+	// Process draft kit request asynchronously
+	go b.processSlashDraftKitRequest(s, i, format, position)
+}
+
+// processSlashDraftKitRequest builds rankings and auction values and sends them with a CSV export
+func (b *Bot) processSlashDraftKitRequest(s Responder, i *discordgo.InteractionCreate, format, position string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error building draft kit", err))
+		return
+	}
+
+	lastSeason, err := b.nflClient.GetPlayerSeasonTotals(seasonInfo.Season - 1)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting last season's stats", err))
+		return
+	}
+
+	// Projections are optional: without them the kit is based on last season alone
+	projections, err := b.nflClient.GetPlayerSeasonProjections(seasonInfo.Season)
+	if err != nil {
+		logger.Warn("draft kit projections unavailable, using last season only", "error", err)
+	}
+
+	kit := fantasy.BuildDraftKit(projections, lastSeason, format, fantasy.DefaultLeague)
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("📋 %d Draft Kit (%s)", seasonInfo.Season, fantasy.FormatName(format)),
+		Description: fmt.Sprintf("Points blend %d projections with %d production • $%d budget, %d teams",
+			seasonInfo.Season, seasonInfo.Season-1, fantasy.DefaultLeague.Budget, fantasy.DefaultLeague.Teams),
+		Color: 0x013369,
+	}
+
+	positions := fantasy.Positions
+	limit := draftKitTopPerPosition
+	if position != "" {
+		positions = []string{position}
+		limit = 20
+	}
+
+	for _, pos := range positions {
+		var text strings.Builder
+		text.WriteString("```\n")
+		shown := 0
+		for _, entry := range kit {
+			if entry.Player.Position != pos {
+				continue
+			}
+			text.WriteString(fmt.Sprintf("%s%-2d %-20s %-3s %5.1f %s\n", pos, entry.PositionRank,
+				truncateName(entry.Player.Name, 20), entry.Player.Team, entry.Points, auctionLabel(entry.AuctionValue)))
+			shown++
+			if shown == limit {
+				break
+			}
+		}
+		text.WriteString("```")
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   pos,
+			Value:  text.String(),
+			Inline: false,
+		})
+	}
+
+	embed.Footer = &discordgo.MessageEmbedFooter{
+		Text: "Rank, player, team, points, auction $ | Full rankings in the attached CSV",
+	}
+
+	file := &discordgo.File{
+		Name:        fmt.Sprintf("draftkit_%d_%s.csv", seasonInfo.Season, format),
+		ContentType: "text/csv",
+		Reader:      draftKitCSV(kit),
+	}
+
+	err = b.completeInteractionFile(s, i, embed, file)
+	if err != nil {
+		logger.Error("error sending draftkit response", "error", err)
+	}
+}
+
+// draftKitCSV renders the full draft kit as CSV
+func draftKitCSV(kit []*fantasy.KitEntry) *bytes.Buffer {
+	var export bytes.Buffer
+	writer := csv.NewWriter(&export)
+	writer.Write([]string{"overall_rank", "position_rank", "player", "position", "team", "points", "projected", "last_season", "auction_value"})
+	for _, entry := range kit {
+		writer.Write([]string{
+			strconv.Itoa(entry.OverallRank),
+			entry.Player.Position + strconv.Itoa(entry.PositionRank),
+			entry.Player.Name,
+			entry.Player.Position,
+			entry.Player.Team,
+			strconv.FormatFloat(entry.Points, 'f', 1, 64),
+			strconv.FormatFloat(entry.Projected, 'f', 1, 64),
+			strconv.FormatFloat(entry.LastSeason, 'f', 1, 64),
+			strconv.Itoa(entry.AuctionValue),
+		})
+	}
+	writer.Flush()
+	return &export
+}
+
+// auctionLabel formats an auction value, showing a dash for players below replacement level
+func auctionLabel(value int) string {
+	if value == 0 {
+		return "  -"
+	}
+	return fmt.Sprintf("$%d", value)
+}
+
+// truncateName shortens a name to fit a fixed-width column
+func truncateName(name string, width int) string {
+	if len(name) <= width {
+		return name
+	}
+	return name[:width-1] + "…"
+}
@@ -0,0 +1,177 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/standings"
+)
+
+// draftOrderDocument is the storage document holding weekly draft order snapshots
+const draftOrderDocument = "draft_order"
+
+// draftOrderSnapshot records the projected pick of every team for a week so
+// movement can be shown against the previous week
+type draftOrderSnapshot struct {
+	Season   int            `json:"season"`
+	Week     int            `json:"week"`
+	Current  map[string]int `json:"current"`
+	Previous map[string]int `json:"previous"`
+}
+
+// handleSlashDraftOrder handles the /draftorder slash command
+func (b *Bot) handleSlashDraftOrder(s Responder, i *discordgo.InteractionCreate) {
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial draftorder response", "error", err)
+		return
+	}
+
+	// Process draft order request asynchronously
+	go b.processSlashDraftOrderRequest(s, i)
+}
+
+// processSlashDraftOrderRequest builds the projected draft order embed and completes the deferred response
+func (b *Bot) processSlashDraftOrderRequest(s Responder, i *discordgo.InteractionCreate) {
+	table, seasonInfo, err := b.computeStandings()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting draft order", err))
+		return
+	}
+
+	order := table.DraftOrder()
+	previous := b.recordDraftOrder(seasonInfo.Season, seasonInfo.Week, order)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📋 %d Draft Order (Projected)", seasonInfo.Season+1),
+		Description: "If the season ended today",
+		Color:       0x013369,
+	}
+	if regularSeasonComplete(table) {
+		embed.Title = fmt.Sprintf("📋 %d Draft Order", seasonInfo.Season+1)
+		embed.Description = "Non-playoff picks are set by the final standings; playoff teams are shown by record until they're eliminated"
+	}
+
+	// Split into two columns so the embed stays within field limits
+	for start := 0; start < len(order); start += 16 {
+		end := start + 16
+		if end > len(order) {
+			end = len(order)
+		}
+
+		var text strings.Builder
+		text.WriteString("```\n")
+		for _, pick := range order[start:end] {
+			text.WriteString(fmt.Sprintf("%2d. %-4s %-7s %.3f %s\n", pick.Pick, pick.Standing.Team.Key,
+				pick.Standing.Overall.String(), pick.SOS, movementLabel(previous, pick)))
+		}
+		text.WriteString("```")
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("Picks %d-%d", start+1, end),
+			Value:  text.String(),
+			Inline: true,
+		})
+	}
+
+	if watch := tankingWatch(order, previous, b.preferences.Get(interactionUserID(i))); watch != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "👀 Tanking Watch",
+			Value:  watch,
+			Inline: false,
+		})
+	}
+
+	embed.Footer = &discordgo.MessageEmbedFooter{
+		Text: fmt.Sprintf("Through %s | Record, SOS, change since last week | Ties broken by weaker SOS", seasonInfo.WeekLabel()),
+	}
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending draftorder embed response", "error", err)
+	}
+}
+
+// regularSeasonComplete reports whether every team has played its last regular season game
+func regularSeasonComplete(table *standings.Table) bool {
+	for _, standing := range table.Teams {
+		if standing.Remaining > 0 {
+			return false
+		}
+	}
+	return len(table.Teams) > 0
+}
+
+// recordDraftOrder stores the current projection and returns last week's picks.
+// The snapshot rolls over the first time the order is requested in a new week.
+func (b *Bot) recordDraftOrder(season, week int, order []standings.DraftPick) map[string]int {
+	var snapshot draftOrderSnapshot
+	if err := b.store.Load(draftOrderDocument, &snapshot); err != nil {
+		logger.Error("error loading draft order snapshot", "error", err)
+	}
+
+	if snapshot.Season != season {
+		snapshot = draftOrderSnapshot{Season: season}
+	}
+	if snapshot.Week != week {
+		snapshot.Previous = snapshot.Current
+		snapshot.Week = week
+	}
+
+	snapshot.Current = make(map[string]int, len(order))
+	for _, pick := range order {
+		snapshot.Current[pick.Standing.Team.Key] = pick.Pick
+	}
+
+	if err := b.store.Save(draftOrderDocument, snapshot); err != nil {
+		logger.Error("error saving draft order snapshot", "error", err)
+	}
+	return snapshot.Previous
+}
+
+// movementLabel formats how many spots a team moved since last week's projection
+func movementLabel(previous map[string]int, pick standings.DraftPick) string {
+	before, exists := previous[pick.Standing.Team.Key]
+	switch {
+	case !exists || before == pick.Pick:
+		return ""
+	case before > pick.Pick:
+		return fmt.Sprintf("▲%d", before-pick.Pick)
+	default:
+		return fmt.Sprintf("▼%d", pick.Pick-before)
+	}
+}
+
+// tankingWatch describes where the user's followed teams sit in the draft order
+func tankingWatch(order []standings.DraftPick, previous map[string]int, preferences UserPreferences) string {
+	if len(order) == 0 || len(preferences.Teams) == 0 {
+		return ""
+	}
+	first := order[0].Standing.Overall
+
+	var lines []string
+	for _, pick := range order {
+		if !preferences.FollowsTeam(pick.Standing.Team.Key) {
+			continue
+		}
+
+		record := pick.Standing.Overall
+		gamesBack := float64((record.Wins-first.Wins)+(first.Losses-record.Losses)) / 2
+
+		line := fmt.Sprintf("**%s** — pick #%d (%s)", pick.Standing.Team.Key, pick.Pick, record.String())
+		if pick.Pick == 1 {
+			line += ", holding the #1 pick"
+		} else {
+			line += fmt.Sprintf(", %.1f games back of #1", gamesBack)
+		}
+		if movement := movementLabel(previous, pick); movement != "" {
+			line += fmt.Sprintf(" %s this week", movement)
+		}
+		if pick.Standing.EliminatedPlayoff {
+			line += " • eliminated"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
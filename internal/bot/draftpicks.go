@@ -0,0 +1,224 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// Live draft feed timing
+const (
+	draftFeedCheckInterval = 2 * time.Minute
+	draftMonth             = time.April // the feed only polls during draft month
+)
+
+// draftFeedTracker remembers which picks of a draft have been posted
+type draftFeedTracker struct {
+	mu    sync.Mutex
+	year  int
+	seen  map[int]bool // overall pick numbers
+	ready bool         // the first poll only records a baseline
+}
+
+// Diff marks picks as seen and returns the new ones, oldest first. The first call for a year only
+// records a baseline, so a restart mid-draft doesn't replay earlier picks.
+func (ft *draftFeedTracker) Diff(year int, picks []*models.DraftPick) []*models.DraftPick {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+
+	if ft.year != year {
+		ft.year, ft.seen, ft.ready = year, make(map[int]bool), false
+	}
+
+	var fresh []*models.DraftPick
+	for _, pick := range picks {
+		if !ft.seen[pick.Pick] {
+			ft.seen[pick.Pick] = true
+			if ft.ready {
+				fresh = append(fresh, pick)
+			}
+		}
+	}
+	ft.ready = true
+	return fresh
+}
+
+// currentDraftYear is the most recent draft that has started: this year's from draft month on,
+// last year's before it
+func currentDraftYear(now time.Time) int {
+	if now.Month() < draftMonth {
+		return now.Year() - 1
+	}
+	return now.Year()
+}
+
+// handleSlashDraft handles the /draft slash command and its subcommands
+func (b *Bot) handleSlashDraft(s Responder, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	subcommand := options[0]
+	switch subcommand.Name {
+	case "order":
+		b.handleSlashDraftOrder(s, i)
+	case "picks":
+		b.handleSlashDraftPicks(s, i, subcommand.Options)
+	case "feed":
+		b.handleSlashDraftFeed(s, i, subcommand.Options)
+	}
+}
+
+// handleSlashDraftPicks handles /draft picks
+func (b *Bot) handleSlashDraftPicks(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	var teamName string
+	year := currentDraftYear(time.Now())
+	for _, option := range options {
+		switch option.Name {
+		case "team":
+			teamName = option.StringValue()
+		case "year":
+			year = int(option.IntValue())
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial draft picks response", "error", err)
+		return
+	}
+
+	// Process draft picks request asynchronously
+	go b.processSlashDraftPicksRequest(s, i, teamName, year)
+}
+
+// processSlashDraftPicksRequest lists a team's draft class and completes the deferred response
+func (b *Bot) processSlashDraftPicksRequest(s Responder, i *discordgo.InteractionCreate, teamName string, year int) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, b.tr(i, "error.unknown_team", teamName))
+		return
+	}
+
+	picks, err := b.nflClient.GetDraftPicks(year)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting %d draft picks", year), err))
+		return
+	}
+
+	var lines []string
+	for _, pick := range picks {
+		if pick.Team == teamInfo.Key {
+			lines = append(lines, fmt.Sprintf("**Rd %d, #%d** — %s (%s, %s)", pick.Round, pick.Pick, pick.Name, pick.Position, pick.College))
+		}
+	}
+	if len(lines) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("📭 No %d draft picks found for the %s %s yet.", year, teamInfo.City, teamInfo.Name))
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📝 %s %s — %d Draft Class", teamInfo.City, teamInfo.Name, year),
+		Description: strings.Join(lines, "\n"),
+		Color:       0x013369,
+		Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("%d picks", len(lines))},
+	}
+	themeEmbed(embed, teamInfo)
+
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending draft picks embed response", "error", err)
+	}
+}
+
+// handleSlashDraftFeed handles /draft feed (Manage Server only)
+func (b *Bot) handleSlashDraftFeed(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+	if !canManageGuild(i) {
+		respondEphemeral(s, i, b.tr(i, "permission.draft_feed"))
+		return
+	}
+
+	var channelID string
+	for _, option := range options {
+		if option.Name == "channel" {
+			channelID = option.ChannelValue(nil).ID
+		}
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.DraftFeedChannelID = channelID
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "draft_feed.save_failed"))
+		return
+	}
+
+	if channelID == "" {
+		respondEphemeral(s, i, b.tr(i, "draft_feed.disabled"))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "draft_feed.enabled", draftMonth, channelID))
+}
+
+// runDraftFeed posts picks to draft feed channels during draft month until the bot stops
+func (b *Bot) runDraftFeed() {
+	ticker := time.NewTicker(draftFeedCheckInterval)
+	defer ticker.Stop()
+
+	tracker := &draftFeedTracker{}
+	for {
+		select {
+		case now := <-ticker.C:
+			b.updateDraftFeed(tracker, now)
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// updateDraftFeed posts the picks made since the last check
+func (b *Bot) updateDraftFeed(tracker *draftFeedTracker, now time.Time) {
+	if now.Month() != draftMonth {
+		return
+	}
+	channels := b.settings.DraftFeedChannels()
+	if len(channels) == 0 {
+		return
+	}
+
+	picks, err := b.nflClient.RefreshDraftPicks(now.Year())
+	if err != nil {
+		logger.Warn("skipping draft feed check", "error", err)
+		return
+	}
+
+	for _, pick := range tracker.Diff(now.Year(), picks) {
+		embed := &discordgo.MessageEmbed{
+			Title:       fmt.Sprintf("📝 Pick %d: %s select %s", pick.Pick, b.teamDisplayName(pick.Team), pick.Name),
+			Description: fmt.Sprintf("%s, %s • Round %d", pick.Position, pick.College, pick.Round),
+			Color:       0x013369,
+		}
+		b.themeEmbedForTeam(embed, pick.Team)
+		for guildID, channelID := range channels {
+			if _, err := b.discord.ChannelMessageSendEmbed(channelID, embed); err != nil {
+				logger.Warn("error posting draft pick", "guild", guildID, "channel", channelID, "pick", pick.Pick, "error", err)
+			}
+		}
+	}
+}
+
+// teamDisplayName returns a team's full name, or its abbreviation when the team is unknown
+func (b *Bot) teamDisplayName(key string) string {
+	if team := b.teamByKey(key); team != nil {
+		return team.City + " " + team.Name
+	}
+	return key
+}
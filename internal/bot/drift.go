@@ -0,0 +1,100 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/nfl"
+)
+
+// Schema drift reporting timing and sizes
+const (
+	driftCheckInterval  = time.Hour
+	driftSummaryPeriod  = 7 * 24 * time.Hour
+	driftIssuesReported = 10 // issues listed per report
+)
+
+// runSchemaDriftReporter posts new API schema issues to the error channel as they're found and a
+// weekly summary of all of them, until the bot stops
+func (b *Bot) runSchemaDriftReporter() {
+	ticker := time.NewTicker(driftCheckInterval)
+	defer ticker.Stop()
+
+	reported := make(map[string]bool)
+	nextSummary := time.Now().Add(driftSummaryPeriod)
+	for {
+		select {
+		case now := <-ticker.C:
+			issues := b.nflClient.SchemaDrift()
+			if now.After(nextSummary) {
+				b.postDriftReport("🧾 Weekly API Schema Report", issues)
+				b.nflClient.ResetSchemaDrift()
+				reported = make(map[string]bool)
+				nextSummary = now.Add(driftSummaryPeriod)
+				continue
+			}
+
+			var fresh []nfl.SchemaIssue
+			for _, issue := range issues {
+				key := driftIssueLabel(issue)
+				if !reported[key] {
+					reported[key] = true
+					fresh = append(fresh, issue)
+				}
+			}
+			if len(fresh) > 0 {
+				b.postDriftReport("⚠️ API Schema Drift Detected", fresh)
+			}
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// postDriftReport sends schema issues to the error channel, if one is configured
+func (b *Bot) postDriftReport(title string, issues []nfl.SchemaIssue) {
+	if b.config.ErrorChannelID == "" {
+		return
+	}
+
+	description := "No unexpected fields, nulls, or formats in NFL API payloads this week."
+	if len(issues) > 0 {
+		description = driftSummary(issues, driftIssuesReported)
+	}
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       0xe67e22,
+		Footer:      &discordgo.MessageEmbedFooter{Text: "Fields the bot reads from SportsData.io, checked as responses are decoded"},
+	}
+	if len(issues) == 0 {
+		embed.Color = 0x2ecc71
+	}
+	if _, err := b.discord.ChannelMessageSendEmbed(b.config.ErrorChannelID, embed); err != nil {
+		logger.Error("error posting schema drift report", "channel", b.config.ErrorChannelID, "error", err)
+	}
+}
+
+// driftSummary lists up to limit issues, one per line
+func driftSummary(issues []nfl.SchemaIssue, limit int) string {
+	var lines []string
+	for index, issue := range issues {
+		if index == limit {
+			lines = append(lines, fmt.Sprintf("…and %d more", len(issues)-limit))
+			break
+		}
+		line := fmt.Sprintf("• %s — %d records, since <t:%d:R>", driftIssueLabel(issue), issue.Count, issue.FirstSeen.Unix())
+		if issue.Example != "" {
+			line += fmt.Sprintf(" (e.g. `%s`)", strings.ReplaceAll(issue.Example, "`", "'"))
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// driftIssueLabel names an issue, e.g. "games.Status: new status value"
+func driftIssueLabel(issue nfl.SchemaIssue) string {
+	return fmt.Sprintf("%s.%s: %s", issue.Schema, issue.Field, issue.Problem)
+}
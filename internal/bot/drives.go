@@ -0,0 +1,150 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/analysis"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/pkg/models"
+)
+
+// handleSlashDrives handles the /drives slash command
+func (b *Bot) handleSlashDrives(s Responder, i *discordgo.InteractionCreate) {
+	var matchup string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "game" {
+			matchup = option.StringValue()
+		}
+	}
+	if matchup == "" {
+		b.respondInteraction(s, i, "Please provide a game, e.g. `BUF @ KC` or `Bills`.")
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial drives response", "error", err)
+		return
+	}
+
+	// Process drives request asynchronously
+	go b.processSlashDrivesRequest(s, i, matchup)
+}
+
+// processSlashDrivesRequest builds the drive chart of a team's game this week
+func (b *Bot) processSlashDrivesRequest(s Responder, i *discordgo.InteractionCreate, matchup string) {
+	teamName := matchupTeamName(matchup)
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding team %s", teamName), err))
+		return
+	}
+
+	game, seasonInfo, err := b.currentWeekGame(teamInfo.Key)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting this week's games", err))
+		return
+	}
+	switch {
+	case game == nil:
+		b.completeInteraction(s, i, fmt.Sprintf("🛌 The %s %s don't play in %s.", teamInfo.City, teamInfo.Name, seasonInfo.WeekLabel()))
+		return
+	case !game.IsLive() && !game.IsCompleted():
+		b.completeInteraction(s, i, fmt.Sprintf("⏳ %s @ %s hasn't kicked off yet — kickoff is <t:%d:R>.",
+			game.AwayTeam, game.HomeTeam, game.GameTime.Unix()))
+		return
+	}
+
+	plays, err := b.nflClient.GetPlayLog(seasonInfo.SeasonType, game)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting play-by-play", err))
+		return
+	}
+	drives := analysis.Drives(plays, game.IsLive())
+	if len(drives) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("No drives have been logged for %s @ %s yet.", game.AwayTeam, game.HomeTeam))
+		return
+	}
+
+	embed := drivesEmbed(game, drives, seasonInfo.WeekLabel(), b.guildFormat(i.GuildID))
+	themeEmbed(embed, teamInfo)
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending drives embed response", "error", err)
+	}
+}
+
+// drivesEmbed lists every drive in order with a scoring summary per team
+func drivesEmbed(game *models.LiveScore, drives []*analysis.Drive, weekLabel string, format models.Format) *discordgo.MessageEmbed {
+	status := "Final"
+	if game.IsLive() {
+		status = fmt.Sprintf("%s • %s", game.Quarter, game.TimeRemaining)
+	}
+
+	var lines []string
+	for _, drive := range drives {
+		start := drive.Start
+		if start == "" {
+			start = "—"
+		}
+		lines = append(lines, fmt.Sprintf("`%-10s` **%s** from %s • %d play(s), %s %s • %s %s",
+			playTime(drive.Quarter, drive.Clock), drive.Team, start, drive.Plays, format.Distance(drive.Yards), format.UnitPlural(), driveResultEmoji(drive.Result), drive.Result))
+	}
+	description := strings.Join(lines, "\n")
+	if len(description) > embedDescriptionLimit {
+		description = description[:strings.LastIndex(description[:embedDescriptionLimit], "\n")]
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🚗 Drive Chart: %s %d @ %s %d — %s", game.AwayTeam, game.AwayScore, game.HomeTeam, game.HomeScore, status),
+		Description: description,
+		Color:       embeds.ColorScores,
+		Footer:      &discordgo.MessageEmbedFooter{Text: weekLabel + " • Data provided by SportsData.io"},
+	}
+	for _, team := range []string{game.AwayTeam, game.HomeTeam} {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   team,
+			Value:  driveSummary(team, drives, format),
+			Inline: true,
+		})
+	}
+	return embed
+}
+
+// driveSummary totals a team's drives, e.g. "4 of 11 drives scored" over "62 plays • 356 yds"
+func driveSummary(team string, drives []*analysis.Drive, format models.Format) string {
+	var count, scoring, yards, plays int
+	for _, drive := range drives {
+		if drive.Team != team {
+			continue
+		}
+		count++
+		yards += drive.Yards
+		plays += drive.Plays
+		if drive.ScoringDrive {
+			scoring++
+		}
+	}
+	if count == 0 {
+		return "No drives yet"
+	}
+	return fmt.Sprintf("%d of %d drives scored\n%d plays • %s %s", scoring, count, plays, format.Distance(yards), format.UnitPlural())
+}
+
+// driveResultEmoji is the badge next to a drive's result
+func driveResultEmoji(result string) string {
+	switch result {
+	case analysis.ResultTouchdown:
+		return "🏈"
+	case analysis.ResultFieldGoal:
+		return "🎯"
+	case analysis.ResultInterception, analysis.ResultFumble, analysis.ResultTurnover, analysis.ResultDowns:
+		return "🔄"
+	case analysis.ResultSafety:
+		return "⚠️"
+	case analysis.ResultInProgress:
+		return "🔴"
+	}
+	return "▫️"
+}
@@ -0,0 +1,266 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/elo"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/pkg/models"
+)
+
+// eloDocument is the storage document holding team Elo ratings
+const eloDocument = "elo"
+
+// eloState is the persisted Elo document
+type eloState struct {
+	Season    int                `json:"season"`
+	Ratings   map[string]float64 `json:"ratings"`
+	Applied   map[string]bool    `json:"applied"`    // game IDs already rated this season
+	Week      string             `json:"week"`       // week WeekStart was taken at, see digestWeekKey
+	WeekStart map[string]float64 `json:"week_start"` // ratings before the week's first rated game
+}
+
+// eloStore keeps team Elo ratings across restarts
+type eloStore struct {
+	mu    sync.Mutex
+	store *storage.Store
+	state eloState
+}
+
+// newEloStore loads Elo ratings from storage
+func newEloStore(store *storage.Store) (*eloStore, error) {
+	ratings := &eloStore{store: store}
+	if err := store.Load(eloDocument, &ratings.state); err != nil {
+		return nil, err
+	}
+	if ratings.state.Ratings == nil {
+		ratings.state.Ratings = make(map[string]float64)
+	}
+	if ratings.state.Applied == nil {
+		ratings.state.Applied = make(map[string]bool)
+	}
+	return ratings, nil
+}
+
+// save persists the document; callers hold the lock
+func (es *eloStore) save() error {
+	return es.store.Save(eloDocument, es.state)
+}
+
+// Season returns the season the ratings belong to
+func (es *eloStore) Season() int {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	return es.state.Season
+}
+
+// Snapshot returns copies of the current ratings and the ratings at the start of the latest week
+func (es *eloStore) Snapshot() (map[string]float64, map[string]float64) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	return copyRatings(es.state.Ratings), copyRatings(es.state.WeekStart)
+}
+
+// StartSeason replaces the ratings with a new season's preseason seeds
+func (es *eloStore) StartSeason(season int, seeds map[string]float64) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	es.state = eloState{
+		Season:  season,
+		Ratings: copyRatings(seeds),
+		Applied: make(map[string]bool),
+	}
+	return es.save()
+}
+
+// Apply rates a final game once, snapshotting the ratings first when it's the week's first game
+func (es *eloStore) Apply(week string, game *models.LiveScore, neutral bool) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	if es.state.Applied[game.GameID] {
+		return nil
+	}
+	if es.state.Week != week {
+		es.state.Week = week
+		es.state.WeekStart = copyRatings(es.state.Ratings)
+	}
+
+	home, away := es.rating(game.HomeTeam), es.rating(game.AwayTeam)
+	es.state.Ratings[game.HomeTeam], es.state.Ratings[game.AwayTeam] = elo.Update(home, away, game.HomeScore, game.AwayScore, neutral)
+	es.state.Applied[game.GameID] = true
+	return es.save()
+}
+
+// rating returns a team's rating, or the league mean for a team not seen yet; callers hold the lock
+func (es *eloStore) rating(team string) float64 {
+	if rating, exists := es.state.Ratings[team]; exists {
+		return rating
+	}
+	return elo.Mean
+}
+
+// copyRatings copies a ratings map
+func copyRatings(ratings map[string]float64) map[string]float64 {
+	copied := make(map[string]float64, len(ratings))
+	for team, rating := range ratings {
+		copied[team] = rating
+	}
+	return copied
+}
+
+// updateEloRatings rates the current week's newly final games, seeding the ratings first when a
+// new season has started
+func (b *Bot) updateEloRatings(games []*models.LiveScore) error {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return err
+	}
+	if seasonInfo.SeasonType == models.SeasonTypePreseason {
+		return nil
+	}
+
+	if b.elo.Season() != seasonInfo.Season {
+		if err := b.elo.StartSeason(seasonInfo.Season, b.eloSeeds(seasonInfo.Season)); err != nil {
+			return err
+		}
+		logger.Info("seeded elo ratings", "season", seasonInfo.Season)
+	}
+
+	final := make([]*models.LiveScore, 0, len(games))
+	for _, game := range games {
+		if game.IsCompleted() {
+			final = append(final, game)
+		}
+	}
+	sort.Slice(final, func(i, j int) bool {
+		return final[i].GameTime.Before(final[j].GameTime)
+	})
+
+	week := digestWeekKey(seasonInfo)
+	// The Super Bowl is played at a neutral site
+	neutral := seasonInfo.SeasonType == models.SeasonTypePostseason && models.PlayoffRounds[seasonInfo.Week] == "Super Bowl"
+	for _, game := range final {
+		if err := b.elo.Apply(week, game, neutral); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eloSeeds builds a season's preseason ratings: last season's ratings regressed toward the mean,
+// or the preseason win-total lines when there are no earlier ratings
+func (b *Bot) eloSeeds(season int) map[string]float64 {
+	previous, _ := b.elo.Snapshot()
+	seeds := make(map[string]float64, len(previous))
+	if len(previous) > 0 {
+		for team, rating := range previous {
+			seeds[team] = elo.Regress(rating)
+		}
+		return seeds
+	}
+
+	lines, err := b.nflClient.GetPreseasonWinTotals(season)
+	if err != nil {
+		logger.Warn("seeding elo ratings at the mean", "season", season, "error", err)
+		return seeds
+	}
+	for team, line := range lines {
+		seeds[team] = elo.FromWinTotal(line)
+	}
+	return seeds
+}
+
+// handleSlashPowerRankings handles the /powerrankings slash command
+func (b *Bot) handleSlashPowerRankings(s Responder, i *discordgo.InteractionCreate) {
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial power rankings response", "error", err)
+		return
+	}
+
+	// Process power rankings request asynchronously
+	go b.processSlashPowerRankingsRequest(s, i)
+}
+
+// processSlashPowerRankingsRequest lists every team by Elo rating with its movement this week
+func (b *Bot) processSlashPowerRankingsRequest(s Responder, i *discordgo.InteractionCreate) {
+	ratings, weekStart := b.elo.Snapshot()
+	if len(ratings) == 0 {
+		b.completeInteraction(s, i, "📭 Power rankings start once the regular season does.")
+		return
+	}
+
+	// Records are a nice-to-have; the rankings stand on the ratings alone
+	records := make(map[string]string)
+	season := b.elo.Season()
+	standings, err := b.nflClient.GetStandings(season)
+	if err != nil {
+		logger.Warn("power rankings without records", "error", err)
+	}
+	for _, standing := range standings {
+		records[standing.Team] = fmt.Sprintf("%d-%d", standing.Wins, standing.Losses)
+		if standing.Ties > 0 {
+			records[standing.Team] += fmt.Sprintf("-%d", standing.Ties)
+		}
+	}
+
+	ranked := rankByRating(ratings)
+	previousRank := make(map[string]int)
+	for index, team := range rankByRating(weekStart) {
+		previousRank[team] = index + 1
+	}
+
+	var text strings.Builder
+	text.WriteString("```\n")
+	for index, team := range ranked {
+		text.WriteString(fmt.Sprintf("%2d %-3s %-4s %4.0f  %s\n", index+1, eloMovement(previousRank[team], index+1), team, ratings[team], records[team]))
+	}
+	text.WriteString("```")
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📶 %d Power Rankings", season),
+		Description: text.String(),
+		Color:       0x013369,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Elo ratings (average %.0f) updated after every final • arrows show movement this week", elo.Mean),
+		},
+	}
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending power rankings embed response", "error", err)
+	}
+}
+
+// rankByRating orders teams from highest to lowest rating
+func rankByRating(ratings map[string]float64) []string {
+	teams := make([]string, 0, len(ratings))
+	for team := range ratings {
+		teams = append(teams, team)
+	}
+	sort.Slice(teams, func(i, j int) bool {
+		if ratings[teams[i]] != ratings[teams[j]] {
+			return ratings[teams[i]] > ratings[teams[j]]
+		}
+		return teams[i] < teams[j]
+	})
+	return teams
+}
+
+// eloMovement is the arrow for a team's rank change, e.g. "▲3", "▼1", or "–" when unchanged or new
+func eloMovement(previous, current int) string {
+	switch {
+	case previous == 0 || previous == current:
+		return "–"
+	case previous > current:
+		return fmt.Sprintf("▲%d", previous-current)
+	default:
+		return fmt.Sprintf("▼%d", current-previous)
+	}
+}
@@ -0,0 +1,92 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/i18n"
+	"nfl-discord-bot/internal/nfl"
+)
+
+// userError words an NFL client error for a reply. Error classes get their own copy; anything else
+// is shown after action, e.g. userError("Error getting live scores", err).
+func userError(action string, err error) string {
+	return localizedUserError(i18n.English, action, err)
+}
+
+// userErrorFor words an NFL client error in the interaction's language, see userError
+func (b *Bot) userErrorFor(i *discordgo.InteractionCreate, action string, err error) string {
+	return localizedUserError(b.interactionLanguage(i), action, err)
+}
+
+// localizedUserError words an NFL client error in a language, see userError. The action and
+// anything not in the i18n catalogs stays in English.
+func localizedUserError(language, action string, err error) string {
+	var notFound *nfl.NotFoundError
+	var inactive *nfl.InactiveError
+	var invalidWeek *nfl.InvalidWeekError
+	switch {
+	case errors.As(err, &notFound) && errors.Is(err, nfl.ErrTeamNotFound):
+		return i18n.T(language, "error.team_not_found", notFound.Name, didYouMean(language, notFound.Suggestions))
+	case errors.As(err, &notFound):
+		scope := notFound.Scope
+		if scope == "" {
+			scope = i18n.T(language, "error.player_not_found.scope")
+		}
+		hint := didYouMean(language, notFound.Suggestions)
+		if hint == "" {
+			hint = i18n.T(language, "error.player_not_found.hint")
+		}
+		return i18n.T(language, "error.player_not_found", notFound.Name, scope, hint)
+	case errors.As(err, &inactive):
+		team := ""
+		if inactive.Team != "" {
+			team = " (" + inactive.Team + ")"
+		}
+		if inactive.Bye {
+			return i18n.T(language, "error.player_bye", inactive.Name, team, inactive.Scope)
+		}
+		injury := ""
+		if inactive.Injury != "" {
+			injury = i18n.T(language, "error.player_inactive.injury", inactive.Injury)
+		}
+		return i18n.T(language, "error.player_inactive", inactive.Name, team, inactive.Scope, injury)
+	case errors.As(err, &invalidWeek):
+		return i18n.T(language, "error.invalid_week",
+			invalidWeek.Week, i18n.T(language, "season_type."+invalidWeek.SeasonType), invalidWeek.MinWeek, invalidWeek.MaxWeek)
+	case errors.Is(err, nfl.ErrRateLimited):
+		return i18n.T(language, "error.rate_limited")
+	case errors.Is(err, nfl.ErrUpstreamUnavailable):
+		return i18n.T(language, "error.upstream_unavailable")
+	default:
+		return fmt.Sprintf("%s: %v", action, err)
+	}
+}
+
+// lookupErrorNote words a failed lookup for one row of a multi-player reply
+func lookupErrorNote(err error) string {
+	var notFound *nfl.NotFoundError
+	if !errors.As(err, &notFound) {
+		return err.Error()
+	}
+	note := "not found in " + notFound.Scope
+	if len(notFound.Suggestions) > 0 {
+		note += " (did you mean " + strings.Join(notFound.Suggestions, ", ") + "?)"
+	}
+	return note
+}
+
+// didYouMean suggests the closest names in a language, or returns "" when there are none
+func didYouMean(language string, suggestions []string) string {
+	switch len(suggestions) {
+	case 0:
+		return ""
+	case 1:
+		return i18n.T(language, "did_you_mean.one", suggestions[0])
+	default:
+		last := len(suggestions) - 1
+		return i18n.T(language, "did_you_mean.many", strings.Join(suggestions[:last], "**, **"), suggestions[last])
+	}
+}
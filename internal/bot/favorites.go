@@ -0,0 +1,137 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// /favorite clear choices
+const (
+	favoriteTeam   = "team"
+	favoritePlayer = "player"
+)
+
+// Prompts for a team or player left out with no favorite to fall back on
+const (
+	missingTeamMessage   = "Please provide a team name, or set a default with `/favorite set team:<name>`."
+	missingPlayerMessage = "Please provide a player name, or set a default with `/favorite set player:<name>`."
+)
+
+// handleSlashFavorite handles the /favorite slash command
+func (b *Bot) handleSlashFavorite(s Responder, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	subcommand := options[0]
+	switch subcommand.Name {
+	case "set":
+		b.respondFavoriteSet(s, i, subcommand.Options)
+	case "show":
+		respondEphemeral(s, i, b.favoriteSummary(interactionUserID(i)))
+	case "clear":
+		which := ""
+		for _, option := range subcommand.Options {
+			if option.Name == "which" {
+				which = option.StringValue()
+			}
+		}
+		err := b.preferences.Update(interactionUserID(i), func(preferences *UserPreferences) {
+			if which != favoritePlayer {
+				preferences.FavoriteTeam = ""
+			}
+			if which != favoriteTeam {
+				preferences.FavoritePlayer = ""
+			}
+		})
+		if err != nil {
+			respondEphemeral(s, i, b.tr(i, "favorites.save_failed"))
+			return
+		}
+		respondEphemeral(s, i, b.tr(i, "favorites.cleared")+b.favoriteSummary(interactionUserID(i)))
+	}
+}
+
+// respondFavoriteSet saves the team and/or player given in the options as the user's favorites
+func (b *Bot) respondFavoriteSet(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	var teamName, playerName string
+	for _, option := range options {
+		switch option.Name {
+		case "team":
+			teamName = strings.TrimSpace(option.StringValue())
+		case "player":
+			playerName = strings.TrimSpace(option.StringValue())
+		}
+	}
+	if teamName == "" && playerName == "" {
+		respondEphemeral(s, i, b.tr(i, "error.provide_team_or_player"))
+		return
+	}
+
+	var team, player string
+	if teamName != "" {
+		teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+		if err != nil {
+			respondEphemeral(s, i, b.tr(i, "error.unknown_team", teamName))
+			return
+		}
+		team = teamInfo.Key
+	}
+	if playerName != "" {
+		resolved, err := b.resolveWatchPlayer(playerName)
+		if err != nil {
+			respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+		player = resolved
+	}
+
+	userID := interactionUserID(i)
+	err := b.preferences.Update(userID, func(preferences *UserPreferences) {
+		if team != "" {
+			preferences.FavoriteTeam = team
+		}
+		if player != "" {
+			preferences.FavoritePlayer = player
+		}
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "favorites.save_failed"))
+		return
+	}
+
+	respondEphemeral(s, i, b.tr(i, "favorites.saved")+b.favoriteSummary(userID))
+}
+
+// favoriteSummary describes a user's favorite team and player and the commands that use them
+func (b *Bot) favoriteSummary(userID string) string {
+	preferences := b.preferences.Get(userID)
+	team, player := "none", "none"
+	if preferences.FavoriteTeam != "" {
+		team = "**" + preferences.FavoriteTeam + "**"
+	}
+	if preferences.FavoritePlayer != "" {
+		player = "**" + preferences.FavoritePlayer + "**"
+	}
+	return fmt.Sprintf("⭐ Favorite team: %s • Favorite player: %s\n"+
+		"Leave out `team` in `/next`, `/schedule`, `/team`, `/coaches`, and `/history`, or `player` in `/stats` and `/trend`, to use them.", team, player)
+}
+
+// teamOrFavorite returns the team a command was given, or the user's favorite team when it was left out
+func (b *Bot) teamOrFavorite(i *discordgo.InteractionCreate, teamName string) string {
+	if teamName != "" {
+		return teamName
+	}
+	return b.preferences.Get(interactionUserID(i)).FavoriteTeam
+}
+
+// playerOrFavorite returns the player a command was given, or the user's favorite player when it was left out
+func (b *Bot) playerOrFavorite(i *discordgo.InteractionCreate, playerName string) string {
+	if playerName != "" {
+		return playerName
+	}
+	return b.preferences.Get(interactionUserID(i)).FavoritePlayer
+}
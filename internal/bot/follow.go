@@ -0,0 +1,283 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// followMaxPlayers keeps one user from making every poll look up a whole roster
+const followMaxPlayers = 10
+
+// interactionUserID returns the ID of the user who triggered an interaction in a guild or DM
+func interactionUserID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
+
+// handleSlashFollow handles the /follow slash command
+func (b *Bot) handleSlashFollow(s Responder, i *discordgo.InteractionCreate) {
+	b.updateFollows(s, i, true)
+}
+
+// handleSlashUnfollow handles the /unfollow slash command
+func (b *Bot) handleSlashUnfollow(s Responder, i *discordgo.InteractionCreate) {
+	b.updateFollows(s, i, false)
+}
+
+// updateFollows adds or removes the team and/or player given in the options for the invoking user
+func (b *Bot) updateFollows(s Responder, i *discordgo.InteractionCreate, follow bool) {
+	var teamName, playerName, delivery, webhook string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "team":
+			teamName = strings.TrimSpace(option.StringValue())
+		case "player":
+			playerName = strings.TrimSpace(option.StringValue())
+		case "deliver":
+			delivery = option.StringValue()
+		case "webhook":
+			webhook = option.StringValue()
+		}
+	}
+	if teamName == "" && playerName == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.provide_team_or_player"))
+		return
+	}
+
+	var actions []string
+	if teamName != "" {
+		action, ok := b.updateFollowedTeam(s, i, teamName, follow)
+		if !ok {
+			return
+		}
+		actions = append(actions, action)
+	}
+	if playerName != "" {
+		channelID := ""
+		switch {
+		case webhook != "":
+			webhookURL, err := parseWebhookURL(webhook)
+			if err != nil {
+				b.respondInteraction(s, i, b.tr(i, "error.invalid_webhook", err))
+				return
+			}
+			channelID = webhookURL
+		case delivery == "here":
+			channelID = i.ChannelID
+		}
+		action, ok := b.updateFollowedPlayer(s, i, playerName, channelID, follow)
+		if !ok {
+			return
+		}
+		actions = append(actions, action)
+	}
+
+	message := strings.Join(actions, "\n") + "\n" + b.followSummary(interactionUserID(i))
+	if err := b.respondInteraction(s, i, message); err != nil {
+		logger.Error("error responding to follow slash command", "error", err)
+	}
+}
+
+// updateFollowedTeam adds or removes a team from the invoking user's followed teams, returning
+// what was done or false after responding with an error
+func (b *Bot) updateFollowedTeam(s Responder, i *discordgo.InteractionCreate, teamName string, follow bool) (string, bool) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.respondInteraction(s, i, b.tr(i, "error.unknown_team", teamName))
+		return "", false
+	}
+
+	err = b.preferences.Update(interactionUserID(i), func(preferences *UserPreferences) {
+		var teams []string
+		for _, team := range preferences.Teams {
+			if team != teamInfo.Key {
+				teams = append(teams, team)
+			}
+		}
+		if follow {
+			teams = append(teams, teamInfo.Key)
+		}
+		preferences.Teams = teams
+	})
+	if err != nil {
+		b.respondInteraction(s, i, b.tr(i, "follow.teams_save_failed"))
+		return "", false
+	}
+
+	action := "Unfollowed"
+	if follow {
+		action = "Now following"
+	}
+	return fmt.Sprintf("✅ %s the %s %s.", action, teamInfo.City, teamInfo.Name), true
+}
+
+// updateFollowedPlayer adds or removes a player whose weekly stat line the user gets, returning
+// what was done or false after responding with an error
+func (b *Bot) updateFollowedPlayer(s Responder, i *discordgo.InteractionCreate, playerName, channelID string, follow bool) (string, bool) {
+	userID := interactionUserID(i)
+	current := b.preferences.Get(userID)
+
+	var player FollowedPlayer
+	for _, followed := range current.Players {
+		if strings.EqualFold(followed.Name, playerName) {
+			player = followed
+		}
+	}
+	if player.Name == "" {
+		if !follow {
+			b.respondInteraction(s, i, fmt.Sprintf("❌ You don't follow %s.", playerName))
+			return "", false
+		}
+		if len(current.Players) >= followMaxPlayers {
+			b.respondInteraction(s, i, fmt.Sprintf("❌ You already follow %d players. Unfollow one first.", followMaxPlayers))
+			return "", false
+		}
+		found, err := b.findRecentPlayer(playerName)
+		if err != nil {
+			b.respondInteraction(s, i, fmt.Sprintf("❌ %v", err))
+			return "", false
+		}
+		player = FollowedPlayer{PlayerID: found.PlayerID, Name: found.Name}
+	}
+	player.ChannelID, player.GuildID = channelID, i.GuildID
+
+	err := b.preferences.Update(userID, func(preferences *UserPreferences) {
+		var players []FollowedPlayer
+		for _, followed := range preferences.Players {
+			if followed.PlayerID != player.PlayerID {
+				players = append(players, followed)
+			}
+		}
+		if follow {
+			players = append(players, player)
+		}
+		preferences.Players = players
+	})
+	if err != nil {
+		b.respondInteraction(s, i, b.tr(i, "follow.players_save_failed"))
+		return "", false
+	}
+
+	if !follow {
+		return fmt.Sprintf("✅ Unfollowed %s.", player.Name), true
+	}
+	where := "by DM"
+	if channelID != "" {
+		where = "in " + targetLabel(channelID)
+		if isWebhookTarget(channelID) {
+			where = "through the " + webhookLabel(channelID)
+		}
+	}
+	return fmt.Sprintf("✅ Now following %s: you'll get their stat line %s shortly after each game.", player.Name, where), true
+}
+
+// followSummary lists a user's followed teams and players
+func (b *Bot) followSummary(userID string) string {
+	preferences := b.preferences.Get(userID)
+	teams, players := "none", "none"
+	if len(preferences.Teams) > 0 {
+		teams = strings.Join(preferences.Teams, ", ")
+	}
+	if len(preferences.Players) > 0 {
+		names := make([]string, len(preferences.Players))
+		for index, player := range preferences.Players {
+			names[index] = player.Name
+		}
+		players = strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("Your teams: %s\nYour players: %s", teams, players)
+}
+
+// sendFollowedPlayerLines sends each follower the stat line of every followed player whose game
+// went final this week and hasn't been reported yet
+func (b *Bot) sendFollowedPlayerLines() error {
+	followers := b.preferences.PlayerFollowers()
+	if len(followers) == 0 {
+		return nil
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return err
+	}
+	scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		return err
+	}
+	sheet, err := b.nflClient.GetWeekStats(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		return err
+	}
+
+	lines := make(map[int]*models.PlayerStats, len(sheet))
+	for _, stats := range sheet {
+		lines[stats.PlayerID] = stats
+	}
+
+	week := digestWeekKey(seasonInfo)
+	for userID, preferences := range followers {
+		for _, player := range preferences.Players {
+			stats, played := lines[player.PlayerID]
+			if player.LastSent == week || !played || !teamGameFinal(stats.Team, scores) {
+				continue
+			}
+			b.sendFollowedPlayerLine(userID, player, stats, seasonInfo, scores)
+
+			err := b.preferences.Update(userID, func(preferences *UserPreferences) {
+				for index := range preferences.Players {
+					if preferences.Players[index].PlayerID == player.PlayerID {
+						preferences.Players[index].LastSent = week
+					}
+				}
+			})
+			if err != nil {
+				logger.Error("error marking followed player stat line sent", "user", userID, "player", player.Name, "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// teamGameFinal reports whether a team's game in the week's scores is final
+func teamGameFinal(team string, scores []*models.LiveScore) bool {
+	for _, score := range scores {
+		if score.HomeTeam == team || score.AwayTeam == team {
+			return score.IsCompleted()
+		}
+	}
+	return false
+}
+
+// sendFollowedPlayerLine delivers one player's stat line by DM, as a mention in the channel the
+// user followed from, or to their webhook
+func (b *Bot) sendFollowedPlayerLine(userID string, player FollowedPlayer, stats *models.PlayerStats, seasonInfo *models.SeasonInfo, scores []*models.LiveScore) {
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📈 %s — %s", stats.Name, seasonInfo.WeekLabel()),
+		Description: fmt.Sprintf("%s • %.1f PPR\n%s", b.keyStatLine(stats, models.Format{}), b.fantasyPoints(stats, 1), watchlistTeamResult(stats.Team, scores)),
+		Color:       0x013369,
+		Footer:      &discordgo.MessageEmbedFooter{Text: "Stop with /unfollow player:" + player.Name},
+	}
+	b.themeEmbedForTeam(embed, stats.Team)
+
+	if player.ChannelID != "" {
+		b.postToTarget(player.GuildID, player.ChannelID, "followed_player", fmt.Sprintf("<@%s>", userID), embed)
+		return
+	}
+
+	channel, err := b.discord.UserChannelCreate(userID)
+	if err != nil {
+		logger.Error("error opening DM for followed player", "user", userID, "error", err)
+		return
+	}
+	if _, err := b.discord.ChannelMessageSendEmbed(channel.ID, embed); err != nil {
+		logger.Error("error sending followed player stat line", "user", userID, "player", player.Name, "error", err)
+	}
+}
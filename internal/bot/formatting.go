@@ -0,0 +1,87 @@
+package bot
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/i18n"
+	"nfl-discord-bot/pkg/models"
+)
+
+// /formatting choices for distances and the clock
+const (
+	distanceYards  = "yards"
+	distanceMeters = "meters"
+	clock12        = "12h"
+	clock24        = "24h"
+)
+
+// guildFormat returns how a guild wants numbers, distances, and times shown, or the default
+// outside a guild
+func (b *Bot) guildFormat(guildID string) models.Format {
+	if guildID == "" {
+		return models.Format{}
+	}
+	settings := b.settings.Get(guildID)
+	return models.Format{
+		Separator: settings.NumberSeparator,
+		Metric:    settings.Metric,
+		Clock24:   settings.Clock24,
+	}
+}
+
+// handleSlashFormatting handles the /formatting slash command (admin only)
+func (b *Bot) handleSlashFormatting(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	var thousands, distance, clock string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "thousands":
+			thousands = option.StringValue()
+		case "distance":
+			distance = option.StringValue()
+		case "clock":
+			clock = option.StringValue()
+		}
+	}
+
+	if thousands == "" && distance == "" && clock == "" {
+		respondEphemeral(s, i, "🔢 "+describeFormat(b.interactionLanguage(i), b.guildFormat(i.GuildID)))
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		if thousands != "" {
+			settings.NumberSeparator = thousands
+			if thousands == models.SeparatorComma {
+				settings.NumberSeparator = ""
+			}
+		}
+		if distance != "" {
+			settings.Metric = distance == distanceMeters
+		}
+		if clock != "" {
+			settings.Clock24 = clock == clock24
+		}
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "formatting.save_failed"))
+		return
+	}
+
+	respondEphemeral(s, i, "✅ "+describeFormat(b.interactionLanguage(i), b.guildFormat(i.GuildID)))
+}
+
+// describeFormat explains a guild's formatting settings with examples
+func describeFormat(language string, format models.Format) string {
+	clock := i18n.T(language, "formatting.clock.12h")
+	if format.Clock24 {
+		clock = i18n.T(language, "formatting.clock.24h")
+	}
+	unit := i18n.T(language, "formatting.unit."+strings.ToLower(format.UnitName()))
+	return i18n.T(language, "formatting.describe", format.Int(4306), unit, format.Distance(100), format.UnitPlural(), clock)
+}
@@ -0,0 +1,209 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"nfl-discord-bot/internal/odds"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/pkg/models"
+)
+
+// futuresRows lists the markets /futures compares, in display order
+var futuresRows = []struct {
+	market string
+	label  string
+}{
+	{models.FuturesSuperBowl, "🏆 Super Bowl"},
+	{models.FuturesConference, "🏈 Conference"},
+	{models.FuturesDivision, "📊 Division"},
+}
+
+// handleSlashFutures handles the /futures slash command
+func (b *Bot) handleSlashFutures(s Responder, i *discordgo.InteractionCreate) {
+	var teamName string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "team" {
+			teamName = option.StringValue()
+		}
+	}
+	if teamName == "" {
+		b.respondInteraction(s, i, "Please specify a team. Example: `/futures team:Bills`")
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial futures response", "error", err)
+		return
+	}
+
+	// Process futures request asynchronously
+	go b.processSlashFuturesRequest(s, i, teamName)
+}
+
+// processSlashFuturesRequest compares a team's futures prices with the bot's simulated probabilities
+func (b *Bot) processSlashFuturesRequest(s Responder, i *discordgo.InteractionCreate, teamName string) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding team %s", teamName), err))
+		return
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+
+	outlooks, err := b.seasonOutlooks(seasonInfo.Season)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting season results", err))
+		return
+	}
+
+	outlook := outlooks[teamInfo.Key]
+	if outlook == nil {
+		b.completeInteraction(s, i, fmt.Sprintf("No simulation results for %s.", teamInfo.Key))
+		return
+	}
+
+	// Market odds are optional; fall back to the model alone when the odds feed is unavailable
+	var teamFutures []*models.FuturesOdds
+	futures, err := b.nflClient.GetFutures(seasonInfo.Season)
+	if err != nil {
+		logger.Warn("futures odds unavailable", "error", err)
+	}
+	for _, future := range futures {
+		if future.Team == teamInfo.Key {
+			teamFutures = append(teamFutures, future)
+		}
+	}
+
+	embed := futuresEmbed(seasonInfo.Season, teamInfo, outlook, teamFutures, err != nil)
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending futures embed response", "error", err)
+	}
+}
+
+// futuresEmbed renders market odds next to the simulated probability for each futures market
+func futuresEmbed(season int, teamInfo *models.TeamInfo, outlook *standings.Outlook, futures []*models.FuturesOdds, oddsUnavailable bool) *discordgo.MessageEmbed {
+	byMarket := make(map[string][]*models.FuturesOdds)
+	for _, future := range futures {
+		byMarket[future.Market] = append(byMarket[future.Market], future)
+	}
+
+	modelProbabilities := map[string]float64{
+		models.FuturesSuperBowl:  outlook.SuperBowl,
+		models.FuturesConference: outlook.Conference,
+		models.FuturesDivision:   outlook.Division,
+	}
+
+	var fields []*discordgo.MessageEmbedField
+	for _, row := range futuresRows {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   row.label,
+			Value:  futuresMarketValue(byMarket[row.market], modelProbabilities[row.market]),
+			Inline: true,
+		})
+	}
+
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   "🎟️ Playoffs",
+		Value:  fmt.Sprintf("Model: **%s**", formatProbability(outlook.Playoffs)),
+		Inline: true,
+	})
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   "📈 Win Total",
+		Value:  winTotalValue(byMarket[models.FuturesWinTotal], outlook.MeanWins),
+		Inline: true,
+	})
+
+	footer := fmt.Sprintf("Model: %d simulated seasons from points scored and allowed • market %% includes the vig", seasonSimulations)
+	if oddsUnavailable {
+		footer = "Futures odds unavailable right now • showing model only"
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:  fmt.Sprintf("🔮 %s %s %d Futures", teamInfo.City, teamInfo.Name, season),
+		Color:  0x013369,
+		Fields: fields,
+		Footer: &discordgo.MessageEmbedFooter{Text: footer},
+	}
+}
+
+// futuresMarketValue summarizes the best price and consensus implied probability against the model
+func futuresMarketValue(prices []*models.FuturesOdds, model float64) string {
+	modelLine := fmt.Sprintf("Model: **%s**", formatProbability(model))
+	if len(prices) == 0 {
+		return "Market: N/A\n" + modelLine
+	}
+
+	best := prices[0]
+	var implied float64
+	for _, price := range prices {
+		if price.AmericanOdds > best.AmericanOdds {
+			best = price
+		}
+		implied += odds.ImpliedProbability(price.AmericanOdds)
+	}
+	implied /= float64(len(prices))
+
+	bestLine := fmt.Sprintf("Best: **%s**", formatAmericanOdds(best.AmericanOdds))
+	if best.Sportsbook != "" {
+		bestLine += fmt.Sprintf(" (%s)", best.Sportsbook)
+	}
+
+	return fmt.Sprintf("%s\nMarket: %s\n%s", bestLine, formatProbability(implied), modelLine)
+}
+
+// winTotalValue compares the consensus win total line with the model's mean wins
+func winTotalValue(prices []*models.FuturesOdds, meanWins float64) string {
+	modelLine := fmt.Sprintf("Model: **%.1f** wins", meanWins)
+
+	var lines []float64
+	for _, price := range prices {
+		if price.Line != nil && (price.Side == "" || price.Side == "over") {
+			lines = append(lines, *price.Line)
+		}
+	}
+	if len(lines) == 0 {
+		return "Line: N/A\n" + modelLine
+	}
+
+	sort.Float64s(lines)
+	line := lines[len(lines)/2]
+
+	lean := "push"
+	switch {
+	case meanWins > line:
+		lean = "over"
+	case meanWins < line:
+		lean = "under"
+	}
+
+	return fmt.Sprintf("Line: **%.1f**\n%s (%s)", line, modelLine, lean)
+}
+
+// formatAmericanOdds renders American odds with an explicit sign for underdogs
+func formatAmericanOdds(american int) string {
+	if american > 0 {
+		return fmt.Sprintf("+%d", american)
+	}
+	return fmt.Sprintf("%d", american)
+}
+
+// formatProbability renders a probability as a percentage, flagging long shots instead of 0%
+func formatProbability(probability float64) string {
+	switch {
+	case probability < 0.001:
+		return "<0.1%"
+	case probability < 0.1:
+		return strings.TrimSuffix(fmt.Sprintf("%.1f", probability*100), ".0") + "%"
+	default:
+		return fmt.Sprintf("%.0f%%", probability*100)
+	}
+}
@@ -0,0 +1,296 @@
+package bot
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/pkg/models"
+)
+
+// gameThreadsDocument is the storage document tracking each guild's game-day threads
+const gameThreadsDocument = "game_threads"
+
+// Game-day thread timing
+const (
+	gameThreadCheckInterval  = 2 * time.Minute
+	gameThreadMaxGameLength  = 5 * time.Hour       // don't open threads for games this long past kickoff
+	gameThreadArchiveDelay   = 30 * time.Minute    // keep the thread open this long after the final for postgame talk
+	gameThreadRetention      = 14 * 24 * time.Hour // forget archived threads this long after archiving
+	gameThreadArchiveMinutes = 1440                // Discord's auto-archive for inactive threads
+)
+
+// GameThread is the thread opened for one game in one guild
+type GameThread struct {
+	ThreadID  string    `json:"thread_id,omitempty"` // empty when the thread couldn't be created
+	AwayScore int       `json:"away_score"`
+	HomeScore int       `json:"home_score"`
+	Quarter   string    `json:"quarter,omitempty"`
+	ArchiveAt time.Time `json:"archive_at,omitempty"` // set once the final score is posted
+	Archived  bool      `json:"archived,omitempty"`
+}
+
+// gameThreadStore remembers each guild's game threads so restarts don't open them twice
+type gameThreadStore struct {
+	mu      sync.Mutex
+	store   *storage.Store
+	threads map[string]map[string]*GameThread // guild ID -> game ID -> thread
+}
+
+// newGameThreadStore loads game threads from storage
+func newGameThreadStore(store *storage.Store) (*gameThreadStore, error) {
+	threads := &gameThreadStore{
+		store:   store,
+		threads: make(map[string]map[string]*GameThread),
+	}
+	if err := store.Load(gameThreadsDocument, &threads.threads); err != nil {
+		return nil, err
+	}
+	return threads, nil
+}
+
+// Get returns a copy of a guild's thread for a game
+func (ts *gameThreadStore) Get(guildID, gameID string) (GameThread, bool) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	thread, exists := ts.threads[guildID][gameID]
+	if !exists {
+		return GameThread{}, false
+	}
+	return *thread, true
+}
+
+// Put saves a guild's thread for a game, dropping threads archived longer than the retention period
+func (ts *gameThreadStore) Put(guildID, gameID string, thread GameThread) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	cutoff := time.Now().Add(-gameThreadRetention)
+	for guild, games := range ts.threads {
+		for game, existing := range games {
+			if existing.Archived && existing.ArchiveAt.Before(cutoff) {
+				delete(games, game)
+			}
+		}
+		if len(games) == 0 {
+			delete(ts.threads, guild)
+		}
+	}
+
+	games, exists := ts.threads[guildID]
+	if !exists {
+		games = make(map[string]*GameThread)
+		ts.threads[guildID] = games
+	}
+	games[gameID] = &thread
+
+	if err := ts.store.Save(gameThreadsDocument, ts.threads); err != nil {
+		logger.Error("error saving game threads", "error", err)
+	}
+}
+
+// runGameThreadWatcher opens, updates, and archives game-day threads until the bot stops
+func (b *Bot) runGameThreadWatcher() {
+	ticker := time.NewTicker(gameThreadCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.updateGameThreads(time.Now())
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// updateGameThreads opens a thread for each game that kicked off, posts score changes into open
+// threads, and archives threads once their game has been final for a while
+func (b *Bot) updateGameThreads(now time.Time) {
+	channels := b.settings.GameThreadChannels()
+	if len(channels) == 0 {
+		return
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		logger.Warn("skipping game thread check", "error", err)
+		return
+	}
+	scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		logger.Warn("skipping game thread check", "error", err)
+		return
+	}
+
+	for guildID, channelID := range channels {
+		teams := b.settings.Get(guildID).GameThreadTeams
+		for _, game := range scores {
+			if len(teams) > 0 && !containsTeam(teams, game.HomeTeam) && !containsTeam(teams, game.AwayTeam) {
+				continue
+			}
+
+			thread, exists := b.gameThreads.Get(guildID, game.GameID)
+			switch {
+			case !exists:
+				if gameThreadKickedOff(game, now) {
+					b.openGameThread(guildID, channelID, seasonInfo, game)
+				}
+			case thread.Archived || thread.ThreadID == "":
+			case !thread.ArchiveAt.IsZero():
+				if now.After(thread.ArchiveAt) {
+					b.archiveGameThread(guildID, game.GameID, thread)
+				}
+			case game.IsCompleted():
+				b.postGameThreadFinal(guildID, game, thread, now)
+			case game.IsLive():
+				if game.AwayScore != thread.AwayScore || game.HomeScore != thread.HomeScore || game.Quarter != thread.Quarter {
+					b.postGameThreadUpdate(guildID, game, thread)
+				}
+			}
+		}
+	}
+}
+
+// gameThreadKickedOff reports whether a game is under way, so a thread should be open for it
+func gameThreadKickedOff(game *models.LiveScore, now time.Time) bool {
+	if game.HomeTeam == "BYE" || game.AwayTeam == "BYE" {
+		return false
+	}
+	switch game.State() {
+	case models.StateLive:
+		return true
+	case models.StateScheduled, models.StateDelayed:
+		return now.After(game.GameTime) && now.Before(game.GameTime.Add(gameThreadMaxGameLength))
+	}
+	return false
+}
+
+// openGameThread starts a game's thread in a guild's game-thread channel with a kickoff post
+func (b *Bot) openGameThread(guildID, channelID string, seasonInfo *models.SeasonInfo, game *models.LiveScore) {
+	name := fmt.Sprintf("🧵 %s @ %s – %s", game.AwayTeam, game.HomeTeam, seasonInfo.WeekLabel())
+	channel, err := b.discord.ThreadStart(channelID, name, discordgo.ChannelTypeGuildPublicThread, gameThreadArchiveMinutes)
+	if err != nil {
+		// Record the failure so a missing permission isn't retried every check for the whole game
+		logger.Warn("error opening game thread", "guild", guildID, "channel", channelID, "game", game.GameID, "error", err)
+		b.gameThreads.Put(guildID, game.GameID, GameThread{ArchiveAt: time.Now(), Archived: true})
+		return
+	}
+	b.gameThreads.Put(guildID, game.GameID, GameThread{ThreadID: channel.ID})
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏈 %s @ %s", game.AwayTeam, game.HomeTeam),
+		Description: fmt.Sprintf("Kickoff %s. Score updates will be posted here, and the thread is archived after the final whistle.", embeds.FormatKickoff(game.GameTime, b.guildLocation(guildID), "3:04 PM", b.guildFormat(guildID))),
+		Color:       embeds.ColorScores,
+		Footer:      &discordgo.MessageEmbedFooter{Text: seasonInfo.WeekLabel()},
+	}
+	b.themeEmbedForTeam(embed, game.HomeTeam)
+	if _, err := b.discord.ChannelMessageSendEmbed(channel.ID, embed); err != nil {
+		logger.Error("error posting game thread kickoff", "thread", channel.ID, "error", err)
+	}
+	logger.Info("game thread opened", "guild", guildID, "thread", channel.ID, "game", game.GameID)
+}
+
+// postGameThreadUpdate posts a game's new score or quarter into its thread
+func (b *Bot) postGameThreadUpdate(guildID string, game *models.LiveScore, thread GameThread) {
+	title := fmt.Sprintf("%s %d - %d %s", game.AwayTeam, game.AwayScore, game.HomeScore, game.HomeTeam)
+	switch {
+	case game.AwayScore > thread.AwayScore:
+		title = fmt.Sprintf("🚨 %s scores! %s", game.AwayTeam, title)
+	case game.HomeScore > thread.HomeScore:
+		title = fmt.Sprintf("🚨 %s scores! %s", game.HomeTeam, title)
+	default:
+		title = "⏱️ " + title
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: fmt.Sprintf("%s, %s", game.Quarter, game.TimeRemaining),
+		Color:       embeds.ColorScores,
+	}
+	if isHalftime(game) {
+		embed.Description = "Halftime"
+	}
+	b.themeEmbedForTeam(embed, game.HomeTeam)
+	b.postAutomated(thread.ThreadID, "", embed)
+
+	thread.AwayScore, thread.HomeScore, thread.Quarter = game.AwayScore, game.HomeScore, game.Quarter
+	b.gameThreads.Put(guildID, game.GameID, thread)
+}
+
+// postGameThreadFinal posts a game's final score into its thread and schedules the thread's archiving
+func (b *Bot) postGameThreadFinal(guildID string, game *models.LiveScore, thread GameThread, now time.Time) {
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏁 Final: %s %d - %d %s", game.AwayTeam, game.AwayScore, game.HomeScore, game.HomeTeam),
+		Description: fmt.Sprintf("This thread will be archived in %d minutes.", int(gameThreadArchiveDelay.Minutes())),
+		Color:       embeds.ColorScores,
+	}
+	b.themeEmbedForTeam(embed, game.HomeTeam)
+	b.postAutomated(thread.ThreadID, "", embed)
+
+	// A spoiler-delayed final must arrive before the thread is archived
+	thread.AwayScore, thread.HomeScore, thread.Quarter = game.AwayScore, game.HomeScore, game.Quarter
+	thread.ArchiveAt = now.Add(b.spoilerDelay(thread.ThreadID) + gameThreadArchiveDelay)
+	b.gameThreads.Put(guildID, game.GameID, thread)
+}
+
+// archiveGameThread archives a finished game's thread
+func (b *Bot) archiveGameThread(guildID, gameID string, thread GameThread) {
+	archived := true
+	if _, err := b.discord.ChannelEdit(thread.ThreadID, &discordgo.ChannelEdit{Archived: &archived}); err != nil {
+		// Stop tracking the thread anyway so a deleted thread isn't retried forever
+		logger.Warn("error archiving game thread", "guild", guildID, "thread", thread.ThreadID, "error", err)
+	} else {
+		logger.Info("game thread archived", "guild", guildID, "thread", thread.ThreadID, "game", gameID)
+	}
+
+	thread.Archived = true
+	b.gameThreads.Put(guildID, gameID, thread)
+}
+
+// handleSlashGameThreads handles the /game-threads slash command (admin only)
+func (b *Bot) handleSlashGameThreads(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	var channelID, teamList string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "channel":
+			channelID = option.ChannelValue(nil).ID
+		case "teams":
+			teamList = option.StringValue()
+		}
+	}
+
+	teams, unknown := b.resolveTeamList(teamList)
+	if unknown != "" {
+		respondEphemeral(s, i, b.tr(i, "error.unknown_team", unknown))
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.GameThreadChannelID = channelID
+		settings.GameThreadTeams = nil
+		if channelID != "" {
+			settings.GameThreadTeams = teams
+		}
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "game_threads.save_failed"))
+		return
+	}
+
+	if channelID == "" {
+		respondEphemeral(s, i, b.tr(i, "game_threads.disabled"))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "game_threads.enabled",
+		channelID, slowModeTeamsLabel(teams)))
+}
@@ -0,0 +1,229 @@
+package bot
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// updateGolden rewrites the golden files from the current output: go test ./internal/bot -run Golden -update
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata/golden")
+
+// goldenDir holds one snapshot per command invocation
+const goldenDir = "testdata/golden"
+
+// volatileText matches reply text that changes from run to run, with its stand-in
+var volatileText = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`Updated (just now|\d+[smhd] ago)`), "Updated <age>"},
+	{regexp.MustCompile(`<t:\d+(:[tTdDfFR])?>`), "<t:TIMESTAMP$1>"},
+	{regexp.MustCompile(`(` + mockDraftPrefix + `[a-z]+:)[0-9a-z]+`), "${1}DRAFT"},
+}
+
+// goldenReply is the part of a reply a snapshot records, with any channel messages the command
+// posted on the way
+type goldenReply struct {
+	Method     string                       `json:"method"`
+	Ephemeral  bool                         `json:"ephemeral,omitempty"`
+	Content    string                       `json:"content,omitempty"`
+	Embeds     []*discordgo.MessageEmbed    `json:"embeds,omitempty"`
+	Components []discordgo.MessageComponent `json:"components,omitempty"`
+	Posts      []goldenPost                 `json:"posts,omitempty"`
+}
+
+// goldenPost is a channel message a command sent besides its reply
+type goldenPost struct {
+	ChannelID  string                       `json:"channel_id"`
+	Content    string                       `json:"content,omitempty"`
+	Embeds     []*discordgo.MessageEmbed    `json:"embeds,omitempty"`
+	Components []discordgo.MessageComponent `json:"components,omitempty"`
+}
+
+// goldenCase is one command invocation and the handler that answers it
+type goldenCase struct {
+	name    string
+	handler func(b *Bot, s Responder, i *discordgo.InteractionCreate)
+	command *discordgo.InteractionCreate
+}
+
+// goldenCases runs every slash command against the nfltest fixtures (2025, week 6 in progress).
+// Left out, because their replies can't be pinned down:
+//   - /today titles its reply with the current date
+//   - /trivia play asks a random question and reveals the answer on a timer
+//   - /botstats reads the guild list from the gateway session's state cache
+//   - /digest preview posts straight to the live session rather than a Responder
+func goldenCases() []goldenCase {
+	return []goldenCase{
+		{"help", (*Bot).handleSlashHelp, slashInteraction("help")},
+		{"stats", (*Bot).handleSlashStats, slashInteraction("stats", stringOption("player", "Josh Allen"))},
+		{"stats_week", (*Bot).handleSlashStats, slashInteraction("stats", stringOption("player", "CeeDee Lamb"), intOption("week", 6), intOption("year", 2025))},
+		{"stats_not_found", (*Bot).handleSlashStats, slashInteraction("stats", stringOption("player", "Jsoh Alen"))},
+		{"multistat", (*Bot).handleSlashMultistat, slashInteraction("multistat", stringOption("players", "Josh Allen, Travis Kelce, Saquon Barkley"))},
+		{"compare", (*Bot).handleSlashCompare, slashInteraction("compare", subcommandOption("players", stringOption("player1", "Josh Allen"), stringOption("player2", "Patrick Mahomes")))},
+		{"team", (*Bot).handleSlashTeam, slashInteraction("team", stringOption("team", "Bills"))},
+		{"team_not_found", (*Bot).handleSlashTeam, slashInteraction("team", stringOption("team", "Gotham Rogues"))},
+		{"coaches", (*Bot).handleSlashCoaches, slashInteraction("coaches", stringOption("team", "Chiefs"))},
+		{"history", (*Bot).handleSlashHistory, slashInteraction("history", stringOption("team", "Eagles"))},
+		{"schedule", (*Bot).handleSlashSchedule, slashInteraction("schedule", stringOption("team", "Cowboys"))},
+		{"scores", (*Bot).handleSlashScores, slashInteraction("scores")},
+		{"scores_live", (*Bot).handleSlashScores, slashInteraction("scores", stringOption("status", "live"))},
+		{"recap", (*Bot).handleSlashRecap, slashInteraction("recap", intOption("week", 6))},
+		{"wintotals", (*Bot).handleSlashWinTotals, slashInteraction("wintotals")},
+		{"standings", (*Bot).handleSlashStandings, slashInteraction("standings", stringOption("conference", "AFC"))},
+		{"division", (*Bot).handleSlashDivision, slashInteraction("division", stringOption("division", "NFC East"))},
+		{"playoffodds", (*Bot).handleSlashPlayoffOdds, slashInteraction("playoffodds", stringOption("team", "Bills"))},
+		{"playoffpicture", (*Bot).handleSlashPlayoffPicture, slashInteraction("playoffpicture", stringOption("conference", "NFC"))},
+		{"whatif", (*Bot).handleSlashWhatIf, slashInteraction("whatif", stringOption("results", "DAL over PHI"))},
+		{"scenarios", (*Bot).handleSlashScenarios, slashInteraction("scenarios", stringOption("team", "Eagles"))},
+		{"safepicks", (*Bot).handleSlashSafePicks, slashInteraction("safepicks", intOption("week", 7))},
+		{"draftorder", (*Bot).handleSlashDraftOrder, slashInteraction("draftorder")},
+		{"waivers", (*Bot).handleSlashWaivers, slashInteraction("waivers")},
+		{"track", (*Bot).handleSlashTrack, slashInteraction("track", stringOption("player", "Saquon Barkley"), stringOption("milestone", "1000 rushing yards"))},
+		{"trend", (*Bot).handleSlashTrend, slashInteraction("trend", stringOption("player", "Josh Allen"))},
+		{"ats", (*Bot).handleSlashATS, slashInteraction("ats", stringOption("team", "Bills"))},
+		{"futures", (*Bot).handleSlashFutures, slashInteraction("futures", stringOption("team", "Chiefs"))},
+		{"scoring", (*Bot).handleSlashScoring, slashInteraction("scoring", stringOption("scoring", "half_ppr"))},
+		{"remind_list", (*Bot).handleSlashRemind, slashInteraction("remind", subcommandOption("list"))},
+		{"pickem_leaderboard", (*Bot).handleSlashPickem, slashInteraction("pickem", subcommandOption("leaderboard"))},
+		{"trivia_leaderboard", (*Bot).handleSlashTrivia, slashInteraction("trivia", subcommandOption("leaderboard"))},
+		{"league_dates", (*Bot).handleSlashLeague, slashInteraction("league", &discordgo.ApplicationCommandInteractionDataOption{Name: "dates", Type: discordgo.ApplicationCommandOptionSubCommandGroup, Options: []*discordgo.ApplicationCommandInteractionDataOption{subcommandOption("list")}})},
+		{"watchlist_show", (*Bot).handleSlashWatchlist, slashInteraction("watchlist", subcommandOption("show"))},
+		{"timezone", (*Bot).handleSlashTimezone, slashInteraction("timezone")},
+		{"favorite_show", (*Bot).handleSlashFavorite, slashInteraction("favorite", subcommandOption("show"))},
+		{"alias_list", (*Bot).handleSlashAlias, slashInteraction("alias", subcommandOption("list"))},
+		{"follow", (*Bot).handleSlashFollow, slashInteraction("follow", stringOption("team", "Eagles"))},
+		{"unfollow", (*Bot).handleSlashUnfollow, slashInteraction("unfollow", stringOption("team", "Eagles"))},
+		{"leaderboard_page", (*Bot).handleSlashLeaderboardPage, slashInteraction("leaderboard-page")},
+		{"owner", (*Bot).handleSlashOwner, slashInteraction("owner")},
+		{"visibility", (*Bot).handleSlashVisibility, asManager(slashInteraction("visibility", stringOption("default", visibilityReset)))},
+		{"language", (*Bot).handleSlashLanguage, slashInteraction("language")},
+		{"formatting", (*Bot).handleSlashFormatting, slashInteraction("formatting")},
+		{"spoiler_delay", (*Bot).handleSlashSpoilerDelay, asManager(slashInteraction("spoiler-delay"))},
+		{"spoiler_mode", (*Bot).handleSlashSpoilerMode, asManager(slashInteraction("spoiler-mode"))},
+		{"next", (*Bot).handleSlashNext, slashInteraction("next", stringOption("team", "Bills"))},
+		{"whattowatch", (*Bot).handleSlashWhatToWatch, slashInteraction("whattowatch")},
+		{"scoredetail", (*Bot).handleScoreDetailComponent, componentInteraction(fmt.Sprintf("%s%d:%s:%d", scoreDetailPrefix, 2025, "REG", 6), "DAL")},
+		{"plays", (*Bot).handleSlashPlays, slashInteraction("plays", stringOption("team", "Cowboys"))},
+		{"drives", (*Bot).handleSlashDrives, slashInteraction("drives", stringOption("game", "Eagles"))},
+		{"predict_closed", (*Bot).handleSlashPredict, slashInteraction("predict", stringOption("game", "Eagles"))},
+		{"powerrankings", (*Bot).handleSlashPowerRankings, slashInteraction("powerrankings")},
+		{"matchup_player", (*Bot).handleSlashMatchupPlayer, slashInteraction("matchup-player", stringOption("player", "Saquon Barkley"))},
+		{"dfs_value", (*Bot).handleSlashValue, slashInteraction("value", stringOption("position", "RB"))},
+		{"rookies", (*Bot).handleSlashRookies, slashInteraction("rookies")},
+		{"draftkit", (*Bot).handleSlashDraftKit, slashInteraction("draftkit", stringOption("position", "WR"))},
+		{"draft_picks", (*Bot).handleSlashDraft, slashInteraction("draft", subcommandOption("picks", stringOption("team", "Giants"), intOption("year", 2025)))},
+		{"draft_feed", (*Bot).handleSlashDraft, asManager(slashInteraction("draft", subcommandOption("feed", channelOption("channel", "channel-draft"))))},
+		{"mockdraft", (*Bot).handleSlashMockDraft, slashInteraction("mockdraft", subcommandOption("start", intOption("teams", 4), intOption("rounds", 5)))},
+		{"alerts", (*Bot).handleSlashAlerts, asManager(slashInteraction("alerts", channelOption("channel", "channel-alerts")))},
+		{"big_games", (*Bot).handleSlashBigGames, asManager(slashInteraction("big-games", channelOption("channel", "channel-games")))},
+		{"digest_configure", (*Bot).handleSlashDigest, asManager(slashInteraction("digest", subcommandOption("configure", channelOption("channel", "channel-digest"), stringOption("day", "wednesday"))))},
+		{"game_threads", (*Bot).handleSlashGameThreads, asManager(slashInteraction("game-threads", channelOption("channel", "channel-threads"), stringOption("teams", "Eagles, Cowboys")))},
+		{"play_alerts_add", (*Bot).handleSlashPlayAlerts, asManager(slashInteraction("play-alerts", subcommandOption("add", channelOption("channel", "channel-plays"), stringOption("teams", "Bills"), stringOption("plays", "touchdowns"))))},
+		{"play_alerts_list", (*Bot).handleSlashPlayAlerts, asManager(slashInteraction("play-alerts", subcommandOption("list")))},
+		{"slowmode_add", (*Bot).handleSlashSlowMode, asManager(slashInteraction("slowmode", subcommandOption("add", channelOption("channel", "channel-chat"), stringOption("teams", "Chiefs"))))},
+		{"slowmode_list", (*Bot).handleSlashSlowMode, asManager(slashInteraction("slowmode", subcommandOption("list")))},
+	}
+}
+
+// goldenSetup prepares state a case needs beyond the fixtures, keyed by case name
+var goldenSetup = map[string]func(t *testing.T, b *Bot){
+	"powerrankings": rateFixtureSeason,
+}
+
+// rateFixtureSeason seeds the Elo ratings and rates each week's finals so far, as the score poller does
+func rateFixtureSeason(t *testing.T, b *Bot) {
+	t.Helper()
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.elo.StartSeason(seasonInfo.Season, b.eloSeeds(seasonInfo.Season)); err != nil {
+		t.Fatal(err)
+	}
+	for week := 1; week <= seasonInfo.Week; week++ {
+		scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, week)
+		if err != nil {
+			t.Fatal(err)
+		}
+		weekInfo := *seasonInfo
+		weekInfo.Week = week
+		for _, game := range scores {
+			if !game.IsCompleted() {
+				continue
+			}
+			if err := b.elo.Apply(digestWeekKey(&weekInfo), game, false); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+// TestGoldenCommandReplies snapshots each command's reply, embeds included, against testdata/golden
+func TestGoldenCommandReplies(t *testing.T) {
+	for _, tc := range goldenCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			b := newTestBot(t)
+			if setup, exists := goldenSetup[tc.name]; exists {
+				setup(t, b)
+			}
+			session := newFakeResponder()
+			tc.handler(b, session, tc.command)
+
+			reply := session.waitReply(t)
+			got := goldenJSON(t, goldenReply{
+				Method:     reply.Method,
+				Ephemeral:  reply.Flags&discordgo.MessageFlagsEphemeral != 0,
+				Content:    reply.Content,
+				Embeds:     reply.Embeds,
+				Components: reply.Components,
+				Posts:      session.posts(),
+			})
+
+			path := filepath.Join(goldenDir, tc.name+".json")
+			if *updateGolden {
+				if err := os.MkdirAll(goldenDir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading golden file (run with -update to create it): %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("reply differs from %s (run with -update if the change is intended)\ngot:\n%s", path, got)
+			}
+		})
+	}
+}
+
+// goldenJSON encodes a reply for its snapshot, masking timestamps and data ages
+func goldenJSON(t *testing.T, reply goldenReply) []byte {
+	t.Helper()
+	for _, embed := range reply.Embeds {
+		if embed.Timestamp != "" {
+			embed.Timestamp = "TIMESTAMP"
+		}
+	}
+	encoded, err := json.MarshalIndent(reply, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, volatile := range volatileText {
+		encoded = volatile.pattern.ReplaceAll(encoded, []byte(volatile.replacement))
+	}
+	return append(encoded, '\n')
+}
@@ -0,0 +1,40 @@
+package bot
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// playerPhoto returns a player's headshot URL, or "" when there is none or the lookup fails
+func (b *Bot) playerPhoto(stats *models.PlayerStats) string {
+	url, err := b.nflClient.GetPlayerPhotoURL(stats.PlayerID, stats.Name, stats.Team)
+	if err != nil {
+		logger.Debug("headshot lookup failed", "player", stats.Name, "error", err)
+		return ""
+	}
+	return url
+}
+
+// addHeadshot shows a player's headshot as the thumbnail; a team logo already there moves to the footer icon
+func addHeadshot(embed *discordgo.MessageEmbed, photoURL string) *discordgo.MessageEmbed {
+	if photoURL == "" {
+		return embed
+	}
+	if embed.Thumbnail != nil && embed.Footer != nil {
+		embed.Footer.IconURL = embed.Thumbnail.URL
+	}
+	embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: photoURL}
+	return embed
+}
+
+// addComparisonHeadshots puts the first player's headshot in the author line on the left and the
+// second player's as the thumbnail on the right, matching the 🔵/🔴 order of the comparison
+func addComparisonHeadshots(embed *discordgo.MessageEmbed, c *comparison) *discordgo.MessageEmbed {
+	if c.photo1 != "" {
+		embed.Author = &discordgo.MessageEmbedAuthor{Name: "🔵 " + c.stats1.Name + " vs 🔴 " + c.stats2.Name, IconURL: c.photo1}
+	}
+	if c.photo2 != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: c.photo2}
+	}
+	return embed
+}
@@ -0,0 +1,100 @@
+package bot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/pkg/models"
+)
+
+// handleSlashHistory handles the /history slash command
+func (b *Bot) handleSlashHistory(s Responder, i *discordgo.InteractionCreate) {
+	var teamName string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "team" {
+			teamName = option.StringValue()
+		}
+	}
+	teamName = b.teamOrFavorite(i, teamName)
+	if teamName == "" {
+		b.respondInteraction(s, i, missingTeamMessage)
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial history response", "error", err)
+		return
+	}
+
+	// Process history request asynchronously
+	go b.processSlashHistoryRequest(s, i, teamName)
+}
+
+// processSlashHistoryRequest builds a franchise's history and completes the deferred response
+func (b *Bot) processSlashHistoryRequest(s Responder, i *discordgo.InteractionCreate, teamName string) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting team info for %s", teamName), err))
+		return
+	}
+
+	history, err := b.nflClient.GetFranchiseHistory(teamInfo.Key)
+	if err != nil {
+		b.completeInteraction(s, i, fmt.Sprintf("📭 No franchise history available for the %s %s.", teamInfo.City, teamInfo.Name))
+		return
+	}
+
+	embed := historyEmbed(teamInfo, history, b.guildFormat(i.GuildID))
+	themeEmbed(embed, teamInfo)
+
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending history embed response", "error", err)
+	}
+}
+
+// historyEmbed shows a franchise's titles, all-time record, and retired numbers
+func historyEmbed(teamInfo *models.TeamInfo, history *models.FranchiseHistory, format models.Format) *discordgo.MessageEmbed {
+	retired := "None"
+	if len(history.RetiredNumbers) > 0 {
+		lines := make([]string, len(history.RetiredNumbers))
+		for index, number := range history.RetiredNumbers {
+			lines[index] = fmt.Sprintf("**#%d** %s", number.Number, number.Name)
+		}
+		retired = strings.Join(lines, "\n")
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏆 %s %s — Franchise History", teamInfo.City, teamInfo.Name),
+		Description: fmt.Sprintf("Founded %d", history.Founded),
+		Color:       embeds.ColorTeam,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Super Bowl Titles", Value: titleYears(history.SuperBowls), Inline: true},
+			{Name: "Conference Titles", Value: titleYears(history.ConferenceTitles), Inline: true},
+			{
+				Name:   "All-Time Record",
+				Value:  fmt.Sprintf("%s (%.3f)", history.RecordString(format), history.Percentage()),
+				Inline: false,
+			},
+			{Name: "Retired Numbers", Value: retired, Inline: false},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Regular season record through %d • title years are Super Bowl years", history.RecordThrough),
+		},
+	}
+}
+
+// titleYears formats a count of titles with their years, e.g. "2 (2018, 2025)"
+func titleYears(years []int) string {
+	if len(years) == 0 {
+		return "0"
+	}
+	labels := make([]string, len(years))
+	for index, year := range years {
+		labels[index] = strconv.Itoa(year)
+	}
+	return fmt.Sprintf("%d (%s)", len(years), strings.Join(labels, ", "))
+}
@@ -0,0 +1,245 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// defaultGuildTimezone is used for league dates until a guild sets its own zone
+const defaultGuildTimezone = "America/New_York"
+
+// leagueEvents maps league event keys to display names
+var leagueEvents = map[string]string{
+	"keeper_deadline": "Keeper Deadline",
+	"trade_deadline":  "Trade Deadline",
+	"playoffs_start":  "Fantasy Playoffs Start",
+}
+
+// leagueReminderLeads are how long before a league event reminders are posted
+var leagueReminderLeads = []reminderLead{
+	{7 * 24 * time.Hour, "1 week"},
+	{24 * time.Hour, "1 day"},
+	{time.Hour, "1 hour"},
+}
+
+// leagueEventOption builds the choice option for selecting a league event
+func leagueEventOption() *discordgo.ApplicationCommandOption {
+	option := &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "event",
+		Description: "League event",
+		Required:    true,
+	}
+	for _, event := range []string{"keeper_deadline", "trade_deadline", "playoffs_start"} {
+		option.Choices = append(option.Choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  leagueEvents[event],
+			Value: event,
+		})
+	}
+	return option
+}
+
+// canManageGuild reports whether the interaction's member has the Manage Server permission
+func canManageGuild(i *discordgo.InteractionCreate) bool {
+	return i.Member != nil && i.Member.Permissions&discordgo.PermissionManageGuild != 0
+}
+
+// guildLocation returns the time zone a guild's league dates are entered and shown in
+func (b *Bot) guildLocation(guildID string) *time.Location {
+	name := b.settings.Get(guildID).Timezone
+	if name == "" {
+		name = defaultGuildTimezone
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		logger.Warn("invalid timezone", "timezone", name, "guild", guildID, "error", err)
+		return time.UTC
+	}
+	return location
+}
+
+// handleSlashLeague handles the /league slash command and its subcommands
+func (b *Bot) handleSlashLeague(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, "League dates are only available in servers.")
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	switch options[0].Name {
+	case "timezone":
+		b.respondLeagueTimezone(s, i, options[0].Options)
+	case "dates":
+		if len(options[0].Options) == 0 {
+			return
+		}
+		subcommand := options[0].Options[0]
+		switch subcommand.Name {
+		case "set":
+			b.respondLeagueDatesSet(s, i, subcommand.Options)
+		case "clear":
+			b.respondLeagueDatesClear(s, i, subcommand.Options[0].StringValue())
+		case "list":
+			b.respondLeagueDatesList(s, i)
+		}
+	}
+}
+
+// respondLeagueTimezone shows or changes the guild's time zone
+func (b *Bot) respondLeagueTimezone(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		location := b.guildLocation(i.GuildID)
+		b.respondInteraction(s, i, fmt.Sprintf("🕒 This server uses **%s** for league dates and game times.", location))
+		return
+	}
+
+	if !canManageGuild(i) {
+		b.respondInteraction(s, i, b.tr(i, "permission.league_timezone"))
+		return
+	}
+
+	name := strings.TrimSpace(options[0].StringValue())
+	if !validTimezone(name) {
+		b.respondInteraction(s, i, fmt.Sprintf("❌ Unknown time zone `%s`. Use an IANA name like `America/Chicago` or `Europe/London`.", name))
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.Timezone = name
+	})
+	if err != nil {
+		b.respondInteraction(s, i, b.tr(i, "league.timezone_save_failed"))
+		return
+	}
+	b.respondInteraction(s, i, fmt.Sprintf("🕒 Server time zone set to **%s** for league dates and game times.", name))
+}
+
+// respondLeagueDatesSet registers a league event and schedules its reminders
+func (b *Bot) respondLeagueDatesSet(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if !canManageGuild(i) {
+		b.respondInteraction(s, i, b.tr(i, "permission.league_dates_set"))
+		return
+	}
+
+	var event, date string
+	clock := "12:00"
+	for _, option := range options {
+		switch option.Name {
+		case "event":
+			event = option.StringValue()
+		case "date":
+			date = option.StringValue()
+		case "time":
+			clock = option.StringValue()
+		}
+	}
+
+	location := b.guildLocation(i.GuildID)
+	when, err := time.ParseInLocation("2006-01-02 15:04", strings.TrimSpace(date)+" "+strings.TrimSpace(clock), location)
+	if err != nil {
+		b.respondInteraction(s, i, "❌ Use `YYYY-MM-DD` for the date and 24-hour `HH:MM` for the time.")
+		return
+	}
+	if !when.After(time.Now()) {
+		b.respondInteraction(s, i, "❌ That date has already passed.")
+		return
+	}
+
+	err = b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		if settings.LeagueDates == nil {
+			settings.LeagueDates = make(map[string]*LeagueDate)
+		}
+		settings.LeagueDates[event] = &LeagueDate{Time: when, ChannelID: i.ChannelID}
+	})
+	if err != nil {
+		b.respondInteraction(s, i, b.tr(i, "league.date_save_failed"))
+		return
+	}
+
+	scheduled, err := b.scheduleLeagueReminders(i.GuildID, i.ChannelID, event, when)
+	if err != nil {
+		logger.Error("error scheduling league reminders", "error", err)
+		b.respondInteraction(s, i, "⚠️ The date was saved but its reminders could not be scheduled.")
+		return
+	}
+
+	b.respondInteraction(s, i, fmt.Sprintf("📅 **%s** set for <t:%d:F> (%s). %d reminder(s) will be posted in this channel.",
+		leagueEvents[event], when.Unix(), location, scheduled))
+}
+
+// scheduleLeagueReminders replaces an event's pending reminders, returning how many were scheduled
+func (b *Bot) scheduleLeagueReminders(guildID, channelID, event string, when time.Time) (int, error) {
+	template := Reminder{GuildID: guildID, ChannelID: channelID, LeagueEvent: event}
+	return b.reminders.ScheduleEvent(template, when, leagueReminderLeads, time.Now(), func(label string) string {
+		return fmt.Sprintf("📅 **%s** is in %s (<t:%d:F>)", leagueEvents[event], label, when.Unix())
+	})
+}
+
+// respondLeagueDatesClear removes a league event and its reminders
+func (b *Bot) respondLeagueDatesClear(s Responder, i *discordgo.InteractionCreate, event string) {
+	if !canManageGuild(i) {
+		b.respondInteraction(s, i, b.tr(i, "permission.league_dates_clear"))
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		delete(settings.LeagueDates, event)
+	})
+	if err == nil {
+		err = b.reminders.RemoveLeagueEvent(i.GuildID, event)
+	}
+	if err != nil {
+		b.respondInteraction(s, i, b.tr(i, "league.date_clear_failed"))
+		return
+	}
+	b.respondInteraction(s, i, fmt.Sprintf("🗑️ **%s** cleared.", leagueEvents[event]))
+}
+
+// respondLeagueDatesList shows the guild's league events in its time zone
+func (b *Bot) respondLeagueDatesList(s Responder, i *discordgo.InteractionCreate) {
+	dates := b.settings.Get(i.GuildID).LeagueDates
+	if len(dates) == 0 {
+		b.respondInteraction(s, i, "No league dates set. Commissioners can add them with `/league dates set`.")
+		return
+	}
+
+	var events []string
+	for event := range dates {
+		events = append(events, event)
+	}
+	sort.Slice(events, func(x, y int) bool {
+		return dates[events[x]].Time.Before(dates[events[y]].Time)
+	})
+
+	location := b.guildLocation(i.GuildID)
+	format := b.guildFormat(i.GuildID)
+	embed := &discordgo.MessageEmbed{
+		Title:  "📅 League Dates",
+		Color:  0x013369,
+		Footer: &discordgo.MessageEmbedFooter{Text: "League time zone: " + location.String()},
+	}
+	for _, event := range events {
+		when := dates[event].Time
+		status := fmt.Sprintf("<t:%d:R>", when.Unix())
+		if when.Before(time.Now()) {
+			status = "passed"
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   leagueEvents[event],
+			Value:  fmt.Sprintf("%s • %s", when.In(location).Format(format.Clock("Mon Jan 2, 3:04 PM")+" MST"), status),
+			Inline: false,
+		})
+	}
+
+	if err := b.respondInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error responding to league dates list", "error", err)
+	}
+}
@@ -0,0 +1,30 @@
+package bot
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/links"
+	"nfl-discord-bot/pkg/models"
+)
+
+// playerLinkButtons builds a row of link buttons to a player's reference pages, or nil when there are none
+func (b *Bot) playerLinkButtons(stats *models.PlayerStats) []discordgo.MessageComponent {
+	var buttons []discordgo.MessageComponent
+	if page := links.PlayerPage(stats.PlayerID, stats.Name); page != "" {
+		buttons = append(buttons, discordgo.Button{Label: "Player Page", Style: discordgo.LinkButton, URL: page})
+	}
+	if site := links.TeamSite(stats.Team); site != "" {
+		buttons = append(buttons, discordgo.Button{Label: stats.Team + " Team Site", Style: discordgo.LinkButton, URL: site})
+	}
+	if b.config.ProFootballReferenceLinks {
+		buttons = append(buttons, discordgo.Button{
+			Label: "Pro-Football-Reference",
+			Style: discordgo.LinkButton,
+			URL:   links.ProFootballReferenceSearch(stats.Name),
+		})
+	}
+
+	if len(buttons) == 0 {
+		return nil
+	}
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+}
@@ -0,0 +1,152 @@
+package bot
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/i18n"
+)
+
+// languageAuto is the /language choice that follows the guild's Discord locale
+const languageAuto = "auto"
+
+// discordLocales are the Discord locales each non-English catalog is registered under
+var discordLocales = map[string][]discordgo.Locale{
+	i18n.Spanish: {discordgo.SpanishES, discordgo.SpanishLATAM},
+}
+
+// localizeCommands fills in Discord's localization fields on command, option, and choice
+// descriptions from the i18n catalogs, so members see commands in their Discord language
+func localizeCommands(commands []*discordgo.ApplicationCommand) {
+	for _, command := range commands {
+		key := "command." + command.Name
+		if localized := localizations(key + ".description"); localized != nil {
+			command.DescriptionLocalizations = &localized
+		}
+		localizeOptions(key, command.Options)
+	}
+}
+
+// localizeOptions localizes options under a command or subcommand key, falling back to the
+// "option.<name>" keys for options shared across commands. Option names fall back on their own,
+// since most options share a translated name but have a description per command.
+func localizeOptions(parentKey string, options []*discordgo.ApplicationCommandOption) {
+	for _, option := range options {
+		key := parentKey + "." + option.Name
+		if localizations(key+".description") == nil && localizations("option."+option.Name+".description") != nil {
+			key = "option." + option.Name
+		}
+
+		option.NameLocalizations = localizations(parentKey + "." + option.Name + ".name")
+		if option.NameLocalizations == nil {
+			option.NameLocalizations = localizations("option." + option.Name + ".name")
+		}
+		option.DescriptionLocalizations = localizations(key + ".description")
+		for _, choice := range option.Choices {
+			value, ok := choice.Value.(string)
+			if !ok {
+				continue
+			}
+			choice.NameLocalizations = localizations(key + ".choice." + value)
+		}
+		localizeOptions(key, option.Options)
+	}
+}
+
+// localizations maps every Discord locale with a translation of key to it, or returns nil
+// when no catalog has one
+func localizations(key string) map[discordgo.Locale]string {
+	var localized map[discordgo.Locale]string
+	for _, language := range i18n.Languages {
+		message, ok := i18n.Lookup(language, key)
+		if !ok || language == i18n.English {
+			continue
+		}
+		if localized == nil {
+			localized = make(map[discordgo.Locale]string)
+		}
+		for _, locale := range discordLocales[language] {
+			localized[locale] = message
+		}
+	}
+	return localized
+}
+
+// guildLanguage returns the language a guild's responses are in: its /language setting, then
+// its Discord locale, then English
+func (b *Bot) guildLanguage(guildID string, guildLocale *discordgo.Locale) string {
+	if guildID != "" {
+		if language := b.settings.Get(guildID).Language; language != "" {
+			return language
+		}
+	}
+	if guildLocale != nil {
+		if language := i18n.FromLocale(string(*guildLocale)); language != "" {
+			return language
+		}
+	}
+	return i18n.English
+}
+
+// interactionLanguage returns the language to answer an interaction in; outside a guild that's
+// the user's own Discord language
+func (b *Bot) interactionLanguage(i *discordgo.InteractionCreate) string {
+	if i.GuildID == "" {
+		if language := i18n.FromLocale(string(i.Locale)); language != "" {
+			return language
+		}
+		return i18n.English
+	}
+	return b.guildLanguage(i.GuildID, i.GuildLocale)
+}
+
+// tr translates a response string into the interaction's language
+func (b *Bot) tr(i *discordgo.InteractionCreate, key string, args ...any) string {
+	return i18n.T(b.interactionLanguage(i), key, args...)
+}
+
+// handleSlashLanguage handles the /language slash command (admin only)
+func (b *Bot) handleSlashLanguage(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	var choice string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "language" {
+			choice = strings.ToLower(option.StringValue())
+		}
+	}
+
+	if choice == "" {
+		language := b.interactionLanguage(i)
+		if b.settings.Get(i.GuildID).Language == "" {
+			respondEphemeral(s, i, i18n.T(language, "language.current_auto", i18n.Name(language)))
+		} else {
+			respondEphemeral(s, i, i18n.T(language, "language.current", i18n.Name(language)))
+		}
+		return
+	}
+
+	if choice != languageAuto && !i18n.Supported(choice) {
+		choice = languageAuto
+	}
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.Language = ""
+		if choice != languageAuto {
+			settings.Language = choice
+		}
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "language.save_failed"))
+		return
+	}
+
+	language := b.interactionLanguage(i)
+	if choice == languageAuto {
+		respondEphemeral(s, i, i18n.T(language, "language.reset", i18n.Name(language)))
+		return
+	}
+	respondEphemeral(s, i, i18n.T(language, "language.set", i18n.Name(language)))
+}
@@ -0,0 +1,90 @@
+package bot
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordOptionName is the pattern Discord accepts for slash command option names and their
+// localizations: lowercase letters, digits, hyphens, and underscores, up to 32 characters
+var discordOptionName = regexp.MustCompile(`^[-_\p{Ll}\p{Lo}\p{N}]{1,32}$`)
+
+func TestLocalizedOptionNamesAreValid(t *testing.T) {
+	b := newTestBot(t)
+	for _, command := range b.commands {
+		checkOptionNames(t, "/"+command.Name, command.Options)
+	}
+}
+
+// checkOptionNames fails when a localized option name is one Discord would reject, or when two
+// options on the same level share a name in one locale
+func checkOptionNames(t *testing.T, path string, options []*discordgo.ApplicationCommandOption) {
+	t.Helper()
+	seen := make(map[discordgo.Locale]map[string]string)
+	for _, option := range options {
+		for locale, name := range option.NameLocalizations {
+			if !discordOptionName.MatchString(name) {
+				t.Errorf("%s %s: %s name %q isn't a valid option name", path, option.Name, locale, name)
+			}
+			if seen[locale] == nil {
+				seen[locale] = make(map[string]string)
+			}
+			if other, taken := seen[locale][name]; taken {
+				t.Errorf("%s: %s and %s are both %q in %s", path, other, option.Name, name, locale)
+			}
+			seen[locale][name] = option.Name
+		}
+		checkOptionNames(t, path+" "+option.Name, option.Options)
+	}
+}
+
+func TestLocalizeOptionNames(t *testing.T) {
+	command := &discordgo.ApplicationCommand{
+		Name: "compare",
+		Options: []*discordgo.ApplicationCommandOption{{
+			Name: "players",
+			Type: discordgo.ApplicationCommandOptionSubCommand,
+			Options: []*discordgo.ApplicationCommandOption{
+				{Name: "player1", Type: discordgo.ApplicationCommandOptionString},
+				{Name: "public", Type: discordgo.ApplicationCommandOptionBoolean},
+				{Name: "unheard_of", Type: discordgo.ApplicationCommandOptionString},
+			},
+		}},
+	}
+
+	localizeCommands([]*discordgo.ApplicationCommand{command})
+
+	subcommand := command.Options[0]
+	if got := subcommand.NameLocalizations[discordgo.SpanishES]; got != "jugadores" {
+		t.Errorf("subcommand name in Spanish = %q, want jugadores", got)
+	}
+	if got := subcommand.Options[0].NameLocalizations[discordgo.SpanishLATAM]; got != "jugador1" {
+		t.Errorf("player1 in Latin American Spanish = %q, want jugador1", got)
+	}
+	if got := subcommand.Options[1].NameLocalizations[discordgo.SpanishES]; got != "público" {
+		t.Errorf("shared public option in Spanish = %q, want público", got)
+	}
+	if subcommand.Options[2].NameLocalizations != nil {
+		t.Errorf("option without a translation got %v, want the English name only", subcommand.Options[2].NameLocalizations)
+	}
+}
+
+func TestEphemeralRepliesFollowGuildLanguage(t *testing.T) {
+	b := newTestBot(t)
+	s := newFakeResponder()
+	i := slashInteraction("alias", subcommandOption("add", stringOption("alias", "bills"), stringOption("name", "BUF")))
+	spanish := discordgo.SpanishES
+	i.GuildLocale = &spanish
+
+	b.handleSlashAlias(s, i)
+
+	reply := s.waitReply(t)
+	if reply.Flags&discordgo.MessageFlagsEphemeral == 0 {
+		t.Error("permission reply isn't ephemeral")
+	}
+	if reply.Content != "❌ Necesitas el permiso Gestionar servidor para agregar alias." {
+		t.Errorf("reply = %q, want the Spanish permission message", reply.Content)
+	}
+}
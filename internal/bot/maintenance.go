@@ -0,0 +1,226 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/odds"
+	"nfl-discord-bot/internal/storage"
+)
+
+// maintenanceHour is the local hour the nightly maintenance job runs at
+const maintenanceHour = 4
+
+// maintenanceReport summarizes one maintenance run
+type maintenanceReport struct {
+	RanAt             time.Time
+	Duration          time.Duration
+	ArchivedPickWeeks int
+	ArchivedLines     int
+	PrunedPredictions int
+	RemovedLeftovers  int
+	Errors            []string
+}
+
+// maintenanceLog remembers the most recent maintenance run for /owner storage stats
+type maintenanceLog struct {
+	mu   sync.Mutex
+	last *maintenanceReport
+}
+
+// Set records a finished run
+func (ml *maintenanceLog) Set(report *maintenanceReport) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	ml.last = report
+}
+
+// Last returns the most recent run, or nil before the first one
+func (ml *maintenanceLog) Last() *maintenanceReport {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	return ml.last
+}
+
+// runMaintenance runs storage maintenance every night at maintenanceHour until the bot stops
+func (b *Bot) runMaintenance() {
+	for {
+		timer := time.NewTimer(time.Until(nextMaintenance(time.Now())))
+		select {
+		case <-timer.C:
+			b.maintain()
+		case <-b.done:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// nextMaintenance returns the next maintenanceHour after now
+func nextMaintenance(now time.Time) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), maintenanceHour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// maintain applies the retention policies: completed seasons older than the retention window
+// are moved out of the live pick'em and closing line documents into per-season archives,
+// stale prediction polls are dropped, and leftover temporary files are removed
+func (b *Bot) maintain() {
+	report := &maintenanceReport{RanAt: time.Now()}
+	fail := func(step string, err error) {
+		logger.Error("maintenance step failed", "step", step, "error", err)
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", step, err))
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		fail("season", err)
+	} else {
+		cutoff := seasonInfo.Season - b.config.RetentionSeasons + 1
+
+		report.ArchivedPickWeeks, err = b.pickem.RetireSeasonsBefore(cutoff, func(season int, weeks map[string]map[string]*pickemWeek) error {
+			return mergeArchive(b.store, archiveDocument(pickemDocument, season), weeks)
+		})
+		if err != nil {
+			fail("pick'em", err)
+		}
+
+		report.ArchivedLines, err = b.closingLines.RetireSeasonsBefore(cutoff, func(season int, lines map[string]odds.ClosingLine) error {
+			return mergeArchive(b.store, archiveDocument(closingLinesDocument, season), lines)
+		})
+		if err != nil {
+			fail("closing lines", err)
+		}
+	}
+
+	retention := time.Duration(b.config.PredictionRetentionDays) * 24 * time.Hour
+	if report.PrunedPredictions, err = b.predictions.PruneKickoffsBefore(time.Now().Add(-retention)); err != nil {
+		fail("predictions", err)
+	}
+
+	if report.RemovedLeftovers, err = b.store.Vacuum(); err != nil {
+		fail("vacuum", err)
+	}
+
+	report.Duration = time.Since(report.RanAt)
+	b.maintenance.Set(report)
+	logger.Info("storage maintenance finished",
+		"archived_pick_weeks", report.ArchivedPickWeeks,
+		"archived_lines", report.ArchivedLines,
+		"pruned_predictions", report.PrunedPredictions,
+		"removed_leftovers", report.RemovedLeftovers,
+		"errors", len(report.Errors),
+		"duration", report.Duration.Round(time.Millisecond))
+}
+
+// archiveDocument names the archive document holding one season of a live document
+func archiveDocument(document string, season int) string {
+	return fmt.Sprintf("%s_archive_%d", document, season)
+}
+
+// mergeArchive adds entries to an archive document, keeping anything archived earlier
+func mergeArchive[T any](store *storage.Store, name string, entries map[string]T) error {
+	archived := make(map[string]T)
+	if err := store.Load(name, &archived); err != nil {
+		return err
+	}
+	for key, entry := range entries {
+		archived[key] = entry
+	}
+	return store.Save(name, archived)
+}
+
+// handleSlashOwner handles the /owner slash command (bot owner only)
+func (b *Bot) handleSlashOwner(s Responder, i *discordgo.InteractionCreate) {
+	if b.ownerID == "" || interactionUserID(i) != b.ownerID {
+		respondEphemeral(s, i, b.tr(i, "permission.owner_only"))
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 || options[0].Name != "storage" || len(options[0].Options) == 0 {
+		return
+	}
+
+	switch options[0].Options[0].Name {
+	case "stats":
+		b.respondStorageStats(s, i)
+	}
+}
+
+// respondStorageStats shows the size of every stored document and the last maintenance run
+func (b *Bot) respondStorageStats(s Responder, i *discordgo.InteractionCreate) {
+	documents, err := b.store.Documents()
+	if err != nil {
+		respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+		return
+	}
+
+	var table strings.Builder
+	var total int64
+	table.WriteString("```\n")
+	for _, document := range documents {
+		total += document.Size
+		table.WriteString(fmt.Sprintf("%-30s %9s  %s\n", document.Name, formatBytes(document.Size), document.Modified.Format("Jan 2 15:04")))
+	}
+	table.WriteString("```")
+	if len(documents) == 0 {
+		table.Reset()
+		table.WriteString("No documents stored yet.")
+	}
+
+	lastRun := fmt.Sprintf("Not run since startup (nightly at %02d:00)", maintenanceHour)
+	if report := b.maintenance.Last(); report != nil {
+		lastRun = fmt.Sprintf("<t:%d:R> in %s\nArchived %d pick'em weeks and %d closing lines\nPruned %d stale polls • removed %d leftover files",
+			report.RanAt.Unix(), report.Duration.Round(time.Millisecond), report.ArchivedPickWeeks, report.ArchivedLines,
+			report.PrunedPredictions, report.RemovedLeftovers)
+		if len(report.Errors) > 0 {
+			lastRun += "\n⚠️ " + strings.Join(report.Errors, "\n⚠️ ")
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🗄️ Storage — %s in %d documents", formatBytes(total), len(documents)),
+		Color:       0x013369,
+		Description: table.String(),
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Retention",
+				Value:  fmt.Sprintf("Pick'em and closing lines: last %d seasons live, older archived\nUnannounced polls: %d days", b.config.RetentionSeasons, b.config.PredictionRetentionDays),
+				Inline: false,
+			},
+			{Name: "Last Maintenance", Value: lastRun, Inline: false},
+		},
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{embed},
+			Flags:  discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		logger.Error("error responding to owner storage stats", "error", err)
+	}
+}
+
+// formatBytes renders a byte count with a binary unit
+func formatBytes(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
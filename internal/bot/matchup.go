@@ -0,0 +1,190 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/pkg/models"
+)
+
+// handleSlashMatchupPlayer handles the /matchup-player slash command
+func (b *Bot) handleSlashMatchupPlayer(s Responder, i *discordgo.InteractionCreate) {
+	var playerName string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "player" {
+			playerName = option.StringValue()
+		}
+	}
+	if playerName == "" {
+		err := b.respondInteraction(s, i, "Please provide a player name.")
+		if err != nil {
+			logger.Error("error responding to matchup-player slash command", "error", err)
+		}
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial matchup-player response", "error", err)
+		return
+	}
+
+	// Process matchup request asynchronously
+	go b.processSlashMatchupPlayerRequest(s, i, playerName)
+}
+
+// processSlashMatchupPlayerRequest finds the player's next opponent and how that defense fares against the position
+func (b *Bot) processSlashMatchupPlayerRequest(s Responder, i *discordgo.InteractionCreate, playerName string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error analyzing matchup", err))
+		return
+	}
+
+	// Early in the season a player may not have stats yet, so fall back to last season to identify them
+	player, err := b.nflClient.FindSeasonPlayer(playerName, seasonInfo.Season)
+	if err != nil {
+		player, err = b.nflClient.FindSeasonPlayer(playerName, seasonInfo.Season-1)
+		if err != nil {
+			b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding player %s", playerName), err))
+			return
+		}
+	}
+
+	seasonType := seasonInfo.SeasonType
+	if seasonType == models.SeasonTypePreseason {
+		seasonType = models.SeasonTypeRegular
+	}
+	game, err := b.nextGame(player.Team, seasonInfo.Season, seasonType, seasonInfo.Week)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding %s's next game", player.Team), err))
+		return
+	}
+
+	opponent := game.HomeTeam
+	if opponent == player.Team {
+		opponent = game.AwayTeam
+	}
+
+	// Use completed weeks of this regular season, or all of last season before week 2
+	defenseSeason, throughWeek := seasonInfo.Season, seasonInfo.Week-1
+	basis := fmt.Sprintf("%d through Week %d", defenseSeason, throughWeek)
+	if seasonInfo.SeasonType != models.SeasonTypeRegular || throughWeek < 1 {
+		defenseSeason, throughWeek = seasonInfo.Season-1, 18
+		basis = fmt.Sprintf("full %d season", defenseSeason)
+	}
+
+	defense, err := b.nflClient.GetDefenseVsPosition(defenseSeason, models.SeasonTypeRegular, throughWeek)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting defensive stats", err))
+		return
+	}
+
+	allowed := defense[opponent][player.Position]
+	if allowed == nil {
+		b.completeInteraction(s, i, fmt.Sprintf("No defensive data for %s against %ss yet.", opponent, player.Position))
+		return
+	}
+
+	location := "vs"
+	if game.AwayTeam == player.Team {
+		location = "@"
+	}
+	format := b.guildFormat(i.GuildID)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎯 %s (%s, %s) %s %s", player.Name, player.Position, player.Team, location, opponent),
+		Description: fmt.Sprintf("%s • %s", models.WeekLabel(seasonType, game.Week), embeds.FormatKickoff(game.GameTime, b.userLocation(i.GuildID, interactionUserID(i)), "Mon Jan 2, 3:04 PM", format)),
+		Color:       matchupColor(allowed.Rank, len(defense)),
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name: fmt.Sprintf("%s Defense vs %ss", opponent, player.Position),
+				Value: fmt.Sprintf("%s\n▫ **Rank:** #%d of %d\n▫ **%s/Game Allowed:** %.1f\n▫ **TDs/Game Allowed:** %.2f",
+					matchupVerdict(allowed.Rank, len(defense)), allowed.Rank, len(defense), format.UnitName(), format.Length(allowed.YardsPerGame()), allowed.TouchdownsPerGame()),
+				Inline: false,
+			},
+			{
+				Name:   fmt.Sprintf("%s vs All Positions", opponent),
+				Value:  positionDefenseTable(defense[opponent], format),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Rank 1 = fewest yards allowed | Based on %s", basis),
+		},
+	}
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending matchup-player embed response", "error", err)
+	}
+}
+
+// nextGame returns a team's next unfinished game from the given week onward
+func (b *Bot) nextGame(team string, season int, seasonType string, fromWeek int) (*models.Game, error) {
+	games, err := b.nflClient.GetSeasonGames(season, seasonType)
+	if err != nil {
+		return nil, err
+	}
+
+	var next *models.Game
+	for index := range games {
+		game := &games[index]
+		if game.HomeTeam != team && game.AwayTeam != team {
+			continue
+		}
+		if game.Week < fromWeek || standings.IsFinal(game.Status) {
+			continue
+		}
+		if next == nil || game.Week < next.Week {
+			next = game
+		}
+	}
+
+	if next == nil {
+		return nil, fmt.Errorf("no upcoming games for %s", team)
+	}
+	return next, nil
+}
+
+// matchupVerdict describes how favorable a defensive rank is for the offense
+func matchupVerdict(rank, teams int) string {
+	switch {
+	case rank <= teams/4:
+		return "🔴 **Tough matchup**"
+	case rank > teams-teams/4:
+		return "🟢 **Favorable matchup**"
+	default:
+		return "🟡 **Neutral matchup**"
+	}
+}
+
+// matchupColor returns the embed color for a defensive rank
+func matchupColor(rank, teams int) int {
+	switch {
+	case rank <= teams/4:
+		return 0xe74c3c
+	case rank > teams-teams/4:
+		return 0x2ecc71
+	default:
+		return 0xf1c40f
+	}
+}
+
+// positionDefenseTable formats a defense's rank against every fantasy position
+func positionDefenseTable(positions map[string]*models.PositionDefense, format models.Format) string {
+	var text strings.Builder
+	text.WriteString("```\n")
+	for _, position := range []string{"QB", "RB", "WR", "TE"} {
+		allowed, exists := positions[position]
+		if !exists {
+			continue
+		}
+		text.WriteString(fmt.Sprintf("%-2s  #%-2d  %6.1f %s/g  %.2f TD/g\n", position, allowed.Rank, format.Length(allowed.YardsPerGame()), format.UnitPlural(), allowed.TouchdownsPerGame()))
+	}
+	text.WriteString("```")
+	return text.String()
+}
@@ -0,0 +1,497 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/pkg/models"
+)
+
+// milestonesDocument is the storage document holding tracked milestones and announced big games
+const milestonesDocument = "milestones"
+
+// Milestone tracking timing and limits
+const (
+	milestoneCheckInterval = 5 * time.Minute
+	milestonesPerGuild     = 25
+)
+
+// Milestone stats that can be tracked
+const (
+	milestonePassingYards   = "passing_yards"
+	milestonePassingTDs     = "passing_tds"
+	milestoneRushingYards   = "rushing_yards"
+	milestoneRushingTDs     = "rushing_tds"
+	milestoneReceivingYards = "receiving_yards"
+	milestoneReceivingTDs   = "receiving_tds"
+	milestoneReceptions     = "receptions"
+	milestoneTouchdowns     = "touchdowns"
+)
+
+// milestoneStatAliases maps the stat names users can type to milestone stats
+var milestoneStatAliases = map[string]string{
+	"passing yards":        milestonePassingYards,
+	"passing yds":          milestonePassingYards,
+	"pass yards":           milestonePassingYards,
+	"pass yds":             milestonePassingYards,
+	"passing tds":          milestonePassingTDs,
+	"passing touchdowns":   milestonePassingTDs,
+	"pass tds":             milestonePassingTDs,
+	"rushing yards":        milestoneRushingYards,
+	"rushing yds":          milestoneRushingYards,
+	"rush yards":           milestoneRushingYards,
+	"rush yds":             milestoneRushingYards,
+	"rushing tds":          milestoneRushingTDs,
+	"rushing touchdowns":   milestoneRushingTDs,
+	"rush tds":             milestoneRushingTDs,
+	"receiving yards":      milestoneReceivingYards,
+	"receiving yds":        milestoneReceivingYards,
+	"rec yards":            milestoneReceivingYards,
+	"rec yds":              milestoneReceivingYards,
+	"receiving tds":        milestoneReceivingTDs,
+	"receiving touchdowns": milestoneReceivingTDs,
+	"rec tds":              milestoneReceivingTDs,
+	"receptions":           milestoneReceptions,
+	"catches":              milestoneReceptions,
+	"touchdowns":           milestoneTouchdowns,
+	"tds":                  milestoneTouchdowns,
+}
+
+// bigGameThresholds are the single-game marks announced to every alert channel
+var bigGameThresholds = []struct {
+	stat      string
+	threshold int
+}{
+	{milestonePassingYards, 300},
+	{milestoneRushingYards, 100},
+	{milestoneReceivingYards, 100},
+}
+
+// TrackedMilestone is a season total a user asked to be told about
+type TrackedMilestone struct {
+	GuildID    string `json:"guild_id"`
+	ChannelID  string `json:"channel_id"` // channel ID or webhook URL the announcement is posted to
+	UserID     string `json:"user_id"`
+	PlayerID   int    `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	Team       string `json:"team"`
+	Stat       string `json:"stat"`
+	Threshold  int    `json:"threshold"`
+	Season     int    `json:"season"`
+	Banked     int    `json:"banked"`      // total through BankedWeek
+	BankedWeek int    `json:"banked_week"` // last regular season week added to Banked
+}
+
+// key identifies a milestone so the same one isn't tracked twice in a guild
+func (m *TrackedMilestone) key() string {
+	return fmt.Sprintf("%s:%d:%s:%d", m.GuildID, m.PlayerID, m.Stat, m.Threshold)
+}
+
+// milestoneState is the persisted milestone document
+type milestoneState struct {
+	Tracked   map[string]*TrackedMilestone `json:"tracked"`
+	Week      string                       `json:"week"`      // week the announced big games belong to, see digestWeekKey
+	Announced map[string]bool              `json:"announced"` // big games already announced this week
+}
+
+// milestoneStore keeps tracked milestones and announced big games across restarts
+type milestoneStore struct {
+	mu    sync.Mutex
+	store *storage.Store
+	state milestoneState
+}
+
+// newMilestoneStore loads milestones from storage
+func newMilestoneStore(store *storage.Store) (*milestoneStore, error) {
+	milestones := &milestoneStore{store: store}
+	if err := store.Load(milestonesDocument, &milestones.state); err != nil {
+		return nil, err
+	}
+	if milestones.state.Tracked == nil {
+		milestones.state.Tracked = make(map[string]*TrackedMilestone)
+	}
+	return milestones, nil
+}
+
+// save persists the document; callers hold the lock
+func (ms *milestoneStore) save() error {
+	return ms.store.Save(milestonesDocument, ms.state)
+}
+
+// All returns copies of every tracked milestone
+func (ms *milestoneStore) All() []TrackedMilestone {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	milestones := make([]TrackedMilestone, 0, len(ms.state.Tracked))
+	for _, milestone := range ms.state.Tracked {
+		milestones = append(milestones, *milestone)
+	}
+	return milestones
+}
+
+// Guild returns copies of a guild's tracked milestones, by player then threshold
+func (ms *milestoneStore) Guild(guildID string) []TrackedMilestone {
+	var milestones []TrackedMilestone
+	for _, milestone := range ms.All() {
+		if milestone.GuildID == guildID {
+			milestones = append(milestones, milestone)
+		}
+	}
+	sort.Slice(milestones, func(i, j int) bool {
+		if milestones[i].PlayerName != milestones[j].PlayerName {
+			return milestones[i].PlayerName < milestones[j].PlayerName
+		}
+		return milestones[i].Threshold < milestones[j].Threshold
+	})
+	return milestones
+}
+
+// Put adds or replaces a tracked milestone
+func (ms *milestoneStore) Put(milestone TrackedMilestone) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.state.Tracked[milestone.key()] = &milestone
+	return ms.save()
+}
+
+// Remove stops tracking a milestone
+func (ms *milestoneStore) Remove(milestone TrackedMilestone) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	delete(ms.state.Tracked, milestone.key())
+	if err := ms.save(); err != nil {
+		logger.Error("error saving milestones", "error", err)
+	}
+}
+
+// Announce marks a big game as announced, returning false when it already was. Marks from earlier
+// weeks are dropped.
+func (ms *milestoneStore) Announce(week, key string) bool {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.state.Week != week {
+		ms.state.Week = week
+		ms.state.Announced = make(map[string]bool)
+	}
+	if ms.state.Announced[key] {
+		return false
+	}
+	ms.state.Announced[key] = true
+	if err := ms.save(); err != nil {
+		logger.Error("error saving milestones", "error", err)
+	}
+	return true
+}
+
+// parseMilestone reads a milestone like "1000 rushing yards" or "1,000 rush yds"
+func parseMilestone(text string) (int, string, error) {
+	fields := strings.Fields(strings.ToLower(text))
+	if len(fields) < 2 {
+		return 0, "", fmt.Errorf("a milestone needs a number and a stat, e.g. `1000 rushing yards`")
+	}
+	threshold, err := strconv.Atoi(strings.ReplaceAll(fields[0], ",", ""))
+	if err != nil || threshold <= 0 {
+		return 0, "", fmt.Errorf("`%s` isn't a positive number", fields[0])
+	}
+	stat, known := milestoneStatAliases[strings.Join(fields[1:], " ")]
+	if !known {
+		return 0, "", fmt.Errorf("unknown stat `%s`; try passing/rushing/receiving yards or TDs, receptions, or touchdowns", strings.Join(fields[1:], " "))
+	}
+	return threshold, stat, nil
+}
+
+// milestoneValue reads a milestone stat from a stat line
+func milestoneValue(stats *models.PlayerStats, stat string) int {
+	switch stat {
+	case milestonePassingYards:
+		return stats.Passing.Yards
+	case milestonePassingTDs:
+		return stats.Passing.Touchdowns
+	case milestoneRushingYards:
+		return stats.Rushing.Yards
+	case milestoneRushingTDs:
+		return stats.Rushing.Touchdowns
+	case milestoneReceivingYards:
+		return stats.Receiving.Yards
+	case milestoneReceivingTDs:
+		return stats.Receiving.Touchdowns
+	case milestoneReceptions:
+		return stats.Receiving.Receptions
+	case milestoneTouchdowns:
+		return stats.TotalTouchdowns()
+	}
+	return 0
+}
+
+// milestoneLabel describes a stat amount with a guild's thousands separator, e.g. "1,000 rushing yards"
+func milestoneLabel(amount int, stat string, format models.Format) string {
+	name := strings.ReplaceAll(stat, "_", " ")
+	name = strings.Replace(name, "tds", "TDs", 1)
+	return format.Int(amount) + " " + name
+}
+
+// handleSlashTrack handles the /track slash command
+func (b *Bot) handleSlashTrack(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	var playerName, milestoneText, webhook string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "player":
+			playerName = option.StringValue()
+		case "milestone":
+			milestoneText = option.StringValue()
+		case "webhook":
+			webhook = option.StringValue()
+		}
+	}
+
+	if playerName == "" && milestoneText == "" {
+		respondEphemeral(s, i, b.milestoneSummary(i.GuildID))
+		return
+	}
+	if playerName == "" || milestoneText == "" {
+		respondEphemeral(s, i, b.tr(i, "track.incomplete"))
+		return
+	}
+	threshold, stat, err := parseMilestone(milestoneText)
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "track.invalid", err))
+		return
+	}
+	target := i.ChannelID
+	if webhook != "" {
+		if target, err = parseWebhookURL(webhook); err != nil {
+			respondEphemeral(s, i, b.tr(i, "error.invalid_webhook", err))
+			return
+		}
+	}
+	if len(b.milestones.Guild(i.GuildID)) >= milestonesPerGuild {
+		respondEphemeral(s, i, b.tr(i, "track.full", milestonesPerGuild))
+		return
+	}
+
+	err = b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial track response", "error", err)
+		return
+	}
+
+	// Process track request asynchronously
+	go b.processSlashTrackRequest(s, i, playerName, threshold, stat, target)
+}
+
+// processSlashTrackRequest totals a player's season so far and starts tracking the milestone,
+// announcing it in a channel or webhook
+func (b *Bot) processSlashTrackRequest(s Responder, i *discordgo.InteractionCreate, playerName string, threshold int, stat, target string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error tracking milestone", err))
+		return
+	}
+	if seasonInfo.SeasonType != models.SeasonTypeRegular {
+		b.completeInteraction(s, i, "📭 Milestones can only be tracked during the regular season.")
+		return
+	}
+
+	weeks, err := b.nflClient.GetPlayerWeekByWeek(playerName, seasonInfo.Season, models.SeasonTypeRegular, seasonInfo.Week)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error tracking milestone", err))
+		return
+	}
+
+	milestone := TrackedMilestone{
+		GuildID:    i.GuildID,
+		ChannelID:  target,
+		UserID:     interactionUserID(i),
+		Stat:       stat,
+		Threshold:  threshold,
+		Season:     seasonInfo.Season,
+		BankedWeek: seasonInfo.Week - 1,
+	}
+	current := 0
+	for _, week := range weeks {
+		if week.Stats == nil {
+			continue
+		}
+		milestone.PlayerID, milestone.PlayerName, milestone.Team = week.Stats.PlayerID, week.Stats.Name, week.Stats.Team
+		if week.Week < seasonInfo.Week {
+			milestone.Banked += milestoneValue(week.Stats, stat)
+		} else {
+			current = milestoneValue(week.Stats, stat)
+		}
+	}
+
+	total := milestone.Banked + current
+	format := b.guildFormat(i.GuildID)
+	if total >= threshold {
+		b.completeInteraction(s, i, fmt.Sprintf("✅ %s already has %s this season.", milestone.PlayerName, milestoneLabel(total, stat, format)))
+		return
+	}
+	if err := b.milestones.Put(milestone); err != nil {
+		b.completeInteraction(s, i, b.tr(i, "track.save_failed"))
+		return
+	}
+
+	where := "here"
+	if isWebhookTarget(target) {
+		where = "to the " + webhookLabel(target)
+	}
+	b.completeInteraction(s, i, fmt.Sprintf("🎯 Tracking %s (%s) to %s: %s so far, %s to go. The announcement will be posted %s.",
+		milestone.PlayerName, milestone.Team, milestoneLabel(threshold, stat, format), format.Int(total), format.Int(threshold-total), where))
+}
+
+// milestoneSummary lists a guild's tracked milestones
+func (b *Bot) milestoneSummary(guildID string) string {
+	milestones := b.milestones.Guild(guildID)
+	if len(milestones) == 0 {
+		return "No milestones tracked. Add one with `/track player:<name> milestone:<e.g. 1000 rushing yards>`."
+	}
+
+	lines := make([]string, len(milestones))
+	for index, milestone := range milestones {
+		lines[index] = fmt.Sprintf("%s (%s) — %s, for <@%s> to %s",
+			milestone.PlayerName, milestone.Team, milestoneLabel(milestone.Threshold, milestone.Stat, b.guildFormat(guildID)), milestone.UserID, targetLabel(milestone.ChannelID))
+	}
+	return "🎯 **Tracked milestones**\n" + strings.Join(lines, "\n")
+}
+
+// runMilestoneWatcher checks tracked milestones and big games until the bot stops
+func (b *Bot) runMilestoneWatcher() {
+	ticker := time.NewTicker(milestoneCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.updateMilestones()
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// updateMilestones announces big single-game performances and tracked milestones reached in the
+// current week's stat sheet
+func (b *Bot) updateMilestones() {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		logger.Warn("skipping milestone check", "error", err)
+		return
+	}
+	sheet, err := b.nflClient.GetWeekStats(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		logger.Warn("skipping milestone check", "error", err)
+		return
+	}
+
+	if seasonInfo.SeasonType != models.SeasonTypePreseason {
+		b.announceBigGames(seasonInfo, sheet)
+	}
+	if seasonInfo.SeasonType == models.SeasonTypeRegular {
+		b.checkTrackedMilestones(seasonInfo, sheet)
+	}
+}
+
+// announceBigGames posts each new 300-yard passing and 100-yard rushing or receiving game to the
+// alert channels
+func (b *Bot) announceBigGames(seasonInfo *models.SeasonInfo, sheet []*models.PlayerStats) {
+	if len(b.settings.AlertChannels()) == 0 {
+		return
+	}
+
+	week := digestWeekKey(seasonInfo)
+	for _, player := range sheet {
+		for _, mark := range bigGameThresholds {
+			value := milestoneValue(player, mark.stat)
+			if value < mark.threshold || !b.milestones.Announce(week, fmt.Sprintf("%d:%s", player.PlayerID, mark.stat)) {
+				continue
+			}
+			embed := &discordgo.MessageEmbed{
+				Title:       fmt.Sprintf("💯 %s: %s+ %s", player.Name, models.Thousands(mark.threshold), strings.ReplaceAll(mark.stat, "_", " ")),
+				Description: fmt.Sprintf("%s (%s) is up to %s in %s.", player.Name, player.Team, milestoneLabel(value, mark.stat, models.Format{}), seasonInfo.WeekLabel()),
+				Color:       embeds.ColorStats,
+			}
+			b.themeEmbedForTeam(embed, player.Team)
+			b.postAlerts("big_game", embed)
+		}
+	}
+}
+
+// checkTrackedMilestones banks finished weeks into each tracked total and announces the ones reached
+func (b *Bot) checkTrackedMilestones(seasonInfo *models.SeasonInfo, sheet []*models.PlayerStats) {
+	current := make(map[int]*models.PlayerStats, len(sheet))
+	for _, player := range sheet {
+		current[player.PlayerID] = player
+	}
+
+	for _, milestone := range b.milestones.All() {
+		if milestone.Season != seasonInfo.Season {
+			b.milestones.Remove(milestone)
+			continue
+		}
+
+		if milestone.BankedWeek < seasonInfo.Week-1 {
+			if !b.bankMilestoneWeeks(&milestone, seasonInfo) {
+				continue
+			}
+			if err := b.milestones.Put(milestone); err != nil {
+				logger.Error("error saving milestones", "error", err)
+			}
+		}
+
+		total := milestone.Banked
+		if stats, playing := current[milestone.PlayerID]; playing {
+			total += milestoneValue(stats, milestone.Stat)
+		}
+		if total >= milestone.Threshold {
+			b.announceMilestone(milestone, total, seasonInfo)
+			b.milestones.Remove(milestone)
+		}
+	}
+}
+
+// bankMilestoneWeeks adds the weeks finished since the milestone was last banked to its total
+func (b *Bot) bankMilestoneWeeks(milestone *TrackedMilestone, seasonInfo *models.SeasonInfo) bool {
+	for week := milestone.BankedWeek + 1; week < seasonInfo.Week; week++ {
+		sheet, err := b.nflClient.GetWeekStats(seasonInfo.Season, models.SeasonTypeRegular, week)
+		if err != nil {
+			logger.Warn("error banking milestone week", "player", milestone.PlayerName, "week", week, "error", err)
+			return false
+		}
+		for _, player := range sheet {
+			if player.PlayerID == milestone.PlayerID {
+				milestone.Banked += milestoneValue(player, milestone.Stat)
+			}
+		}
+		milestone.BankedWeek = week
+	}
+	return true
+}
+
+// announceMilestone posts a reached milestone in the channel it was tracked from, or its webhook
+func (b *Bot) announceMilestone(milestone TrackedMilestone, total int, seasonInfo *models.SeasonInfo) {
+	format := b.guildFormat(milestone.GuildID)
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎯 Milestone: %s reaches %s", milestone.PlayerName, milestoneLabel(milestone.Threshold, milestone.Stat, format)),
+		Description: fmt.Sprintf("%s (%s) is up to %s on the season in %s. Tracked by <@%s>.", milestone.PlayerName, milestone.Team, milestoneLabel(total, milestone.Stat, format), seasonInfo.WeekLabel(), milestone.UserID),
+		Color:       embeds.ColorStats,
+	}
+	b.themeEmbedForTeam(embed, milestone.Team)
+	logger.Info("milestone reached", "guild", milestone.GuildID, "player", milestone.PlayerName, "stat", milestone.Stat, "threshold", milestone.Threshold)
+	b.postToTarget(milestone.GuildID, milestone.ChannelID, "milestone", "", embed)
+}
@@ -0,0 +1,625 @@
+package bot
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// mockDraftPrefix prefixes the custom IDs of every mock draft button
+const mockDraftPrefix = "mockdraft_"
+
+// Mock draft button actions
+const (
+	mockDraftClaim  = "claim"
+	mockDraftBegin  = "begin"
+	mockDraftCancel = "cancel"
+	mockDraftPick   = "pick"
+)
+
+// mockDraftSuggestions is how many best-available players are offered as pick buttons
+const mockDraftSuggestions = 5
+
+// mockDraftRosterLimits caps how many players of a position the bot will suggest or auto-pick for one team
+var mockDraftRosterLimits = map[string]int{"QB": 2, "RB": 6, "WR": 6, "TE": 2}
+
+// mockDraftSlot is one team in a mock draft; an empty UserID means the bot drafts for it
+type mockDraftSlot struct {
+	UserID string
+	Name   string
+	Picks  []*models.SeasonPlayerStats
+}
+
+// mockDraft is a running mock draft lobby
+type mockDraft struct {
+	mu sync.Mutex
+
+	id        string
+	hostID    string
+	season    int
+	rounds    int
+	clock     time.Duration
+	slots     []*mockDraftSlot
+	available []*models.SeasonPlayerStats
+
+	threadID       string
+	lobbyChannelID string
+	lobbyMessageID string
+	clockMessageID string
+
+	pick    int // overall pick index, 0-based
+	started bool
+	timer   *time.Timer
+}
+
+// mockDraftManager tracks active mock drafts by ID
+type mockDraftManager struct {
+	mu     sync.Mutex
+	drafts map[string]*mockDraft
+}
+
+// newMockDraftManager creates an empty mock draft manager
+func newMockDraftManager() *mockDraftManager {
+	return &mockDraftManager{drafts: make(map[string]*mockDraft)}
+}
+
+// Add registers a draft
+func (m *mockDraftManager) Add(draft *mockDraft) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.drafts[draft.id] = draft
+}
+
+// Get returns a draft by ID
+func (m *mockDraftManager) Get(id string) (*mockDraft, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	draft, exists := m.drafts[id]
+	return draft, exists
+}
+
+// Remove forgets a finished or cancelled draft
+func (m *mockDraftManager) Remove(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.drafts, id)
+}
+
+// totalPicks returns the number of picks in the draft
+func (d *mockDraft) totalPicks() int {
+	return len(d.slots) * d.rounds
+}
+
+// slotIndex returns the slot that owns an overall pick in snake order
+func (d *mockDraft) slotIndex(pick int) int {
+	round := pick / len(d.slots)
+	position := pick % len(d.slots)
+	if round%2 == 1 {
+		return len(d.slots) - 1 - position
+	}
+	return position
+}
+
+// pickLabel formats an overall pick as round.pick, e.g. "2.05"
+func (d *mockDraft) pickLabel(pick int) string {
+	return fmt.Sprintf("%d.%02d", pick/len(d.slots)+1, pick%len(d.slots)+1)
+}
+
+// hasUsers reports whether anyone has claimed a slot
+func (d *mockDraft) hasUsers() bool {
+	for _, slot := range d.slots {
+		if slot.UserID != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// slotName returns a slot's display name
+func (d *mockDraft) slotName(index int) string {
+	if d.slots[index].UserID == "" {
+		return fmt.Sprintf("🤖 Team %d", index+1)
+	}
+	return d.slots[index].Name
+}
+
+// suggestions returns the best available players that fit the slot's roster limits
+func (d *mockDraft) suggestions(slot *mockDraftSlot, count int) []*models.SeasonPlayerStats {
+	rostered := make(map[string]int)
+	for _, player := range slot.Picks {
+		rostered[player.Position]++
+	}
+
+	var suggestions []*models.SeasonPlayerStats
+	for _, player := range d.available {
+		if rostered[player.Position] >= mockDraftRosterLimits[player.Position] {
+			continue
+		}
+		suggestions = append(suggestions, player)
+		if len(suggestions) == count {
+			break
+		}
+	}
+
+	// Roster limits are only a preference; never leave a team without a pick
+	if len(suggestions) == 0 && len(d.available) > 0 {
+		suggestions = append(suggestions, d.available[0])
+	}
+	return suggestions
+}
+
+// draftPlayer moves a player from the available pool to the slot on the clock
+func (d *mockDraft) draftPlayer(playerID int) (*models.SeasonPlayerStats, bool) {
+	for index, player := range d.available {
+		if player.PlayerID == playerID {
+			d.available = append(d.available[:index], d.available[index+1:]...)
+			slot := d.slots[d.slotIndex(d.pick)]
+			slot.Picks = append(slot.Picks, player)
+			d.pick++
+			return player, true
+		}
+	}
+	return nil, false
+}
+
+// mockDraftCustomID builds a mock draft button custom ID
+func mockDraftCustomID(action, draftID string, args ...string) string {
+	return mockDraftPrefix + strings.Join(append([]string{action, draftID}, args...), ":")
+}
+
+// handleSlashMockDraft handles the /mockdraft slash command
+func (b *Bot) handleSlashMockDraft(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, "Mock drafts can only be run in a server.")
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 || options[0].Name != "start" {
+		b.respondInteraction(s, i, "Usage: `/mockdraft start teams:<n>`")
+		return
+	}
+
+	teams, rounds, clockSeconds := 0, 15, 60
+	for _, option := range options[0].Options {
+		switch option.Name {
+		case "teams":
+			teams = int(option.IntValue())
+		case "rounds":
+			rounds = int(option.IntValue())
+		case "pick_clock":
+			clockSeconds = int(option.IntValue())
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial mockdraft response", "error", err)
+		return
+	}
+
+	// Process mock draft setup asynchronously
+	go b.processSlashMockDraftRequest(s, i, teams, rounds, time.Duration(clockSeconds)*time.Second)
+}
+
+// processSlashMockDraftRequest loads rankings, posts the lobby, and opens the draft thread
+func (b *Bot) processSlashMockDraftRequest(s Responder, i *discordgo.InteractionCreate, teams, rounds int, clock time.Duration) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error starting mock draft", err))
+		return
+	}
+
+	// Rankings are based on last season's PPR fantasy points
+	rankingSeason := seasonInfo.Season - 1
+	rankings, err := b.nflClient.GetFantasyRankings(rankingSeason)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error loading player rankings", err))
+		return
+	}
+	if len(rankings) < teams*rounds {
+		b.completeInteraction(s, i, fmt.Sprintf("Not enough ranked players for %d teams × %d rounds.", teams, rounds))
+		return
+	}
+
+	draft := &mockDraft{
+		id:        strconv.FormatInt(time.Now().UnixNano(), 36),
+		hostID:    interactionUserID(i),
+		season:    rankingSeason,
+		rounds:    rounds,
+		clock:     clock,
+		available: rankings,
+	}
+	for index := 0; index < teams; index++ {
+		draft.slots = append(draft.slots, &mockDraftSlot{})
+	}
+
+	lobby, err := s.ChannelMessageSendComplex(i.ChannelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{b.mockDraftLobbyEmbed(draft)},
+		Components: mockDraftLobbyButtons(draft),
+	})
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error creating mock draft lobby", err))
+		return
+	}
+
+	thread, err := s.MessageThreadStart(i.ChannelID, lobby.ID, fmt.Sprintf("Mock Draft (%d teams)", teams), 1440)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error creating mock draft thread", err))
+		return
+	}
+
+	draft.threadID = thread.ID
+	draft.lobbyChannelID = i.ChannelID
+	draft.lobbyMessageID = lobby.ID
+	b.mockDrafts.Add(draft)
+
+	b.completeInteraction(s, i, fmt.Sprintf("🏈 Mock draft lobby is open in <#%s>. Claim a slot, then the host presses **Start Draft**.", thread.ID))
+}
+
+// mockDraftLobbyEmbed renders the lobby's slot list
+func (b *Bot) mockDraftLobbyEmbed(draft *mockDraft) *discordgo.MessageEmbed {
+	var slots strings.Builder
+	for index := range draft.slots {
+		slots.WriteString(fmt.Sprintf("**%d.** %s\n", index+1, draft.slotName(index)))
+	}
+
+	return &discordgo.MessageEmbed{
+		Title: "🏈 Mock Draft Lobby",
+		Description: fmt.Sprintf("%d teams • %d rounds • %s pick clock • snake order\nRankings: %d PPR fantasy points",
+			len(draft.slots), draft.rounds, draft.clock, draft.season),
+		Color: 0x013369,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Draft Order",
+				Value:  slots.String(),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Unclaimed slots are drafted by the bot",
+		},
+	}
+}
+
+// mockDraftLobbyButtons builds the claim/start/cancel buttons
+func mockDraftLobbyButtons(draft *mockDraft) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{Label: "Claim Slot", Style: discordgo.PrimaryButton, CustomID: mockDraftCustomID(mockDraftClaim, draft.id)},
+				discordgo.Button{Label: "Start Draft", Style: discordgo.SuccessButton, CustomID: mockDraftCustomID(mockDraftBegin, draft.id)},
+				discordgo.Button{Label: "Cancel", Style: discordgo.DangerButton, CustomID: mockDraftCustomID(mockDraftCancel, draft.id)},
+			},
+		},
+	}
+}
+
+// respondEphemeral sends a private reply to a component interaction
+func respondEphemeral(s Responder, i *discordgo.InteractionCreate, content string) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		logger.Error("error sending ephemeral response", "error", err)
+	}
+}
+
+// handleMockDraftComponent routes mock draft button presses
+func (b *Bot) handleMockDraftComponent(s Responder, i *discordgo.InteractionCreate) {
+	parts := strings.Split(strings.TrimPrefix(i.MessageComponentData().CustomID, mockDraftPrefix), ":")
+	if len(parts) < 2 {
+		return
+	}
+
+	draft, exists := b.mockDrafts.Get(parts[1])
+	if !exists {
+		respondEphemeral(s, i, b.tr(i, "mockdraft.inactive"))
+		return
+	}
+
+	draft.mu.Lock()
+	defer draft.mu.Unlock()
+
+	userID := interactionUserID(i)
+
+	switch parts[0] {
+	case mockDraftClaim:
+		b.claimMockDraftSlot(s, i, draft, userID)
+	case mockDraftBegin:
+		if userID != draft.hostID {
+			respondEphemeral(s, i, b.tr(i, "mockdraft.host_start"))
+			return
+		}
+		if draft.started {
+			respondEphemeral(s, i, b.tr(i, "mockdraft.started"))
+			return
+		}
+		if !draft.hasUsers() {
+			respondEphemeral(s, i, b.tr(i, "mockdraft.claim_first"))
+			return
+		}
+		draft.started = true
+		b.updateMockDraftLobby(s, i, draft, nil)
+		b.advanceMockDraft(s, draft)
+	case mockDraftCancel:
+		if userID != draft.hostID {
+			respondEphemeral(s, i, b.tr(i, "mockdraft.host_cancel"))
+			return
+		}
+		if draft.timer != nil {
+			draft.timer.Stop()
+		}
+		b.mockDrafts.Remove(draft.id)
+		b.updateMockDraftLobby(s, i, draft, nil)
+		b.sendMessage(s, draft.threadID, "🛑 Mock draft cancelled by the host.")
+	case mockDraftPick:
+		if len(parts) != 4 {
+			return
+		}
+		pick, _ := strconv.Atoi(parts[2])
+		playerID, _ := strconv.Atoi(parts[3])
+		b.makeMockDraftPick(s, i, draft, userID, pick, playerID)
+	}
+}
+
+// claimMockDraftSlot gives the user the first open slot
+func (b *Bot) claimMockDraftSlot(s Responder, i *discordgo.InteractionCreate, draft *mockDraft, userID string) {
+	if draft.started {
+		respondEphemeral(s, i, b.tr(i, "mockdraft.started"))
+		return
+	}
+
+	for _, slot := range draft.slots {
+		if slot.UserID == userID {
+			respondEphemeral(s, i, b.tr(i, "mockdraft.has_slot"))
+			return
+		}
+	}
+
+	for _, slot := range draft.slots {
+		if slot.UserID == "" {
+			slot.UserID = userID
+			slot.Name = i.Member.DisplayName()
+			b.updateMockDraftLobby(s, i, draft, mockDraftLobbyButtons(draft))
+			return
+		}
+	}
+
+	respondEphemeral(s, i, b.tr(i, "mockdraft.full"))
+}
+
+// updateMockDraftLobby re-renders the lobby message in response to a button press
+func (b *Bot) updateMockDraftLobby(s Responder, i *discordgo.InteractionCreate, draft *mockDraft, components []discordgo.MessageComponent) {
+	if components == nil {
+		components = []discordgo.MessageComponent{}
+	}
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{b.mockDraftLobbyEmbed(draft)},
+			Components: components,
+		},
+	})
+	if err != nil {
+		logger.Error("error updating mock draft lobby", "error", err)
+	}
+}
+
+// makeMockDraftPick records a user's pick from a suggestion button
+func (b *Bot) makeMockDraftPick(s Responder, i *discordgo.InteractionCreate, draft *mockDraft, userID string, pick, playerID int) {
+	if pick != draft.pick {
+		respondEphemeral(s, i, b.tr(i, "mockdraft.pick_made"))
+		return
+	}
+	if draft.slots[draft.slotIndex(pick)].UserID != userID {
+		respondEphemeral(s, i, b.tr(i, "mockdraft.not_on_clock"))
+		return
+	}
+
+	player, ok := draft.draftPlayer(playerID)
+	if !ok {
+		respondEphemeral(s, i, b.tr(i, "mockdraft.unavailable"))
+		return
+	}
+	if draft.timer != nil {
+		draft.timer.Stop()
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    mockDraftPickLine(draft, pick, player, false),
+			Embeds:     []*discordgo.MessageEmbed{},
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+	if err != nil {
+		logger.Error("error recording mock draft pick", "error", err)
+	}
+
+	b.advanceMockDraft(s, draft)
+}
+
+// mockDraftPickLine formats a completed pick
+func mockDraftPickLine(draft *mockDraft, pick int, player *models.SeasonPlayerStats, auto bool) string {
+	line := fmt.Sprintf("**%s** %s — %s (%s, %s)", draft.pickLabel(pick), draft.slotName(draft.slotIndex(pick)),
+		player.Name, player.Position, player.Team)
+	if auto {
+		line += " ⏰ auto-pick"
+	}
+	return line
+}
+
+// advanceMockDraft makes bot picks until a user is on the clock, then posts their pick buttons.
+// The caller must hold draft.mu.
+func (b *Bot) advanceMockDraft(s Responder, draft *mockDraft) {
+	// Consecutive bot picks are announced together to keep the thread readable
+	var botPicks []string
+	for draft.pick < draft.totalPicks() {
+		slot := draft.slots[draft.slotIndex(draft.pick)]
+		if slot.UserID != "" {
+			break
+		}
+
+		pick := draft.pick
+		player, _ := draft.draftPlayer(draft.suggestions(slot, 1)[0].PlayerID)
+		botPicks = append(botPicks, mockDraftPickLine(draft, pick, player, false))
+	}
+	if len(botPicks) > 0 {
+		b.sendMessage(s, draft.threadID, strings.Join(botPicks, "\n"))
+	}
+
+	if draft.pick < draft.totalPicks() {
+		b.postMockDraftClock(s, draft, draft.slots[draft.slotIndex(draft.pick)])
+		return
+	}
+
+	b.finishMockDraft(s, draft)
+}
+
+// postMockDraftClock puts a user on the clock with best-available suggestions and starts the pick timer
+func (b *Bot) postMockDraftClock(s Responder, draft *mockDraft, slot *mockDraftSlot) {
+	pick := draft.pick
+	suggestions := draft.suggestions(slot, mockDraftSuggestions)
+
+	var buttons []discordgo.MessageComponent
+	var board strings.Builder
+	for rank, player := range suggestions {
+		buttons = append(buttons, discordgo.Button{
+			Label:    fmt.Sprintf("%s (%s)", player.Name, player.Position),
+			Style:    discordgo.SecondaryButton,
+			CustomID: mockDraftCustomID(mockDraftPick, draft.id, strconv.Itoa(pick), strconv.Itoa(player.PlayerID)),
+		})
+		board.WriteString(fmt.Sprintf("%d. **%s** %s, %s — %.1f pts\n", rank+1, player.Name, player.Position, player.Team, player.FantasyPointsPPR))
+	}
+
+	var roster []string
+	for _, player := range slot.Picks {
+		roster = append(roster, fmt.Sprintf("%s %s", player.Position, player.Name))
+	}
+	rosterText := "No picks yet"
+	if len(roster) > 0 {
+		rosterText = strings.Join(roster, "\n")
+	}
+
+	message, err := s.ChannelMessageSendComplex(draft.threadID, &discordgo.MessageSend{
+		Content: fmt.Sprintf("<@%s> you're on the clock at **%s** — %s to pick", slot.UserID, draft.pickLabel(pick), draft.clock),
+		Embeds: []*discordgo.MessageEmbed{{
+			Color: 0x013369,
+			Fields: []*discordgo.MessageEmbedField{
+				{Name: "Best Available", Value: board.String(), Inline: true},
+				{Name: "Your Roster", Value: rosterText, Inline: true},
+			},
+		}},
+		Components: []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}},
+	})
+	if err != nil {
+		logger.Error("error posting mock draft clock", "error", err)
+	} else {
+		draft.clockMessageID = message.ID
+	}
+
+	draft.timer = time.AfterFunc(draft.clock, func() {
+		b.autoPickMockDraft(s, draft, pick)
+	})
+}
+
+// autoPickMockDraft drafts the best available player when a user's pick clock expires
+func (b *Bot) autoPickMockDraft(s Responder, draft *mockDraft, pick int) {
+	draft.mu.Lock()
+	defer draft.mu.Unlock()
+
+	if _, active := b.mockDrafts.Get(draft.id); !active || draft.pick != pick {
+		return
+	}
+
+	slot := draft.slots[draft.slotIndex(pick)]
+	player, _ := draft.draftPlayer(draft.suggestions(slot, 1)[0].PlayerID)
+
+	content := mockDraftPickLine(draft, pick, player, true)
+	_, err := s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		Channel:    draft.threadID,
+		ID:         draft.clockMessageID,
+		Content:    &content,
+		Embeds:     &[]*discordgo.MessageEmbed{},
+		Components: &[]discordgo.MessageComponent{},
+	})
+	if err != nil {
+		logger.Error("error editing mock draft clock", "error", err)
+	}
+
+	b.advanceMockDraft(s, draft)
+}
+
+// finishMockDraft posts the final rosters and a CSV export of every pick
+func (b *Bot) finishMockDraft(s Responder, draft *mockDraft) {
+	b.mockDrafts.Remove(draft.id)
+
+	// Keep the summary within embed limits; the CSV always has every pick
+	shownRounds := draft.rounds
+	if shownRounds > 8 {
+		shownRounds = 8
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "✅ Mock Draft Complete",
+		Color: 0x2ecc71,
+	}
+	for index, slot := range draft.slots {
+		var lines []string
+		for _, player := range slot.Picks[:shownRounds] {
+			lines = append(lines, fmt.Sprintf("%s %s", player.Position, player.Name))
+		}
+		if shownRounds < draft.rounds {
+			lines = append(lines, fmt.Sprintf("*+%d more in CSV*", draft.rounds-shownRounds))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   draft.slotName(index),
+			Value:  strings.Join(lines, "\n"),
+			Inline: true,
+		})
+	}
+
+	var export bytes.Buffer
+	writer := csv.NewWriter(&export)
+	writer.Write([]string{"pick", "round", "slot", "drafter", "player", "position", "team", "ppr_points"})
+	for pick := 0; pick < draft.totalPicks(); pick++ {
+		index := draft.slotIndex(pick)
+		player := draft.slots[index].Picks[pick/len(draft.slots)]
+		writer.Write([]string{
+			draft.pickLabel(pick),
+			strconv.Itoa(pick/len(draft.slots) + 1),
+			strconv.Itoa(index + 1),
+			draft.slotName(index),
+			player.Name,
+			player.Position,
+			player.Team,
+			strconv.FormatFloat(player.FantasyPointsPPR, 'f', 1, 64),
+		})
+	}
+	writer.Flush()
+
+	_, err := s.ChannelMessageSendComplex(draft.threadID, &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{embed},
+		Files: []*discordgo.File{{
+			Name:        "mockdraft.csv",
+			ContentType: "text/csv",
+			Reader:      &export,
+		}},
+	})
+	if err != nil {
+		logger.Error("error posting mock draft results", "error", err)
+	}
+}
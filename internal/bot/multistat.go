@@ -0,0 +1,157 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/nfl"
+	"nfl-discord-bot/pkg/models"
+)
+
+// multistatMaxPlayers is the most players a single /multistat can look up
+const multistatMaxPlayers = 8
+
+// handleSlashMultistat handles the /multistat slash command
+func (b *Bot) handleSlashMultistat(s Responder, i *discordgo.InteractionCreate) {
+	var playerList, seasonType string
+	var week *int64
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "players":
+			playerList = option.StringValue()
+		case "week":
+			weekVal := option.IntValue()
+			week = &weekVal
+		case "season_type":
+			seasonType = option.StringValue()
+		}
+	}
+
+	names := parsePlayerList(playerList)
+	if len(names) == 0 {
+		respondEphemeral(s, i, b.tr(i, "multistat.no_players"))
+		return
+	}
+	if len(names) > multistatMaxPlayers {
+		respondEphemeral(s, i, b.tr(i, "multistat.too_many", multistatMaxPlayers, len(names)))
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial multistat response", "error", err)
+		return
+	}
+
+	// Process multistat request asynchronously
+	go b.processSlashMultistatRequest(s, i, names, seasonType, week)
+}
+
+// parsePlayerList splits a comma-separated list of player names, dropping blanks and repeats
+func parsePlayerList(list string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.Join(strings.Fields(name), " ")
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// processSlashMultistatRequest looks up every player against the week's shared stat sheet and completes the deferred response
+func (b *Bot) processSlashMultistatRequest(s Responder, i *discordgo.InteractionCreate, names []string, seasonTypeChoice string, week *int64) {
+	var seasonWeek *models.SeasonInfo
+	var err error
+	if seasonTypeChoice == "" && week == nil {
+		seasonWeek, err = b.nflClient.GetCurrentSeason()
+	} else {
+		seasonWeek, err = b.resolveSeasonWeek(seasonTypeChoice, week)
+	}
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting stats", err))
+		return
+	}
+
+	lookups, err := b.nflClient.GetPlayersWeekStats(names, seasonWeek.Season, seasonWeek.SeasonType, seasonWeek.Week)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting %s, %d stats", models.WeekLabel(seasonWeek.SeasonType, seasonWeek.Week), seasonWeek.Season), err))
+		return
+	}
+
+	if err := b.completeInteractionEmbed(s, i, b.multistatEmbed(seasonWeek, lookups, b.guildFormat(i.GuildID))); err != nil {
+		logger.Error("error sending multistat embed response", "error", err)
+	}
+}
+
+// multistatEmbed renders each player's key line for the week as a compact table
+func (b *Bot) multistatEmbed(seasonWeek *models.SeasonInfo, lookups []nfl.WeekLookup, format models.Format) *discordgo.MessageEmbed {
+	var table strings.Builder
+	var missing []string
+	table.WriteString("```\n")
+	table.WriteString(fmt.Sprintf("%-18s %-3s %-3s %5s  %s\n", "PLAYER", "POS", "TM", "PPR", "LINE"))
+	for _, lookup := range lookups {
+		if lookup.Err != nil {
+			missing = append(missing, fmt.Sprintf("• %s — %s", lookup.Query, lookupErrorNote(lookup.Err)))
+			continue
+		}
+		stats := lookup.Stats
+		table.WriteString(fmt.Sprintf("%-18s %-3s %-3s %5.1f  %s\n",
+			truncateName(stats.Name, 18), stats.Position, stats.Team, b.fantasyPoints(stats, 1), b.keyStatLine(stats, format)))
+	}
+	table.WriteString("```")
+
+	description := table.String()
+	if len(missing) == len(lookups) {
+		description = "None of those players were found."
+	}
+	if len(missing) > 0 {
+		description += "\n**Not found:**\n" + strings.Join(missing, "\n")
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📋 %s, %d Stat Lines", models.WeekLabel(seasonWeek.SeasonType, seasonWeek.Week), seasonWeek.Season),
+		Color:       0x0099ff,
+		Description: description,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "PPR = full-point-per-reception fantasy points",
+		},
+	}
+}
+
+// keyStatLine summarizes the stats that matter for a player's week in a few tokens
+func (b *Bot) keyStatLine(stats *models.PlayerStats, format models.Format) string {
+	var parts []string
+
+	if passing := stats.Passing; passing.Yards > 0 {
+		parts = append(parts, fmt.Sprintf("%s pass %s %d TD %d INT", format.Distance(passing.Yards), format.Unit(), passing.Touchdowns, passing.Interceptions))
+	}
+	if rushing := stats.Rushing; rushing.Yards != 0 || rushing.Touchdowns > 0 {
+		line := fmt.Sprintf("%s rush %s", format.Distance(rushing.Yards), format.Unit())
+		if rushing.Touchdowns > 0 {
+			line += fmt.Sprintf(" %d TD", rushing.Touchdowns)
+		}
+		parts = append(parts, line)
+	}
+	if receiving := stats.Receiving; receiving.Receptions > 0 || receiving.Yards != 0 {
+		line := fmt.Sprintf("%d/%d %s rec %s", receiving.Receptions, receiving.Targets, format.Distance(receiving.Yards), format.Unit())
+		if receiving.Touchdowns > 0 {
+			line += fmt.Sprintf(" %d TD", receiving.Touchdowns)
+		}
+		parts = append(parts, line)
+	}
+
+	if len(parts) == 0 && stats.Defense.Recorded() {
+		parts = append(parts, stats.Defense.Summary(format))
+	}
+
+	if len(parts) == 0 {
+		return "no stats recorded"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,115 @@
+package bot
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/pkg/models"
+)
+
+// nextGameSeasonTypes is the order season types are searched for a team's next game
+var nextGameSeasonTypes = []string{models.SeasonTypePreseason, models.SeasonTypeRegular, models.SeasonTypePostseason}
+
+// handleSlashNext handles the /next slash command
+func (b *Bot) handleSlashNext(s Responder, i *discordgo.InteractionCreate) {
+	var teamName string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "team" {
+			teamName = option.StringValue()
+		}
+	}
+	teamName = b.teamOrFavorite(i, teamName)
+	if teamName == "" {
+		b.respondInteraction(s, i, missingTeamMessage)
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial next game response", "error", err)
+		return
+	}
+
+	// Process next game request asynchronously
+	go b.processSlashNextRequest(s, i, teamName)
+}
+
+// processSlashNextRequest answers who, when, where, and on what channel for a team's next game
+func (b *Bot) processSlashNextRequest(s Responder, i *discordgo.InteractionCreate, teamName string) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting team info for %s", teamName), err))
+		return
+	}
+
+	game, err := b.upcomingGame(teamInfo.Key)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting the schedule", err))
+		return
+	}
+	if game == nil {
+		b.completeInteraction(s, i, fmt.Sprintf("🛌 The %s %s have no more games scheduled this season.", teamInfo.City, teamInfo.Name))
+		return
+	}
+
+	opponent := "vs " + game.AwayTeam
+	if game.AwayTeam == teamInfo.Key {
+		opponent = "@ " + game.HomeTeam
+	}
+	when := fmt.Sprintf("%s • <t:%d:R>", embeds.FormatKickoff(game.GameTime, b.guildLocation(i.GuildID), "Mon Jan 2, 3:04 PM", b.guildFormat(i.GuildID)), game.GameTime.Unix())
+	switch {
+	case game.IsLive():
+		when = fmt.Sprintf("🔴 Playing now (kicked off <t:%d:R>)", game.GameTime.Unix())
+	case game.State().Interrupted():
+		when = fmt.Sprintf("%s %s", game.State().Emoji(), models.StatusLabel(game.Status))
+	}
+	channel := game.Channel
+	if channel == "" {
+		channel = "TBA"
+	}
+	where := game.Stadium
+	if where == "" {
+		where = "TBA"
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("⏭️ %s %s", teamInfo.Key, opponent),
+		Color: embeds.ColorSchedule,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "When", Value: when, Inline: false},
+			{Name: "Where", Value: where, Inline: true},
+			{Name: "TV", Value: "📺 " + channel, Inline: true},
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: models.WeekLabel(game.GameType, game.Week)},
+	}
+	themeEmbed(embed, teamInfo)
+
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending next game embed response", "error", err)
+	}
+}
+
+// upcomingGame returns a team's live or next unplayed game this season, searching from the current
+// season type onward, or nil when none is scheduled
+func (b *Bot) upcomingGame(team string) (*models.Game, error) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return nil, err
+	}
+
+	searching := false
+	for _, seasonType := range nextGameSeasonTypes {
+		if seasonType == seasonInfo.SeasonType {
+			searching = true
+		}
+		if !searching {
+			continue
+		}
+		// Later season types may not be published yet, so a miss just moves on
+		if game, err := b.nextGame(team, seasonInfo.Season, seasonType, 0); err == nil {
+			return game, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,835 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/odds"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/pkg/models"
+)
+
+// pickemDocument is the storage document holding every guild's pick'em pool
+const pickemDocument = "pickem"
+
+// pickemPrefix prefixes the custom IDs of pick'em components
+const pickemPrefix = "pickem_"
+
+// pickemGamesPerPage is how many game select menus fit on one picker page (one row is kept for navigation)
+const pickemGamesPerPage = 4
+
+// Recorded results that no pick can match: a tied game, a line landing exactly on the number,
+// and a game that closed without a line
+const (
+	pickemTie    = "TIE"
+	pickemPush   = "PUSH"
+	pickemNoLine = "NOLINE"
+)
+
+// Pick'em pick types: straight winners, against the spread, and game totals
+const (
+	pickemWinners = "winners"
+	pickemSpread  = "spread"
+	pickemTotals  = "totals"
+)
+
+// pickemKinds lists the pick types in display order
+var pickemKinds = []string{pickemWinners, pickemSpread, pickemTotals}
+
+// pickemKindLabels are the display names of each pick type
+var pickemKindLabels = map[string]string{
+	pickemWinners: "Winners",
+	pickemSpread:  "Against the Spread",
+	pickemTotals:  "Over/Under",
+}
+
+// pickemWeek holds one week's picks and results for a pool
+type pickemWeek struct {
+	Season        int                          `json:"season"`
+	SeasonType    string                       `json:"season_type"`
+	Week          int                          `json:"week"`
+	Picks         map[string]map[string]string `json:"picks"`                    // user ID -> game ID -> picked team
+	Results       map[string]string            `json:"results"`                  // game ID -> winning team or TIE
+	SpreadPicks   map[string]map[string]string `json:"spread_picks,omitempty"`   // user ID -> game ID -> team taken against the spread
+	SpreadResults map[string]string            `json:"spread_results,omitempty"` // game ID -> covering team, PUSH, or NOLINE
+	TotalPicks    map[string]map[string]string `json:"total_picks,omitempty"`    // user ID -> game ID -> over or under
+	TotalResults  map[string]string            `json:"total_results,omitempty"`  // game ID -> over, under, PUSH, or NOLINE
+	LateEntries   map[string]map[string]bool   `json:"late_entries,omitempty"`   // pick type -> user IDs who first picked after the week locked
+	RemindersFor  time.Time                    `json:"reminders_for,omitempty"`  // the lock time deadline reminders were scheduled for
+	Announced     bool                         `json:"announced"`
+}
+
+// picks returns the week's picks of a type, creating the map when needed
+func (w *pickemWeek) picks(kind string) map[string]map[string]string {
+	field := &w.Picks
+	switch kind {
+	case pickemSpread:
+		field = &w.SpreadPicks
+	case pickemTotals:
+		field = &w.TotalPicks
+	}
+	if *field == nil {
+		*field = make(map[string]map[string]string)
+	}
+	return *field
+}
+
+// results returns the week's results of a type, creating the map when needed
+func (w *pickemWeek) results(kind string) map[string]string {
+	field := &w.Results
+	switch kind {
+	case pickemSpread:
+		field = &w.SpreadResults
+	case pickemTotals:
+		field = &w.TotalResults
+	}
+	if *field == nil {
+		*field = make(map[string]string)
+	}
+	return *field
+}
+
+// pickemPool is a guild's pick'em competition
+type pickemPool struct {
+	ChannelID string                 `json:"channel_id"`
+	CreatedBy string                 `json:"created_by"`
+	Weeks     map[string]*pickemWeek `json:"weeks"`            // keyed by pickemWeekKey
+	League    string                 `json:"league,omitempty"` // invite code of the cross-server league the pool is in
+	Names     map[string]string      `json:"names,omitempty"`  // user ID -> display name at their latest pick, for league standings
+}
+
+// pickemRecord is a user's graded pick'em record
+type pickemRecord struct {
+	UserID  string
+	Correct int
+	Graded  int
+}
+
+// pickemWeekKey identifies a week across season types
+func pickemWeekKey(season int, seasonType string, week int) string {
+	return fmt.Sprintf("%d%s-%d", season, seasonType, week)
+}
+
+// Records grades every user's picks of a type in the week. Pushes don't count as graded.
+func (w *pickemWeek) Records(kind string) map[string]*pickemRecord {
+	records := make(map[string]*pickemRecord)
+	results := w.results(kind)
+	for userID, picks := range w.picks(kind) {
+		record := &pickemRecord{UserID: userID}
+		for gameID, team := range picks {
+			result, graded := results[gameID]
+			if !graded || result == pickemPush || result == pickemNoLine {
+				continue
+			}
+			record.Graded++
+			if result == team {
+				record.Correct++
+			}
+		}
+		records[userID] = record
+	}
+	return records
+}
+
+// SeasonRecords totals every user's graded picks of a type for a season
+func (p *pickemPool) SeasonRecords(season int, kind string) map[string]*pickemRecord {
+	totals := make(map[string]*pickemRecord)
+	for _, week := range p.Weeks {
+		if week.Season != season {
+			continue
+		}
+		for userID, record := range week.Records(kind) {
+			total, exists := totals[userID]
+			if !exists {
+				total = &pickemRecord{UserID: userID}
+				totals[userID] = total
+			}
+			total.Correct += record.Correct
+			total.Graded += record.Graded
+		}
+	}
+	return totals
+}
+
+// sortedRecords orders records by correct picks, then fewest graded picks
+func sortedRecords(records map[string]*pickemRecord) []*pickemRecord {
+	var sorted []*pickemRecord
+	for _, record := range records {
+		sorted = append(sorted, record)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Correct != sorted[j].Correct {
+			return sorted[i].Correct > sorted[j].Correct
+		}
+		if sorted[i].Graded != sorted[j].Graded {
+			return sorted[i].Graded < sorted[j].Graded
+		}
+		return sorted[i].UserID < sorted[j].UserID
+	})
+	return sorted
+}
+
+// pickemStore keeps pick'em pools and the cross-server leagues joining them in memory and
+// persists every change
+type pickemStore struct {
+	mu      sync.Mutex
+	store   *storage.Store
+	pools   map[string]*pickemPool
+	leagues map[string]*pickemLeague // keyed by invite code
+}
+
+// newPickemStore loads pick'em pools and leagues from storage
+func newPickemStore(store *storage.Store) (*pickemStore, error) {
+	pickem := &pickemStore{
+		store:   store,
+		pools:   make(map[string]*pickemPool),
+		leagues: make(map[string]*pickemLeague),
+	}
+	if err := store.Load(pickemDocument, &pickem.pools); err != nil {
+		return nil, err
+	}
+	if err := store.Load(pickemLeaguesDocument, &pickem.leagues); err != nil {
+		return nil, err
+	}
+	return pickem, nil
+}
+
+// Create opens a pool for a guild
+func (ps *pickemStore) Create(guildID, channelID, userID string) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if _, exists := ps.pools[guildID]; exists {
+		return fmt.Errorf("this server already has a pick'em pool")
+	}
+	ps.pools[guildID] = &pickemPool{
+		ChannelID: channelID,
+		CreatedBy: userID,
+		Weeks:     make(map[string]*pickemWeek),
+	}
+	return ps.store.Save(pickemDocument, ps.pools)
+}
+
+// View runs fn with read access to a guild's pool, reporting whether the pool exists
+func (ps *pickemStore) View(guildID string, fn func(*pickemPool)) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	pool, exists := ps.pools[guildID]
+	if exists {
+		fn(pool)
+	}
+	return exists
+}
+
+// Update runs fn against a guild's pool and persists the result
+func (ps *pickemStore) Update(guildID string, fn func(*pickemPool) error) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	pool, exists := ps.pools[guildID]
+	if !exists {
+		return fmt.Errorf("this server has no pick'em pool; an admin can start one with `/pickem create`")
+	}
+	if err := fn(pool); err != nil {
+		return err
+	}
+	if err := ps.store.Save(pickemDocument, ps.pools); err != nil {
+		logger.Error("error saving pick'em pools", "error", err)
+		return err
+	}
+	return nil
+}
+
+// GuildIDs returns every guild with a pool
+func (ps *pickemStore) GuildIDs() []string {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	var guildIDs []string
+	for guildID := range ps.pools {
+		guildIDs = append(guildIDs, guildID)
+	}
+	return guildIDs
+}
+
+// RetireSeasonsBefore hands each season older than cutoff to archive, then drops those weeks
+// from the live pools. Nothing is dropped unless every season archives successfully.
+func (ps *pickemStore) RetireSeasonsBefore(cutoff int, archive func(season int, weeks map[string]map[string]*pickemWeek) error) (int, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	retired := make(map[int]map[string]map[string]*pickemWeek) // season -> guild ID -> week key -> week
+	count := 0
+	for guildID, pool := range ps.pools {
+		for weekKey, week := range pool.Weeks {
+			if week.Season >= cutoff {
+				continue
+			}
+			if retired[week.Season] == nil {
+				retired[week.Season] = make(map[string]map[string]*pickemWeek)
+			}
+			if retired[week.Season][guildID] == nil {
+				retired[week.Season][guildID] = make(map[string]*pickemWeek)
+			}
+			retired[week.Season][guildID][weekKey] = week
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	for season, weeks := range retired {
+		if err := archive(season, weeks); err != nil {
+			return 0, err
+		}
+	}
+	for _, pool := range ps.pools {
+		for weekKey, week := range pool.Weeks {
+			if week.Season < cutoff {
+				delete(pool.Weeks, weekKey)
+			}
+		}
+	}
+
+	if err := ps.store.Save(pickemDocument, ps.pools); err != nil {
+		logger.Error("error saving pick'em pools", "error", err)
+		return count, err
+	}
+	return count, nil
+}
+
+// pickemGameLocked reports whether picks for a game are closed (kickoff has passed)
+func pickemGameLocked(game *models.LiveScore, now time.Time) bool {
+	if game.IsLive() || standings.IsFinal(game.Status) {
+		return true
+	}
+	return !game.GameTime.IsZero() && !now.Before(game.GameTime)
+}
+
+// pickemKindOption builds the optional pick type choice for /pickem subcommands
+func pickemKindOption() *discordgo.ApplicationCommandOption {
+	option := &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "type",
+		Description: "Pick type (default: winners)",
+		Required:    false,
+	}
+	for _, kind := range pickemKinds {
+		option.Choices = append(option.Choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  pickemKindLabels[kind],
+			Value: kind,
+		})
+	}
+	return option
+}
+
+// pickemKindChoice returns the pick type chosen in a subcommand's options, defaulting to winners
+func pickemKindChoice(options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	for _, option := range options {
+		if option.Name == "type" {
+			return option.StringValue()
+		}
+	}
+	return pickemWinners
+}
+
+// handleSlashPickem handles the /pickem slash command and its subcommands
+func (b *Bot) handleSlashPickem(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, "Pick'em is only available in servers.")
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	switch options[0].Name {
+	case "create":
+		if !canManageGuild(i) {
+			b.respondInteraction(s, i, b.tr(i, "permission.pickem_create"))
+			return
+		}
+		if err := b.pickem.Create(i.GuildID, i.ChannelID, interactionUserID(i)); err != nil {
+			b.respondInteraction(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+		b.respondInteraction(s, i, "🏈 Pick'em pool created! Results and weekly standings will be posted in this channel. Make your picks with `/pickem picks` — add `type:` for spread or over/under picks.")
+	case "picks":
+		b.respondPickemPicker(s, i, pickemKindChoice(options[0].Options), 0, false)
+	case "leaderboard":
+		b.respondPickemLeaderboard(s, i, pickemKindChoice(options[0].Options))
+	case "league":
+		if len(options[0].Options) > 0 {
+			b.respondPickemLeague(s, i, options[0].Options[0])
+		}
+	}
+}
+
+// currentPickemWeek returns the current week and its games
+func (b *Bot) currentPickemWeek() (*models.SeasonInfo, []*models.LiveScore, error) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	games, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].GameTime.Before(games[j].GameTime)
+	})
+	return seasonInfo, games, nil
+}
+
+// pickemLines returns the week's posted spreads and totals keyed by game ID
+func (b *Bot) pickemLines(seasonInfo *models.SeasonInfo) (map[string]models.Game, error) {
+	games, err := b.nflClient.GetSeasonGames(seasonInfo.Season, seasonInfo.SeasonType)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(map[string]models.Game)
+	for _, game := range games {
+		if game.Week == seasonInfo.Week {
+			lines[game.ID] = game
+		}
+	}
+	return lines, nil
+}
+
+// pickemOptions returns a game's select options for a pick type, or nil when no line is posted
+func pickemOptions(kind string, game *models.LiveScore, line models.Game, picked string) []discordgo.SelectMenuOption {
+	switch kind {
+	case pickemSpread:
+		if line.PointSpread == nil {
+			return nil
+		}
+		home := *line.PointSpread
+		return []discordgo.SelectMenuOption{
+			{Label: fmt.Sprintf("%s %s", game.AwayTeam, formatSpread(-home)), Value: game.AwayTeam, Default: picked == game.AwayTeam},
+			{Label: fmt.Sprintf("%s %s", game.HomeTeam, formatSpread(home)), Value: game.HomeTeam, Default: picked == game.HomeTeam},
+		}
+	case pickemTotals:
+		if line.OverUnder == nil {
+			return nil
+		}
+		return []discordgo.SelectMenuOption{
+			{Label: fmt.Sprintf("Over %.1f", *line.OverUnder), Value: "over", Default: picked == "over"},
+			{Label: fmt.Sprintf("Under %.1f", *line.OverUnder), Value: "under", Default: picked == "under"},
+		}
+	default:
+		return []discordgo.SelectMenuOption{
+			{Label: game.AwayTeam + " (away)", Value: game.AwayTeam, Default: picked == game.AwayTeam},
+			{Label: game.HomeTeam + " (home)", Value: game.HomeTeam, Default: picked == game.HomeTeam},
+		}
+	}
+}
+
+// formatSpread formats a line from one team's side, e.g. "+3.5", "-7", or "PK"
+func formatSpread(spread float64) string {
+	if spread == 0 {
+		return "PK"
+	}
+	if spread == float64(int(spread)) {
+		return fmt.Sprintf("%+d", int(spread))
+	}
+	return fmt.Sprintf("%+.1f", spread)
+}
+
+// respondPickemPicker shows one page of the week's games as select menus. When update is set the
+// existing picker message is edited in place.
+func (b *Bot) respondPickemPicker(s Responder, i *discordgo.InteractionCreate, kind string, page int, update bool) {
+	seasonInfo, games, err := b.currentPickemWeek()
+	if err != nil {
+		respondEphemeral(s, i, userError("Error loading this week's games", err))
+		return
+	}
+
+	lines := make(map[string]models.Game)
+	if kind != pickemWinners {
+		lines, err = b.pickemLines(seasonInfo)
+		if err != nil {
+			respondEphemeral(s, i, userError("Error loading this week's lines", err))
+			return
+		}
+	}
+
+	now := time.Now()
+	lock := pickemLockTime(games)
+	weekLocked := !lock.IsZero() && !now.Before(lock)
+
+	userID := interactionUserID(i)
+	weekKey := pickemWeekKey(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	picks := make(map[string]string)
+	late := weekLocked
+	exists := b.pickem.View(i.GuildID, func(pool *pickemPool) {
+		if week, ok := pool.Weeks[weekKey]; ok {
+			late = week.lateEntry(kind, userID, weekLocked)
+			for gameID, pick := range week.picks(kind)[userID] {
+				picks[gameID] = pick
+			}
+		}
+	})
+	if !exists {
+		respondEphemeral(s, i, b.tr(i, "pickem.no_pool"))
+		return
+	}
+
+	pages := (len(games) + pickemGamesPerPage - 1) / pickemGamesPerPage
+	if page >= pages {
+		page = pages - 1
+	}
+	if page < 0 {
+		page = 0
+	}
+
+	location := b.userLocation(i.GuildID, interactionUserID(i))
+	format := b.guildFormat(i.GuildID)
+	var components []discordgo.MessageComponent
+	start := page * pickemGamesPerPage
+	for index := start; index < len(games) && index < start+pickemGamesPerPage; index++ {
+		game := games[index]
+		locked := pickemPickLocked(game, lock, now, late)
+
+		placeholder := fmt.Sprintf("%s @ %s — %s", game.AwayTeam, game.HomeTeam, game.GameTime.In(location).Format(format.Clock("Mon 3:04 PM")+" MST"))
+		options := pickemOptions(kind, game, lines[game.GameID], picks[game.GameID])
+		if options == nil {
+			// Discord requires at least one option even on a disabled menu
+			locked = true
+			placeholder += " — no line yet"
+			options = []discordgo.SelectMenuOption{{Label: "No line", Value: "none"}}
+		}
+		if locked {
+			placeholder = "🔒 " + placeholder
+		}
+
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("%spick:%s:%d:%s", pickemPrefix, kind, page, game.GameID),
+					Placeholder: placeholder,
+					Disabled:    locked,
+					Options:     options,
+				},
+			},
+		})
+	}
+
+	if pages > 1 {
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{Label: "◀ Previous", Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("%spage:%s:%d", pickemPrefix, kind, page-1), Disabled: page == 0},
+				discordgo.Button{Label: "Next ▶", Style: discordgo.SecondaryButton, CustomID: fmt.Sprintf("%spage:%s:%d", pickemPrefix, kind, page+1), Disabled: page == pages-1},
+			},
+		})
+	}
+
+	content := fmt.Sprintf("🏈 **%s Picks — %s** — page %d of %d • %d of %d games picked\n%s",
+		seasonInfo.WeekLabel(), pickemKindLabels[kind], page+1, pages, len(picks), len(games), pickemLockNotice(lock, weekLocked, late))
+	if kind != pickemWinners {
+		content += " Lines shown are current; picks are graded against the closing line."
+	}
+
+	responseType := discordgo.InteractionResponseChannelMessageWithSource
+	if update {
+		responseType = discordgo.InteractionResponseUpdateMessage
+	}
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: responseType,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: components,
+			Flags:      discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		logger.Error("error responding with pick'em picker", "error", err)
+	}
+}
+
+// pickemLockNotice explains when the picker's games lock for the user
+func pickemLockNotice(lock time.Time, weekLocked, late bool) string {
+	switch {
+	case lock.IsZero():
+		return "Picks lock at the week's first kickoff."
+	case !weekLocked:
+		return fmt.Sprintf("Picks lock at the week's first kickoff, <t:%d:F>.", lock.Unix())
+	case late:
+		return "Picks locked at the first kickoff, but you hadn't picked yet, so you can still pick the games that haven't kicked off."
+	default:
+		return fmt.Sprintf("Picks locked at the first kickoff (<t:%d:R>).", lock.Unix())
+	}
+}
+
+// handlePickemComponent handles pick'em select menus and page buttons
+func (b *Bot) handlePickemComponent(s Responder, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	parts := strings.Split(strings.TrimPrefix(data.CustomID, pickemPrefix), ":")
+	if len(parts) < 3 {
+		return
+	}
+	kind := parts[1]
+	page, _ := strconv.Atoi(parts[2])
+
+	switch parts[0] {
+	case "page":
+		b.respondPickemPicker(s, i, kind, page, true)
+	case "pick":
+		if len(parts) != 4 || len(data.Values) == 0 {
+			return
+		}
+		if err := b.recordPickemPick(i.GuildID, interactionUserID(i), interactionUserName(i), kind, parts[3], data.Values[0]); err != nil {
+			respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+		b.respondPickemPicker(s, i, kind, page, true)
+	}
+}
+
+// recordPickemPick saves a user's pick for a game of the current week if the week hasn't locked,
+// or, for a late entry, if the game hasn't started
+func (b *Bot) recordPickemPick(guildID, userID, userName, kind, gameID, pick string) error {
+	seasonInfo, games, err := b.currentPickemWeek()
+	if err != nil {
+		return err
+	}
+
+	var game *models.LiveScore
+	for _, candidate := range games {
+		if candidate.GameID == gameID {
+			game = candidate
+		}
+	}
+	if game == nil {
+		return fmt.Errorf("that game is not part of this week's pick'em")
+	}
+	now := time.Now()
+	if pickemGameLocked(game, now) {
+		return fmt.Errorf("%s @ %s has already kicked off; picks are locked", game.AwayTeam, game.HomeTeam)
+	}
+	lock := pickemLockTime(games)
+	weekLocked := !lock.IsZero() && !now.Before(lock)
+
+	switch kind {
+	case pickemWinners, pickemSpread:
+		if pick != game.AwayTeam && pick != game.HomeTeam {
+			return fmt.Errorf("invalid pick")
+		}
+	case pickemTotals:
+		if pick != "over" && pick != "under" {
+			return fmt.Errorf("invalid pick")
+		}
+	default:
+		return fmt.Errorf("unknown pick type")
+	}
+
+	return b.pickem.Update(guildID, func(pool *pickemPool) error {
+		week := pool.week(seasonInfo)
+		late := week.lateEntry(kind, userID, weekLocked)
+		if pickemPickLocked(game, lock, now, late) {
+			return fmt.Errorf("this week's picks locked at the first kickoff (<t:%d:F>) and can't be changed", lock.Unix())
+		}
+		if late {
+			week.markLateEntry(kind, userID)
+		}
+		if userName != "" {
+			if pool.Names == nil {
+				pool.Names = make(map[string]string)
+			}
+			pool.Names[userID] = userName
+		}
+		picks := week.picks(kind)
+		if picks[userID] == nil {
+			picks[userID] = make(map[string]string)
+		}
+		picks[userID][gameID] = pick
+		return nil
+	})
+}
+
+// respondPickemLeaderboard shows the season and current week standings for a pick type
+func (b *Bot) respondPickemLeaderboard(s Responder, i *discordgo.InteractionCreate, kind string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.respondInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+
+	var embed *discordgo.MessageEmbed
+	exists := b.pickem.View(i.GuildID, func(pool *pickemPool) {
+		embed = pickemLeaderboardEmbed(pool, seasonInfo, kind)
+	})
+	if !exists {
+		b.respondInteraction(s, i, "This server has no pick'em pool; an admin can start one with `/pickem create`.")
+		return
+	}
+
+	if err := b.respondInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error responding with pick'em leaderboard", "error", err)
+	}
+}
+
+// pickemLeaderboardEmbed renders the season leaderboard and the current week's standings for a pick type
+func pickemLeaderboardEmbed(pool *pickemPool, seasonInfo *models.SeasonInfo, kind string) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🏆 %d Pick'em Leaderboard — %s", seasonInfo.Season, pickemKindLabels[kind]),
+		Color: 0xffd700,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Season",
+				Value:  formatPickemRecords(sortedRecords(pool.SeasonRecords(seasonInfo.Season, kind))),
+				Inline: false,
+			},
+		},
+	}
+
+	if week, exists := pool.Weeks[pickemWeekKey(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)]; exists {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   seasonInfo.WeekLabel(),
+			Value:  formatPickemRecords(sortedRecords(week.Records(kind))),
+			Inline: false,
+		})
+	}
+	if kind != pickemWinners {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: "Graded against closing lines • pushes don't count"}
+	}
+	return embed
+}
+
+// formatPickemRecords formats the top of a leaderboard with user mentions
+func formatPickemRecords(records []*pickemRecord) string {
+	if len(records) == 0 {
+		return "No picks yet"
+	}
+
+	medals := []string{"🥇", "🥈", "🥉"}
+	var lines []string
+	for index, record := range records {
+		if index == 15 {
+			lines = append(lines, fmt.Sprintf("*…and %d more*", len(records)-index))
+			break
+		}
+		rank := fmt.Sprintf("%d.", index+1)
+		if index < len(medals) {
+			rank = medals[index]
+		}
+		lines = append(lines, fmt.Sprintf("%s <@%s> — %d/%d", rank, record.UserID, record.Correct, record.Graded))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// gradePickemGame records a final game's result for every pick type
+func (b *Bot) gradePickemGame(week *pickemWeek, game *models.LiveScore) {
+	winners := week.results(pickemWinners)
+	switch {
+	case game.HomeScore > game.AwayScore:
+		winners[game.GameID] = game.HomeTeam
+	case game.AwayScore > game.HomeScore:
+		winners[game.GameID] = game.AwayTeam
+	default:
+		winners[game.GameID] = pickemTie
+	}
+
+	spreads, totals := week.results(pickemSpread), week.results(pickemTotals)
+	line, archived := b.closingLines.Get(game.GameID)
+	if !archived {
+		spreads[game.GameID] = pickemNoLine
+		totals[game.GameID] = pickemNoLine
+		return
+	}
+
+	switch line.Cover(line.HomeTeam) {
+	case odds.Win:
+		spreads[game.GameID] = line.HomeTeam
+	case odds.Loss:
+		spreads[game.GameID] = line.AwayTeam
+	default:
+		spreads[game.GameID] = pickemPush
+	}
+
+	over, graded := line.OverUnder()
+	switch {
+	case !graded:
+		totals[game.GameID] = pickemNoLine
+	case over == odds.Win:
+		totals[game.GameID] = "over"
+	case over == odds.Loss:
+		totals[game.GameID] = "under"
+	default:
+		totals[game.GameID] = pickemPush
+	}
+}
+
+// gradePickem records results for final games in every pool and posts each week's
+// standings once all of its games are graded. Closing lines must be archived first.
+func (b *Bot) gradePickem() {
+	for _, guildID := range b.pickem.GuildIDs() {
+		// Collect the weeks that still need grading without holding the store lock during API calls
+		var pending []*models.SeasonInfo
+		b.pickem.View(guildID, func(pool *pickemPool) {
+			for _, week := range pool.Weeks {
+				if !week.Announced {
+					pending = append(pending, &models.SeasonInfo{Season: week.Season, SeasonType: week.SeasonType, Week: week.Week})
+				}
+			}
+		})
+
+		for _, weekInfo := range pending {
+			games, err := b.nflClient.GetScoresForWeek(weekInfo.Season, weekInfo.SeasonType, weekInfo.Week)
+			if err != nil {
+				logger.Error("pick'em grading failed", "guild", guildID, "week", weekInfo.WeekLabel(), "error", err)
+				continue
+			}
+
+			var announce []*discordgo.MessageEmbed
+			var channelID string
+			err = b.pickem.Update(guildID, func(pool *pickemPool) error {
+				week := pool.Weeks[pickemWeekKey(weekInfo.Season, weekInfo.SeasonType, weekInfo.Week)]
+				final := 0
+				for _, game := range games {
+					if !standings.IsFinal(game.Status) {
+						continue
+					}
+					final++
+					if _, graded := week.results(pickemWinners)[game.GameID]; !graded {
+						b.gradePickemGame(week, game)
+					}
+				}
+
+				if final == len(games) && len(games) > 0 {
+					week.Announced = true
+					channelID = pool.ChannelID
+					for _, kind := range pickemKinds {
+						if len(week.picks(kind)) == 0 {
+							continue
+						}
+						embed := pickemLeaderboardEmbed(pool, weekInfo, kind)
+						embed.Title = fmt.Sprintf("✅ %s Pick'em Results — %s", weekInfo.WeekLabel(), pickemKindLabels[kind])
+						announce = append(announce, embed)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				logger.Error("error saving pick'em results", "error", err)
+				continue
+			}
+
+			for _, embed := range announce {
+				b.postAutomated(channelID, "", embed)
+			}
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package bot
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// pickemLeaguesDocument is the storage document holding cross-server pick'em leagues
+const pickemLeaguesDocument = "pickem_leagues"
+
+// maxPickemLeagueGuilds caps how many servers one league can combine
+const maxPickemLeagueGuilds = 25
+
+// pickemInviteAlphabet avoids characters that are easy to misread when an invite code is shared
+const pickemInviteAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// pickemInviteLength is how many characters an invite code has
+const pickemInviteLength = 8
+
+// pickemLeague joins several servers' pick'em pools into one competition. Each server keeps its
+// own pool and picks; the league's leaderboard combines them by Discord user ID.
+type pickemLeague struct {
+	Name      string   `json:"name"`
+	Code      string   `json:"code"`   // invite code other servers join with
+	Guilds    []string `json:"guilds"` // member guild IDs, the owning server first
+	CreatedBy string   `json:"created_by"`
+}
+
+// pickemLeagueRecord is a user's graded record in a league, counted from one member server's pool
+type pickemLeagueRecord struct {
+	pickemRecord
+	Name    string // display name from the user's latest pick
+	GuildID string // the server whose pool the record comes from
+}
+
+// newPickemInviteCode generates a random invite code
+func newPickemInviteCode() (string, error) {
+	raw := make([]byte, pickemInviteLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("error generating invite code: %v", err)
+	}
+	code := make([]byte, pickemInviteLength)
+	for index, value := range raw {
+		code[index] = pickemInviteAlphabet[int(value)%len(pickemInviteAlphabet)]
+	}
+	return string(code), nil
+}
+
+// saveLeagues persists the leagues and pools together, since membership is recorded in both;
+// the caller must hold ps.mu
+func (ps *pickemStore) saveLeagues() error {
+	if err := ps.store.Save(pickemLeaguesDocument, ps.leagues); err != nil {
+		logger.Error("error saving pick'em leagues", "error", err)
+		return err
+	}
+	if err := ps.store.Save(pickemDocument, ps.pools); err != nil {
+		logger.Error("error saving pick'em pools", "error", err)
+		return err
+	}
+	return nil
+}
+
+// memberPool returns a guild's pool for league changes, or an error when it has none
+func (ps *pickemStore) memberPool(guildID string) (*pickemPool, error) {
+	pool, exists := ps.pools[guildID]
+	if !exists {
+		return nil, fmt.Errorf("this server has no pick'em pool; an admin can start one with `/pickem create`")
+	}
+	return pool, nil
+}
+
+// CreateLeague starts a league owned by a guild's pool and returns it with its invite code
+func (ps *pickemStore) CreateLeague(guildID, userID, name string) (pickemLeague, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	pool, err := ps.memberPool(guildID)
+	if err != nil {
+		return pickemLeague{}, err
+	}
+	if pool.League != "" {
+		return pickemLeague{}, fmt.Errorf("this server is already in the %s league; leave it first", ps.leagues[pool.League].Name)
+	}
+
+	code, err := newPickemInviteCode()
+	for err == nil && ps.leagues[code] != nil {
+		code, err = newPickemInviteCode()
+	}
+	if err != nil {
+		return pickemLeague{}, err
+	}
+
+	league := &pickemLeague{Name: name, Code: code, Guilds: []string{guildID}, CreatedBy: userID}
+	ps.leagues[code] = league
+	pool.League = code
+	return *league, ps.saveLeagues()
+}
+
+// JoinLeague adds a guild's pool to the league with an invite code
+func (ps *pickemStore) JoinLeague(guildID, code string) (pickemLeague, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	pool, err := ps.memberPool(guildID)
+	if err != nil {
+		return pickemLeague{}, err
+	}
+	league, exists := ps.leagues[strings.ToUpper(strings.TrimSpace(code))]
+	switch {
+	case !exists:
+		return pickemLeague{}, fmt.Errorf("no pick'em league has the invite code `%s`", code)
+	case pool.League == league.Code:
+		return pickemLeague{}, fmt.Errorf("this server is already in the %s league", league.Name)
+	case pool.League != "":
+		return pickemLeague{}, fmt.Errorf("this server is already in the %s league; leave it first", ps.leagues[pool.League].Name)
+	case len(league.Guilds) >= maxPickemLeagueGuilds:
+		return pickemLeague{}, fmt.Errorf("the %s league is full (%d servers)", league.Name, maxPickemLeagueGuilds)
+	}
+
+	league.Guilds = append(league.Guilds, guildID)
+	pool.League = league.Code
+	return *league, ps.saveLeagues()
+}
+
+// LeaveLeague takes a guild's pool out of its league, returning the league's name. The last
+// server to leave closes the league.
+func (ps *pickemStore) LeaveLeague(guildID string) (string, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	pool, err := ps.memberPool(guildID)
+	if err != nil {
+		return "", err
+	}
+	league, exists := ps.leagues[pool.League]
+	if !exists {
+		return "", fmt.Errorf("this server isn't in a pick'em league")
+	}
+
+	for index, member := range league.Guilds {
+		if member == guildID {
+			league.Guilds = append(league.Guilds[:index], league.Guilds[index+1:]...)
+			break
+		}
+	}
+	if len(league.Guilds) == 0 {
+		delete(ps.leagues, league.Code)
+	}
+	pool.League = ""
+	return league.Name, ps.saveLeagues()
+}
+
+// LeagueRecords returns the league a guild belongs to and its combined season leaderboard for a
+// pick type. Players are identified by Discord user ID, so someone in two member servers appears
+// once, with the record from the server where they've had the most picks graded.
+func (ps *pickemStore) LeagueRecords(guildID string, season int, kind string) (pickemLeague, []*pickemLeagueRecord, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	pool, err := ps.memberPool(guildID)
+	if err != nil {
+		return pickemLeague{}, nil, err
+	}
+	league, exists := ps.leagues[pool.League]
+	if !exists {
+		return pickemLeague{}, nil, fmt.Errorf("this server isn't in a pick'em league; start one with `/pickem league create` or join one with `/pickem league join`")
+	}
+
+	best := make(map[string]*pickemLeagueRecord)
+	for _, memberID := range league.Guilds {
+		member, exists := ps.pools[memberID]
+		if !exists {
+			continue
+		}
+		for userID, record := range member.SeasonRecords(season, kind) {
+			current, counted := best[userID]
+			if counted && (current.Graded > record.Graded || (current.Graded == record.Graded && current.Correct >= record.Correct)) {
+				continue
+			}
+			best[userID] = &pickemLeagueRecord{pickemRecord: *record, Name: member.Names[userID], GuildID: memberID}
+		}
+	}
+
+	records := make(map[string]*pickemRecord, len(best))
+	for userID, record := range best {
+		records[userID] = &record.pickemRecord
+	}
+	var sorted []*pickemLeagueRecord
+	for _, record := range sortedRecords(records) {
+		sorted = append(sorted, best[record.UserID])
+	}
+
+	copied := *league
+	copied.Guilds = append([]string(nil), league.Guilds...)
+	return copied, sorted, nil
+}
+
+// interactionUserName is the name the user goes by where the interaction happened
+func interactionUserName(i *discordgo.InteractionCreate) string {
+	if i.Member != nil {
+		return i.Member.DisplayName()
+	}
+	if i.User != nil {
+		return i.User.GlobalName
+	}
+	return ""
+}
+
+// respondPickemLeague handles the /pickem league subcommands
+func (b *Bot) respondPickemLeague(s Responder, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	switch subcommand.Name {
+	case "create", "join", "leave":
+		if !canManageGuild(i) {
+			b.respondInteraction(s, i, b.tr(i, "permission.pickem_league"))
+			return
+		}
+	}
+
+	switch subcommand.Name {
+	case "create":
+		name := subcommand.Options[0].StringValue()
+		league, err := b.pickem.CreateLeague(i.GuildID, interactionUserID(i), name)
+		if err != nil {
+			b.respondInteraction(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+		b.respondInteraction(s, i, fmt.Sprintf("🤝 The **%s** pick'em league is open. Other servers with a pool can join with `/pickem league join code:%s`, and `/pickem league standings` ranks everyone together.",
+			league.Name, league.Code))
+	case "join":
+		league, err := b.pickem.JoinLeague(i.GuildID, subcommand.Options[0].StringValue())
+		if err != nil {
+			b.respondInteraction(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+		b.respondInteraction(s, i, fmt.Sprintf("🤝 This server joined the **%s** pick'em league with %d other server(s). Picks here now count on `/pickem league standings` too.",
+			league.Name, len(league.Guilds)-1))
+	case "leave":
+		name, err := b.pickem.LeaveLeague(i.GuildID)
+		if err != nil {
+			b.respondInteraction(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+		b.respondInteraction(s, i, fmt.Sprintf("👋 This server left the **%s** pick'em league. Its own pool and picks are unchanged.", name))
+	case "standings":
+		b.respondPickemLeagueStandings(s, i, pickemKindChoice(subcommand.Options))
+	}
+}
+
+// respondPickemLeagueStandings shows the combined season leaderboard of the guild's league
+func (b *Bot) respondPickemLeagueStandings(s Responder, i *discordgo.InteractionCreate, kind string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.respondInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+
+	league, records, err := b.pickem.LeagueRecords(i.GuildID, seasonInfo.Season, kind)
+	if err != nil {
+		b.respondInteraction(s, i, fmt.Sprintf("❌ %v", err))
+		return
+	}
+
+	if err := b.respondInteractionEmbed(s, i, pickemLeagueEmbed(league, records, seasonInfo, kind, i.GuildID)); err != nil {
+		logger.Error("error responding with pick'em league standings", "error", err)
+	}
+}
+
+// pickemLeagueEmbed renders a league's combined leaderboard, marking players from other servers
+func pickemLeagueEmbed(league pickemLeague, records []*pickemLeagueRecord, seasonInfo *models.SeasonInfo, kind, guildID string) *discordgo.MessageEmbed {
+	value := "No picks graded yet"
+	if len(records) > 0 {
+		medals := []string{"🥇", "🥈", "🥉"}
+		var lines []string
+		for index, record := range records {
+			if index == 25 {
+				lines = append(lines, fmt.Sprintf("*…and %d more*", len(records)-index))
+				break
+			}
+			rank := fmt.Sprintf("%d.", index+1)
+			if index < len(medals) {
+				rank = medals[index]
+			}
+			// Mentions of members of other servers don't resolve, so those rows use the saved name
+			name := fmt.Sprintf("<@%s>", record.UserID)
+			if record.GuildID != guildID && record.Name != "" {
+				name = "**" + record.Name + "**"
+			}
+			lines = append(lines, fmt.Sprintf("%s %s — %d/%d", rank, name, record.Correct, record.Graded))
+		}
+		value = strings.Join(lines, "\n")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:  fmt.Sprintf("🤝 %s — %d %s", league.Name, seasonInfo.Season, pickemKindLabels[kind]),
+		Color:  0xffd700,
+		Fields: []*discordgo.MessageEmbedField{{Name: "Combined Season Standings", Value: value}},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%d server(s) • invite code %s • players in several servers count once", len(league.Guilds), league.Code),
+		},
+	}
+	if kind != pickemWinners {
+		embed.Footer.Text += " • graded against closing lines"
+	}
+	return embed
+}
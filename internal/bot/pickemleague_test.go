@@ -0,0 +1,104 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+)
+
+// pickemWeekWith is a graded week of winners picks: each user's picks of game 1 and 2
+func pickemWeekWith(picks map[string]map[string]string) *pickemWeek {
+	return &pickemWeek{Season: 2025, SeasonType: "REG", Week: 6, Picks: picks, Results: map[string]string{"1": "KC", "2": "PHI"}}
+}
+
+func TestPickemLeagueCombinesServersByUserID(t *testing.T) {
+	b := newTestBot(t)
+	for _, guildID := range []string{"guild-a", "guild-b", "guild-c"} {
+		if err := b.pickem.Create(guildID, "channel-"+guildID, testUserID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	league, err := b.pickem.CreateLeague("guild-a", testUserID, "Sunday Crew")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(league.Code) != pickemInviteLength {
+		t.Fatalf("invite code = %q, want %d characters", league.Code, pickemInviteLength)
+	}
+	if _, err := b.pickem.JoinLeague("guild-b", strings.ToLower(league.Code)); err != nil {
+		t.Fatalf("joining with a lowercase code: %v", err)
+	}
+	if _, err := b.pickem.JoinLeague("guild-b", league.Code); err == nil {
+		t.Error("joining the same league twice should fail")
+	}
+	if _, err := b.pickem.JoinLeague("guild-c", "NOPE2345"); err == nil {
+		t.Error("joining with an unknown code should fail")
+	}
+
+	// "both" plays in both servers and counts once, from guild-b where more picks were graded
+	weeks := map[string]map[string]map[string]string{
+		"guild-a": {"alice": {"1": "KC", "2": "PHI"}, "both": {"1": "KC"}},
+		"guild-b": {"bob": {"1": "BUF", "2": "DAL"}, "both": {"1": "BUF", "2": "PHI"}},
+		"guild-c": {"carol": {"1": "KC", "2": "PHI"}},
+	}
+	for guildID, picks := range weeks {
+		err := b.pickem.Update(guildID, func(pool *pickemPool) error {
+			pool.Weeks["2025REG-6"] = pickemWeekWith(picks)
+			pool.Names = map[string]string{"bob": "Bobby"}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, records, err := b.pickem.LeagueRecords("guild-b", 2025, pickemWinners)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, record := range records {
+		got = append(got, record.UserID+"@"+record.GuildID)
+	}
+	want := []string{"alice@guild-a", "both@guild-b", "bob@guild-b"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("league standings = %v, want %v (carol's server isn't in the league)", got, want)
+	}
+	if records[2].Name != "Bobby" || records[2].Correct != 0 || records[1].Correct != 1 {
+		t.Errorf("records = %+v %+v, want Bobby 0/2 and both 1/2", records[2], records[1])
+	}
+
+	// The league survives its owner leaving and closes with its last server
+	if _, err := b.pickem.LeaveLeague("guild-a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := b.pickem.LeagueRecords("guild-a", 2025, pickemWinners); err == nil {
+		t.Error("a server that left should have no league standings")
+	}
+	if _, err := b.pickem.LeaveLeague("guild-b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.pickem.JoinLeague("guild-c", league.Code); err == nil {
+		t.Error("a league whose servers all left should be closed")
+	}
+}
+
+func TestPickemLeaguePersists(t *testing.T) {
+	b := newTestBot(t)
+	if err := b.pickem.Create(testGuildID, "channel-1", testUserID); err != nil {
+		t.Fatal(err)
+	}
+	league, err := b.pickem.CreateLeague(testGuildID, testUserID, "Office League")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := newPickemStore(b.pickem.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := reloaded.LeagueRecords(testGuildID, 2025, pickemWinners)
+	if err != nil || got.Code != league.Code || got.Name != "Office League" {
+		t.Errorf("reloaded league = %+v, %v; want %+v", got, err, league)
+	}
+}
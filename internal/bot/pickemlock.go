@@ -0,0 +1,173 @@
+package bot
+
+import (
+	"fmt"
+	"time"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// pickemReminderEvent keys a pool's deadline reminders in the reminder store
+const pickemReminderEvent = "pickem_deadline"
+
+// pickemReminderLeads are how long before a week locks its deadline reminders are posted
+var pickemReminderLeads = []reminderLead{
+	{24 * time.Hour, "1 day"},
+	{2 * time.Hour, "2 hours"},
+}
+
+// pickemLockTime is when a week's picks lock: its first kickoff, usually Thursday night. Zero
+// when no game has a kickoff time yet.
+func pickemLockTime(games []*models.LiveScore) time.Time {
+	var lock time.Time
+	for _, game := range games {
+		if !game.GameTime.IsZero() && (lock.IsZero() || game.GameTime.Before(lock)) {
+			lock = game.GameTime
+		}
+	}
+	return lock
+}
+
+// pickemPickLocked reports whether a user can no longer pick a game. Everyone's picks lock at the
+// week's first kickoff; late entries instead lock game by game at each kickoff.
+func pickemPickLocked(game *models.LiveScore, lock, now time.Time, late bool) bool {
+	if pickemGameLocked(game, now) {
+		return true
+	}
+	return !late && !lock.IsZero() && !now.Before(lock)
+}
+
+// week returns the pool's week for a season week, creating it when needed
+func (p *pickemPool) week(seasonInfo *models.SeasonInfo) *pickemWeek {
+	weekKey := pickemWeekKey(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	week, exists := p.Weeks[weekKey]
+	if !exists {
+		week = &pickemWeek{
+			Season:     seasonInfo.Season,
+			SeasonType: seasonInfo.SeasonType,
+			Week:       seasonInfo.Week,
+		}
+		p.Weeks[weekKey] = week
+	}
+	return week
+}
+
+// lateEntry reports whether a user picks a type this week as a late entry: one who hadn't picked
+// any game of the type when the week locked, so missed the Thursday game but can still pick the
+// games that haven't kicked off
+func (w *pickemWeek) lateEntry(kind, userID string, locked bool) bool {
+	if w.LateEntries[kind][userID] {
+		return true
+	}
+	return locked && len(w.picks(kind)[userID]) == 0
+}
+
+// markLateEntry records that a user joined a pick type after the week locked
+func (w *pickemWeek) markLateEntry(kind, userID string) {
+	if w.LateEntries == nil {
+		w.LateEntries = make(map[string]map[string]bool)
+	}
+	if w.LateEntries[kind] == nil {
+		w.LateEntries[kind] = make(map[string]bool)
+	}
+	w.LateEntries[kind][userID] = true
+}
+
+// unpicked returns the pool's members, anyone who has picked this season, who haven't made a
+// pick of any type in a week
+func (p *pickemPool) unpicked(season int, weekKey string) []string {
+	members := make(map[string]bool)
+	for _, week := range p.Weeks {
+		if week.Season != season {
+			continue
+		}
+		for _, kind := range pickemKinds {
+			for userID := range week.picks(kind) {
+				members[userID] = true
+			}
+		}
+	}
+
+	if week, exists := p.Weeks[weekKey]; exists {
+		for _, kind := range pickemKinds {
+			for userID, picks := range week.picks(kind) {
+				if len(picks) > 0 {
+					delete(members, userID)
+				}
+			}
+		}
+	}
+
+	var missing []string
+	for userID := range members {
+		missing = append(missing, userID)
+	}
+	return missing
+}
+
+// schedulePickemReminders schedules each pool's deadline reminders for the current week, once per
+// lock time, so a flexed Thursday kickoff moves them with it
+func (b *Bot) schedulePickemReminders() {
+	seasonInfo, games, err := b.currentPickemWeek()
+	if err != nil {
+		logger.Warn("error loading the week for pick'em reminders", "error", err)
+		return
+	}
+	lock := pickemLockTime(games)
+	if lock.IsZero() {
+		return
+	}
+
+	weekKey := pickemWeekKey(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	for _, guildID := range b.pickem.GuildIDs() {
+		var channelID string
+		scheduled := false
+		b.pickem.View(guildID, func(pool *pickemPool) {
+			channelID = pool.ChannelID
+			week, exists := pool.Weeks[weekKey]
+			scheduled = exists && week.RemindersFor.Equal(lock)
+		})
+		if scheduled {
+			continue
+		}
+
+		template := Reminder{GuildID: guildID, ChannelID: channelID, LeagueEvent: pickemReminderEvent, PickemWeek: weekKey}
+		_, err := b.reminders.ScheduleEvent(template, lock, pickemReminderLeads, time.Now(), func(label string) string {
+			return fmt.Sprintf("🏈 **%s** picks lock in %s (<t:%d:F>)", seasonInfo.WeekLabel(), label, lock.Unix())
+		})
+		if err != nil {
+			logger.Error("error scheduling pick'em reminders", "guild", guildID, "error", err)
+			continue
+		}
+
+		err = b.pickem.Update(guildID, func(pool *pickemPool) error {
+			pool.week(seasonInfo).RemindersFor = lock
+			return nil
+		})
+		if err != nil {
+			logger.Error("error saving pick'em reminder schedule", "guild", guildID, "error", err)
+		}
+	}
+}
+
+// deliverPickemReminder posts a deadline reminder with how many members still haven't picked,
+// or nothing when everyone has
+func (b *Bot) deliverPickemReminder(reminder *Reminder) {
+	var missing []string
+	b.pickem.View(reminder.GuildID, func(pool *pickemPool) {
+		if week, exists := pool.Weeks[reminder.PickemWeek]; exists {
+			missing = pool.unpicked(week.Season, reminder.PickemWeek)
+		}
+	})
+	if len(missing) == 0 {
+		logger.Debug("skipping pick'em reminder, everyone has picked", "guild", reminder.GuildID, "week", reminder.PickemWeek)
+		return
+	}
+
+	members := fmt.Sprintf("%d members haven't picked", len(missing))
+	if len(missing) == 1 {
+		members = "1 member hasn't picked"
+	}
+	b.sendMessage(b.discord, reminder.ChannelID, fmt.Sprintf("⏰ %s — %s. Make yours with `/pickem picks`; miss the lock and you can still pick the games that haven't kicked off.",
+		reminder.Message, members))
+}
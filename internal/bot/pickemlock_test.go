@@ -0,0 +1,102 @@
+package bot
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+func TestPickemLockAtFirstKickoff(t *testing.T) {
+	thursday := time.Date(2025, time.October, 16, 20, 15, 0, 0, time.UTC)
+	sunday := thursday.Add(65 * time.Hour)
+	games := []*models.LiveScore{
+		{GameID: "sun", AwayTeam: "KC", HomeTeam: "BUF", GameTime: sunday, Status: "Scheduled"},
+		{GameID: "thu", AwayTeam: "PHI", HomeTeam: "DAL", GameTime: thursday, Status: "Scheduled"},
+	}
+	lock := pickemLockTime(games)
+	if !lock.Equal(thursday) {
+		t.Fatalf("lock = %v, want Thursday's kickoff %v", lock, thursday)
+	}
+
+	week := &pickemWeek{Picks: map[string]map[string]string{"on-time": {"thu": "PHI"}}}
+	friday := thursday.Add(24 * time.Hour)
+	tests := []struct {
+		name   string
+		userID string
+		now    time.Time
+		locked bool
+	}{
+		{"before the lock", "on-time", thursday.Add(-time.Hour), false},
+		{"member who picked before the lock", "on-time", friday, true},
+		{"late entry after Thursday's game", "late", friday, false},
+		{"late entry once Sunday kicks off", "late", sunday, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weekLocked := !tt.now.Before(lock)
+			late := week.lateEntry(pickemWinners, tt.userID, weekLocked)
+			game := *games[0]
+			if !tt.now.Before(game.GameTime) {
+				game.Status = "InProgress"
+			}
+			if got := pickemPickLocked(&game, lock, tt.now, late); got != tt.locked {
+				t.Errorf("Sunday game locked = %v, want %v", got, tt.locked)
+			}
+		})
+	}
+
+	// Once a late entry has picked, they keep picking game by game
+	week.picks(pickemWinners)["late"] = map[string]string{"sun": "KC"}
+	week.markLateEntry(pickemWinners, "late")
+	if !week.lateEntry(pickemWinners, "late", true) {
+		t.Error("a late entry who has picked should stay a late entry")
+	}
+}
+
+func TestPickemUnpickedMembers(t *testing.T) {
+	pool := &pickemPool{Weeks: map[string]*pickemWeek{
+		"2025REG-6": {Season: 2025, Picks: map[string]map[string]string{"a": {"1": "KC"}, "b": {"1": "BUF"}, "c": {"1": "KC"}}},
+		"2025REG-7": {Season: 2025, Picks: map[string]map[string]string{"a": {"2": "PHI"}}, TotalPicks: map[string]map[string]string{"b": {"2": "over"}}},
+		"2024REG-7": {Season: 2024, Picks: map[string]map[string]string{"gone": {"3": "DAL"}}},
+	}}
+
+	missing := pool.unpicked(2025, "2025REG-7")
+	sort.Strings(missing)
+	if len(missing) != 1 || missing[0] != "c" {
+		t.Errorf("unpicked = %v, want [c]", missing)
+	}
+}
+
+func TestScheduleEventReplacesPendingReminders(t *testing.T) {
+	b := newTestBot(t)
+	now := time.Now()
+	lock := now.Add(6 * time.Hour)
+	template := Reminder{GuildID: testGuildID, ChannelID: "channel-1", LeagueEvent: pickemReminderEvent, PickemWeek: "2025REG-7"}
+	message := func(label string) string { return "picks lock in " + label }
+
+	// The day-before reminder has already passed, so only the two-hour one is scheduled
+	scheduled, err := b.reminders.ScheduleEvent(template, lock, pickemReminderLeads, now, message)
+	if err != nil || scheduled != 1 {
+		t.Fatalf("ScheduleEvent = %d, %v; want 1 reminder", scheduled, err)
+	}
+
+	// A flexed kickoff replaces the pending reminder instead of adding another
+	lock = now.Add(48 * time.Hour)
+	if scheduled, err = b.reminders.ScheduleEvent(template, lock, pickemReminderLeads, now, message); err != nil || scheduled != 2 {
+		t.Fatalf("rescheduled = %d, %v; want 2 reminders", scheduled, err)
+	}
+	due := b.reminders.TakeDue(lock)
+	if len(due) != 2 {
+		t.Fatalf("pending reminders = %d, want 2", len(due))
+	}
+	for _, reminder := range due {
+		if reminder.PickemWeek != "2025REG-7" || !reminder.EventTime.Equal(lock) {
+			t.Errorf("reminder = %+v, want the week's lock as its event", reminder)
+		}
+	}
+	if due[0].Message != "picks lock in 1 day" && due[1].Message != "picks lock in 1 day" {
+		t.Errorf("messages = %q, %q, want one a day ahead", due[0].Message, due[1].Message)
+	}
+}
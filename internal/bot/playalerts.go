@@ -0,0 +1,350 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/pkg/models"
+)
+
+// playKindAliases maps the names admins can type for each play kind
+var playKindAliases = map[string]string{
+	"td":           models.PlayTouchdown,
+	"tds":          models.PlayTouchdown,
+	"touchdown":    models.PlayTouchdown,
+	"touchdowns":   models.PlayTouchdown,
+	"fg":           models.PlayFieldGoal,
+	"fgs":          models.PlayFieldGoal,
+	"field goal":   models.PlayFieldGoal,
+	"field goals":  models.PlayFieldGoal,
+	"field_goal":   models.PlayFieldGoal,
+	"fieldgoal":    models.PlayFieldGoal,
+	"safety":       models.PlaySafety,
+	"safeties":     models.PlaySafety,
+	"turnover":     models.PlayTurnover,
+	"turnovers":    models.PlayTurnover,
+	"interception": models.PlayTurnover,
+	"int":          models.PlayTurnover,
+	"fumble":       models.PlayTurnover,
+}
+
+// playTracker remembers which plays of each live game have already been seen
+type playTracker struct {
+	mu    sync.Mutex
+	games map[string]map[int]bool // play IDs by game ID
+}
+
+// newPlayTracker creates an empty play tracker
+func newPlayTracker() *playTracker {
+	return &playTracker{games: make(map[string]map[int]bool)}
+}
+
+// Diff marks a game's plays as seen and returns the ones that weren't before. The first call for
+// a game only records a baseline, so a restart mid-game doesn't replay earlier plays.
+func (pt *playTracker) Diff(gameID string, plays []*models.GamePlay) []*models.GamePlay {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	seen, tracked := pt.games[gameID]
+	if !tracked {
+		seen = make(map[int]bool, len(plays))
+		pt.games[gameID] = seen
+	}
+
+	var fresh []*models.GamePlay
+	for _, play := range plays {
+		if !seen[play.ID] {
+			seen[play.ID] = true
+			if tracked {
+				fresh = append(fresh, play)
+			}
+		}
+	}
+	return fresh
+}
+
+// Tracking reports whether a game's plays are being followed
+func (pt *playTracker) Tracking(gameID string) bool {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	_, tracked := pt.games[gameID]
+	return tracked
+}
+
+// Forget stops following a game
+func (pt *playTracker) Forget(gameID string) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	delete(pt.games, gameID)
+}
+
+// pollScoringPlays checks the play-by-play of live games that play alert rules cover and posts
+// alerts for plays that are new since the last poll
+func (b *Bot) pollScoringPlays() error {
+	rules := b.settings.PlayAlertRules()
+	if len(rules) == 0 {
+		return nil
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return err
+	}
+	scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		return err
+	}
+
+	for _, game := range scores {
+		state := game.State()
+		// One last poll after the final catches plays from the closing minutes
+		finished := state == models.StateFinal && b.seenPlays.Tracking(game.GameID)
+		if (state != models.StateLive && !finished) || !playAlertsCover(rules, game) {
+			continue
+		}
+
+		plays, err := b.nflClient.GetNotablePlays(seasonInfo.SeasonType, game)
+		if err != nil {
+			logger.Warn("error fetching play-by-play", "game", game.GameID, "error", err)
+			continue
+		}
+		for _, play := range b.seenPlays.Diff(game.GameID, plays) {
+			b.postPlayAlert(rules, play)
+		}
+		if finished {
+			b.seenPlays.Forget(game.GameID)
+		}
+	}
+	return nil
+}
+
+// playAlertsCover reports whether any rule covers a game's teams
+func playAlertsCover(rules map[string]map[string]*PlayAlertRule, game *models.LiveScore) bool {
+	for _, channels := range rules {
+		for _, rule := range channels {
+			if len(rule.Teams) == 0 || containsTeam(rule.Teams, game.AwayTeam) || containsTeam(rule.Teams, game.HomeTeam) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// postPlayAlert posts a play to every channel and webhook whose rule matches its teams and kind
+func (b *Bot) postPlayAlert(rules map[string]map[string]*PlayAlertRule, play *models.GamePlay) {
+	for guildID, targets := range rules {
+		for target, rule := range targets {
+			if len(rule.Teams) > 0 && !containsTeam(rule.Teams, play.AwayTeam) && !containsTeam(rule.Teams, play.HomeTeam) {
+				continue
+			}
+			if len(rule.Plays) > 0 && !play.HasKind(rule.Plays) {
+				continue
+			}
+			logger.Info("posting play alert", "guild", guildID, "target", targetLabel(target), "game", play.GameID, "play", play.ID)
+			post := &DelayedPost{GuildID: guildID, ChannelID: target, Event: "play_alert", Embeds: []*discordgo.MessageEmbed{b.playAlertEmbed(play)}}
+			if isWebhookTarget(target) && !discordWebhookPattern.MatchString(target) {
+				// Generic webhooks get the play itself rather than its embed
+				post.Payload = playAlertJSON(guildID, play)
+			}
+			b.sendAutomated(post)
+		}
+	}
+}
+
+// playAlertEmbed builds the alert for a play, e.g. "🏈 TOUCHDOWN — BUF" with the play call and score
+func (b *Bot) playAlertEmbed(play *models.GamePlay) *discordgo.MessageEmbed {
+	labels := make([]string, len(play.Kinds))
+	for index, kind := range play.Kinds {
+		labels[index] = models.PlayKindLabel(kind)
+	}
+
+	situation := playTime(play.Quarter, play.Clock)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("%s %s — %s", playKindEmoji(play.Kinds[0]), strings.Join(labels, " + "), play.Team),
+		Description: play.Description,
+		Color:       embeds.ColorScores,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Score", Value: fmt.Sprintf("%s %d - %d %s", play.AwayTeam, play.AwayScore, play.HomeScore, play.HomeTeam), Inline: true},
+		},
+	}
+	if situation != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Time", Value: situation, Inline: true})
+	}
+	b.themeEmbedForTeam(embed, play.Team)
+	return embed
+}
+
+// playTime formats when a play happened, e.g. "Q3 4:32" or "OT 8:10"
+func playTime(quarter, clock string) string {
+	// Quarters come as "1" through "4", or "OT"
+	if len(quarter) == 1 {
+		quarter = "Q" + quarter
+	}
+	return strings.TrimSpace(quarter + " " + clock)
+}
+
+// playKindEmoji is the badge shown in a play alert's title
+func playKindEmoji(kind string) string {
+	switch kind {
+	case models.PlayTouchdown:
+		return "🏈"
+	case models.PlayFieldGoal:
+		return "🥅"
+	case models.PlaySafety:
+		return "🛡️"
+	case models.PlayTurnover:
+		return "🔄"
+	}
+	return "🚨"
+}
+
+// parsePlayKinds resolves a comma-separated list of play kinds, also returning the first name
+// that matched no kind
+func parsePlayKinds(playList string) ([]string, string) {
+	var kinds []string
+	for _, name := range strings.Split(playList, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		kind, exists := playKindAliases[name]
+		if !exists {
+			return nil, name
+		}
+		if !containsTeam(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds, ""
+}
+
+// handleSlashPlayAlerts handles the /play-alerts slash command (admin only)
+func (b *Bot) handleSlashPlayAlerts(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	subcommand := options[0]
+	switch subcommand.Name {
+	case "add":
+		b.respondPlayAlertsAdd(s, i, subcommand.Options)
+	case "remove":
+		target, ok := b.playAlertTarget(s, i, subcommand.Options)
+		if ok {
+			b.respondPlayAlertsRemove(s, i, target)
+		}
+	case "list":
+		respondEphemeral(s, i, b.playAlertsSummary(i.GuildID))
+	}
+}
+
+// playAlertTarget reads the channel or webhook a /play-alerts subcommand names, telling the
+// admin and returning false unless exactly one of them is valid
+func (b *Bot) playAlertTarget(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) (string, bool) {
+	target, ok := b.subscriptionTarget(s, i, options)
+	if ok && target == "" {
+		respondEphemeral(s, i, b.tr(i, "error.channel_or_webhook"))
+		return "", false
+	}
+	return target, ok
+}
+
+// respondPlayAlertsAdd creates or replaces a channel's or webhook's play alert rule
+func (b *Bot) respondPlayAlertsAdd(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	target, ok := b.playAlertTarget(s, i, options)
+	if !ok {
+		return
+	}
+
+	var teamList, playList string
+	for _, option := range options {
+		switch option.Name {
+		case "teams":
+			teamList = option.StringValue()
+		case "plays":
+			playList = option.StringValue()
+		}
+	}
+
+	teams, unknown := b.resolveTeamList(teamList)
+	if unknown != "" {
+		respondEphemeral(s, i, b.tr(i, "error.unknown_team", unknown))
+		return
+	}
+	plays, unknown := parsePlayKinds(playList)
+	if unknown != "" {
+		respondEphemeral(s, i, b.tr(i, "play_alerts.unknown_play", unknown))
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		if settings.PlayAlerts == nil {
+			settings.PlayAlerts = make(map[string]*PlayAlertRule)
+		}
+		settings.PlayAlerts[target] = &PlayAlertRule{Teams: teams, Plays: plays}
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "play_alerts.save_failed"))
+		return
+	}
+
+	respondEphemeral(s, i, b.tr(i, "play_alerts.added",
+		targetLabel(target), playKindsLabel(plays), slowModeTeamsLabel(teams)))
+}
+
+// respondPlayAlertsRemove deletes a channel's or webhook's play alert rule
+func (b *Bot) respondPlayAlertsRemove(s Responder, i *discordgo.InteractionCreate, target string) {
+	if _, exists := b.settings.Get(i.GuildID).PlayAlerts[target]; !exists {
+		respondEphemeral(s, i, b.tr(i, "play_alerts.no_rule", targetLabel(target)))
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		delete(settings.PlayAlerts, target)
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "play_alerts.remove_failed"))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "play_alerts.removed", targetLabel(target)))
+}
+
+// playAlertsSummary lists a guild's play alert rules
+func (b *Bot) playAlertsSummary(guildID string) string {
+	rules := b.settings.Get(guildID).PlayAlerts
+	if len(rules) == 0 {
+		return "No play alert rules. Add one with `/play-alerts add`."
+	}
+
+	var lines []string
+	for target, rule := range rules {
+		lines = append(lines, fmt.Sprintf("%s — %s during %s", targetLabel(target), playKindsLabel(rule.Plays), slowModeTeamsLabel(rule.Teams)))
+	}
+	sort.Strings(lines)
+	return "🏈 **Play alerts**\n" + strings.Join(lines, "\n")
+}
+
+// playKindsLabel describes which plays a rule alerts on
+func playKindsLabel(kinds []string) string {
+	if len(kinds) == 0 {
+		kinds = models.PlayKinds
+	}
+	labels := make([]string, len(kinds))
+	for index, kind := range kinds {
+		labels[index] = strings.ToLower(models.PlayKindLabel(kind))
+	}
+	return strings.Join(labels, ", ")
+}
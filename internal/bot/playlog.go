@@ -0,0 +1,140 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/pkg/models"
+)
+
+// defaultPlayLogCount is how many plays /plays shows when no count is given
+const defaultPlayLogCount = 10
+
+// playLogMaxCount is the most plays /plays shows at once
+const playLogMaxCount = 25
+
+// handleSlashPlays handles the /plays slash command
+func (b *Bot) handleSlashPlays(s Responder, i *discordgo.InteractionCreate) {
+	var teamName string
+	count := defaultPlayLogCount
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "team":
+			teamName = option.StringValue()
+		case "count":
+			count = int(option.IntValue())
+		}
+	}
+	if teamName == "" {
+		b.respondInteraction(s, i, "Please provide a team name.")
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial plays response", "error", err)
+		return
+	}
+
+	// Process plays request asynchronously
+	go b.processSlashPlaysRequest(s, i, teamName, count)
+}
+
+// processSlashPlaysRequest shows the latest plays of a team's game this week as a drive log
+func (b *Bot) processSlashPlaysRequest(s Responder, i *discordgo.InteractionCreate, teamName string, count int) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting team info for %s", teamName), err))
+		return
+	}
+
+	game, seasonInfo, err := b.currentWeekGame(teamInfo.Key)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting live scores", err))
+		return
+	}
+	switch {
+	case game == nil:
+		b.completeInteraction(s, i, fmt.Sprintf("🛌 The %s %s don't play in %s.", teamInfo.City, teamInfo.Name, seasonInfo.WeekLabel()))
+		return
+	case !game.IsLive() && !game.IsCompleted():
+		b.completeInteraction(s, i, fmt.Sprintf("⏳ %s @ %s hasn't kicked off yet — kickoff is <t:%d:R>. Try `/plays` again once it's underway.",
+			game.AwayTeam, game.HomeTeam, game.GameTime.Unix()))
+		return
+	}
+
+	plays, err := b.nflClient.GetPlayLog(seasonInfo.SeasonType, game)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting play-by-play", err))
+		return
+	}
+	if len(plays) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("No plays have been logged for %s @ %s yet.", game.AwayTeam, game.HomeTeam))
+		return
+	}
+	if len(plays) > count {
+		plays = plays[len(plays)-count:]
+	}
+
+	embed := playLogEmbed(game, plays, b.guildFormat(i.GuildID))
+	themeEmbed(embed, teamInfo)
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending plays embed response", "error", err)
+	}
+}
+
+// playLogEmbed lays out plays oldest first, with a header each time the ball changes hands.
+// The oldest plays are dropped if the log doesn't fit in the description.
+func playLogEmbed(game *models.LiveScore, plays []*models.LoggedPlay, format models.Format) *discordgo.MessageEmbed {
+	status := "Final"
+	if game.IsLive() {
+		status = fmt.Sprintf("%s • %s", game.Quarter, game.TimeRemaining)
+	}
+
+	description := drivePlayLog(plays, format)
+	shown := len(plays)
+	for len(description) > embedDescriptionLimit && shown > 1 {
+		shown--
+		description = drivePlayLog(plays[len(plays)-shown:], format)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📜 %s %s @ %s %s — %s", game.AwayTeam, format.Int(game.AwayScore), game.HomeTeam, format.Int(game.HomeScore), status),
+		Description: description,
+		Color:       embeds.ColorScores,
+		Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Last %d plays • Data provided by SportsData.io", shown)},
+	}
+}
+
+// drivePlayLog formats plays as a drive log, e.g. "**KC ball**" followed by one line per play
+func drivePlayLog(plays []*models.LoggedPlay, format models.Format) string {
+	var lines []string
+	offense := ""
+	for index, play := range plays {
+		if index == 0 || play.Offense != offense {
+			offense = play.Offense
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			if offense != "" {
+				lines = append(lines, fmt.Sprintf("**%s ball**", offense))
+			}
+		}
+
+		line := "`" + playTime(play.Quarter, play.Clock) + "`"
+		if len(play.Kinds) > 0 {
+			line += " " + playKindEmoji(play.Kinds[0])
+		}
+		if situation := play.Situation(format); situation != "" {
+			line += " *" + situation + "*"
+		}
+		line += " — " + play.Description
+		if len(play.Kinds) > 0 && play.Kinds[0] != models.PlayTurnover {
+			line += fmt.Sprintf(" (%s-%s)", format.Int(play.AwayScore), format.Int(play.HomeScore))
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
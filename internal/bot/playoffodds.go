@@ -0,0 +1,141 @@
+package bot
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/pkg/models"
+)
+
+// seasonSimulations is how many seasons /playoffodds and /futures play out for their probabilities
+const seasonSimulations = 5000
+
+// outlookCache keeps one day's simulated season so repeated lookups don't rerun it
+type outlookCache struct {
+	mu       sync.Mutex
+	key      string // season, day, and final game count the outlooks were simulated from
+	outlooks map[string]*standings.Outlook
+}
+
+// newOutlookCache creates an empty outlook cache
+func newOutlookCache() *outlookCache {
+	return &outlookCache{}
+}
+
+// seasonOutlooks returns every team's simulated outlook for a season, re-simulating at most once a
+// day unless new results have come in since
+func (b *Bot) seasonOutlooks(season int) (map[string]*standings.Outlook, error) {
+	teams, games, err := b.regularSeasonResults(season)
+	if err != nil {
+		return nil, err
+	}
+
+	finals := 0
+	for _, game := range games {
+		if standings.IsFinal(game.Status) {
+			finals++
+		}
+	}
+	now := time.Now()
+	key := fmt.Sprintf("%d/%s/%d", season, now.Format("2006-01-02"), finals)
+
+	// Hold the lock while simulating so concurrent requests wait for one run instead of starting their own
+	b.outlooks.mu.Lock()
+	defer b.outlooks.mu.Unlock()
+	if b.outlooks.key == key {
+		return b.outlooks.outlooks, nil
+	}
+
+	// Seeding from the results means the same standings always give the same odds
+	rng := rand.New(rand.NewSource(int64(season)*1000 + int64(finals)))
+	b.outlooks.outlooks = standings.Simulate(teams, games, seasonSimulations, rng)
+	b.outlooks.key = key
+	logger.Info("simulated season outlooks", "season", season, "runs", seasonSimulations, "duration", time.Since(now))
+	return b.outlooks.outlooks, nil
+}
+
+// handleSlashPlayoffOdds handles the /playoffodds slash command
+func (b *Bot) handleSlashPlayoffOdds(s Responder, i *discordgo.InteractionCreate) {
+	var teamName string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "team" {
+			teamName = option.StringValue()
+		}
+	}
+	if teamName == "" {
+		b.respondInteraction(s, i, "Please specify a team. Example: `/playoffodds team:Bills`")
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial playoff odds response", "error", err)
+		return
+	}
+
+	// Process playoff odds request asynchronously
+	go b.processSlashPlayoffOddsRequest(s, i, teamName)
+}
+
+// processSlashPlayoffOddsRequest reports a team's simulated playoff and division chances
+func (b *Bot) processSlashPlayoffOddsRequest(s Responder, i *discordgo.InteractionCreate, teamName string) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding team %s", teamName), err))
+		return
+	}
+
+	table, seasonInfo, err := b.computeStandings()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting standings", err))
+		return
+	}
+
+	outlooks, err := b.seasonOutlooks(seasonInfo.Season)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error simulating the season", err))
+		return
+	}
+	outlook, standing := outlooks[teamInfo.Key], table.Teams[teamInfo.Key]
+	if outlook == nil || standing == nil {
+		b.completeInteraction(s, i, fmt.Sprintf("No simulation results for %s.", teamInfo.Key))
+		return
+	}
+
+	embed := playoffOddsEmbed(seasonInfo.Season, teamInfo, standing, outlook)
+	themeEmbed(embed, teamInfo)
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending playoff odds embed response", "error", err)
+	}
+}
+
+// playoffOddsEmbed shows a team's current position next to its simulated chances
+func playoffOddsEmbed(season int, teamInfo *models.TeamInfo, standing *standings.Standing, outlook *standings.Outlook) *discordgo.MessageEmbed {
+	position := fmt.Sprintf("%s • #%d in the %s", standing.Overall.String(), standing.DivisionRank, standing.Team.DivisionName())
+	if standing.Seed > 0 {
+		position += fmt.Sprintf(" • %s #%d seed", standing.Team.Conference, standing.Seed)
+	}
+	if marker := standing.StatusMarker(); marker != "" {
+		position += fmt.Sprintf(" (%s)", marker)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎲 %s %s %d Playoff Odds", teamInfo.City, teamInfo.Name, season),
+		Description: position,
+		Color:       0x013369,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "🎟️ Make Playoffs", Value: formatProbability(outlook.Playoffs), Inline: true},
+			{Name: "📊 Win Division", Value: formatProbability(outlook.Division), Inline: true},
+			{Name: "📈 Projected Wins", Value: fmt.Sprintf("%.1f", outlook.MeanWins), Inline: true},
+			{Name: "🏈 Win Conference", Value: formatProbability(outlook.Conference), Inline: true},
+			{Name: "🏆 Win Super Bowl", Value: formatProbability(outlook.SuperBowl), Inline: true},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%d simulated seasons from points scored and allowed, refreshed daily and after each final", seasonSimulations),
+		},
+	}
+}
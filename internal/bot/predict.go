@@ -0,0 +1,438 @@
+package bot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/pkg/models"
+)
+
+// predictionsDocument is the storage document holding open and closed prediction polls
+const predictionsDocument = "predictions"
+
+// predictPrefix prefixes the custom IDs of prediction vote buttons
+const predictPrefix = "predict_"
+
+// predictionCheckInterval is how often polls are closed at kickoff and graded after the final
+const predictionCheckInterval = time.Minute
+
+// Prediction is a community vote on the winner of one game
+type Prediction struct {
+	ID         string            `json:"id"`
+	ChannelID  string            `json:"channel_id"`
+	MessageID  string            `json:"message_id"`
+	GameID     string            `json:"game_id"`
+	Season     int               `json:"season"`
+	SeasonType string            `json:"season_type"`
+	Week       int               `json:"week"`
+	AwayTeam   string            `json:"away_team"`
+	HomeTeam   string            `json:"home_team"`
+	Kickoff    time.Time         `json:"kickoff"`
+	Votes      map[string]string `json:"votes"` // user ID -> team
+	Closed     bool              `json:"closed"`
+}
+
+// Tally counts the votes for each team
+func (p *Prediction) Tally() (away, home int) {
+	for _, team := range p.Votes {
+		if team == p.AwayTeam {
+			away++
+		} else if team == p.HomeTeam {
+			home++
+		}
+	}
+	return away, home
+}
+
+// predictionStore keeps prediction polls in memory and persists every change
+type predictionStore struct {
+	mu          sync.Mutex
+	store       *storage.Store
+	predictions map[string]*Prediction
+}
+
+// newPredictionStore loads prediction polls from storage
+func newPredictionStore(store *storage.Store) (*predictionStore, error) {
+	predictions := &predictionStore{
+		store:       store,
+		predictions: make(map[string]*Prediction),
+	}
+	if err := store.Load(predictionsDocument, &predictions.predictions); err != nil {
+		return nil, err
+	}
+	return predictions, nil
+}
+
+// save persists the polls; the caller must hold ps.mu
+func (ps *predictionStore) save() error {
+	if err := ps.store.Save(predictionsDocument, ps.predictions); err != nil {
+		logger.Error("error saving predictions", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Add stores a new poll, assigning its ID
+func (ps *predictionStore) Add(prediction *Prediction) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	prediction.ID = strconv.FormatInt(time.Now().UnixNano()%1e9, 36)
+	ps.predictions[prediction.ID] = prediction
+	return ps.save()
+}
+
+// SetMessageID records the channel message a poll was posted as
+func (ps *predictionStore) SetMessageID(id, messageID string) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if prediction, exists := ps.predictions[id]; exists {
+		prediction.MessageID = messageID
+	}
+	return ps.save()
+}
+
+// Vote records or changes a user's vote, returning a copy of the updated poll
+func (ps *predictionStore) Vote(id, userID, team string, now time.Time) (Prediction, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	prediction, exists := ps.predictions[id]
+	if !exists {
+		return Prediction{}, fmt.Errorf("this poll no longer exists")
+	}
+	if prediction.Closed || !now.Before(prediction.Kickoff) {
+		return Prediction{}, fmt.Errorf("voting closed at kickoff")
+	}
+
+	prediction.Votes[userID] = team
+	return prediction.copy(), ps.save()
+}
+
+// Close marks a poll as closed, returning a copy of it
+func (ps *predictionStore) Close(id string) (Prediction, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	prediction := ps.predictions[id]
+	prediction.Closed = true
+	return prediction.copy(), ps.save()
+}
+
+// Remove deletes a poll once its result has been announced
+func (ps *predictionStore) Remove(id string) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	delete(ps.predictions, id)
+	return ps.save()
+}
+
+// PruneKickoffsBefore deletes polls for games that kicked off before cutoff but were never
+// announced (e.g. postponed games), returning how many were removed
+func (ps *predictionStore) PruneKickoffsBefore(cutoff time.Time) (int, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	pruned := 0
+	for id, prediction := range ps.predictions {
+		if prediction.Kickoff.Before(cutoff) {
+			delete(ps.predictions, id)
+			pruned++
+		}
+	}
+	if pruned == 0 {
+		return 0, nil
+	}
+	return pruned, ps.save()
+}
+
+// All returns copies of every stored poll
+func (ps *predictionStore) All() []Prediction {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	var predictions []Prediction
+	for _, prediction := range ps.predictions {
+		predictions = append(predictions, prediction.copy())
+	}
+	return predictions
+}
+
+// copy returns a deep copy of the poll
+func (p *Prediction) copy() Prediction {
+	copied := *p
+	copied.Votes = make(map[string]string, len(p.Votes))
+	for userID, team := range p.Votes {
+		copied.Votes[userID] = team
+	}
+	return copied
+}
+
+// handleSlashPredict handles the /predict slash command
+func (b *Bot) handleSlashPredict(s Responder, i *discordgo.InteractionCreate) {
+	matchup := i.ApplicationCommandData().Options[0].StringValue()
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial predict response", "error", err)
+		return
+	}
+
+	// Process predict request asynchronously
+	go b.processSlashPredictRequest(s, i, matchup)
+}
+
+// processSlashPredictRequest finds the matchup in the current week and posts its poll
+func (b *Bot) processSlashPredictRequest(s Responder, i *discordgo.InteractionCreate, matchup string) {
+	teamName := matchupTeamName(matchup)
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding team %s", teamName), err))
+		return
+	}
+
+	game, seasonInfo, err := b.currentWeekGame(teamInfo.Key)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting this week's games", err))
+		return
+	}
+	if game == nil {
+		b.completeInteraction(s, i, fmt.Sprintf("The %s %s don't play in %s.", teamInfo.City, teamInfo.Name, seasonInfo.WeekLabel()))
+		return
+	}
+	if game.GameTime.IsZero() || !time.Now().Before(game.GameTime) || game.IsLive() || standings.IsFinal(game.Status) {
+		b.completeInteraction(s, i, fmt.Sprintf("%s @ %s has already kicked off — predictions are closed.", game.AwayTeam, game.HomeTeam))
+		return
+	}
+
+	// The poll is posted to the channel directly so it stays public even when slash responses are ephemeral
+	if err := b.postPrediction(i.ChannelID, seasonInfo, game); err != nil {
+		b.completeInteraction(s, i, b.tr(i, "predict.poll_failed"))
+		return
+	}
+
+	b.completeInteraction(s, i, fmt.Sprintf("🗳️ Poll posted for %s @ %s — voting closes at kickoff (<t:%d:t>).",
+		game.AwayTeam, game.HomeTeam, game.GameTime.Unix()))
+}
+
+// postPrediction creates a prediction poll for a game and posts it in a channel
+func (b *Bot) postPrediction(channelID string, seasonInfo *models.SeasonInfo, game *models.LiveScore) error {
+	prediction := &Prediction{
+		ChannelID:  channelID,
+		GameID:     game.GameID,
+		Season:     seasonInfo.Season,
+		SeasonType: seasonInfo.SeasonType,
+		Week:       seasonInfo.Week,
+		AwayTeam:   game.AwayTeam,
+		HomeTeam:   game.HomeTeam,
+		Kickoff:    game.GameTime,
+		Votes:      make(map[string]string),
+	}
+	if err := b.predictions.Add(prediction); err != nil {
+		return err
+	}
+
+	message, err := b.discord.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{predictionEmbed(prediction)},
+		Components: predictionComponents(prediction),
+	})
+	if err != nil {
+		logger.Error("error posting prediction poll", "channel", channelID, "error", err)
+		b.predictions.Remove(prediction.ID)
+		return err
+	}
+
+	b.predictions.SetMessageID(prediction.ID, message.ID)
+	return nil
+}
+
+// predictionEmbed renders a poll with its current tally
+func predictionEmbed(prediction *Prediction) *discordgo.MessageEmbed {
+	away, home := prediction.Tally()
+	total := away + home
+
+	share := func(votes int) string {
+		if total == 0 {
+			return "0 votes"
+		}
+		return fmt.Sprintf("%d vote(s) • %.0f%%", votes, float64(votes)*100/float64(total))
+	}
+
+	status := fmt.Sprintf("Voting closes at kickoff <t:%d:R>", prediction.Kickoff.Unix())
+	if prediction.Closed {
+		status = "🔒 Voting closed — results will be posted after the final whistle"
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🗳️ Who wins? %s @ %s", prediction.AwayTeam, prediction.HomeTeam),
+		Description: status,
+		Color:       0x013369,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: prediction.AwayTeam, Value: share(away), Inline: true},
+			{Name: prediction.HomeTeam, Value: share(home), Inline: true},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: models.WeekLabel(prediction.SeasonType, prediction.Week),
+		},
+	}
+}
+
+// predictionComponents renders the team vote buttons, disabled once voting closes
+func predictionComponents(prediction *Prediction) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    prediction.AwayTeam,
+					Style:    discordgo.PrimaryButton,
+					CustomID: fmt.Sprintf("%svote:%s:%s", predictPrefix, prediction.ID, prediction.AwayTeam),
+					Disabled: prediction.Closed,
+				},
+				discordgo.Button{
+					Label:    prediction.HomeTeam,
+					Style:    discordgo.DangerButton,
+					CustomID: fmt.Sprintf("%svote:%s:%s", predictPrefix, prediction.ID, prediction.HomeTeam),
+					Disabled: prediction.Closed,
+				},
+			},
+		},
+	}
+}
+
+// handlePredictComponent records a vote and refreshes the poll's tally
+func (b *Bot) handlePredictComponent(s Responder, i *discordgo.InteractionCreate) {
+	parts := strings.Split(strings.TrimPrefix(i.MessageComponentData().CustomID, predictPrefix), ":")
+	if len(parts) != 3 || parts[0] != "vote" {
+		return
+	}
+
+	prediction, err := b.predictions.Vote(parts[1], interactionUserID(i), parts[2], time.Now())
+	if err != nil {
+		respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{predictionEmbed(&prediction)},
+			Components: predictionComponents(&prediction),
+		},
+	})
+	if err != nil {
+		logger.Error("error updating prediction poll", "error", err)
+	}
+}
+
+// runPredictionWatcher closes polls at kickoff and announces results once games are final
+func (b *Bot) runPredictionWatcher() {
+	ticker := time.NewTicker(predictionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.checkPredictions()
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// checkPredictions closes and grades every stored poll that is due
+func (b *Bot) checkPredictions() {
+	now := time.Now()
+	for _, prediction := range b.predictions.All() {
+		if !prediction.Closed {
+			if now.Before(prediction.Kickoff) {
+				continue
+			}
+			closed, err := b.predictions.Close(prediction.ID)
+			if err != nil {
+				continue
+			}
+			b.refreshPredictionMessage(&closed)
+			continue
+		}
+		if b.upstreamDown() {
+			continue // grade once fresh scores are available
+		}
+
+		games, err := b.nflClient.GetScoresForWeek(prediction.Season, prediction.SeasonType, prediction.Week)
+		if err != nil {
+			logger.Error("error checking prediction", "prediction", prediction.ID, "error", err)
+			continue
+		}
+		for _, game := range games {
+			if game.GameID == prediction.GameID && standings.IsFinal(game.Status) {
+				b.announcePrediction(&prediction, game)
+				b.predictions.Remove(prediction.ID)
+				break
+			}
+		}
+	}
+}
+
+// refreshPredictionMessage re-renders a poll message after it changes outside an interaction
+func (b *Bot) refreshPredictionMessage(prediction *Prediction) {
+	if prediction.MessageID == "" {
+		return
+	}
+
+	embeds := []*discordgo.MessageEmbed{predictionEmbed(prediction)}
+	components := predictionComponents(prediction)
+	_, err := b.discord.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         prediction.MessageID,
+		Channel:    prediction.ChannelID,
+		Embeds:     &embeds,
+		Components: &components,
+	})
+	if err != nil {
+		logger.Error("error closing prediction poll", "prediction", prediction.ID, "error", err)
+	}
+}
+
+// announcePrediction posts the final score and how accurate the community was
+func (b *Bot) announcePrediction(prediction *Prediction, game *models.LiveScore) {
+	away, home := prediction.Tally()
+	total := away + home
+
+	var winner string
+	correct := 0
+	switch {
+	case game.AwayScore > game.HomeScore:
+		winner, correct = game.AwayTeam, away
+	case game.HomeScore > game.AwayScore:
+		winner, correct = game.HomeTeam, home
+	}
+
+	result := fmt.Sprintf("It ended in a %d-%d tie — nobody called that one!", game.AwayScore, game.HomeScore)
+	if winner != "" {
+		result = fmt.Sprintf("**%s** won. ", winner)
+		if total == 0 {
+			result += "Nobody voted."
+		} else {
+			result += fmt.Sprintf("The community was **%.0f%%** accurate (%d of %d votes).",
+				float64(correct)*100/float64(total), correct, total)
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏁 Final: %s %d @ %s %d", game.AwayTeam, game.AwayScore, game.HomeTeam, game.HomeScore),
+		Description: result,
+		Color:       0x2ecc71,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: models.WeekLabel(prediction.SeasonType, prediction.Week),
+		},
+	}
+
+	b.postAutomated(prediction.ChannelID, prediction.MessageID, embed)
+}
@@ -0,0 +1,145 @@
+package bot
+
+import (
+	"sync"
+	"time"
+
+	"nfl-discord-bot/internal/storage"
+)
+
+// userPreferencesDocument is the storage document holding per-user preferences
+const userPreferencesDocument = "user_preferences"
+
+// UserPreferences holds what an individual user has chosen to follow and how they see times
+type UserPreferences struct {
+	Teams    []string `json:"teams,omitempty"`    // followed team abbreviations
+	Timezone string   `json:"timezone,omitempty"` // IANA zone overriding the server's for game times
+
+	FavoriteTeam   string `json:"favorite_team,omitempty"`   // team abbreviation used when a command's team is left out
+	FavoritePlayer string `json:"favorite_player,omitempty"` // player name, as resolved from season stats, used when a command's player is left out
+
+	WatchPlayers  []string `json:"watch_players,omitempty"`  // watch list player names as resolved from season stats
+	WatchTeams    []string `json:"watch_teams,omitempty"`    // watch list team abbreviations
+	WeeklySummary bool     `json:"weekly_summary,omitempty"` // DM a watch list summary every Monday
+
+	Comparisons []SavedComparison `json:"comparisons,omitempty"` // recent /compare pairings, newest first
+
+	Players []FollowedPlayer `json:"players,omitempty"` // players whose stat line is sent after each game
+}
+
+// FollowedPlayer is a player whose weekly stat line a user gets once the player's game is final
+type FollowedPlayer struct {
+	PlayerID  int    `json:"player_id"`
+	Name      string `json:"name"`                 // as resolved from season stats
+	ChannelID string `json:"channel_id,omitempty"` // channel ID or webhook URL; empty means send by DM
+	GuildID   string `json:"guild_id,omitempty"`   // server followed from, whose spoiler settings apply
+	LastSent  string `json:"last_sent,omitempty"`  // week of the last stat line sent, see digestWeekKey
+}
+
+// SavedComparison is a /compare the user ran, kept so it can be re-run with fresh data
+type SavedComparison struct {
+	Player1 string    `json:"player1"` // names as resolved from the stats
+	Player2 string    `json:"player2"`
+	Type    string    `json:"type"`               // "current" or "season"
+	Week    int       `json:"week,omitempty"`     // specific week, 0 for the current one
+	PerGame bool      `json:"per_game,omitempty"` // season totals divided by games played
+	At      time.Time `json:"at"`
+}
+
+// FollowsTeam reports whether the team abbreviation is in the user's followed teams
+func (p UserPreferences) FollowsTeam(key string) bool {
+	for _, team := range p.Teams {
+		if team == key {
+			return true
+		}
+	}
+	return false
+}
+
+// preferencesStore keeps user preferences in memory and persists every change
+type preferencesStore struct {
+	mu    sync.RWMutex
+	store *storage.Store
+	users map[string]*UserPreferences
+}
+
+// newPreferencesStore loads user preferences from storage
+func newPreferencesStore(store *storage.Store) (*preferencesStore, error) {
+	preferences := &preferencesStore{
+		store: store,
+		users: make(map[string]*UserPreferences),
+	}
+	if err := store.Load(userPreferencesDocument, &preferences.users); err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// Get returns a copy of a user's preferences (zero values when the user has none)
+func (ps *preferencesStore) Get(userID string) UserPreferences {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	if preferences, exists := ps.users[userID]; exists {
+		return preferences.clone()
+	}
+	return UserPreferences{}
+}
+
+// clone copies preferences so callers cannot modify the stored slices
+func (p *UserPreferences) clone() UserPreferences {
+	copied := *p
+	copied.Teams = append([]string(nil), p.Teams...)
+	copied.WatchPlayers = append([]string(nil), p.WatchPlayers...)
+	copied.WatchTeams = append([]string(nil), p.WatchTeams...)
+	copied.Comparisons = append([]SavedComparison(nil), p.Comparisons...)
+	copied.Players = append([]FollowedPlayer(nil), p.Players...)
+	return copied
+}
+
+// SummarySubscribers returns a copy of the preferences of every user who opted into the weekly summary
+func (ps *preferencesStore) SummarySubscribers() map[string]UserPreferences {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	subscribers := make(map[string]UserPreferences)
+	for userID, preferences := range ps.users {
+		if preferences.WeeklySummary {
+			subscribers[userID] = preferences.clone()
+		}
+	}
+	return subscribers
+}
+
+// PlayerFollowers returns a copy of the preferences of every user who follows a player
+func (ps *preferencesStore) PlayerFollowers() map[string]UserPreferences {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	followers := make(map[string]UserPreferences)
+	for userID, preferences := range ps.users {
+		if len(preferences.Players) > 0 {
+			followers[userID] = preferences.clone()
+		}
+	}
+	return followers
+}
+
+// Update applies a change to a user's preferences and persists the result
+func (ps *preferencesStore) Update(userID string, change func(*UserPreferences)) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	preferences, exists := ps.users[userID]
+	if !exists {
+		preferences = &UserPreferences{}
+		ps.users[userID] = preferences
+	}
+	change(preferences)
+
+	if err := ps.store.Save(userPreferencesDocument, ps.users); err != nil {
+		logger.Error("error saving user preferences", "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package bot
+
+import (
+	"math"
+	"time"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// refreshTick is how often the prefetcher checks for due refresh jobs
+const refreshTick = time.Minute
+
+// Live job intervals while games are on; outside game windows they fall back to STATS_UPDATE_INTERVAL
+const (
+	gameWindowScoresInterval = time.Minute
+	gameWindowStatsInterval  = 5 * time.Minute
+)
+
+// gameWindowLead is how long before kickoff live refreshes speed up
+const gameWindowLead = 30 * time.Minute
+
+// gameWindowLength is how long after kickoff a game that hasn't gone final counts as in progress
+const gameWindowLength = 4 * time.Hour
+
+// backgroundQuotaShare is the share of the remaining monthly API quota background refreshes may
+// spend, leaving the rest for user commands
+const backgroundQuotaShare = 0.5
+
+// teamsRefreshInterval is how often the team list is re-fetched
+const teamsRefreshInterval = 24 * time.Hour
+
+// playersRefreshInterval is how often the active player directory used to resolve names is re-fetched
+const playersRefreshInterval = 24 * time.Hour
+
+// runPrefetcher keeps scores, stats, teams, players, and schedules warm in the cache so user commands
+// rarely wait on a live API round trip. Live-game endpoints refresh every minute during game
+// windows and are dispatched ahead of static data when the API quota is tight.
+func (b *Bot) runPrefetcher(interval time.Duration) {
+	if interval <= 0 {
+		logger.Info("prefetcher disabled (STATS_UPDATE_INTERVAL is 0)")
+		return
+	}
+
+	pool := newRefreshPool(b.done)
+	pool.jobs = b.refreshJobs(pool, interval)
+	pool.credit = float64(pool.fullRunCost()) // warm everything once at startup
+	b.prefetch(pool)
+
+	ticker := time.NewTicker(refreshTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.prefetch(pool)
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// refreshJobs defines the pool's background refreshes, from live scores down to static team data
+func (b *Bot) refreshJobs(pool *refreshPool, interval time.Duration) []*refreshJob {
+	scheduleInterval := b.config.ScheduleUpdateInterval
+	if scheduleInterval <= 0 {
+		scheduleInterval = teamsRefreshInterval
+	}
+
+	return []*refreshJob{
+		{
+			name:     "scores",
+			priority: refreshLive,
+			interval: liveInterval(gameWindowScoresInterval, interval),
+			cost:     fixedCost(2), // the season, then the week's scores
+			run: func() error {
+				games, err := b.nflClient.RefreshScores()
+				if err != nil {
+					return err
+				}
+				pool.gameWindow.Store(inGameWindow(games, time.Now()))
+				pool.liveGames.Store(int32(countLive(games)))
+
+				// Fresh scores are in place, so grade pick'em games and rate teams on games that have gone final
+				b.gradePickem()
+				b.schedulePickemReminders()
+				if err := b.updateEloRatings(games); err != nil {
+					logger.Warn("error updating elo ratings", "error", err)
+				}
+				return nil
+			},
+		},
+		{
+			name:     "plays",
+			priority: refreshLive,
+			interval: liveInterval(gameWindowScoresInterval, interval),
+			cost: func() int {
+				if len(b.settings.PlayAlertRules()) == 0 {
+					return 0
+				}
+				// The season and the week's scores, then the play-by-play of each live game
+				return 2 + int(pool.liveGames.Load())
+			},
+			run: b.pollScoringPlays,
+		},
+		{
+			name:     "week_stats",
+			priority: refreshLive,
+			interval: liveInterval(gameWindowStatsInterval, interval),
+			cost:     fixedCost(2), // the season, then the week's stat sheet
+			run:      b.nflClient.RefreshWeekStats,
+		},
+		{
+			name:     "followed_players",
+			priority: refreshLive,
+			interval: liveInterval(gameWindowStatsInterval, interval),
+			cost: func() int {
+				if len(b.preferences.PlayerFollowers()) == 0 {
+					return 0
+				}
+				// The season, the week's scores, and the week's stat sheet
+				return 3
+			},
+			run: b.sendFollowedPlayerLines,
+		},
+		{
+			name:     "closing_lines",
+			priority: refreshWeekly,
+			interval: fixedInterval(interval),
+			cost:     fixedCost(3), // the season, then the regular season and playoff schedules
+			run: func() error {
+				seasonInfo, err := b.nflClient.GetCurrentSeason()
+				if err != nil {
+					return err
+				}
+				return b.archiveClosingLines(seasonInfo.Season)
+			},
+		},
+		{
+			name:     "schedule",
+			priority: refreshStatic,
+			interval: fixedInterval(scheduleInterval),
+			cost:     fixedCost(2), // the season, then its schedule
+			run:      b.nflClient.RefreshSchedule,
+		},
+		{
+			name:     "teams",
+			priority: refreshStatic,
+			interval: fixedInterval(teamsRefreshInterval),
+			run:      b.nflClient.RefreshTeams,
+		},
+		{
+			name:     "players",
+			priority: refreshStatic,
+			interval: fixedInterval(playersRefreshInterval),
+			run:      b.nflClient.RefreshPlayers,
+		},
+	}
+}
+
+// liveInterval refreshes at gameWindow pace while games are on and at idle pace otherwise
+func liveInterval(gameWindow, idle time.Duration) func(bool) time.Duration {
+	return func(inWindow bool) time.Duration {
+		if inWindow && gameWindow < idle {
+			return gameWindow
+		}
+		return idle
+	}
+}
+
+// fixedInterval refreshes at the same pace regardless of games
+func fixedInterval(interval time.Duration) func(bool) time.Duration {
+	return func(bool) time.Duration {
+		return interval
+	}
+}
+
+// countLive counts the games in progress
+func countLive(games []*models.LiveScore) int {
+	live := 0
+	for _, game := range games {
+		if game.IsLive() {
+			live++
+		}
+	}
+	return live
+}
+
+// fixedCost estimates the same number of calls for every run
+func fixedCost(calls int) func() int {
+	return func() int {
+		return calls
+	}
+}
+
+// inGameWindow reports whether any game is live, about to kick off, or should still be in progress
+func inGameWindow(games []*models.LiveScore, now time.Time) bool {
+	for _, game := range games {
+		if game.IsLive() {
+			return true
+		}
+		if game.IsCompleted() || game.GameTime.IsZero() {
+			continue
+		}
+		if now.After(game.GameTime.Add(-gameWindowLead)) && now.Before(game.GameTime.Add(gameWindowLength)) {
+			return true
+		}
+	}
+	return false
+}
+
+// prefetch dispatches the due refresh jobs within this tick's request budget
+func (b *Bot) prefetch(pool *refreshPool) {
+	if b.upstreamDown() {
+		logger.Warn("skipping prefetch while the NFL API is unavailable")
+		return
+	}
+
+	budget := b.refreshBudget(pool, time.Now())
+	ran, deferred, spent := pool.dispatch(time.Now(), budget, b.done)
+	if budget >= 0 {
+		pool.credit -= float64(spent)
+	}
+	if len(deferred) > 0 {
+		logger.Debug("api quota tight, deferring lower-priority refreshes", "ran", ran, "deferred", deferred, "game_window", pool.gameWindow.Load())
+	}
+}
+
+// refreshBudget returns how many API calls background refreshes may make this tick, spreading
+// their share of the calls left in NFL_API_MONTHLY_QUOTA evenly over the rest of the month.
+// Unused allowance carries over, up to one run of every job at its estimated cost. Returns -1
+// when no quota is set.
+func (b *Bot) refreshBudget(pool *refreshPool, now time.Time) int {
+	quota := b.config.NFLAPIMonthlyQuota
+	if quota <= 0 {
+		return -1
+	}
+	remaining := int64(quota) - b.nflClient.Usage().MonthAPICalls
+	if remaining <= 0 {
+		return 0
+	}
+
+	utc := now.UTC()
+	monthEnd := time.Date(utc.Year(), utc.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	allowance := backgroundQuotaShare * float64(remaining) * float64(refreshTick) / float64(monthEnd.Sub(utc))
+	pool.credit = math.Min(pool.credit+allowance, float64(pool.fullRunCost()))
+	return int(pool.credit)
+}
@@ -0,0 +1,209 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// handleSlashRecap handles the /recap slash command
+func (b *Bot) handleSlashRecap(s Responder, i *discordgo.InteractionCreate) {
+	week := 0
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "week" {
+			week = int(option.IntValue())
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial recap response", "error", err)
+		return
+	}
+
+	// Process recap request asynchronously
+	go b.processSlashRecapRequest(s, i, week)
+}
+
+// processSlashRecapRequest summarizes a completed week and completes the deferred response. Week 0
+// means the most recent completed week.
+func (b *Bot) processSlashRecapRequest(s Responder, i *discordgo.InteractionCreate, week int) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+
+	target := &models.SeasonInfo{Season: seasonInfo.Season, SeasonType: seasonInfo.SeasonType, Week: week}
+	if week > 0 || target.SeasonType == models.SeasonTypePreseason {
+		target.SeasonType = models.SeasonTypeRegular
+	}
+	if week == 0 {
+		target.Week = seasonInfo.Week
+	}
+
+	scores, err := b.nflClient.GetScoresForWeek(target.Season, target.SeasonType, target.Week)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting %s scores", target.WeekLabel()), err))
+		return
+	}
+	if !digestWeekFinished(scores) && week == 0 && target.Week > 1 {
+		// The current week is still being played, so recap the one before it
+		target.Week--
+		scores, err = b.nflClient.GetScoresForWeek(target.Season, target.SeasonType, target.Week)
+		if err != nil {
+			b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting %s scores", target.WeekLabel()), err))
+			return
+		}
+	}
+	if !digestWeekFinished(scores) {
+		b.completeInteraction(s, i, fmt.Sprintf("⏳ %s isn't complete yet. Try `/scores` for games in progress.", target.WeekLabel()))
+		return
+	}
+
+	sheet, err := b.nflClient.GetWeekStats(target.Season, target.SeasonType, target.Week)
+	if err != nil {
+		logger.Warn("recap without player leaders", "week", target.WeekLabel(), "error", err)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:  fmt.Sprintf("📰 %d %s Recap", target.Season, target.WeekLabel()),
+		Color:  0x013369,
+		Fields: recapGameFields(scores),
+	}
+	if leaders := recapLeaders(sheet, b.guildFormat(i.GuildID)); leaders != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "⭐ Top Performers", Value: leaders, Inline: false})
+	}
+	if upsets := b.recapUpsets(target, scores); upsets != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "😱 Upsets", Value: upsets, Inline: false})
+	}
+
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending recap embed response", "error", err)
+	}
+}
+
+// recapGameFields picks out the week's biggest blowout, closest game, and highest-scoring game
+func recapGameFields(scores []*models.LiveScore) []*discordgo.MessageEmbedField {
+	var blowout, closest, shootout *models.LiveScore
+	for _, score := range scores {
+		if score.State() != models.StateFinal {
+			continue
+		}
+		if blowout == nil || recapMargin(score) > recapMargin(blowout) {
+			blowout = score
+		}
+		if closest == nil || recapMargin(score) < recapMargin(closest) {
+			closest = score
+		}
+		if shootout == nil || score.HomeScore+score.AwayScore > shootout.HomeScore+shootout.AwayScore {
+			shootout = score
+		}
+	}
+	if blowout == nil {
+		return nil
+	}
+
+	return []*discordgo.MessageEmbedField{
+		{Name: "💥 Biggest Blowout", Value: fmt.Sprintf("%s (by %d)", recapScoreLine(blowout), recapMargin(blowout)), Inline: false},
+		{Name: "😬 Closest Game", Value: fmt.Sprintf("%s (by %d)", recapScoreLine(closest), recapMargin(closest)), Inline: false},
+		{Name: "🔥 Highest Scoring", Value: fmt.Sprintf("%s (%d points)", recapScoreLine(shootout), shootout.HomeScore+shootout.AwayScore), Inline: false},
+	}
+}
+
+// recapMargin is a final's margin of victory
+func recapMargin(score *models.LiveScore) int {
+	margin := score.HomeScore - score.AwayScore
+	if margin < 0 {
+		return -margin
+	}
+	return margin
+}
+
+// recapScoreLine formats a final with the winner in bold, e.g. "**BUF 31** - 10 MIA"
+func recapScoreLine(score *models.LiveScore) string {
+	away := fmt.Sprintf("%s %d", score.AwayTeam, score.AwayScore)
+	home := fmt.Sprintf("%d %s", score.HomeScore, score.HomeTeam)
+	switch {
+	case score.AwayScore > score.HomeScore:
+		away = "**" + away + "**"
+	case score.HomeScore > score.AwayScore:
+		home = "**" + home + "**"
+	}
+	return away + " @ " + home
+}
+
+// recapLeaders names the week's top passer, rusher, and receiver by yards
+func recapLeaders(sheet []*models.PlayerStats, format models.Format) string {
+	var passer, rusher, receiver *models.PlayerStats
+	for _, stats := range sheet {
+		if passer == nil || stats.Passing.Yards > passer.Passing.Yards {
+			passer = stats
+		}
+		if rusher == nil || stats.Rushing.Yards > rusher.Rushing.Yards {
+			rusher = stats
+		}
+		if receiver == nil || stats.Receiving.Yards > receiver.Receiving.Yards {
+			receiver = stats
+		}
+	}
+
+	var lines []string
+	if passer != nil && passer.Passing.Yards > 0 {
+		lines = append(lines, fmt.Sprintf("🎯 **%s** (%s) — %s pass %s, %d TD, %d INT",
+			passer.Name, passer.Team, format.Distance(passer.Passing.Yards), format.Unit(), passer.Passing.Touchdowns, passer.Passing.Interceptions))
+	}
+	if rusher != nil && rusher.Rushing.Yards > 0 {
+		lines = append(lines, fmt.Sprintf("🏃 **%s** (%s) — %s rush %s, %d TD",
+			rusher.Name, rusher.Team, format.Distance(rusher.Rushing.Yards), format.Unit(), rusher.Rushing.Touchdowns))
+	}
+	if receiver != nil && receiver.Receiving.Yards > 0 {
+		lines = append(lines, fmt.Sprintf("🙌 **%s** (%s) — %d rec, %s %s, %d TD",
+			receiver.Name, receiver.Team, receiver.Receiving.Receptions, format.Distance(receiver.Receiving.Yards), format.Unit(), receiver.Receiving.Touchdowns))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// recapUpsets lists finals the pregame underdog won, using the schedule's closing spreads. It's
+// empty when no lines were posted for the week.
+func (b *Bot) recapUpsets(target *models.SeasonInfo, scores []*models.LiveScore) string {
+	games, err := b.nflClient.GetSeasonGames(target.Season, target.SeasonType)
+	if err != nil {
+		logger.Warn("recap without upsets", "week", target.WeekLabel(), "error", err)
+		return ""
+	}
+
+	spreads := make(map[string]float64)
+	for _, game := range games {
+		if game.Week == target.Week && game.PointSpread != nil {
+			spreads[game.AwayTeam+"@"+game.HomeTeam] = *game.PointSpread
+		}
+	}
+
+	var lines []string
+	for _, score := range scores {
+		spread, posted := spreads[score.AwayTeam+"@"+score.HomeTeam]
+		if !posted || spread == 0 || score.State() != models.StateFinal {
+			continue
+		}
+		// A negative spread means the home team was favored
+		homeFavored := spread < 0
+		if (homeFavored && score.AwayScore > score.HomeScore) || (!homeFavored && score.HomeScore > score.AwayScore) {
+			underdog := score.AwayTeam
+			if !homeFavored {
+				underdog = score.HomeTeam
+			}
+			points := spread
+			if points < 0 {
+				points = -points
+			}
+			lines = append(lines, fmt.Sprintf("%s as a %s-point underdog: %s", underdog, strings.TrimSuffix(fmt.Sprintf("%.1f", points), ".0"), recapScoreLine(score)))
+		}
+	}
+	if len(lines) == 0 && len(spreads) > 0 {
+		return "None — every favorite won."
+	}
+	return strings.Join(lines, "\n")
+}
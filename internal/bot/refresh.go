@@ -0,0 +1,162 @@
+package bot
+
+import (
+	"container/heap"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"nfl-discord-bot/internal/nfl"
+)
+
+// refreshPriority orders background refresh jobs; lower values are dispatched first and keep
+// running when the request budget can't cover every due job
+type refreshPriority int
+
+const (
+	refreshLive   refreshPriority = iota // scores and live stats
+	refreshWeekly                        // week-level data such as closing lines
+	refreshStatic                        // teams and schedules
+)
+
+// refreshWorkers is how many refresh jobs may call the API at once
+const refreshWorkers = 2
+
+// rateLimitBackoff is how long a job waits before retrying after the API rate limited it
+const rateLimitBackoff = 5 * time.Minute
+
+// refreshJob is a recurring background refresh of one or more API endpoints
+type refreshJob struct {
+	name     string
+	priority refreshPriority
+	interval func(gameWindow bool) time.Duration
+	cost     func() int // estimated API calls per run; nil means one
+	run      func() error
+	next     time.Time
+}
+
+// calls is how many API calls the job is expected to make on its next run
+func (job *refreshJob) calls() int {
+	if job.cost == nil {
+		return 1
+	}
+	return job.cost()
+}
+
+// refreshQueue is a priority queue of due jobs: highest priority first, then the longest overdue
+type refreshQueue []*refreshJob
+
+func (q refreshQueue) Len() int { return len(q) }
+
+func (q refreshQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority < q[j].priority
+	}
+	return q[i].next.Before(q[j].next)
+}
+
+func (q refreshQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *refreshQueue) Push(job any) { *q = append(*q, job.(*refreshJob)) }
+
+func (q *refreshQueue) Pop() any {
+	old := *q
+	job := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return job
+}
+
+// refreshPool runs due refresh jobs on a fixed set of workers in priority order, within a
+// per-tick budget of API calls
+type refreshPool struct {
+	jobs       []*refreshJob
+	work       chan *refreshJob
+	pending    sync.WaitGroup
+	credit     float64      // unspent call allowance carried between ticks
+	gameWindow atomic.Bool  // set by the scores job; shortens live job intervals
+	liveGames  atomic.Int32 // set by the scores job; live jobs that poll each game cost more
+}
+
+// fullRunCost is the calls needed to run every job once, the most credit worth carrying over
+func (p *refreshPool) fullRunCost() int {
+	total := 0
+	for _, job := range p.jobs {
+		total += job.calls()
+	}
+	return total
+}
+
+// newRefreshPool starts the workers, which exit when done is closed
+func newRefreshPool(done <-chan struct{}) *refreshPool {
+	pool := &refreshPool{work: make(chan *refreshJob)}
+	for worker := 0; worker < refreshWorkers; worker++ {
+		go pool.runWorker(done)
+	}
+	return pool
+}
+
+// runWorker runs jobs handed to it by dispatch
+func (p *refreshPool) runWorker(done <-chan struct{}) {
+	for {
+		select {
+		case job := <-p.work:
+			start := time.Now()
+			err := job.run()
+			switch {
+			case errors.Is(err, nfl.ErrRateLimited):
+				// Calling again at the job's usual pace would only extend the limit
+				job.next = time.Now().Add(rateLimitBackoff)
+				logger.Warn("background refresh rate limited", "job", job.name, "retry_in", rateLimitBackoff)
+			case nfl.Retryable(err):
+				logger.Warn("background refresh skipped, api unavailable", "job", job.name, "error", err)
+			case err != nil:
+				logger.Error("background refresh failed", "job", job.name, "error", err)
+			default:
+				logger.Debug("background refresh complete", "job", job.name, "latency", time.Since(start).Round(time.Millisecond))
+			}
+			p.pending.Done()
+		case <-done:
+			return
+		}
+	}
+}
+
+// dispatch runs every due job in priority order until the budget of API calls (negative for
+// unlimited) can't cover the next job's cost, waits for them to finish, and returns the jobs it
+// ran, the ones left due for next tick, and the calls it spent. Once a job is deferred every job
+// behind it is too, so cheap static refreshes can't starve an expensive live one.
+func (p *refreshPool) dispatch(now time.Time, budget int, done <-chan struct{}) (ran, deferred []string, spent int) {
+	var queue refreshQueue
+	for _, job := range p.jobs {
+		if !job.next.After(now) {
+			heap.Push(&queue, job)
+		}
+	}
+
+	for queue.Len() > 0 {
+		job := heap.Pop(&queue).(*refreshJob)
+		cost := job.calls()
+		if len(deferred) > 0 || (budget >= 0 && cost > budget) {
+			deferred = append(deferred, job.name)
+			continue
+		}
+		if budget >= 0 {
+			budget -= cost
+		}
+		spent += cost
+
+		job.next = now.Add(job.interval(p.gameWindow.Load()))
+		p.pending.Add(1)
+		select {
+		case p.work <- job:
+			ran = append(ran, job.name)
+		case <-done:
+			p.pending.Done()
+			return ran, deferred, spent
+		}
+	}
+
+	p.pending.Wait()
+	return ran, deferred, spent
+}
@@ -0,0 +1,50 @@
+package bot
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDispatchSpendsEachJobsCost(t *testing.T) {
+	now := time.Now()
+	newPool := func(done chan struct{}) *refreshPool {
+		pool := newRefreshPool(done)
+		pool.liveGames.Store(3)
+		noop := func() error { return nil }
+		pool.jobs = []*refreshJob{
+			{name: "scores", priority: refreshLive, interval: fixedInterval(time.Minute), cost: fixedCost(2), run: noop, next: now.Add(-2 * time.Minute)},
+			{name: "plays", priority: refreshLive, interval: fixedInterval(time.Minute), cost: func() int { return 2 + int(pool.liveGames.Load()) }, run: noop, next: now.Add(-time.Minute)},
+			{name: "teams", priority: refreshStatic, interval: fixedInterval(time.Hour), run: noop, next: now},
+		}
+		return pool
+	}
+
+	tests := []struct {
+		name         string
+		budget       int
+		wantRan      []string
+		wantDeferred []string
+		wantSpent    int
+	}{
+		// Plays polls three live games, so it needs 5 of the 2 calls left after scores, and the
+		// one-call teams job waits behind it rather than jumping the queue
+		{"tight budget", 4, []string{"scores"}, []string{"plays", "teams"}, 2},
+		{"enough for everything", 8, []string{"scores", "plays", "teams"}, nil, 8},
+		{"unlimited", -1, []string{"scores", "plays", "teams"}, nil, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done := make(chan struct{})
+			defer close(done)
+			pool := newPool(done)
+
+			ran, deferred, spent := pool.dispatch(now, tt.budget, done)
+			if !reflect.DeepEqual(ran, tt.wantRan) || !reflect.DeepEqual(deferred, tt.wantDeferred) || spent != tt.wantSpent {
+				t.Errorf("dispatch(budget %d) = ran %v, deferred %v, spent %d; want ran %v, deferred %v, spent %d",
+					tt.budget, ran, deferred, spent, tt.wantRan, tt.wantDeferred, tt.wantSpent)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// commandRegistrar keeps Discord's registered slash commands in line with the bot's definitions
+type commandRegistrar struct {
+	session *discordgo.Session
+	guildID string // empty registers globally
+
+	registered []*discordgo.ApplicationCommand
+}
+
+// newCommandRegistrar creates a registrar for global commands, or for one guild when guildID is set
+func newCommandRegistrar(session *discordgo.Session, guildID string) *commandRegistrar {
+	return &commandRegistrar{session: session, guildID: guildID}
+}
+
+// scope describes where commands are registered, for logging
+func (r *commandRegistrar) scope() string {
+	if r.guildID == "" {
+		return "global"
+	}
+	return "guild " + r.guildID
+}
+
+// Sync diffs the registered commands against desired: new commands are created, changed ones
+// edited, and commands that are no longer defined are deleted. Unchanged commands are left alone.
+func (r *commandRegistrar) Sync(desired []*discordgo.ApplicationCommand) error {
+	appID := r.session.State.User.ID
+
+	existing, err := r.session.ApplicationCommands(appID, r.guildID)
+	if err != nil {
+		return fmt.Errorf("error listing %s commands: %v", r.scope(), err)
+	}
+	existingByName := make(map[string]*discordgo.ApplicationCommand, len(existing))
+	for _, cmd := range existing {
+		existingByName[cmd.Name] = cmd
+	}
+
+	var created, updated, unchanged, deleted int
+	r.registered = nil
+	for _, cmd := range desired {
+		current, ok := existingByName[cmd.Name]
+		delete(existingByName, cmd.Name)
+
+		switch {
+		case !ok:
+			registered, err := r.session.ApplicationCommandCreate(appID, r.guildID, cmd)
+			if err != nil {
+				logger.Error("cannot create command", "command", cmd.Name, "scope", r.scope(), "error", err)
+				continue
+			}
+			r.registered = append(r.registered, registered)
+			created++
+		case commandSignature(current) != commandSignature(cmd):
+			registered, err := r.session.ApplicationCommandEdit(appID, r.guildID, current.ID, cmd)
+			if err != nil {
+				logger.Error("cannot update command", "command", cmd.Name, "scope", r.scope(), "error", err)
+				r.registered = append(r.registered, current)
+				continue
+			}
+			r.registered = append(r.registered, registered)
+			updated++
+		default:
+			r.registered = append(r.registered, current)
+			unchanged++
+		}
+	}
+
+	// Anything left over was renamed or removed from the bot
+	for _, stale := range existingByName {
+		if err := r.session.ApplicationCommandDelete(appID, r.guildID, stale.ID); err != nil {
+			logger.Error("cannot delete stale command", "command", stale.Name, "scope", r.scope(), "error", err)
+			continue
+		}
+		deleted++
+	}
+
+	logger.Info("slash commands synced", "scope", r.scope(),
+		"created", created, "updated", updated, "unchanged", unchanged, "deleted", deleted)
+	return nil
+}
+
+// Deregister deletes every command registered by the last Sync
+func (r *commandRegistrar) Deregister() {
+	appID := r.session.State.User.ID
+	for _, cmd := range r.registered {
+		if err := r.session.ApplicationCommandDelete(appID, r.guildID, cmd.ID); err != nil {
+			logger.Error("cannot delete command", "command", cmd.Name, "scope", r.scope(), "error", err)
+		}
+	}
+	logger.Info("slash commands deregistered", "scope", r.scope(), "count", len(r.registered))
+	r.registered = nil
+}
+
+// commandSignature serializes the parts of a command definition the bot controls, so a
+// command fetched from Discord compares equal to the same definition built locally
+func commandSignature(cmd *discordgo.ApplicationCommand) string {
+	commandType := cmd.Type
+	if commandType == 0 {
+		commandType = discordgo.ChatApplicationCommand
+	}
+
+	var descriptionLocalizations map[discordgo.Locale]string
+	if cmd.DescriptionLocalizations != nil {
+		descriptionLocalizations = *cmd.DescriptionLocalizations
+	}
+
+	signature, _ := json.Marshal(struct {
+		Type                     discordgo.ApplicationCommandType
+		Description              string
+		DescriptionLocalizations map[discordgo.Locale]string `json:",omitempty"`
+		DefaultMemberPermissions *int64
+		Options                  []*discordgo.ApplicationCommandOption
+	}{commandType, cmd.Description, descriptionLocalizations, cmd.DefaultMemberPermissions, normalizeOptions(cmd.Options)})
+	return string(signature)
+}
+
+// normalizeOptions copies options with empty slices cleared, since Discord omits them
+func normalizeOptions(options []*discordgo.ApplicationCommandOption) []*discordgo.ApplicationCommandOption {
+	if len(options) == 0 {
+		return nil
+	}
+
+	normalized := make([]*discordgo.ApplicationCommandOption, len(options))
+	for i, option := range options {
+		copied := *option
+		copied.Options = normalizeOptions(option.Options)
+		if len(copied.Choices) == 0 {
+			copied.Choices = nil
+		}
+		if len(copied.ChannelTypes) == 0 {
+			copied.ChannelTypes = nil
+		}
+		normalized[i] = &copied
+	}
+	return normalized
+}
@@ -0,0 +1,366 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/pkg/models"
+)
+
+// remindersDocument is the storage document holding pending reminders
+const remindersDocument = "reminders"
+
+// reminderCheckInterval is how often the dispatcher looks for due reminders
+const reminderCheckInterval = 30 * time.Second
+
+// maxRemindersPerUser keeps one user from filling the store
+const maxRemindersPerUser = 10
+
+// Reminder is a pending notification for a user
+type Reminder struct {
+	ID          string    `json:"id"`
+	UserID      string    `json:"user_id,omitempty"`      // empty for league reminders
+	GuildID     string    `json:"guild_id,omitempty"`     // set for league reminders
+	LeagueEvent string    `json:"league_event,omitempty"` // a league event, or pickemReminderEvent
+	PickemWeek  string    `json:"pickem_week,omitempty"`  // the pick'em week a deadline reminder counts picks for
+	ChannelID   string    `json:"channel_id,omitempty"`   // empty means send by DM
+	Message     string    `json:"message"`
+	RemindAt    time.Time `json:"remind_at"`
+	EventTime   time.Time `json:"event_time"`
+}
+
+// reminderLead is how long before an event a reminder is posted, with a label for the message
+type reminderLead struct {
+	lead  time.Duration
+	label string
+}
+
+// reminderStore keeps pending reminders in memory and persists every change
+type reminderStore struct {
+	mu        sync.Mutex
+	store     *storage.Store
+	reminders []*Reminder
+}
+
+// newReminderStore loads pending reminders from storage
+func newReminderStore(store *storage.Store) (*reminderStore, error) {
+	reminders := &reminderStore{store: store}
+	if err := store.Load(remindersDocument, &reminders.reminders); err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// save persists the reminders; the caller must hold rs.mu
+func (rs *reminderStore) save() error {
+	if err := rs.store.Save(remindersDocument, rs.reminders); err != nil {
+		logger.Error("error saving reminders", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Add stores a new reminder, assigning its ID
+func (rs *reminderStore) Add(reminder *Reminder) error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	count := 0
+	for _, existing := range rs.reminders {
+		if existing.UserID == reminder.UserID {
+			count++
+		}
+	}
+	if reminder.UserID != "" && count >= maxRemindersPerUser {
+		return fmt.Errorf("you already have %d reminders; cancel one first", maxRemindersPerUser)
+	}
+
+	reminder.ID = newReminderID()
+	rs.reminders = append(rs.reminders, reminder)
+	return rs.save()
+}
+
+// newReminderID returns a short ID for a reminder
+func newReminderID() string {
+	return strconv.FormatInt(time.Now().UnixNano()%1e9, 36)
+}
+
+// ScheduleEvent replaces a guild event's pending reminders with a copy of template for each lead
+// still ahead of when, returning how many were scheduled. message builds each copy's text from
+// its lead's label.
+func (rs *reminderStore) ScheduleEvent(template Reminder, when time.Time, leads []reminderLead, now time.Time, message func(label string) string) (int, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	var kept []*Reminder
+	for _, reminder := range rs.reminders {
+		if reminder.GuildID != template.GuildID || reminder.LeagueEvent != template.LeagueEvent {
+			kept = append(kept, reminder)
+		}
+	}
+
+	scheduled := 0
+	for _, lead := range leads {
+		remindAt := when.Add(-lead.lead)
+		if !remindAt.After(now) {
+			continue
+		}
+		reminder := template
+		reminder.ID = newReminderID() + strconv.Itoa(scheduled)
+		reminder.Message = message(lead.label)
+		reminder.RemindAt = remindAt
+		reminder.EventTime = when
+		kept = append(kept, &reminder)
+		scheduled++
+	}
+
+	rs.reminders = kept
+	return scheduled, rs.save()
+}
+
+// ForUser returns a user's pending reminders, soonest first
+func (rs *reminderStore) ForUser(userID string) []Reminder {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	var reminders []Reminder
+	for _, reminder := range rs.reminders {
+		if reminder.UserID == userID {
+			reminders = append(reminders, *reminder)
+		}
+	}
+	sort.Slice(reminders, func(i, j int) bool {
+		return reminders[i].RemindAt.Before(reminders[j].RemindAt)
+	})
+	return reminders
+}
+
+// Remove deletes one of a user's reminders, reporting whether it existed
+func (rs *reminderStore) Remove(userID, id string) (bool, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	for index, reminder := range rs.reminders {
+		if reminder.UserID == userID && reminder.ID == id {
+			rs.reminders = append(rs.reminders[:index], rs.reminders[index+1:]...)
+			return true, rs.save()
+		}
+	}
+	return false, nil
+}
+
+// RemoveLeagueEvent deletes every pending reminder for a guild's league event
+func (rs *reminderStore) RemoveLeagueEvent(guildID, event string) error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	var kept []*Reminder
+	for _, reminder := range rs.reminders {
+		if reminder.GuildID != guildID || reminder.LeagueEvent != event {
+			kept = append(kept, reminder)
+		}
+	}
+	if len(kept) == len(rs.reminders) {
+		return nil
+	}
+
+	rs.reminders = kept
+	return rs.save()
+}
+
+// TakeDue removes and returns every reminder due at or before now
+func (rs *reminderStore) TakeDue(now time.Time) []*Reminder {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	var due, pending []*Reminder
+	for _, reminder := range rs.reminders {
+		if !reminder.RemindAt.After(now) {
+			due = append(due, reminder)
+		} else {
+			pending = append(pending, reminder)
+		}
+	}
+	if len(due) == 0 {
+		return nil
+	}
+
+	rs.reminders = pending
+	rs.save()
+	return due
+}
+
+// runReminderDispatcher delivers reminders as they come due
+func (b *Bot) runReminderDispatcher() {
+	ticker := time.NewTicker(reminderCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, reminder := range b.reminders.TakeDue(time.Now()) {
+				b.deliverReminder(reminder)
+			}
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// deliverReminder sends a reminder by DM or as a mention in its channel
+func (b *Bot) deliverReminder(reminder *Reminder) {
+	// Reminders that were due while the bot was offline are stale once the event starts
+	if !reminder.EventTime.IsZero() && time.Now().After(reminder.EventTime) {
+		logger.Info("dropping stale reminder", "reminder", reminder.ID, "user", reminder.UserID)
+		return
+	}
+
+	if reminder.PickemWeek != "" {
+		b.deliverPickemReminder(reminder)
+		return
+	}
+
+	if reminder.UserID == "" {
+		b.sendMessage(b.discord, reminder.ChannelID, "⏰ "+reminder.Message)
+		return
+	}
+
+	if reminder.ChannelID != "" {
+		b.sendMessage(b.discord, reminder.ChannelID, fmt.Sprintf("<@%s> ⏰ %s", reminder.UserID, reminder.Message))
+		return
+	}
+
+	channel, err := b.discord.UserChannelCreate(reminder.UserID)
+	if err != nil {
+		logger.Error("error opening DM for reminder", "reminder", reminder.ID, "error", err)
+		return
+	}
+	b.sendMessage(b.discord, channel.ID, "⏰ "+reminder.Message)
+}
+
+// handleSlashRemind handles the /remind slash command and its subcommands
+func (b *Bot) handleSlashRemind(s Responder, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	subcommand := options[0]
+	switch subcommand.Name {
+	case "game":
+		var teamName, delivery string
+		minutes := int64(30)
+		for _, option := range subcommand.Options {
+			switch option.Name {
+			case "team":
+				teamName = option.StringValue()
+			case "minutes_before":
+				minutes = option.IntValue()
+			case "deliver":
+				delivery = option.StringValue()
+			}
+		}
+
+		err := b.deferInteraction(s, i)
+		if err != nil {
+			logger.Error("error sending initial remind response", "error", err)
+			return
+		}
+
+		// Process reminder request asynchronously
+		go b.processSlashRemindGameRequest(s, i, teamName, int(minutes), delivery == "here")
+	case "list":
+		b.respondRemindList(s, i)
+	case "cancel":
+		id := subcommand.Options[0].StringValue()
+		removed, err := b.reminders.Remove(interactionUserID(i), id)
+		switch {
+		case err != nil:
+			b.respondInteraction(s, i, b.tr(i, "remind.cancel_failed"))
+		case !removed:
+			b.respondInteraction(s, i, fmt.Sprintf("No reminder with ID `%s`. Use `/remind list` to see yours.", id))
+		default:
+			b.respondInteraction(s, i, fmt.Sprintf("🗑️ Reminder `%s` cancelled.", id))
+		}
+	}
+}
+
+// processSlashRemindGameRequest schedules a reminder before the team's next kickoff
+func (b *Bot) processSlashRemindGameRequest(s Responder, i *discordgo.InteractionCreate, teamName string, minutes int, inChannel bool) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding team %s", teamName), err))
+		return
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+
+	game, err := b.nextGame(teamInfo.Key, seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil || game.GameTime.IsZero() {
+		b.completeInteraction(s, i, fmt.Sprintf("No upcoming kickoff found for the %s %s.", teamInfo.City, teamInfo.Name))
+		return
+	}
+
+	remindAt := game.GameTime.Add(-time.Duration(minutes) * time.Minute)
+	if !remindAt.After(time.Now()) {
+		b.completeInteraction(s, i, fmt.Sprintf("Kickoff is less than %d minutes away — no reminder needed!", minutes))
+		return
+	}
+
+	reminder := &Reminder{
+		UserID: interactionUserID(i),
+		Message: fmt.Sprintf("%s @ %s kicks off in %d minutes (%s)",
+			game.AwayTeam, game.HomeTeam, minutes, models.WeekLabel(game.GameType, game.Week)),
+		RemindAt:  remindAt,
+		EventTime: game.GameTime,
+	}
+	if inChannel {
+		reminder.ChannelID = i.ChannelID
+	}
+
+	if err := b.reminders.Add(reminder); err != nil {
+		b.completeInteraction(s, i, fmt.Sprintf("❌ %v", err))
+		return
+	}
+
+	where := "by DM"
+	if inChannel {
+		where = "in this channel"
+	}
+	b.completeInteraction(s, i, fmt.Sprintf("⏰ I'll remind you %s %d minutes before %s @ %s (<t:%d:F>). Reminder ID: `%s`",
+		where, minutes, game.AwayTeam, game.HomeTeam, game.GameTime.Unix(), reminder.ID))
+}
+
+// respondRemindList lists the user's pending reminders
+func (b *Bot) respondRemindList(s Responder, i *discordgo.InteractionCreate) {
+	reminders := b.reminders.ForUser(interactionUserID(i))
+	if len(reminders) == 0 {
+		b.respondInteraction(s, i, "You have no pending reminders. Create one with `/remind game`.")
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "⏰ Your Reminders",
+		Color: 0x013369,
+	}
+	for _, reminder := range reminders {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("`%s` • <t:%d:R>", reminder.ID, reminder.RemindAt.Unix()),
+			Value:  reminder.Message,
+			Inline: false,
+		})
+	}
+
+	if err := b.respondInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error responding to remind list", "error", err)
+	}
+}
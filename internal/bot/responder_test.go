@@ -0,0 +1,392 @@
+package bot
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/config"
+	"nfl-discord-bot/internal/nfl/nfltest"
+)
+
+// replyTimeout bounds how long a test waits for a deferred handler to finish its reply
+const replyTimeout = 5 * time.Second
+
+// recordedCall is one call a handler made on the fake session
+type recordedCall struct {
+	Method     string
+	Type       discordgo.InteractionResponseType // InteractionRespond only
+	Flags      discordgo.MessageFlags
+	Content    string
+	Embeds     []*discordgo.MessageEmbed
+	Components []discordgo.MessageComponent
+	ChannelID  string
+}
+
+// final reports whether the call completes an interaction reply, as opposed to deferring it or
+// posting to a channel along the way
+func (c recordedCall) final() bool {
+	switch c.Method {
+	case "InteractionRespond":
+		return c.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource
+	case "InteractionResponseEdit", "FollowupMessageCreate":
+		return true
+	}
+	return false
+}
+
+// post reports whether the call sent a message to a channel or thread rather than replying
+func (c recordedCall) post() bool {
+	return strings.HasPrefix(c.Method, "ChannelMessageSend")
+}
+
+// fakeResponder is a Responder that records every call instead of talking to Discord
+type fakeResponder struct {
+	mu      sync.Mutex
+	calls   []recordedCall
+	replied chan struct{} // receives once per final call
+}
+
+// newFakeResponder creates an empty fake session
+func newFakeResponder() *fakeResponder {
+	return &fakeResponder{replied: make(chan struct{}, 64)}
+}
+
+// record stores a call and signals waiters when it completes a reply
+func (f *fakeResponder) record(call recordedCall) *discordgo.Message {
+	f.mu.Lock()
+	f.calls = append(f.calls, call)
+	id := len(f.calls)
+	f.mu.Unlock()
+
+	if call.final() {
+		f.replied <- struct{}{}
+	}
+	return &discordgo.Message{ID: strings.Repeat("1", id), ChannelID: call.ChannelID, Content: call.Content, Embeds: call.Embeds}
+}
+
+// Calls returns a copy of the calls recorded so far
+func (f *fakeResponder) Calls() []recordedCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]recordedCall(nil), f.calls...)
+}
+
+// posts returns the messages sent to channels so far, in order
+func (f *fakeResponder) posts() []goldenPost {
+	var posts []goldenPost
+	for _, call := range f.Calls() {
+		if call.post() {
+			posts = append(posts, goldenPost{ChannelID: call.ChannelID, Content: call.Content, Embeds: call.Embeds, Components: call.Components})
+		}
+	}
+	return posts
+}
+
+// waitReply waits for the handler's final reply, following a deferred response to its edit
+func (f *fakeResponder) waitReply(t *testing.T) recordedCall {
+	t.Helper()
+	select {
+	case <-f.replied:
+	case <-time.After(replyTimeout):
+		t.Fatalf("no reply within %s; calls: %+v", replyTimeout, f.Calls())
+	}
+	calls := f.Calls()
+	for index := len(calls) - 1; index >= 0; index-- {
+		if calls[index].final() {
+			return calls[index]
+		}
+	}
+	t.Fatal("no final call recorded")
+	return recordedCall{}
+}
+
+func (f *fakeResponder) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+	call := recordedCall{Method: "InteractionRespond", Type: resp.Type}
+	if resp.Data != nil {
+		call.Flags = resp.Data.Flags
+		call.Content = resp.Data.Content
+		call.Embeds = resp.Data.Embeds
+		call.Components = resp.Data.Components
+	}
+	f.record(call)
+	return nil
+}
+
+func (f *fakeResponder) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	call := recordedCall{Method: "InteractionResponseEdit"}
+	if newresp.Content != nil {
+		call.Content = *newresp.Content
+	}
+	if newresp.Embeds != nil {
+		call.Embeds = *newresp.Embeds
+	}
+	if newresp.Components != nil {
+		call.Components = *newresp.Components
+	}
+	return f.record(call), nil
+}
+
+func (f *fakeResponder) FollowupMessageCreate(interaction *discordgo.Interaction, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return f.record(recordedCall{Method: "FollowupMessageCreate", Flags: data.Flags, Content: data.Content, Embeds: data.Embeds, Components: data.Components}), nil
+}
+
+func (f *fakeResponder) ChannelMessageSend(channelID string, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return f.record(recordedCall{Method: "ChannelMessageSend", ChannelID: channelID, Content: content}), nil
+}
+
+func (f *fakeResponder) ChannelMessageSendEmbed(channelID string, embed *discordgo.MessageEmbed, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return f.record(recordedCall{Method: "ChannelMessageSendEmbed", ChannelID: channelID, Embeds: []*discordgo.MessageEmbed{embed}}), nil
+}
+
+func (f *fakeResponder) ChannelMessageSendComplex(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	return f.record(recordedCall{Method: "ChannelMessageSendComplex", ChannelID: channelID, Content: data.Content, Embeds: data.Embeds, Components: data.Components}), nil
+}
+
+func (f *fakeResponder) ChannelMessageEditComplex(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	call := recordedCall{Method: "ChannelMessageEditComplex", ChannelID: m.Channel}
+	if m.Content != nil {
+		call.Content = *m.Content
+	}
+	if m.Embeds != nil {
+		call.Embeds = *m.Embeds
+	}
+	return f.record(call), nil
+}
+
+func (f *fakeResponder) ChannelMessageDelete(channelID, messageID string, options ...discordgo.RequestOption) error {
+	f.record(recordedCall{Method: "ChannelMessageDelete", ChannelID: channelID})
+	return nil
+}
+
+func (f *fakeResponder) UserChannelCreate(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	return &discordgo.Channel{ID: "dm-" + recipientID, Type: discordgo.ChannelTypeDM}, nil
+}
+
+func (f *fakeResponder) MessageThreadStart(channelID, messageID string, name string, archiveDuration int, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	f.record(recordedCall{Method: "MessageThreadStart", ChannelID: channelID, Content: name})
+	return &discordgo.Channel{ID: "thread-" + messageID, ParentID: channelID, Name: name, Type: discordgo.ChannelTypeGuildPublicThread}, nil
+}
+
+// The fake must keep satisfying Responder
+var _ Responder = (*fakeResponder)(nil)
+
+// newTestBot creates a bot backed by the nfltest fixtures and a temporary data directory
+func newTestBot(t *testing.T) *Bot {
+	t.Helper()
+	server := nfltest.NewServer(nfltest.Fixtures)
+	t.Cleanup(server.Close)
+
+	t.Setenv("NFL_API_KEY", "test")
+	t.Setenv("NFL_API_BASE_URL", server.URL)
+	t.Setenv("DATA_DIR", t.TempDir())
+	t.Setenv("CACHE_BACKEND", "memory")
+	t.Setenv("LOG_FILE", "")
+	t.Setenv("BOT_VISIBILITY_ROLE", "")
+
+	cfg, err := config.LoadLocal()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	b, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating bot: %v", err)
+	}
+	return b
+}
+
+// Test interaction identities
+const (
+	testGuildID   = "guild-1"
+	testChannelID = "channel-1"
+	testUserID    = "user-1"
+)
+
+// slashInteraction builds a guild slash command interaction from a member without special permissions
+func slashInteraction(name string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:        "interaction-" + name,
+		Type:      discordgo.InteractionApplicationCommand,
+		GuildID:   testGuildID,
+		ChannelID: testChannelID,
+		Member:    &discordgo.Member{User: &discordgo.User{ID: testUserID, Username: "tester"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:    name,
+			Options: options,
+		},
+	}}
+}
+
+// asManager gives an interaction's member the Manage Server permission
+func asManager(i *discordgo.InteractionCreate) *discordgo.InteractionCreate {
+	i.Member.Permissions = discordgo.PermissionManageGuild
+	return i
+}
+
+// stringOption is a string slash command option
+func stringOption(name, value string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: name, Type: discordgo.ApplicationCommandOptionString, Value: value}
+}
+
+// intOption is an integer slash command option; Discord delivers numbers as float64
+func intOption(name string, value int) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: name, Type: discordgo.ApplicationCommandOptionInteger, Value: float64(value)}
+}
+
+// channelOption is a channel slash command option; handlers only read the channel's ID
+func channelOption(name, channelID string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: name, Type: discordgo.ApplicationCommandOptionChannel, Value: channelID}
+}
+
+// componentInteraction builds a guild message component interaction choosing values from a select menu
+func componentInteraction(customID string, values ...string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:        "interaction-" + customID,
+		Type:      discordgo.InteractionMessageComponent,
+		GuildID:   testGuildID,
+		ChannelID: testChannelID,
+		Member:    &discordgo.Member{User: &discordgo.User{ID: testUserID, Username: "tester"}},
+		Data: discordgo.MessageComponentInteractionData{
+			CustomID:      customID,
+			ComponentType: discordgo.SelectMenuComponent,
+			Values:        values,
+		},
+	}}
+}
+
+// subcommandOption is a subcommand with its own options
+func subcommandOption(name string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: name, Type: discordgo.ApplicationCommandOptionSubCommand, Options: options}
+}
+
+func TestHelpRepliesWithEmbed(t *testing.T) {
+	b := newTestBot(t)
+	session := newFakeResponder()
+
+	b.handleSlashHelp(session, slashInteraction("help"))
+
+	reply := session.waitReply(t)
+	if reply.Method != "InteractionRespond" || reply.Type != discordgo.InteractionResponseChannelMessageWithSource {
+		t.Fatalf("reply = %s type %d, want an immediate InteractionRespond", reply.Method, reply.Type)
+	}
+	if reply.Flags&discordgo.MessageFlagsEphemeral != 0 {
+		t.Error("help should be public by default")
+	}
+	if len(reply.Embeds) != 1 || !strings.Contains(reply.Embeds[0].Title, "Slash Commands Guide") {
+		t.Fatalf("embeds = %+v, want the help guide", reply.Embeds)
+	}
+}
+
+func TestVisibilityPrivateMakesRepliesEphemeral(t *testing.T) {
+	b := newTestBot(t)
+	session := newFakeResponder()
+
+	b.handleSlashVisibility(session, asManager(slashInteraction("visibility", stringOption("default", visibilityPrivate))))
+	session.waitReply(t)
+
+	b.handleSlashHelp(session, slashInteraction("help"))
+	reply := session.waitReply(t)
+	if reply.Flags&discordgo.MessageFlagsEphemeral == 0 {
+		t.Error("help should be ephemeral after /visibility private")
+	}
+}
+
+func TestFavoriteSetShowClear(t *testing.T) {
+	b := newTestBot(t)
+	session := newFakeResponder()
+
+	b.handleSlashFavorite(session, slashInteraction("favorite", subcommandOption("set", stringOption("team", "Bills"))))
+	reply := session.waitReply(t)
+	if !strings.Contains(reply.Content, "Favorites saved") || !strings.Contains(reply.Content, "**BUF**") {
+		t.Fatalf("set reply = %q, want BUF saved", reply.Content)
+	}
+	if got := b.preferences.Get(testUserID).FavoriteTeam; got != "BUF" {
+		t.Errorf("FavoriteTeam = %q, want BUF", got)
+	}
+
+	b.handleSlashFavorite(session, slashInteraction("favorite", subcommandOption("show")))
+	if reply := session.waitReply(t); !strings.Contains(reply.Content, "Favorite team: **BUF**") {
+		t.Errorf("show reply = %q, want BUF listed", reply.Content)
+	}
+
+	b.handleSlashFavorite(session, slashInteraction("favorite", subcommandOption("clear")))
+	session.waitReply(t)
+	if got := b.preferences.Get(testUserID).FavoriteTeam; got != "" {
+		t.Errorf("FavoriteTeam after clear = %q, want empty", got)
+	}
+}
+
+func TestFavoriteSetRejectsUnknownTeam(t *testing.T) {
+	b := newTestBot(t)
+	session := newFakeResponder()
+
+	b.handleSlashFavorite(session, slashInteraction("favorite", subcommandOption("set", stringOption("team", "Gotham Rogues"))))
+	reply := session.waitReply(t)
+	if !strings.HasPrefix(reply.Content, "❌") || reply.Flags&discordgo.MessageFlagsEphemeral == 0 {
+		t.Errorf("reply = %q (flags %d), want an ephemeral error", reply.Content, reply.Flags)
+	}
+}
+
+func TestAliasAddRequiresManageServer(t *testing.T) {
+	b := newTestBot(t)
+	session := newFakeResponder()
+	add := subcommandOption("add", stringOption("alias", "mafia"), stringOption("name", "Bills"))
+
+	b.handleSlashAlias(session, slashInteraction("alias", add))
+	session.waitReply(t)
+	if aliases := b.settings.Get(testGuildID).Aliases; len(aliases) != 0 {
+		t.Fatalf("aliases = %v, want none saved without Manage Server", aliases)
+	}
+
+	b.handleSlashAlias(session, asManager(slashInteraction("alias", add)))
+	if reply := session.waitReply(t); !strings.HasPrefix(reply.Content, "✅") {
+		t.Fatalf("add reply = %q, want the alias saved", reply.Content)
+	}
+	b.handleSlashAlias(session, slashInteraction("alias", subcommandOption("list")))
+	if reply := session.waitReply(t); !strings.Contains(reply.Content, "BUF") {
+		t.Errorf("list reply = %q, want the new alias", reply.Content)
+	}
+}
+
+func TestFormattingShowsDefaults(t *testing.T) {
+	b := newTestBot(t)
+	session := newFakeResponder()
+
+	b.handleSlashFormatting(session, slashInteraction("formatting"))
+	reply := session.waitReply(t)
+	if reply.Content == "" && len(reply.Embeds) == 0 {
+		t.Fatal("formatting replied with nothing")
+	}
+}
+
+func TestStatsDefersThenEditsWithEmbed(t *testing.T) {
+	b := newTestBot(t)
+	session := newFakeResponder()
+
+	b.handleSlashStats(session, slashInteraction("stats", stringOption("player", "Josh Allen")))
+	reply := session.waitReply(t)
+
+	calls := session.Calls()
+	if calls[0].Type != discordgo.InteractionResponseDeferredChannelMessageWithSource {
+		t.Errorf("first call = %s type %d, want a deferred response", calls[0].Method, calls[0].Type)
+	}
+	if reply.Method != "InteractionResponseEdit" || len(reply.Embeds) == 0 {
+		t.Fatalf("reply = %+v, want an edit with the stats embed", reply)
+	}
+	if !strings.Contains(reply.Embeds[0].Title, "Josh Allen") {
+		t.Errorf("title = %q, want Josh Allen", reply.Embeds[0].Title)
+	}
+}
+
+func TestStatsSuggestsNamesForUnknownPlayer(t *testing.T) {
+	b := newTestBot(t)
+	session := newFakeResponder()
+
+	b.handleSlashStats(session, slashInteraction("stats", stringOption("player", "Jsoh Alen")))
+	reply := session.waitReply(t)
+	if !strings.Contains(reply.Content, "Josh Allen") || len(reply.Components) == 0 {
+		t.Errorf("reply = %q with %d component rows, want a Josh Allen suggestion button", reply.Content, len(reply.Components))
+	}
+}
@@ -0,0 +1,164 @@
+package bot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// interactionTokenLifetime is how long Discord accepts edits to an interaction response
+const interactionTokenLifetime = 15 * time.Minute
+
+// interactionTokenMargin leaves time for the edit request itself before the token expires
+const interactionTokenMargin = 30 * time.Second
+
+// responseManager tracks interactions whose token can no longer be used, so slow commands
+// can still deliver their result as a regular message
+type responseManager struct {
+	mu      sync.Mutex
+	expired map[string]time.Time // interaction ID -> when it was found expired
+}
+
+// newResponseManager creates an empty response manager
+func newResponseManager() *responseManager {
+	return &responseManager{expired: make(map[string]time.Time)}
+}
+
+// markExpired records that an interaction's token was rejected
+func (rm *responseManager) markExpired(interactionID string) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	now := time.Now()
+	rm.expired[interactionID] = now
+	for id, at := range rm.expired {
+		if now.Sub(at) > interactionTokenLifetime {
+			delete(rm.expired, id)
+		}
+	}
+}
+
+// usable reports whether an interaction's token can still be used to edit its response
+func (rm *responseManager) usable(i *discordgo.InteractionCreate) bool {
+	rm.mu.Lock()
+	_, expired := rm.expired[i.ID]
+	rm.mu.Unlock()
+	if expired {
+		return false
+	}
+
+	// Interaction IDs are snowflakes, so they carry the time the command was run
+	createdAt, err := discordgo.SnowflakeTimestamp(i.ID)
+	if err != nil {
+		return true
+	}
+	return time.Since(createdAt) < interactionTokenLifetime-interactionTokenMargin
+}
+
+// tokenRejected reports whether Discord refused a request because the interaction token is no longer valid
+func tokenRejected(err error) bool {
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) || restErr.Message == nil {
+		return false
+	}
+	switch restErr.Message.Code {
+	case discordgo.ErrCodeUnknownInteraction, discordgo.ErrCodeUnknownWebhook, discordgo.ErrCodeInvalidWebhookTokenProvided:
+		return true
+	}
+	return false
+}
+
+// finishInteraction replaces a deferred response, or posts the result as a regular message
+// mentioning the requester when the interaction token has expired
+func (b *Bot) finishInteraction(s Responder, i *discordgo.InteractionCreate, edit *discordgo.WebhookEdit) (*discordgo.Message, error) {
+	// Attachments are read while sending, so keep their bytes for a possible second attempt
+	attachments, err := bufferFiles(edit.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.responses.usable(i) {
+		edit.Files = attachments.files()
+		message, err := s.InteractionResponseEdit(i.Interaction, edit)
+		if err == nil || !tokenRejected(err) {
+			return message, err
+		}
+		b.responses.markExpired(i.ID)
+	}
+
+	logger.Warn("interaction token expired, posting result as a message", "interaction", i.ID, "user", interactionUserID(i))
+	edit.Files = attachments.files()
+	return b.postInteractionResult(s, i, edit)
+}
+
+// bufferedFile is an attachment held in memory so it can be sent more than once
+type bufferedFile struct {
+	name        string
+	contentType string
+	data        []byte
+}
+
+// bufferedFiles is a set of attachments held in memory
+type bufferedFiles []bufferedFile
+
+// bufferFiles reads attachments into memory
+func bufferFiles(files []*discordgo.File) (bufferedFiles, error) {
+	buffered := make(bufferedFiles, 0, len(files))
+	for _, file := range files {
+		data, err := io.ReadAll(file.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("error reading attachment %s: %v", file.Name, err)
+		}
+		buffered = append(buffered, bufferedFile{name: file.Name, contentType: file.ContentType, data: data})
+	}
+	return buffered, nil
+}
+
+// files returns fresh readers over the buffered attachments
+func (bf bufferedFiles) files() []*discordgo.File {
+	if len(bf) == 0 {
+		return nil
+	}
+	files := make([]*discordgo.File, len(bf))
+	for index, file := range bf {
+		files[index] = &discordgo.File{Name: file.name, ContentType: file.contentType, Reader: bytes.NewReader(file.data)}
+	}
+	return files
+}
+
+// postInteractionResult delivers a result outside the interaction: in the channel with a mention,
+// or by DM when the response was meant to be private
+func (b *Bot) postInteractionResult(s Responder, i *discordgo.InteractionCreate, edit *discordgo.WebhookEdit) (*discordgo.Message, error) {
+	userID := interactionUserID(i)
+	channelID := i.ChannelID
+	content := "<@" + userID + "> here's the result you asked for:"
+	if b.ephemeral(i) {
+		channel, err := s.UserChannelCreate(userID)
+		if err != nil {
+			return nil, err
+		}
+		channelID = channel.ID
+		content = "Here's the result of your command (it took too long to reply in the channel):"
+	}
+	if edit.Content != nil && *edit.Content != "" {
+		content += "\n" + *edit.Content
+	}
+
+	message := &discordgo.MessageSend{
+		Content:         content,
+		Files:           edit.Files,
+		AllowedMentions: &discordgo.MessageAllowedMentions{Users: []string{userID}},
+	}
+	if edit.Embeds != nil {
+		message.Embeds = *edit.Embeds
+	}
+	if edit.Components != nil {
+		message.Components = *edit.Components
+	}
+	return s.ChannelMessageSendComplex(channelID, message)
+}
@@ -0,0 +1,109 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/fantasy"
+	"nfl-discord-bot/pkg/models"
+)
+
+// rookieLeaderboardSize is how many rookies /rookies lists
+const rookieLeaderboardSize = 15
+
+// handleSlashRookies handles the /rookies slash command
+func (b *Bot) handleSlashRookies(s Responder, i *discordgo.InteractionCreate) {
+	var position string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "position" {
+			position = option.StringValue()
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial rookies response", "error", err)
+		return
+	}
+
+	// Process rookies request asynchronously
+	go b.processSlashRookiesRequest(s, i, b.guildScoringFormat(i.GuildID), position)
+}
+
+// processSlashRookiesRequest ranks this season's rookies by fantasy points in the server's format
+func (b *Bot) processSlashRookiesRequest(s Responder, i *discordgo.InteractionCreate, format, position string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting rookie leaders", err))
+		return
+	}
+
+	rookies, err := b.nflClient.GetRookieSeasonTotals(seasonInfo.Season)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting rookie leaders", err))
+		return
+	}
+
+	var leaders []*models.SeasonPlayerStats
+	for _, rookie := range rookies {
+		if position == "" || rookie.Position == position {
+			leaders = append(leaders, rookie)
+		}
+	}
+	if len(leaders) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("🌱 No %srookies have recorded regular season stats in %d yet.",
+			strings.TrimSpace(position+" "), seasonInfo.Season))
+		return
+	}
+
+	sort.SliceStable(leaders, func(a, c int) bool {
+		return fantasy.Points(leaders[a], format) > fantasy.Points(leaders[c], format)
+	})
+	if len(leaders) > rookieLeaderboardSize {
+		leaders = leaders[:rookieLeaderboardSize]
+	}
+
+	if err := b.completeInteractionEmbed(s, i, rookiesEmbed(seasonInfo.Season, format, position, leaders, b.guildFormat(i.GuildID))); err != nil {
+		logger.Error("error sending rookies embed response", "error", err)
+	}
+}
+
+// rookiesEmbed lists the top rookies with their fantasy points and headline stats
+func rookiesEmbed(season int, format, position string, leaders []*models.SeasonPlayerStats, display models.Format) *discordgo.MessageEmbed {
+	title := fmt.Sprintf("🌱 %d Rookie Leaders", season)
+	if position != "" {
+		title = fmt.Sprintf("🌱 %d Rookie Leaders: %s", season, position)
+	}
+
+	var lines []string
+	for index, rookie := range leaders {
+		lines = append(lines, fmt.Sprintf("**%d. %s** (%s, %s) — **%.1f pts** in %d games\n%s",
+			index+1, rookie.Name, rookie.Position, rookie.Team, fantasy.Points(rookie, format), rookie.Games, rookieStatLine(rookie, display)))
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: strings.Join(lines, "\n"),
+		Color:       0x013369,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Regular season • %s scoring • Data provided by SportsData.io", fantasy.FormatName(format)),
+		},
+	}
+}
+
+// rookieStatLine summarizes a rookie's production for their position, e.g. "64 rec • 812 rec yds • 6 TD"
+func rookieStatLine(rookie *models.SeasonPlayerStats, format models.Format) string {
+	unit := format.UnitPlural()
+	switch rookie.Position {
+	case "QB":
+		return fmt.Sprintf("%.0f pass %s • %.0f TD • %.0f INT • %.0f rush %s",
+			format.Length(rookie.PassingYards), unit, rookie.PassingTouchdowns, rookie.Interceptions, format.Length(rookie.RushingYards), unit)
+	case "RB":
+		return fmt.Sprintf("%.0f rush %s • %.0f rec • %.0f rec %s • %.0f TD",
+			format.Length(rookie.RushingYards), unit, rookie.Receptions, format.Length(rookie.ReceivingYards), unit, rookie.RushingTouchdowns+rookie.ReceivingTouchdowns)
+	}
+	return fmt.Sprintf("%.0f rec • %.0f rec %s • %.0f TD",
+		rookie.Receptions, format.Length(rookie.ReceivingYards), unit, rookie.ReceivingTouchdowns+rookie.RushingTouchdowns)
+}
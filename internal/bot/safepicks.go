@@ -0,0 +1,118 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/pkg/models"
+)
+
+// safePick is one game's model favorite
+type safePick struct {
+	winner      string
+	loser       string
+	home        bool // the favorite is the home team
+	probability float64
+}
+
+// handleSlashSafePicks handles the /safepicks slash command
+func (b *Bot) handleSlashSafePicks(s Responder, i *discordgo.InteractionCreate) {
+	var week *int64
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "week" {
+			weekVal := option.IntValue()
+			week = &weekVal
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial safepicks response", "error", err)
+		return
+	}
+
+	// Process safe picks request asynchronously
+	go b.processSlashSafePicksRequest(s, i, week)
+}
+
+// processSlashSafePicksRequest ranks a week's games by the model's win probability gap and completes the deferred response
+func (b *Bot) processSlashSafePicksRequest(s Responder, i *discordgo.InteractionCreate, week *int64) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+
+	targetWeek := seasonInfo.Week
+	if week != nil {
+		targetWeek = int(*week)
+	} else if seasonInfo.SeasonType != models.SeasonTypeRegular {
+		b.completeInteraction(s, i, "Safe picks are only available for regular season weeks. Try `/safepicks week:<n>`.")
+		return
+	}
+
+	teams, games, err := b.regularSeasonResults(seasonInfo.Season)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting season results", err))
+		return
+	}
+
+	table := standings.Compute(teams, games)
+	var picks []safePick
+	for _, game := range games {
+		if game.Week != targetWeek || standings.IsFinal(game.Status) || game.HomeTeam == "BYE" || game.AwayTeam == "BYE" {
+			continue
+		}
+		homeWin := table.WinProbability(game.HomeTeam, game.AwayTeam)
+		if homeWin >= 0.5 {
+			picks = append(picks, safePick{winner: game.HomeTeam, loser: game.AwayTeam, home: true, probability: homeWin})
+		} else {
+			picks = append(picks, safePick{winner: game.AwayTeam, loser: game.HomeTeam, probability: 1 - homeWin})
+		}
+	}
+
+	weekLabel := models.WeekLabel(models.SeasonTypeRegular, targetWeek)
+	if len(picks) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("There are no unplayed games in %s.", weekLabel))
+		return
+	}
+
+	sort.SliceStable(picks, func(x, y int) bool {
+		return picks[x].probability > picks[y].probability
+	})
+
+	// Pick'em confidence points: the safest pick gets the most
+	var text strings.Builder
+	for index, pick := range picks {
+		venue := "@"
+		if pick.home {
+			venue = "vs"
+		}
+		text.WriteString(fmt.Sprintf("**%d.** %s %s %s — %.0f%% | %d pts\n",
+			index+1, pick.winner, venue, pick.loser, pick.probability*100, len(picks)-index))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🛡️ Safest Picks — %d %s", seasonInfo.Season, weekLabel),
+		Description: text.String(),
+		Color:       0x013369,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Survivor",
+				Value:  fmt.Sprintf("Safest: **%s**. Already used them? Go down the list to the next team you haven't picked.", picks[0].winner),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Model win chance from points scored and allowed, plus home field | pts = pick'em confidence points | No odds used",
+		},
+	}
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending safepicks embed response", "error", err)
+	}
+}
@@ -0,0 +1,126 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/pkg/models"
+)
+
+// handleSlashScenarios handles the /scenarios slash command
+func (b *Bot) handleSlashScenarios(s Responder, i *discordgo.InteractionCreate) {
+	var teamName string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "team" {
+			teamName = option.StringValue()
+		}
+	}
+	if teamName == "" {
+		b.respondInteraction(s, i, "Please specify a team. Example: `/scenarios team:Bills`")
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial scenarios response", "error", err)
+		return
+	}
+
+	// Process scenarios request asynchronously
+	go b.processSlashScenariosRequest(s, i, teamName)
+}
+
+// processSlashScenariosRequest lists what a team can clinch or lose this week and completes the deferred response
+func (b *Bot) processSlashScenariosRequest(s Responder, i *discordgo.InteractionCreate, teamName string) {
+	teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error finding team %s", teamName), err))
+		return
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+	if seasonInfo.SeasonType != models.SeasonTypeRegular {
+		b.completeInteraction(s, i, "Playoff scenarios are only available during the regular season.")
+		return
+	}
+
+	teams, games, err := b.regularSeasonResults(seasonInfo.Season)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting season results", err))
+		return
+	}
+
+	report := standings.FindScenarios(teams, games, teamInfo.Key, seasonInfo.Week)
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🧮 %s Playoff Scenarios — %s", teamInfo.Key, seasonInfo.WeekLabel()),
+		Color: 0x013369,
+	}
+
+	for _, goal := range standings.Goals {
+		var value string
+		switch {
+		case report.Already[goal]:
+			value = "✅ Already done"
+		case !report.Possible[goal]:
+			continue
+		default:
+			var lines []string
+			for _, scenario := range report.Scenarios[goal] {
+				lines = append(lines, "• "+describeScenario(scenario, report.Team))
+			}
+			if len(lines) == 0 {
+				lines = append(lines, "• Only with a combination of more than three results")
+			}
+			value = strings.Join(lines, "\n")
+		}
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s %s", report.Team, goal),
+			Value:  value,
+			Inline: false,
+		})
+	}
+
+	if len(embed.Fields) == 0 {
+		embed.Description = fmt.Sprintf("Nothing can be clinched or lost by %s in %s.", report.Team, seasonInfo.WeekLabel())
+	}
+
+	footer := fmt.Sprintf("Checked every outcome of %d games | Ties ignored | Simplified tiebreakers", report.Games)
+	if report.Skipped > 0 {
+		footer += fmt.Sprintf(" | %d less relevant games not considered", report.Skipped)
+	}
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: footer}
+	b.themeEmbedForTeam(embed, teamInfo.Key)
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending scenarios embed response", "error", err)
+	}
+}
+
+// describeScenario joins a scenario's results from the team's point of view, e.g. "BUF win + MIA loss"
+func describeScenario(scenario standings.Scenario, team string) string {
+	if len(scenario) == 0 {
+		return "Regardless of this week's results"
+	}
+
+	var parts []string
+	for _, outcome := range scenario {
+		switch team {
+		case outcome.Winner:
+			parts = append(parts, team+" win")
+		case outcome.Loser:
+			parts = append(parts, team+" loss")
+		default:
+			parts = append(parts, outcome.Loser+" loss")
+		}
+	}
+	return strings.Join(parts, " + ")
+}
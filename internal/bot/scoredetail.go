@@ -0,0 +1,183 @@
+package bot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// scoreDetailPrefix starts the custom ID of the /scores game detail menu; the rest is
+// "<season>:<season type>:<week>" and each option's value is the game's home team
+const scoreDetailPrefix = "scores_detail:"
+
+// selectMenuOptionLimit is the most options Discord allows in a select menu
+const selectMenuOptionLimit = 25
+
+// scoreDetailMenu is the component row for picking a started game's line score, or nil when
+// none of the games have kicked off
+func scoreDetailMenu(season int, seasonType string, week int, games []*models.LiveScore) []discordgo.MessageComponent {
+	var options []discordgo.SelectMenuOption
+	for _, game := range games {
+		if !game.IsLive() && !game.IsCompleted() {
+			continue
+		}
+		if len(options) == selectMenuOptionLimit {
+			break
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label: fmt.Sprintf("%s @ %s", game.AwayTeam, game.HomeTeam),
+			Value: game.HomeTeam,
+			Emoji: &discordgo.ComponentEmoji{Name: "📋"},
+		})
+	}
+	if len(options) == 0 {
+		return nil
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    fmt.Sprintf("%s%d:%s:%d", scoreDetailPrefix, season, seasonType, week),
+					Placeholder: "Game detail: line score, down & distance",
+					Options:     options,
+				},
+			},
+		},
+	}
+}
+
+// handleScoreDetailComponent shows a game's line score privately when it's picked from /scores
+func (b *Bot) handleScoreDetailComponent(s Responder, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	parts := strings.Split(strings.TrimPrefix(data.CustomID, scoreDetailPrefix), ":")
+	if len(parts) != 3 || len(data.Values) != 1 {
+		return
+	}
+	season, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return
+	}
+	week, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	})
+	if err != nil {
+		logger.Error("error sending initial game detail response", "error", err)
+		return
+	}
+
+	go b.processScoreDetailRequest(s, i, season, parts[1], week, data.Values[0])
+}
+
+// processScoreDetailRequest looks up a game and replies with its line score and situation
+func (b *Bot) processScoreDetailRequest(s Responder, i *discordgo.InteractionCreate, season int, seasonType string, week int, homeTeam string) {
+	games, err := b.nflClient.GetScoresForWeek(season, seasonType, week)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting game detail", err))
+		return
+	}
+
+	var game *models.LiveScore
+	for _, candidate := range games {
+		if candidate.HomeTeam == homeTeam {
+			game = candidate
+			break
+		}
+	}
+	if game == nil {
+		b.completeInteraction(s, i, fmt.Sprintf("❌ %s isn't hosting a game in %s anymore.", homeTeam, models.WeekLabel(seasonType, week)))
+		return
+	}
+
+	detail, err := b.nflClient.GetGameDetail(seasonType, game)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting game detail", err))
+		return
+	}
+
+	embed := scoreDetailEmbed(game, detail, models.WeekLabel(seasonType, week), b.guildFormat(i.GuildID))
+	b.themeEmbedForTeam(embed, game.HomeTeam)
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending game detail response", "error", err)
+	}
+}
+
+// scoreDetailEmbed renders a game's line score by quarter and, while it's live, the situation
+func scoreDetailEmbed(game *models.LiveScore, detail *models.GameDetail, weekLabel string, format models.Format) *discordgo.MessageEmbed {
+	status := "Final"
+	if game.IsLive() {
+		status = fmt.Sprintf("%s • %s", game.Quarter, game.TimeRemaining)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📋 %s %s @ %s %s", game.AwayTeam, format.Int(game.AwayScore), game.HomeTeam, format.Int(game.HomeScore)),
+		Description: fmt.Sprintf("%s — %s", weekLabel, status),
+		Color:       0x013369,
+		Footer:      &discordgo.MessageEmbedFooter{Text: "Data provided by SportsData.io"},
+	}
+
+	if len(detail.Quarters) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Line Score",
+			Value: lineScoreTable(game, detail.Quarters, format),
+		})
+	}
+
+	if game.IsLive() {
+		if detail.Possession != "" {
+			situation := "🏈 " + detail.Possession
+			if detail.DownDistance != "" {
+				situation += " • " + detail.DownAndDistance(format)
+			}
+			if detail.BallOn != "" {
+				situation += " at the " + detail.BallOn
+			}
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  "Possession",
+				Value: situation,
+			})
+		}
+		if lastPlay := detail.LastPlay; lastPlay != "" {
+			if len(lastPlay) > embedFieldValueLimit {
+				lastPlay = lastPlay[:embedFieldValueLimit-3] + "..."
+			}
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  "Last Play",
+				Value: lastPlay,
+			})
+		}
+	}
+
+	if len(embed.Fields) == 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Line Score",
+			Value: "No quarter-by-quarter scoring is available for this game yet.",
+		})
+	}
+	return embed
+}
+
+// lineScoreTable lays out the points per quarter and the total as a monospaced table
+func lineScoreTable(game *models.LiveScore, quarters []models.QuarterScore, format models.Format) string {
+	header := fmt.Sprintf("%-4s", "")
+	away := fmt.Sprintf("%-4s", game.AwayTeam)
+	home := fmt.Sprintf("%-4s", game.HomeTeam)
+	for _, quarter := range quarters {
+		header += fmt.Sprintf("%4s", quarter.Name)
+		away += fmt.Sprintf("%4s", format.Int(quarter.AwayScore))
+		home += fmt.Sprintf("%4s", format.Int(quarter.HomeScore))
+	}
+	header += fmt.Sprintf("%5s", "T")
+	away += fmt.Sprintf("%5s", format.Int(game.AwayScore))
+	home += fmt.Sprintf("%5s", format.Int(game.HomeScore))
+	return "```\n" + header + "\n" + away + "\n" + home + "\n```"
+}
@@ -0,0 +1,120 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// Score status filters for /scores
+const (
+	scoresStatusLive     = "live"
+	scoresStatusFinal    = "final"
+	scoresStatusUpcoming = "upcoming"
+)
+
+// scoresFilter narrows a week's scoreboard to the games a user asked for
+type scoresFilter struct {
+	Status     string // one of the scoresStatus values, empty for all
+	TeamName   string // as typed, resolved to Team by resolveScoresFilter
+	Team       string
+	Conference string // "AFC" or "NFC"
+
+	conferences map[string]string // team key to conference, loaded when filtering by conference
+}
+
+// active reports whether any filter was given
+func (f *scoresFilter) active() bool {
+	return f.Status != "" || f.TeamName != "" || f.Conference != ""
+}
+
+// resolveScoresFilter looks up the team and conference alignments a scores filter needs
+func (b *Bot) resolveScoresFilter(filter *scoresFilter) error {
+	if filter.TeamName != "" {
+		teamInfo, err := b.nflClient.GetTeamInfo(filter.TeamName)
+		if err != nil {
+			return err
+		}
+		filter.Team = teamInfo.Key
+	}
+	if filter.Conference != "" {
+		teams, err := b.nflClient.GetTeams()
+		if err != nil {
+			return err
+		}
+		filter.conferences = make(map[string]string, len(teams))
+		for _, team := range teams {
+			filter.conferences[team.Key] = team.Conference
+		}
+	}
+	return nil
+}
+
+// apply returns the scores that pass every filter, keeping their order
+func (f *scoresFilter) apply(scores []*models.LiveScore) []*models.LiveScore {
+	var kept []*models.LiveScore
+	for _, score := range scores {
+		if f.matches(score) {
+			kept = append(kept, score)
+		}
+	}
+	return kept
+}
+
+// matches reports whether one game passes the filter
+func (f *scoresFilter) matches(score *models.LiveScore) bool {
+	switch f.Status {
+	case scoresStatusLive:
+		if !score.IsLive() {
+			return false
+		}
+	case scoresStatusFinal:
+		if !score.IsCompleted() {
+			return false
+		}
+	case scoresStatusUpcoming:
+		if score.State() != models.StateScheduled {
+			return false
+		}
+	}
+	if f.Team != "" && score.AwayTeam != f.Team && score.HomeTeam != f.Team {
+		return false
+	}
+	// Interconference games show under either conference
+	if f.Conference != "" && f.conferences[score.AwayTeam] != f.Conference && f.conferences[score.HomeTeam] != f.Conference {
+		return false
+	}
+	return true
+}
+
+// label describes the filter for the scoreboard title, e.g. "Live • AFC"
+func (f *scoresFilter) label() string {
+	var parts []string
+	if f.Status != "" {
+		parts = append(parts, strings.ToUpper(f.Status[:1])+f.Status[1:])
+	}
+	if f.Team != "" {
+		parts = append(parts, f.Team)
+	}
+	if f.Conference != "" {
+		parts = append(parts, f.Conference)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// emptyMessage explains that nothing matched, e.g. "No live AFC games in Week 5."
+func (f *scoresFilter) emptyMessage(weekLabel string) string {
+	var parts []string
+	if f.Status != "" {
+		parts = append(parts, f.Status)
+	}
+	if f.Conference != "" {
+		parts = append(parts, f.Conference)
+	}
+	games := strings.TrimSpace(strings.Join(parts, " ") + " games")
+	if f.Team != "" {
+		games += " for " + f.Team
+	}
+	return fmt.Sprintf("No %s in %s.", games, weekLabel)
+}
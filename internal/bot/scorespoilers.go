@@ -0,0 +1,271 @@
+package bot
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/i18n"
+)
+
+// revealCustomID is the custom ID of the reveal button on spoiler-hidden scores
+const revealCustomID = "spoiler_reveal"
+
+// revealTTL is how long the hidden scores behind a reveal button are kept
+const revealTTL = 48 * time.Hour
+
+// spoilerModeOff is the /spoiler-mode choice that turns score spoilers off (choice values can't be empty)
+const spoilerModeOff = "off"
+
+// Discord's length limits for embed text that gets wrapped in spoiler tags
+const (
+	embedDescriptionLimit = 4096
+	embedFieldValueLimit  = 1024
+)
+
+// reveal is the unhidden version of a spoiler-hidden message
+type reveal struct {
+	content string
+	embeds  []*discordgo.MessageEmbed
+	expires time.Time
+}
+
+// revealCache tracks the unhidden versions of spoiler-hidden messages by message ID
+type revealCache struct {
+	mu      sync.Mutex
+	reveals map[string]*reveal
+}
+
+// newRevealCache creates an empty reveal cache
+func newRevealCache() *revealCache {
+	return &revealCache{reveals: make(map[string]*reveal)}
+}
+
+// Put stores the unhidden version of a message and drops expired entries
+func (rc *revealCache) Put(messageID, content string, revealed []*discordgo.MessageEmbed) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	now := time.Now()
+	for id, existing := range rc.reveals {
+		if now.After(existing.expires) {
+			delete(rc.reveals, id)
+		}
+	}
+	rc.reveals[messageID] = &reveal{content: content, embeds: revealed, expires: now.Add(revealTTL)}
+}
+
+// Get returns the unhidden version of a message if it has not expired
+func (rc *revealCache) Get(messageID string) (*reveal, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	r, exists := rc.reveals[messageID]
+	if !exists || time.Now().After(r.expires) {
+		return nil, false
+	}
+	return r, true
+}
+
+// revealButton is the component row holding the reveal button
+func revealButton() []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Reveal",
+					Style:    discordgo.SecondaryButton,
+					CustomID: revealCustomID,
+					Emoji:    &discordgo.ComponentEmoji{Name: "👁️"},
+				},
+			},
+		},
+	}
+}
+
+// scoreSpoilers returns a guild's score spoiler mode
+func (b *Bot) scoreSpoilers(guildID string) string {
+	if guildID == "" {
+		return embeds.SpoilersOff
+	}
+	return b.settings.Get(guildID).ScoreSpoilers
+}
+
+// channelGuildID returns the guild a channel belongs to, or "" for DMs and unknown channels
+func (b *Bot) channelGuildID(channelID string) string {
+	channel, err := b.discord.State.Channel(channelID)
+	if err != nil {
+		channel, err = b.discord.Channel(channelID)
+	}
+	if err != nil {
+		return ""
+	}
+	return channel.GuildID
+}
+
+// completeInteractionScores replaces a deferred response with a scores embed and any extra
+// components; when revealed is set, the embed has scores hidden and a reveal button shows
+// revealed privately
+func (b *Bot) completeInteractionScores(s Responder, i *discordgo.InteractionCreate, embed, revealed *discordgo.MessageEmbed, components ...discordgo.MessageComponent) error {
+	if revealed == nil {
+		if len(components) == 0 {
+			return b.completeInteractionEmbed(s, i, embed)
+		}
+		_, err := b.completeInteractionComponents(s, i, embed, components)
+		return err
+	}
+	message, err := b.completeInteractionComponents(s, i, embed, append(revealButton(), components...))
+	if err != nil {
+		return err
+	}
+	b.reveals.Put(message.ID, "", []*discordgo.MessageEmbed{revealed})
+	return nil
+}
+
+// sendScoresEmbed sends a scores embed to a channel for prefix commands, with a reveal button
+// when revealed is set
+func (b *Bot) sendScoresEmbed(s Responder, channelID string, embed, revealed *discordgo.MessageEmbed) {
+	if revealed == nil {
+		b.sendEmbed(s, channelID, embed)
+		return
+	}
+	message, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: revealButton(),
+	})
+	if err != nil {
+		logger.Error("error sending embed", "error", err)
+		return
+	}
+	b.reveals.Put(message.ID, "", []*discordgo.MessageEmbed{revealed})
+}
+
+// hidePost returns a copy of an automated post with its content and embeds hidden for a spoiler
+// mode; polls are left as they are
+func hidePost(post *DelayedPost, spoilers string) *DelayedPost {
+	hidden := *post
+	switch {
+	case post.Content == "":
+	case spoilers == embeds.SpoilersTagged:
+		hidden.Content = embeds.Spoiler(post.Content)
+	default:
+		hidden.Content = "🙈 Result hidden on this server — press **Reveal** to see it."
+	}
+
+	hidden.Embeds = make([]*discordgo.MessageEmbed, len(post.Embeds))
+	for index, embed := range post.Embeds {
+		hidden.Embeds[index] = hideEmbed(embed, spoilers)
+	}
+	return &hidden
+}
+
+// hideEmbed returns a copy of an automated embed with its text behind spoiler tags, or replaced
+// with a reveal prompt when the mode hides scores entirely. The title moves into the description
+// since Discord doesn't render spoiler tags in titles.
+func hideEmbed(embed *discordgo.MessageEmbed, spoilers string) *discordgo.MessageEmbed {
+	hidden := &discordgo.MessageEmbed{
+		Title:  "🙈 Result hidden",
+		Color:  embed.Color,
+		Footer: embed.Footer,
+	}
+	if spoilers != embeds.SpoilersTagged {
+		hidden.Description = "This server hides scores — press **Reveal** to see this post privately."
+		return hidden
+	}
+
+	var description string
+	if embed.Title != "" {
+		description = embeds.Spoiler(embed.Title)
+	}
+	if embed.Description != "" {
+		if description != "" {
+			description += "\n"
+		}
+		description += embeds.Spoiler(embed.Description)
+	}
+	hidden.Description = spoilerOrPrompt(description, embedDescriptionLimit)
+
+	for _, field := range embed.Fields {
+		hidden.Fields = append(hidden.Fields, &discordgo.MessageEmbedField{
+			Name:   field.Name,
+			Value:  spoilerOrPrompt(embeds.Spoiler(field.Value), embedFieldValueLimit),
+			Inline: field.Inline,
+		})
+	}
+	return hidden
+}
+
+// spoilerOrPrompt returns tagged text, or a reveal prompt when the spoiler tags push it past limit
+func spoilerOrPrompt(text string, limit int) string {
+	if len(text) > limit {
+		return "Press **Reveal** to see it."
+	}
+	return text
+}
+
+// handleRevealComponent privately shows the scores behind a spoiler-hidden message
+func (b *Bot) handleRevealComponent(s Responder, i *discordgo.InteractionCreate) {
+	r, exists := b.reveals.Get(i.Message.ID)
+	if !exists {
+		respondEphemeral(s, i, b.tr(i, "spoilers.expired"))
+		return
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: r.content,
+			Embeds:  r.embeds,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		logger.Error("error revealing hidden scores", "error", err)
+	}
+}
+
+// handleSlashSpoilerMode handles the /spoiler-mode slash command (admin only)
+func (b *Bot) handleSlashSpoilerMode(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	mode := ""
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "mode" {
+			mode = option.StringValue()
+		}
+	}
+
+	if mode == "" {
+		respondEphemeral(s, i, "🙈 "+describeSpoilerMode(b.interactionLanguage(i), b.settings.Get(i.GuildID).ScoreSpoilers))
+		return
+	}
+
+	spoilers := mode
+	if mode == spoilerModeOff {
+		spoilers = embeds.SpoilersOff
+	}
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.ScoreSpoilers = spoilers
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "spoilers.save_failed"))
+		return
+	}
+
+	respondEphemeral(s, i, "✅ "+describeSpoilerMode(b.interactionLanguage(i), spoilers))
+}
+
+// describeSpoilerMode explains what a score spoiler mode does
+func describeSpoilerMode(language, spoilers string) string {
+	switch spoilers {
+	case embeds.SpoilersTagged:
+		return i18n.T(language, "spoilers.mode.tagged")
+	case embeds.SpoilersHidden:
+		return i18n.T(language, "spoilers.mode.hidden")
+	}
+	return i18n.T(language, "spoilers.mode.off")
+}
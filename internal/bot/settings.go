@@ -0,0 +1,273 @@
+package bot
+
+import (
+	"crypto/subtle"
+	"sync"
+	"time"
+
+	"nfl-discord-bot/internal/storage"
+)
+
+// guildSettingsDocument is the storage document holding per-guild settings
+const guildSettingsDocument = "guild_settings"
+
+// GuildSettings holds configuration a guild's admins can change with slash commands
+type GuildSettings struct {
+	AlertChannelID string                 `json:"alert_channel_id,omitempty"` // channel ID or webhook URL
+	ScoringFormat  string                 `json:"scoring_format,omitempty"`   // fantasy scoring, see fantasy.ParseFormat
+	Timezone       string                 `json:"timezone,omitempty"`         // IANA zone used for league dates
+	Language       string                 `json:"language,omitempty"`         // response language, see i18n.Languages; empty follows the guild's Discord locale
+	LeagueDates    map[string]*LeagueDate `json:"league_dates,omitempty"`
+	Aliases        map[string]string      `json:"aliases,omitempty"` // lowercase shorthand to the team abbreviation or player name it stands for
+
+	PublicResponses *bool `json:"public_responses,omitempty"` // default for the public option; nil falls back to BOT_VISIBILITY_ROLE
+
+	LeaderboardToken string `json:"leaderboard_token,omitempty"` // secret path of the public leaderboard page
+
+	SlowMode map[string]*SlowModeRule `json:"slow_mode,omitempty"` // game-day slow mode rules by channel ID
+
+	SpoilerDelayMinutes int    `json:"spoiler_delay_minutes,omitempty"` // hold automated score posts and alerts back this long
+	ScoreSpoilers       string `json:"score_spoilers,omitempty"`        // hide scores behind spoiler tags or a reveal button, see embeds.SpoilersTagged
+
+	NumberSeparator string `json:"number_separator,omitempty"` // thousands separator, see models.SeparatorComma; empty means comma
+	Metric          bool   `json:"metric,omitempty"`           // show distances in meters instead of yards
+	Clock24         bool   `json:"clock_24,omitempty"`         // show game times on a 24-hour clock
+
+	BigGameChannelID  string `json:"big_game_channel_id,omitempty"`  // where championship weekend and Super Bowl posts go
+	BigGameWatchParty bool   `json:"big_game_watch_party,omitempty"` // also schedule a watch-party event for each big game
+
+	GameThreadChannelID string   `json:"game_thread_channel_id,omitempty"` // where a thread is opened for each game at kickoff
+	GameThreadTeams     []string `json:"game_thread_teams,omitempty"`      // team abbreviations; empty means every game
+
+	PlayAlerts map[string]*PlayAlertRule `json:"play_alerts,omitempty"` // scoring play alert rules by channel ID or webhook URL
+
+	DigestChannelID   string `json:"digest_channel_id,omitempty"`   // channel ID or webhook URL weekly previews and results go to
+	DigestDay         string `json:"digest_day,omitempty"`          // preview day, see digestDays
+	DigestPreviewWeek string `json:"digest_preview_week,omitempty"` // last week previewed, see digestWeekKey
+	DigestResultsWeek string `json:"digest_results_week,omitempty"` // last week recapped
+
+	DraftFeedChannelID string `json:"draft_feed_channel_id,omitempty"` // where live draft picks are posted
+}
+
+// PlayAlertRule posts alerts for notable plays to a channel or webhook
+type PlayAlertRule struct {
+	Teams []string `json:"teams,omitempty"` // team abbreviations; empty means every game
+	Plays []string `json:"plays,omitempty"` // play kinds, see models.PlayKinds; empty means every kind
+}
+
+// SlowModeRule turns on slow mode in a channel while one of its teams is playing
+type SlowModeRule struct {
+	Teams   []string `json:"teams,omitempty"` // team abbreviations; empty means every game
+	Seconds int      `json:"seconds"`         // slow mode delay during games
+
+	Active      bool `json:"active,omitempty"`       // the bot currently has slow mode on
+	RestoreRate int  `json:"restore_rate,omitempty"` // the channel's delay before the bot turned slow mode on
+}
+
+// LeagueDate is a commissioner-registered league event
+type LeagueDate struct {
+	Time      time.Time `json:"time"`
+	ChannelID string    `json:"channel_id"` // where reminders are posted
+}
+
+// settingsStore keeps guild settings in memory and persists every change
+type settingsStore struct {
+	mu     sync.RWMutex
+	store  *storage.Store
+	guilds map[string]*GuildSettings
+}
+
+// newSettingsStore loads guild settings from storage
+func newSettingsStore(store *storage.Store) (*settingsStore, error) {
+	settings := &settingsStore{
+		store:  store,
+		guilds: make(map[string]*GuildSettings),
+	}
+	if err := store.Load(guildSettingsDocument, &settings.guilds); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// Get returns a copy of a guild's settings (zero values when the guild has none)
+func (ss *settingsStore) Get(guildID string) GuildSettings {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	if settings, exists := ss.guilds[guildID]; exists {
+		copied := *settings
+		if settings.LeagueDates != nil {
+			copied.LeagueDates = make(map[string]*LeagueDate, len(settings.LeagueDates))
+			for event, date := range settings.LeagueDates {
+				dateCopy := *date
+				copied.LeagueDates[event] = &dateCopy
+			}
+		}
+		if settings.SlowMode != nil {
+			copied.SlowMode = copySlowModeRules(settings.SlowMode)
+		}
+		copied.GameThreadTeams = append([]string(nil), settings.GameThreadTeams...)
+		if settings.PlayAlerts != nil {
+			copied.PlayAlerts = copyPlayAlertRules(settings.PlayAlerts)
+		}
+		return copied
+	}
+	return GuildSettings{}
+}
+
+// Update applies a change to a guild's settings and persists the result
+func (ss *settingsStore) Update(guildID string, change func(*GuildSettings)) error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	settings, exists := ss.guilds[guildID]
+	if !exists {
+		settings = &GuildSettings{}
+		ss.guilds[guildID] = settings
+	}
+	change(settings)
+
+	if err := ss.store.Save(guildSettingsDocument, ss.guilds); err != nil {
+		logger.Error("error saving guild settings", "error", err)
+		return err
+	}
+	return nil
+}
+
+// AlertChannels returns the alert channel ID or webhook URL for every guild that configured one, keyed by guild ID
+func (ss *settingsStore) AlertChannels() map[string]string {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	channels := make(map[string]string)
+	for guildID, settings := range ss.guilds {
+		if settings.AlertChannelID != "" {
+			channels[guildID] = settings.AlertChannelID
+		}
+	}
+	return channels
+}
+
+// BigGameChannels returns the big-game channel ID for every guild that configured one, keyed by guild ID
+func (ss *settingsStore) BigGameChannels() map[string]string {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	channels := make(map[string]string)
+	for guildID, settings := range ss.guilds {
+		if settings.BigGameChannelID != "" {
+			channels[guildID] = settings.BigGameChannelID
+		}
+	}
+	return channels
+}
+
+// DigestChannels returns the weekly digest channel ID or webhook URL for every guild that configured one, keyed by guild ID
+func (ss *settingsStore) DigestChannels() map[string]string {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	channels := make(map[string]string)
+	for guildID, settings := range ss.guilds {
+		if settings.DigestChannelID != "" {
+			channels[guildID] = settings.DigestChannelID
+		}
+	}
+	return channels
+}
+
+// DraftFeedChannels returns the draft feed channel ID for every guild that configured one, keyed by guild ID
+func (ss *settingsStore) DraftFeedChannels() map[string]string {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	channels := make(map[string]string)
+	for guildID, settings := range ss.guilds {
+		if settings.DraftFeedChannelID != "" {
+			channels[guildID] = settings.DraftFeedChannelID
+		}
+	}
+	return channels
+}
+
+// GameThreadChannels returns the game-thread channel ID for every guild that configured one, keyed by guild ID
+func (ss *settingsStore) GameThreadChannels() map[string]string {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	channels := make(map[string]string)
+	for guildID, settings := range ss.guilds {
+		if settings.GameThreadChannelID != "" {
+			channels[guildID] = settings.GameThreadChannelID
+		}
+	}
+	return channels
+}
+
+// SlowModeRules returns a copy of every guild's game-day slow mode rules
+func (ss *settingsStore) SlowModeRules() map[string]map[string]*SlowModeRule {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	rules := make(map[string]map[string]*SlowModeRule)
+	for guildID, settings := range ss.guilds {
+		if len(settings.SlowMode) > 0 {
+			rules[guildID] = copySlowModeRules(settings.SlowMode)
+		}
+	}
+	return rules
+}
+
+// copySlowModeRules deep-copies a guild's slow mode rules
+func copySlowModeRules(rules map[string]*SlowModeRule) map[string]*SlowModeRule {
+	copied := make(map[string]*SlowModeRule, len(rules))
+	for channelID, rule := range rules {
+		ruleCopy := *rule
+		ruleCopy.Teams = append([]string(nil), rule.Teams...)
+		copied[channelID] = &ruleCopy
+	}
+	return copied
+}
+
+// PlayAlertRules returns a copy of every guild's scoring play alert rules
+func (ss *settingsStore) PlayAlertRules() map[string]map[string]*PlayAlertRule {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	rules := make(map[string]map[string]*PlayAlertRule)
+	for guildID, settings := range ss.guilds {
+		if len(settings.PlayAlerts) > 0 {
+			rules[guildID] = copyPlayAlertRules(settings.PlayAlerts)
+		}
+	}
+	return rules
+}
+
+// copyPlayAlertRules deep-copies a guild's play alert rules
+func copyPlayAlertRules(rules map[string]*PlayAlertRule) map[string]*PlayAlertRule {
+	copied := make(map[string]*PlayAlertRule, len(rules))
+	for channelID, rule := range rules {
+		copied[channelID] = &PlayAlertRule{
+			Teams: append([]string(nil), rule.Teams...),
+			Plays: append([]string(nil), rule.Plays...),
+		}
+	}
+	return copied
+}
+
+// GuildByLeaderboardToken finds the guild a public leaderboard page token belongs to
+func (ss *settingsStore) GuildByLeaderboardToken(token string) (string, bool) {
+	if token == "" {
+		return "", false
+	}
+
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	for guildID, settings := range ss.guilds {
+		if subtle.ConstantTimeCompare([]byte(settings.LeaderboardToken), []byte(token)) == 1 {
+			return guildID, true
+		}
+	}
+	return "", false
+}
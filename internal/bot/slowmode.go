@@ -0,0 +1,271 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// Game-day slow mode timing
+const (
+	slowModeCheckInterval  = time.Minute
+	slowModeLeadTime       = 15 * time.Minute // turn slow mode on this long before kickoff
+	slowModeMaxGameLength  = 5 * time.Hour    // give up on a game whose final never arrives
+	slowModeDefaultSeconds = 10
+	slowModeMaxSeconds     = 21600 // Discord's limit
+)
+
+// runSlowModeWatcher toggles game-day slow mode as games start and finish until the bot stops
+func (b *Bot) runSlowModeWatcher() {
+	ticker := time.NewTicker(slowModeCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.updateSlowMode(time.Now())
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// updateSlowMode turns slow mode on in channels whose teams are playing and restores the rest
+func (b *Bot) updateSlowMode(now time.Time) {
+	rules := b.settings.SlowModeRules()
+	if len(rules) == 0 {
+		return
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		logger.Warn("skipping slow mode check", "error", err)
+		return
+	}
+	scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		logger.Warn("skipping slow mode check", "error", err)
+		return
+	}
+
+	for guildID, channels := range rules {
+		for channelID, rule := range channels {
+			playing := slowModeGameOn(rule.Teams, scores, now)
+			if playing && !rule.Active {
+				b.enableSlowMode(guildID, channelID, rule)
+			} else if !playing && rule.Active {
+				b.restoreSlowMode(guildID, channelID, rule)
+			}
+		}
+	}
+}
+
+// slowModeGameOn reports whether any game involving the teams (or any game at all when teams
+// is empty) is live or inside its game window
+func slowModeGameOn(teams []string, scores []*models.LiveScore, now time.Time) bool {
+	for _, score := range scores {
+		if len(teams) > 0 && !containsTeam(teams, score.HomeTeam) && !containsTeam(teams, score.AwayTeam) {
+			continue
+		}
+		state := score.State()
+		if state == models.StateLive {
+			return true
+		}
+		if state != models.StateScheduled && state != models.StateDelayed {
+			continue // final, postponed, and canceled games don't need slow mode
+		}
+		if now.After(score.GameTime.Add(-slowModeLeadTime)) && now.Before(score.GameTime.Add(slowModeMaxGameLength)) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsTeam reports whether team is in teams
+func containsTeam(teams []string, team string) bool {
+	for _, candidate := range teams {
+		if candidate == team {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTeamList resolves a comma-separated list of team names to unique abbreviations, also
+// returning the first name that matched no team
+func (b *Bot) resolveTeamList(teamList string) ([]string, string) {
+	var teams []string
+	for _, name := range strings.Split(teamList, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		teamInfo, err := b.nflClient.GetTeamInfo(name)
+		if err != nil {
+			return nil, name
+		}
+		if !containsTeam(teams, teamInfo.Key) {
+			teams = append(teams, teamInfo.Key)
+		}
+	}
+	return teams, ""
+}
+
+// enableSlowMode applies a rule's delay to its channel, remembering the delay to restore afterward
+func (b *Bot) enableSlowMode(guildID, channelID string, rule *SlowModeRule) {
+	channel, err := b.discord.Channel(channelID)
+	if err != nil {
+		logger.Warn("error reading channel for slow mode", "guild", guildID, "channel", channelID, "error", err)
+		return
+	}
+
+	seconds := rule.Seconds
+	if _, err := b.discord.ChannelEdit(channelID, &discordgo.ChannelEdit{RateLimitPerUser: &seconds}); err != nil {
+		logger.Warn("error enabling slow mode", "guild", guildID, "channel", channelID, "error", err)
+		return
+	}
+
+	b.settings.Update(guildID, func(settings *GuildSettings) {
+		if current, exists := settings.SlowMode[channelID]; exists {
+			current.Active = true
+			current.RestoreRate = channel.RateLimitPerUser
+		}
+	})
+	logger.Info("game-day slow mode enabled", "guild", guildID, "channel", channelID, "seconds", seconds)
+}
+
+// restoreSlowMode puts a channel's delay back to what it was before game-day slow mode
+func (b *Bot) restoreSlowMode(guildID, channelID string, rule *SlowModeRule) {
+	restore := rule.RestoreRate
+	if _, err := b.discord.ChannelEdit(channelID, &discordgo.ChannelEdit{RateLimitPerUser: &restore}); err != nil {
+		// Stop tracking the channel anyway so a deleted channel or lost permission isn't retried forever
+		logger.Warn("error restoring slow mode", "guild", guildID, "channel", channelID, "error", err)
+	} else {
+		logger.Info("game-day slow mode disabled", "guild", guildID, "channel", channelID)
+	}
+
+	b.settings.Update(guildID, func(settings *GuildSettings) {
+		if current, exists := settings.SlowMode[channelID]; exists {
+			current.Active = false
+			current.RestoreRate = 0
+		}
+	})
+}
+
+// handleSlashSlowMode handles the /slowmode slash command (admin only)
+func (b *Bot) handleSlashSlowMode(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	subcommand := options[0]
+	switch subcommand.Name {
+	case "add":
+		b.respondSlowModeAdd(s, i, subcommand.Options)
+	case "remove":
+		b.respondSlowModeRemove(s, i, subcommand.Options[0].ChannelValue(nil).ID)
+	case "list":
+		respondEphemeral(s, i, b.slowModeSummary(i.GuildID))
+	}
+}
+
+// respondSlowModeAdd creates or replaces a channel's game-day slow mode rule
+func (b *Bot) respondSlowModeAdd(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	var channelID, teamList string
+	seconds := slowModeDefaultSeconds
+	for _, option := range options {
+		switch option.Name {
+		case "channel":
+			channelID = option.ChannelValue(nil).ID
+		case "teams":
+			teamList = option.StringValue()
+		case "seconds":
+			seconds = int(option.IntValue())
+		}
+	}
+
+	teams, unknown := b.resolveTeamList(teamList)
+	if unknown != "" {
+		respondEphemeral(s, i, b.tr(i, "error.unknown_team", unknown))
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		if settings.SlowMode == nil {
+			settings.SlowMode = make(map[string]*SlowModeRule)
+		}
+		rule, exists := settings.SlowMode[channelID]
+		if !exists {
+			rule = &SlowModeRule{}
+			settings.SlowMode[channelID] = rule
+		}
+		// An active rule keeps its restore point so the channel still goes back to normal
+		rule.Teams = teams
+		rule.Seconds = seconds
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "slowmode.save_failed"))
+		return
+	}
+
+	respondEphemeral(s, i, b.tr(i, "slowmode.added",
+		channelID, seconds, slowModeTeamsLabel(teams)))
+}
+
+// respondSlowModeRemove deletes a channel's rule, restoring its delay first if slow mode is on
+func (b *Bot) respondSlowModeRemove(s Responder, i *discordgo.InteractionCreate, channelID string) {
+	rule, exists := b.settings.Get(i.GuildID).SlowMode[channelID]
+	if !exists {
+		respondEphemeral(s, i, b.tr(i, "slowmode.no_rule", channelID))
+		return
+	}
+	if rule.Active {
+		b.restoreSlowMode(i.GuildID, channelID, rule)
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		delete(settings.SlowMode, channelID)
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "slowmode.remove_failed"))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "slowmode.removed", channelID))
+}
+
+// slowModeSummary lists a guild's slow mode rules
+func (b *Bot) slowModeSummary(guildID string) string {
+	rules := b.settings.Get(guildID).SlowMode
+	if len(rules) == 0 {
+		return "No game-day slow mode rules. Add one with `/slowmode add`."
+	}
+
+	var lines []string
+	for channelID, rule := range rules {
+		line := fmt.Sprintf("<#%s> — %ds during %s", channelID, rule.Seconds, slowModeTeamsLabel(rule.Teams))
+		if rule.Active {
+			line += " • **on now**"
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+	return "🐢 **Game-day slow mode**\n" + strings.Join(lines, "\n")
+}
+
+// slowModeTeamsLabel describes which games a rule covers
+func slowModeTeamsLabel(teams []string) string {
+	if len(teams) == 0 {
+		return "every game"
+	}
+	return strings.Join(teams, ", ") + " games"
+}
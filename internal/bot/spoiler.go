@@ -0,0 +1,261 @@
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/storage"
+)
+
+// delayedPostsDocument is the storage document holding automated posts waiting out a spoiler delay
+const delayedPostsDocument = "delayed_posts"
+
+// delayedPostCheckInterval is how often the queue looks for posts whose delay has passed
+const delayedPostCheckInterval = 30 * time.Second
+
+// maxSpoilerDelayMinutes caps /spoiler-delay at one day
+const maxSpoilerDelayMinutes = 1440
+
+// DelayedPost is an automated score post or alert held back for a guild's spoiler delay
+type DelayedPost struct {
+	ChannelID string                    `json:"channel_id"`
+	Content   string                    `json:"content,omitempty"`
+	Notice    string                    `json:"notice,omitempty"` // spoiler delay notice, shown even when scores are hidden
+	Embeds    []*discordgo.MessageEmbed `json:"embeds"`
+	Poll      *discordgo.Poll           `json:"poll,omitempty"`
+	ReplyTo   string                    `json:"reply_to,omitempty"` // message the post replies to
+	PostAt    time.Time                 `json:"post_at"`
+
+	// Posts to a webhook go through it instead of ChannelID, and carry their guild since the bot
+	// may not be able to see the webhook's channel
+	WebhookURL string `json:"webhook_url,omitempty"`
+	GuildID    string `json:"guild_id,omitempty"`
+
+	// Generic (non-Discord) webhooks get this JSON body instead of a message; without one they get
+	// the message itself as an automatedPayload tagged with Event
+	Payload json.RawMessage `json:"payload,omitempty"`
+	Event   string          `json:"event,omitempty"`
+}
+
+// delayedPostQueue keeps pending posts in memory and persists every change so restarts don't drop them
+type delayedPostQueue struct {
+	mu    sync.Mutex
+	store *storage.Store
+	posts []*DelayedPost
+}
+
+// newDelayedPostQueue loads pending posts from storage
+func newDelayedPostQueue(store *storage.Store) (*delayedPostQueue, error) {
+	queue := &delayedPostQueue{store: store}
+	if err := store.Load(delayedPostsDocument, &queue.posts); err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// save persists the queue; the caller must hold dq.mu
+func (dq *delayedPostQueue) save() error {
+	if err := dq.store.Save(delayedPostsDocument, dq.posts); err != nil {
+		logger.Error("error saving delayed posts", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Add queues a post
+func (dq *delayedPostQueue) Add(post *DelayedPost) error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	dq.posts = append(dq.posts, post)
+	return dq.save()
+}
+
+// TakeDue removes and returns every post due at or before now
+func (dq *delayedPostQueue) TakeDue(now time.Time) []*DelayedPost {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	var due, pending []*DelayedPost
+	for _, post := range dq.posts {
+		if !post.PostAt.After(now) {
+			due = append(due, post)
+		} else {
+			pending = append(pending, post)
+		}
+	}
+	if len(due) == 0 {
+		return nil
+	}
+
+	dq.posts = pending
+	dq.save()
+	return due
+}
+
+// postAutomated sends an automated score post or alert, holding it back when the channel's
+// guild has a spoiler delay
+func (b *Bot) postAutomated(channelID, replyTo string, embeds ...*discordgo.MessageEmbed) {
+	b.sendAutomated(&DelayedPost{ChannelID: channelID, Embeds: embeds, ReplyTo: replyTo})
+}
+
+// sendAutomated sends an automated post now, or queues it when the channel's guild has a spoiler delay
+func (b *Bot) sendAutomated(post *DelayedPost) {
+	if isWebhookTarget(post.ChannelID) {
+		// Subscriptions store a webhook URL in place of a channel ID
+		post.WebhookURL, post.ChannelID = post.ChannelID, ""
+	}
+	channelID := post.ChannelID
+	delay := b.guildSpoilerDelay(b.postGuildID(post))
+	if delay <= 0 {
+		b.deliverPost(post)
+		return
+	}
+
+	post.PostAt = time.Now().Add(delay)
+	post.Notice = fmt.Sprintf("⏱️ Posted on this server's %d-minute spoiler delay.", int(delay.Minutes()))
+	if err := b.delayedPosts.Add(post); err != nil {
+		// Losing the post is worse than posting it early
+		logger.Error("error queueing delayed post; sending now", "channel", channelID, "error", err)
+		b.deliverPost(post)
+	}
+}
+
+// spoilerDelay returns the spoiler delay of the guild a channel belongs to
+func (b *Bot) spoilerDelay(channelID string) time.Duration {
+	return b.guildSpoilerDelay(b.channelGuildID(channelID))
+}
+
+// guildSpoilerDelay returns a guild's spoiler delay, or 0 outside a guild
+func (b *Bot) guildSpoilerDelay(guildID string) time.Duration {
+	if guildID == "" {
+		return 0
+	}
+	return time.Duration(b.settings.Get(guildID).SpoilerDelayMinutes) * time.Minute
+}
+
+// postGuildID returns the guild an automated post belongs to
+func (b *Bot) postGuildID(post *DelayedPost) string {
+	if post.GuildID != "" {
+		return post.GuildID
+	}
+	return b.channelGuildID(post.ChannelID)
+}
+
+// deliverPost sends a post to its channel, hiding its scores behind a reveal button when the
+// channel's guild has a score spoiler mode
+func (b *Bot) deliverPost(post *DelayedPost) {
+	if post.Payload != nil {
+		// Posted in the background so a slow endpoint can't hold up other alerts
+		go func() {
+			if err := postJSONWebhook(post.WebhookURL, post.Payload); err != nil {
+				logger.Error("error posting webhook alert", "guild", post.GuildID, "webhook", webhookLabel(post.WebhookURL), "error", err)
+			}
+		}()
+		return
+	}
+
+	spoilers := b.scoreSpoilers(b.postGuildID(post))
+	if post.WebhookURL != "" && spoilers == embeds.SpoilersHidden {
+		// Webhook messages can't carry the bot's reveal button, so hide scores behind spoiler tags
+		spoilers = embeds.SpoilersTagged
+	}
+	sent := post
+	if spoilers != embeds.SpoilersOff {
+		sent = hidePost(post, spoilers)
+	}
+
+	content := sent.Content
+	if post.Notice != "" {
+		if content != "" {
+			content += "\n"
+		}
+		content += post.Notice
+	}
+	message := &discordgo.MessageSend{Content: content, Embeds: sent.Embeds, Poll: sent.Poll}
+	if post.WebhookURL != "" && !discordWebhookPattern.MatchString(post.WebhookURL) {
+		b.postAutomatedPayload(post, message)
+		return
+	}
+	if post.WebhookURL != "" {
+		if err := b.executeDiscordWebhook(post.WebhookURL, message); err != nil {
+			logger.Error("error sending automated webhook post", "guild", post.GuildID, "webhook", webhookLabel(post.WebhookURL), "error", err)
+		}
+		return
+	}
+	if spoilers != embeds.SpoilersOff {
+		message.Components = revealButton()
+	}
+	if post.ReplyTo != "" {
+		message.Reference = &discordgo.MessageReference{MessageID: post.ReplyTo, ChannelID: post.ChannelID}
+	}
+
+	sentMessage, err := b.discord.ChannelMessageSendComplex(post.ChannelID, message)
+	if err != nil {
+		logger.Error("error sending automated post", "channel", post.ChannelID, "error", err)
+		return
+	}
+	if spoilers != embeds.SpoilersOff {
+		b.reveals.Put(sentMessage.ID, post.Content, post.Embeds)
+	}
+}
+
+// runDelayedPostDispatcher sends delayed posts as their spoiler delay passes
+func (b *Bot) runDelayedPostDispatcher() {
+	ticker := time.NewTicker(delayedPostCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, post := range b.delayedPosts.TakeDue(time.Now()) {
+				b.deliverPost(post)
+			}
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// handleSlashSpoilerDelay handles the /spoiler-delay slash command (admin only)
+func (b *Bot) handleSlashSpoilerDelay(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	minutes := -1
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "minutes" {
+			minutes = int(option.IntValue())
+		}
+	}
+
+	if minutes < 0 {
+		current := b.settings.Get(i.GuildID).SpoilerDelayMinutes
+		if current == 0 {
+			respondEphemeral(s, i, b.tr(i, "spoiler_delay.off_current"))
+		} else {
+			respondEphemeral(s, i, b.tr(i, "spoiler_delay.current", current))
+		}
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.SpoilerDelayMinutes = minutes
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "spoiler_delay.save_failed"))
+		return
+	}
+
+	if minutes == 0 {
+		respondEphemeral(s, i, b.tr(i, "spoiler_delay.off"))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "spoiler_delay.set", minutes))
+}
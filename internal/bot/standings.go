@@ -0,0 +1,427 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/pkg/models"
+)
+
+// playoffAlertInterval is how often clinch/elimination status is re-checked
+const playoffAlertInterval = time.Hour
+
+// playoffStatusDocument is the storage document holding the last announced playoff statuses
+const playoffStatusDocument = "playoff_status"
+
+// lateSeasonRemaining is the number of remaining games at which magic numbers start being shown
+const lateSeasonRemaining = 6
+
+// playoffStatus is the set of clinch/elimination flags announced for a team
+type playoffStatus struct {
+	ClinchedTopSeed   bool `json:"clinched_top_seed"`
+	ClinchedDivision  bool `json:"clinched_division"`
+	ClinchedPlayoff   bool `json:"clinched_playoff"`
+	EliminatedPlayoff bool `json:"eliminated_playoff"`
+}
+
+// playoffStatusSnapshot is the persisted record of statuses for a season
+type playoffStatusSnapshot struct {
+	Season int                      `json:"season"`
+	Teams  map[string]playoffStatus `json:"teams"`
+}
+
+// computeStandings builds the standings table for the current regular season
+func (b *Bot) computeStandings() (*standings.Table, *models.SeasonInfo, error) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	standingsTeams, games, err := b.regularSeasonResults(seasonInfo.Season)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return standings.Compute(standingsTeams, games), seasonInfo, nil
+}
+
+// regularSeasonResults fetches the teams and regular season games standings are computed from
+func (b *Bot) regularSeasonResults(season int) ([]standings.Team, []models.Game, error) {
+	teams, err := b.nflClient.GetTeams()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	games, err := b.nflClient.GetSeasonGames(season, models.SeasonTypeRegular)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var standingsTeams []standings.Team
+	for _, team := range teams {
+		standingsTeams = append(standingsTeams, standings.Team{
+			Key:        team.Key,
+			Conference: team.Conference,
+			Division:   team.Division,
+		})
+	}
+
+	return standingsTeams, games, nil
+}
+
+// isLateSeason reports whether magic numbers are meaningful yet
+func isLateSeason(table *standings.Table) bool {
+	for _, standing := range table.Teams {
+		if standing.Remaining > lateSeasonRemaining {
+			return false
+		}
+	}
+	return true
+}
+
+// magicLabel formats a team's playoff magic number or final status
+func magicLabel(standing *standings.Standing) string {
+	switch {
+	case standing.ClinchedPlayoff:
+		return "✓"
+	case standing.EliminatedPlayoff:
+		return "e"
+	default:
+		return fmt.Sprintf("%d", standing.PlayoffMagic)
+	}
+}
+
+// conferenceChoiceOption builds the optional conference option shared by standings commands
+func conferenceChoiceOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "conference",
+		Description: "Limit to one conference",
+		Required:    false,
+		Choices: []*discordgo.ApplicationCommandOptionChoice{
+			{Name: "AFC", Value: "AFC"},
+			{Name: "NFC", Value: "NFC"},
+		},
+	}
+}
+
+// handleSlashStandings handles the /standings slash command
+func (b *Bot) handleSlashStandings(s Responder, i *discordgo.InteractionCreate) {
+	var conference string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "conference" {
+			conference = option.StringValue()
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial standings response", "error", err)
+		return
+	}
+
+	// Process standings request asynchronously
+	go b.processSlashStandingsRequest(s, i, conference)
+}
+
+// processSlashStandingsRequest builds the division standings embed and completes the deferred response
+func (b *Bot) processSlashStandingsRequest(s Responder, i *discordgo.InteractionCreate, conference string) {
+	table, seasonInfo, err := b.computeStandings()
+	if err != nil {
+		b.completeInteraction(s, i, b.userErrorFor(i, "Error getting standings", err))
+		return
+	}
+
+	lateSeason := isLateSeason(table)
+
+	embed := &discordgo.MessageEmbed{
+		Title: b.tr(i, "standings.title", seasonInfo.Season),
+		Color: 0x013369,
+	}
+
+	for _, division := range table.Divisions() {
+		if conference != "" && !strings.HasPrefix(division, conference) {
+			continue
+		}
+
+		var text strings.Builder
+		text.WriteString("```\n")
+		if lateSeason {
+			text.WriteString(fmt.Sprintf("  %-4s %-7s %5s %-5s %4s %3s\n", "TEAM", "W-L-T", "PCT", "DIV", "PD", "M#"))
+		} else {
+			text.WriteString(fmt.Sprintf("  %-4s %-7s %5s %-5s %4s\n", "TEAM", "W-L-T", "PCT", "DIV", "PD"))
+		}
+		for _, standing := range table.Division(division) {
+			marker := standing.StatusMarker()
+			if marker == "" {
+				marker = " "
+			}
+			line := fmt.Sprintf("%s %-4s %-7s %5.3f %-5s %+4d", marker, standing.Team.Key, standing.Overall.String(),
+				standing.Overall.Pct(), standing.Division.String(), standing.PointDifferential())
+			if lateSeason {
+				line += fmt.Sprintf(" %3s", magicLabel(standing))
+			}
+			text.WriteString(line + "\n")
+		}
+		text.WriteString("```")
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   division,
+			Value:  text.String(),
+			Inline: false,
+		})
+	}
+
+	footer := b.tr(i, "standings.legend")
+	if lateSeason {
+		footer += b.tr(i, "standings.legend.magic")
+	}
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: footer}
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending standings embed response", "error", err)
+	}
+}
+
+// handleSlashPlayoffPicture handles the /playoffpicture slash command
+func (b *Bot) handleSlashPlayoffPicture(s Responder, i *discordgo.InteractionCreate) {
+	var conference string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "conference" {
+			conference = option.StringValue()
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial playoffpicture response", "error", err)
+		return
+	}
+
+	// Process playoff picture request asynchronously
+	go b.processSlashPlayoffPictureRequest(s, i, conference)
+}
+
+// processSlashPlayoffPictureRequest builds the seeding embed and completes the deferred response
+func (b *Bot) processSlashPlayoffPictureRequest(s Responder, i *discordgo.InteractionCreate, conference string) {
+	table, seasonInfo, err := b.computeStandings()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting playoff picture", err))
+		return
+	}
+
+	conferences := []string{"AFC", "NFC"}
+	if conference != "" {
+		conferences = []string{conference}
+	}
+
+	lateSeason := isLateSeason(table)
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🏈 %d Playoff Picture", seasonInfo.Season),
+		Color: 0x013369,
+	}
+
+	for _, conf := range conferences {
+		var seeds, hunt, eliminated strings.Builder
+		for _, standing := range table.Conference(conf) {
+			switch {
+			case standing.Seed > 0:
+				status := ""
+				if marker := standing.StatusMarker(); marker != "" {
+					status = " (" + marker + ")"
+				} else if lateSeason {
+					status = fmt.Sprintf(" — magic #%d", standing.PlayoffMagic)
+				}
+				seeds.WriteString(fmt.Sprintf("**%d.** %s %s%s\n", standing.Seed, standing.Team.Key, standing.Overall.String(), status))
+			case standing.EliminatedPlayoff:
+				eliminated.WriteString(standing.Team.Key + " ")
+			default:
+				hunt.WriteString(fmt.Sprintf("%s %s\n", standing.Team.Key, standing.Overall.String()))
+			}
+		}
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s Seeds", conf),
+			Value:  seeds.String(),
+			Inline: true,
+		})
+		if hunt.Len() > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   "In the Hunt",
+				Value:  hunt.String(),
+				Inline: true,
+			})
+		}
+		if eliminated.Len() > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   fmt.Sprintf("%s Eliminated", conf),
+				Value:  eliminated.String(),
+				Inline: false,
+			})
+		}
+	}
+
+	embed.Footer = &discordgo.MessageEmbedFooter{
+		Text: fmt.Sprintf("Through %s | Simplified tiebreakers: H2H, division, conference, point differential", seasonInfo.WeekLabel()),
+	}
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending playoffpicture embed response", "error", err)
+	}
+}
+
+// runPlayoffAlerts periodically checks clinch/elimination status and announces changes
+func (b *Bot) runPlayoffAlerts() {
+	ticker := time.NewTicker(playoffAlertInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.checkPlayoffAlerts()
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// checkPlayoffAlerts compares current statuses to the last announced ones and posts alerts for changes
+func (b *Bot) checkPlayoffAlerts() {
+	// Never announce clinches or eliminations from stale standings
+	if b.upstreamDown() {
+		return
+	}
+
+	table, seasonInfo, err := b.computeStandings()
+	if err != nil {
+		logger.Error("playoff alert check failed", "error", err)
+		return
+	}
+
+	var previous playoffStatusSnapshot
+	if err := b.store.Load(playoffStatusDocument, &previous); err != nil {
+		logger.Error("error loading playoff status", "error", err)
+		return
+	}
+
+	current := playoffStatusSnapshot{Season: seasonInfo.Season, Teams: make(map[string]playoffStatus)}
+	for key, standing := range table.Teams {
+		current.Teams[key] = playoffStatus{
+			ClinchedTopSeed:   standing.ClinchedTopSeed,
+			ClinchedDivision:  standing.ClinchedDivision,
+			ClinchedPlayoff:   standing.ClinchedPlayoff,
+			EliminatedPlayoff: standing.EliminatedPlayoff,
+		}
+	}
+
+	// First check of a season only records the baseline so a restart never floods channels
+	if previous.Season == seasonInfo.Season && previous.Teams != nil {
+		alerts := b.playoffAlertMessages(table, previous.Teams, current.Teams)
+		if len(alerts) > 0 {
+			b.postAlerts("standings_alert", &discordgo.MessageEmbed{
+				Title:       "🚨 Playoff Race Update",
+				Color:       0xd50a0a,
+				Description: strings.Join(alerts, "\n"),
+				Timestamp:   time.Now().Format(time.RFC3339),
+			})
+		}
+	}
+
+	if err := b.store.Save(playoffStatusDocument, current); err != nil {
+		logger.Error("error saving playoff status", "error", err)
+	}
+}
+
+// playoffAlertMessages describes every status that newly became true since the last check
+func (b *Bot) playoffAlertMessages(table *standings.Table, previous, current map[string]playoffStatus) []string {
+	names := b.teamDisplayNames()
+
+	keys := make([]string, 0, len(current))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// A team can clinch a berth, its division, and the top seed in the same week, so each
+	// newly true status gets its own alert
+	var alerts []string
+	for _, key := range keys {
+		status, before := current[key], previous[key]
+		standing := table.Teams[key]
+		name := names[key]
+		if name == "" {
+			name = key
+		}
+
+		if status.ClinchedPlayoff && !before.ClinchedPlayoff {
+			alerts = append(alerts, fmt.Sprintf("🎟️ The %s have clinched a playoff berth", name))
+		}
+		if status.ClinchedDivision && !before.ClinchedDivision {
+			alerts = append(alerts, fmt.Sprintf("👑 The %s have clinched the %s", name, standing.Team.DivisionName()))
+		}
+		if status.ClinchedTopSeed && !before.ClinchedTopSeed {
+			alerts = append(alerts, fmt.Sprintf("🥇 The %s have clinched the %s's top seed", name, standing.Team.Conference))
+		}
+		if status.EliminatedPlayoff && !before.EliminatedPlayoff {
+			alerts = append(alerts, fmt.Sprintf("❌ The %s have been eliminated from playoff contention", name))
+		}
+	}
+	return alerts
+}
+
+// teamDisplayNames maps team abbreviations to "City Name" display names
+func (b *Bot) teamDisplayNames() map[string]string {
+	names := make(map[string]string)
+	teams, err := b.nflClient.GetTeams()
+	if err != nil {
+		return names
+	}
+	for _, team := range teams {
+		names[team.Key] = team.City + " " + team.Name
+	}
+	return names
+}
+
+// postAlerts sends an alert embed to every guild's configured alert channel or webhook, after any
+// spoiler delay
+func (b *Bot) postAlerts(event string, embed *discordgo.MessageEmbed) {
+	for guildID, target := range b.settings.AlertChannels() {
+		b.postToTarget(guildID, target, event, "", embed)
+	}
+}
+
+// handleSlashAlerts handles the /alerts slash command (admin only)
+func (b *Bot) handleSlashAlerts(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	target, ok := b.subscriptionTarget(s, i, i.ApplicationCommandData().Options)
+	if !ok {
+		return
+	}
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		settings.AlertChannelID = target
+	})
+	if err != nil {
+		b.respondInteraction(s, i, b.tr(i, "alerts.save_failed"))
+		return
+	}
+
+	message := "🔕 Alerts disabled for this server."
+	if target != "" {
+		message = fmt.Sprintf("🔔 Alerts will be posted to %s.", targetLabel(target))
+	}
+	if err := b.respondInteraction(s, i, message); err != nil {
+		logger.Error("error responding to alerts slash command", "error", err)
+	}
+}
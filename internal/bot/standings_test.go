@@ -0,0 +1,35 @@
+package bot
+
+import (
+	"reflect"
+	"testing"
+
+	"nfl-discord-bot/internal/standings"
+)
+
+func TestPlayoffAlertsAnnounceEveryNewClinch(t *testing.T) {
+	b := newTestBot(t)
+	table := &standings.Table{Teams: map[string]*standings.Standing{
+		"BUF": {Team: standings.Team{Key: "BUF", Conference: "AFC", Division: "East"}},
+		"KC":  {Team: standings.Team{Key: "KC", Conference: "AFC", Division: "West"}},
+	}}
+	previous := map[string]playoffStatus{
+		"KC": {ClinchedPlayoff: true},
+	}
+	current := map[string]playoffStatus{
+		"BUF": {ClinchedPlayoff: true, ClinchedDivision: true, ClinchedTopSeed: true},
+		"KC":  {ClinchedPlayoff: true, ClinchedDivision: true},
+	}
+
+	got := b.playoffAlertMessages(table, previous, current)
+
+	want := []string{
+		"🎟️ The Buffalo Bills have clinched a playoff berth",
+		"👑 The Buffalo Bills have clinched the AFC East",
+		"🥇 The Buffalo Bills have clinched the AFC's top seed",
+		"👑 The Kansas City Chiefs have clinched the AFC West",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("alerts = %q\nwant %q", got, want)
+	}
+}
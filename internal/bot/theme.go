@@ -0,0 +1,53 @@
+package bot
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// themeEmbed colors an embed with a team's primary color and shows its logo as the thumbnail,
+// keeping the embed's defaults for anything the team data lacks
+func themeEmbed(embed *discordgo.MessageEmbed, team *models.TeamInfo) *discordgo.MessageEmbed {
+	if team == nil {
+		return embed
+	}
+	if color, known := team.EmbedColor(); known {
+		embed.Color = color
+	}
+	if team.LogoURL != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: team.LogoURL}
+	}
+	return embed
+}
+
+// teamByKey returns the team with an exact abbreviation, or nil when it is unknown
+func (b *Bot) teamByKey(key string) *models.TeamInfo {
+	teams, err := b.nflClient.GetTeams()
+	if err != nil {
+		logger.Debug("team lookup for theming failed", "team", key, "error", err)
+		return nil
+	}
+	for _, team := range teams {
+		if team.Key == key {
+			return team
+		}
+	}
+	return nil
+}
+
+// themeEmbedForTeam themes an embed by team abbreviation
+func (b *Bot) themeEmbedForTeam(embed *discordgo.MessageEmbed, key string) *discordgo.MessageEmbed {
+	if key == "" {
+		return embed
+	}
+	return themeEmbed(embed, b.teamByKey(key))
+}
+
+// themeSingleGameScores themes a scores embed when the slate is a single game, such as the
+// Super Bowl; full weeks have no one team to theme by and keep the league colors
+func (b *Bot) themeSingleGameScores(embed *discordgo.MessageEmbed, scores []*models.LiveScore) {
+	if len(scores) != 1 {
+		return
+	}
+	b.themeEmbedForTeam(embed, scores[0].HomeTeam)
+}
@@ -0,0 +1,73 @@
+package bot
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// timezoneReset clears a user's time zone override
+const timezoneReset = "reset"
+
+// validTimezone reports whether name is an IANA zone the bot can render times in
+func validTimezone(name string) bool {
+	if name == "" || strings.EqualFold(name, "local") {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// userLocation returns the zone a user sees game times in: their own override, else the server's
+func (b *Bot) userLocation(guildID, userID string) *time.Location {
+	if name := b.preferences.Get(userID).Timezone; name != "" {
+		if location, err := time.LoadLocation(name); err == nil {
+			return location
+		}
+	}
+	return b.guildLocation(guildID)
+}
+
+// handleSlashTimezone handles the /timezone slash command
+func (b *Bot) handleSlashTimezone(s Responder, i *discordgo.InteractionCreate) {
+	userID := interactionUserID(i)
+
+	var name string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "zone" {
+			name = strings.TrimSpace(option.StringValue())
+		}
+	}
+
+	if name == "" {
+		location := b.userLocation(i.GuildID, userID)
+		source := b.tr(i, "timezone.source.server")
+		if b.preferences.Get(userID).Timezone != "" {
+			source = b.tr(i, "timezone.source.own")
+		}
+		respondEphemeral(s, i, b.tr(i, "timezone.current", location, source))
+		return
+	}
+
+	if strings.EqualFold(name, timezoneReset) {
+		name = ""
+	} else if !validTimezone(name) {
+		respondEphemeral(s, i, b.tr(i, "timezone.unknown", name))
+		return
+	}
+
+	err := b.preferences.Update(userID, func(preferences *UserPreferences) {
+		preferences.Timezone = name
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "timezone.save_failed"))
+		return
+	}
+
+	if name == "" {
+		respondEphemeral(s, i, b.tr(i, "timezone.cleared", b.guildLocation(i.GuildID)))
+		return
+	}
+	respondEphemeral(s, i, b.tr(i, "timezone.set", name))
+}
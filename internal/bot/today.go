@@ -0,0 +1,233 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/internal/nfl"
+	"nfl-discord-bot/pkg/models"
+)
+
+// handleSlashToday handles the /today slash command
+func (b *Bot) handleSlashToday(s Responder, i *discordgo.InteractionCreate) {
+	userID := interactionUserID(i)
+	preferences := b.preferences.Get(userID)
+	if len(todayTeams(preferences)) == 0 && len(preferences.WatchPlayers) == 0 && len(b.reminders.ForUser(userID)) == 0 {
+		respondEphemeral(s, i, b.tr(i, "today.nothing_followed"))
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial today response", "error", err)
+		return
+	}
+
+	// Process today request asynchronously
+	go b.processSlashTodayRequest(s, i, userID, preferences)
+}
+
+// todayTeams returns the user's followed and watched teams without repeats
+func todayTeams(preferences UserPreferences) []string {
+	var teams []string
+	for _, team := range append(append([]string(nil), preferences.Teams...), preferences.WatchTeams...) {
+		if !containsTeam(teams, team) {
+			teams = append(teams, team)
+		}
+	}
+	sort.Strings(teams)
+	return teams
+}
+
+// processSlashTodayRequest gathers the user's games, players, pick'em deadlines, and reminders for today
+func (b *Bot) processSlashTodayRequest(s Responder, i *discordgo.InteractionCreate, userID string, preferences UserPreferences) {
+	seasonInfo, games, err := b.currentPickemWeek()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting today's games", err))
+		return
+	}
+
+	location := b.userLocation(i.GuildID, userID)
+	format := b.guildFormat(i.GuildID)
+	now := time.Now().In(location)
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	var today []*models.LiveScore
+	for _, game := range games {
+		if !game.GameTime.Before(dayStart) && game.GameTime.Before(dayEnd) {
+			today = append(today, game)
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📅 Today for You — %s", now.Format("Monday, Jan 2")),
+		Description: fmt.Sprintf("%s, %d • times in %s", models.WeekLabel(seasonInfo.SeasonType, seasonInfo.Week), seasonInfo.Season, location.String()),
+		Color:       0x013369,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Follow teams with /follow • players with /watchlist • set your zone with /timezone",
+		},
+	}
+
+	if teams := todayTeams(preferences); len(teams) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "🏈 Your Teams",
+			Value: todayTeamLines(teams, games, dayEnd, location, format),
+		})
+	}
+
+	if len(preferences.WatchPlayers) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "👀 Your Players",
+			Value: b.todayPlayerLines(seasonInfo, preferences.WatchPlayers, today, location, format),
+		})
+	}
+
+	if i.GuildID != "" {
+		if deadlines := b.todayPickemDeadlines(i.GuildID, userID, seasonInfo, pickemLockTime(games), today, time.Now(), location, format); deadlines != "" {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "🎯 Pick'em", Value: deadlines})
+		}
+	}
+
+	if reminders := todayReminderLines(b.reminders.ForUser(userID), dayEnd); reminders != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "⏰ Reminders", Value: reminders})
+	}
+
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending today embed response", "error", err)
+	}
+}
+
+// todayTeamLines describes each team's game today, or its next kickoff this week when it doesn't play today
+func todayTeamLines(teams []string, games []*models.LiveScore, dayEnd time.Time, location *time.Location, format models.Format) string {
+	var lines []string
+	for _, team := range teams {
+		var game *models.LiveScore
+		for _, candidate := range games {
+			if candidate.HomeTeam == team || candidate.AwayTeam == team {
+				game = candidate
+				break
+			}
+		}
+
+		switch {
+		case game == nil:
+			lines = append(lines, fmt.Sprintf("**%s** — no game this week", team))
+		case game.IsLive():
+			lines = append(lines, fmt.Sprintf("🔴 **%s** — live: %s", team, game.GetScoreString()))
+		case game.IsCompleted(), game.State().Interrupted():
+			lines = append(lines, fmt.Sprintf("**%s** — %s", team, game.GetScoreString()))
+		case game.GameTime.Before(dayEnd):
+			lines = append(lines, fmt.Sprintf("**%s** — %s @ %s, kickoff %s", team, game.AwayTeam, game.HomeTeam,
+				embeds.FormatKickoff(game.GameTime, location, "3:04 PM", format)))
+		default:
+			lines = append(lines, fmt.Sprintf("**%s** — no game today; next %s @ %s %s", team, game.AwayTeam, game.HomeTeam,
+				embeds.FormatKickoff(game.GameTime, location, "Mon 3:04 PM", format)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// todayPlayerLines lists each watched player's injury designation and whether their team plays today
+func (b *Bot) todayPlayerLines(seasonInfo *models.SeasonInfo, names []string, today []*models.LiveScore, location *time.Location, format models.Format) string {
+	lookups, err := b.nflClient.GetPlayersWeekStats(names, seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		logger.Warn("today players shown without status", "error", err)
+		lookups = make([]nfl.WeekLookup, len(names))
+		for index, name := range names {
+			lookups[index] = nfl.WeekLookup{Query: name, Err: err}
+		}
+	}
+
+	var lines []string
+	for _, lookup := range lookups {
+		if lookup.Err != nil {
+			lines = append(lines, fmt.Sprintf("**%s** — no status yet this week", lookup.Query))
+			continue
+		}
+		stats := lookup.Stats
+		status := "no injury designation"
+		if lookup.Injury != "" {
+			status = "🩹 " + lookup.Injury
+		}
+		playing := "no game today"
+		for _, game := range today {
+			if game.HomeTeam == stats.Team || game.AwayTeam == stats.Team {
+				playing = "plays " + embeds.FormatKickoff(game.GameTime, location, "3:04 PM", format)
+				if game.IsLive() || game.IsCompleted() {
+					playing = fmt.Sprintf("%s • %.1f PPR", b.keyStatLine(stats, format), b.fantasyPoints(stats, 1))
+				}
+				break
+			}
+		}
+		lines = append(lines, fmt.Sprintf("**%s** (%s, %s) — %s • %s", stats.Name, stats.Position, stats.Team, status, playing))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// todayPickemDeadlines summarizes today's pick'em games still open to the user and how many they
+// have picked, or returns empty when the guild has no pool or nothing locks today. lock is the
+// week's first kickoff.
+func (b *Bot) todayPickemDeadlines(guildID, userID string, seasonInfo *models.SeasonInfo, lock time.Time, today []*models.LiveScore, now time.Time, location *time.Location, format models.Format) string {
+	weekLocked := !lock.IsZero() && !now.Before(lock)
+	late := weekLocked
+	userPicks := make(map[string]string)
+	exists := b.pickem.View(guildID, func(pool *pickemPool) {
+		week, found := pool.Weeks[pickemWeekKey(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)]
+		if !found {
+			return
+		}
+		late = week.lateEntry(pickemWinners, userID, weekLocked)
+		for gameID, pick := range week.Picks[userID] {
+			userPicks[gameID] = pick
+		}
+	})
+	if !exists {
+		return ""
+	}
+
+	var open []*models.LiveScore
+	for _, game := range today {
+		if !pickemPickLocked(game, lock, now, late) {
+			open = append(open, game)
+		}
+	}
+	if len(open) == 0 {
+		return ""
+	}
+
+	picked := 0
+	for _, game := range open {
+		if _, done := userPicks[game.GameID]; done {
+			picked++
+		}
+	}
+
+	line := fmt.Sprintf("%d game(s) lock today, the first at %s. You've picked **%d/%d**.",
+		len(open), embeds.FormatKickoff(open[0].GameTime, location, "3:04 PM", format), picked, len(open))
+	if picked < len(open) {
+		line += " Finish with `/pickem picks`."
+	}
+	return line
+}
+
+// todayReminderLines lists the user's reminders firing before the end of today and counts the rest
+func todayReminderLines(reminders []Reminder, dayEnd time.Time) string {
+	var lines []string
+	later := 0
+	for _, reminder := range reminders {
+		if reminder.RemindAt.Before(dayEnd) {
+			lines = append(lines, fmt.Sprintf("<t:%d:t> — %s", reminder.RemindAt.Unix(), reminder.Message))
+		} else {
+			later++
+		}
+	}
+	if later > 0 {
+		lines = append(lines, fmt.Sprintf("*%d more later — see `/remind list`*", later))
+	}
+	return strings.Join(lines, "\n")
+}
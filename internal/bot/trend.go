@@ -0,0 +1,354 @@
+package bot
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/nfl"
+	"nfl-discord-bot/pkg/models"
+)
+
+// Trend chart stats
+const (
+	trendStatYards       = "yards"
+	trendStatTDs         = "tds"
+	trendStatFantasy     = "fantasy"
+	trendStatTargetShare = "target_share"
+	trendStatSnapShare   = "snap_share"
+)
+
+// Trend chart size and colors
+const (
+	trendChartWidth   = 640
+	trendChartHeight  = 260
+	trendChartPadding = 24
+)
+
+var (
+	trendLine       = color.RGBA{0x00, 0x99, 0xFF, 0xFF} // used when the player's team has no color
+	trendBackground = color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	trendGrid       = color.RGBA{0xE3, 0xE5, 0xE8, 0xFF}
+	trendMissed     = color.RGBA{0xB5, 0xBA, 0xC1, 0xFF}
+	trendBest       = color.RGBA{0x57, 0xF2, 0x87, 0xFF}
+	trendWorst      = color.RGBA{0xED, 0x42, 0x45, 0xFF}
+)
+
+// handleSlashTrend handles the /trend slash command
+func (b *Bot) handleSlashTrend(s Responder, i *discordgo.InteractionCreate) {
+	var playerName string
+	stat := trendStatFantasy
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "player":
+			playerName = option.StringValue()
+		case "stat":
+			stat = option.StringValue()
+		}
+	}
+
+	playerName = b.playerOrFavorite(i, playerName)
+	if playerName == "" {
+		respondEphemeral(s, i, missingPlayerMessage)
+		return
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial trend response", "error", err)
+		return
+	}
+
+	// Process trend request asynchronously
+	go b.processSlashTrendRequest(s, i, playerName, stat)
+}
+
+// processSlashTrendRequest charts a player's week-by-week regular season and completes the deferred response
+func (b *Bot) processSlashTrendRequest(s Responder, i *discordgo.InteractionCreate, playerName, stat string) {
+	current, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting trend", err))
+		return
+	}
+
+	// Chart the regular season in progress, or the last completed one before week 1
+	season, throughWeek := current.Season, 18
+	switch current.SeasonType {
+	case models.SeasonTypeRegular:
+		throughWeek = current.Week
+	case models.SeasonTypePreseason:
+		season--
+	}
+
+	weeks, err := b.nflClient.GetPlayerWeekByWeek(playerName, season, models.SeasonTypeRegular, throughWeek)
+	if err != nil {
+		b.completeInteraction(s, i, userError(fmt.Sprintf("Error getting %d trend", season), err))
+		return
+	}
+
+	values := make([]float64, len(weeks))
+	played := make([]bool, len(weeks))
+	var player *models.PlayerStats
+	for index, week := range weeks {
+		if week.Stats == nil {
+			continue
+		}
+		values[index] = b.trendValue(week.Stats, stat)
+		played[index] = true
+		player = week.Stats
+	}
+
+	best, worst := trendExtremes(values, played)
+	lineColor := trendLine
+	team := b.teamByKey(player.Team)
+	if team != nil {
+		if rgb, known := team.EmbedColor(); known {
+			lineColor = color.RGBA{uint8(rgb >> 16), uint8(rgb >> 8), uint8(rgb), 0xFF}
+		}
+	}
+
+	chart, err := renderTrendChart(values, played, best, worst, lineColor)
+	if err != nil {
+		logger.Error("error rendering trend chart", "error", err)
+		b.completeInteraction(s, i, b.tr(i, "trend.chart_failed"))
+		return
+	}
+
+	embed := b.trendEmbed(player, season, stat, weeks, values, played, best, worst, b.guildFormat(i.GuildID))
+	themeEmbed(embed, team)
+	embed.Image = &discordgo.MessageEmbedImage{URL: "attachment://trend.png"}
+
+	file := &discordgo.File{Name: "trend.png", ContentType: "image/png", Reader: chart}
+	if err := b.completeInteractionFile(s, i, embed, file); err != nil {
+		logger.Error("error sending trend embed response", "error", err)
+	}
+}
+
+// trendValue returns the charted stat for one week
+func (b *Bot) trendValue(stats *models.PlayerStats, stat string) float64 {
+	switch stat {
+	case trendStatYards:
+		return float64(stats.TotalYards())
+	case trendStatTDs:
+		return float64(stats.TotalTouchdowns())
+	case trendStatTargetShare:
+		return stats.Receiving.TargetShare()
+	case trendStatSnapShare:
+		return stats.Receiving.SnapShare()
+	default:
+		return b.fantasyPoints(stats, 1)
+	}
+}
+
+// trendStatName labels a trend stat
+func trendStatName(stat string, format models.Format) string {
+	switch stat {
+	case trendStatYards:
+		return "Total " + format.UnitName()
+	case trendStatTDs:
+		return "Total Touchdowns"
+	case trendStatTargetShare:
+		return "Target Share"
+	case trendStatSnapShare:
+		return "Snap Share"
+	default:
+		return "PPR Fantasy Points"
+	}
+}
+
+// trendExtremes returns the indexes of the best and worst played weeks (-1 when none were played)
+func trendExtremes(values []float64, played []bool) (int, int) {
+	best, worst := -1, -1
+	for index, value := range values {
+		if !played[index] {
+			continue
+		}
+		if best < 0 || value > values[best] {
+			best = index
+		}
+		if worst < 0 || value < values[worst] {
+			worst = index
+		}
+	}
+	return best, worst
+}
+
+// trendAmount formats one charted value, a week's or a season's, in the guild's format
+func trendAmount(value float64, stat string, format models.Format) string {
+	switch stat {
+	case trendStatYards:
+		return format.Distance(int(math.Round(value))) + " " + format.UnitPlural()
+	case trendStatTDs:
+		return format.Int(int(math.Round(value)))
+	case trendStatTargetShare, trendStatSnapShare:
+		return format.Decimal(value) + "%"
+	default:
+		return format.Decimal(value)
+	}
+}
+
+// trendEmbed summarizes the season and calls out the best and worst weeks
+func (b *Bot) trendEmbed(player *models.PlayerStats, season int, stat string, weeks []nfl.PlayerWeek, values []float64, played []bool, best, worst int, format models.Format) *discordgo.MessageEmbed {
+	games := 0
+	total := 0.0
+	for index, value := range values {
+		if played[index] {
+			games++
+			total += value
+		}
+	}
+
+	average := total / float64(games)
+	if stat == trendStatYards {
+		average = format.Length(average)
+	}
+	summary := fmt.Sprintf("**%s** total over %d games (**%s** per game)", trendAmount(total, stat, format), games, format.Decimal(average))
+	if stat == trendStatTargetShare || stat == trendStatSnapShare {
+		// Shares don't add up across weeks, so only the average means anything
+		summary = fmt.Sprintf("**%s** on average over %d games", trendAmount(average, stat, format), games)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📈 %s — %d %s", player.Name, season, trendStatName(stat, format)),
+		Description: fmt.Sprintf("%s • %s\n%s", player.Position, player.Team, summary),
+		Color:       0x0099ff,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "🟢 Best week", Value: fmt.Sprintf("Week %d: %s", weeks[best].Week, trendAmount(values[best], stat, format)), Inline: true},
+			{Name: "🔴 Worst week", Value: fmt.Sprintf("Week %d: %s", weeks[worst].Week, trendAmount(values[worst], stat, format)), Inline: true},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Weeks %d-%d • grey ticks mark weeks without a stat line (bye, injury, or inactive)", weeks[0].Week, weeks[len(weeks)-1].Week),
+		},
+	}
+}
+
+// renderTrendChart draws a week-by-week line chart as a PNG. Missed weeks break the line and are
+// marked on the baseline; the best and worst weeks get colored markers.
+func renderTrendChart(values []float64, played []bool, best, worst int, lineColor color.RGBA) (*bytes.Buffer, error) {
+	img := image.NewRGBA(image.Rect(0, 0, trendChartWidth, trendChartHeight))
+	fillRect(img, 0, 0, trendChartWidth, trendChartHeight, trendBackground)
+
+	low, high := 0.0, 1.0
+	for index, value := range values {
+		if !played[index] {
+			continue
+		}
+		if value > high {
+			high = value
+		}
+		if value < low {
+			low = value
+		}
+	}
+
+	plotWidth := trendChartWidth - 2*trendChartPadding
+	plotHeight := trendChartHeight - 2*trendChartPadding
+	pointX := func(index int) int {
+		if len(values) == 1 {
+			return trendChartWidth / 2
+		}
+		return trendChartPadding + index*plotWidth/(len(values)-1)
+	}
+	pointY := func(value float64) int {
+		return trendChartPadding + int(float64(plotHeight)*(high-value)/(high-low))
+	}
+
+	// Quarter gridlines plus a baseline at zero
+	for step := 0; step <= 4; step++ {
+		y := trendChartPadding + step*plotHeight/4
+		fillRect(img, trendChartPadding, y, trendChartWidth-trendChartPadding, y+1, trendGrid)
+	}
+	zero := pointY(0)
+	fillRect(img, trendChartPadding, zero, trendChartWidth-trendChartPadding, zero+2, trendMissed)
+
+	for index := range values {
+		if !played[index] {
+			x := pointX(index)
+			fillRect(img, x-1, zero-6, x+2, zero+6, trendMissed)
+			continue
+		}
+		if index > 0 && played[index-1] {
+			drawLine(img, pointX(index-1), pointY(values[index-1]), pointX(index), pointY(values[index]), lineColor)
+		}
+	}
+
+	for index, value := range values {
+		if !played[index] {
+			continue
+		}
+		marker, radius := lineColor, 4
+		switch index {
+		case best:
+			marker, radius = trendBest, 7
+		case worst:
+			marker, radius = trendWorst, 7
+		}
+		fillCircle(img, pointX(index), pointY(value), radius, marker)
+	}
+
+	var chart bytes.Buffer
+	if err := png.Encode(&chart, img); err != nil {
+		return nil, err
+	}
+	return &chart, nil
+}
+
+// fillRect fills the rectangle [x0,x1) x [y0,y1)
+func fillRect(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
+
+// fillCircle fills a circle centered on (cx, cy)
+func fillCircle(img *image.RGBA, cx, cy, radius int, c color.RGBA) {
+	for y := -radius; y <= radius; y++ {
+		for x := -radius; x <= radius; x++ {
+			if x*x+y*y <= radius*radius {
+				img.SetRGBA(cx+x, cy+y, c)
+			}
+		}
+	}
+}
+
+// drawLine draws a three-pixel-wide line from (x0, y0) to (x1, y1) with Bresenham's algorithm
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		fillRect(img, x0-1, y0-1, x0+2, y0+2, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		doubled := 2 * err
+		if doubled >= dy {
+			err += dy
+			x0 += sx
+		}
+		if doubled <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// abs returns the absolute value of an int
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
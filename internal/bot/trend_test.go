@@ -0,0 +1,28 @@
+package bot
+
+import (
+	"testing"
+
+	"nfl-discord-bot/internal/nfl"
+	"nfl-discord-bot/pkg/models"
+)
+
+func TestTrendEmbedUsesGuildFormat(t *testing.T) {
+	b := newTestBot(t)
+	player := rusher(1, "James Cook", 20, 600)
+	weeks := []nfl.PlayerWeek{{Week: 1, Stats: player}, {Week: 2, Stats: player}}
+	values := []float64{600, 650}
+	played := []bool{true, true}
+
+	embed := b.trendEmbed(player, 2025, trendStatYards, weeks, values, played, 1, 0, models.Format{Metric: true, Separator: models.SeparatorComma})
+
+	if want := "📈 James Cook — 2025 Total Meters"; embed.Title != want {
+		t.Errorf("title = %q, want %q", embed.Title, want)
+	}
+	if want := "RB • BUF\n**1,143 m** total over 2 games (**571.5** per game)"; embed.Description != want {
+		t.Errorf("description = %q, want %q", embed.Description, want)
+	}
+	if want := "Week 2: 594 m"; embed.Fields[0].Value != want {
+		t.Errorf("best week = %q, want %q", embed.Fields[0].Value, want)
+	}
+}
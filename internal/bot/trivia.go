@@ -0,0 +1,366 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/storage"
+	"nfl-discord-bot/internal/trivia"
+)
+
+// triviaScoresDocument is the storage document holding per-guild trivia scores
+const triviaScoresDocument = "trivia_scores"
+
+// triviaPrefix prefixes the custom IDs of trivia answer buttons
+const triviaPrefix = "trivia_"
+
+// triviaAnswerWindow is how long a question stays open for answers
+const triviaAnswerWindow = 20 * time.Second
+
+// TriviaScore is a user's running trivia record in a guild
+type TriviaScore struct {
+	Points   int `json:"points"`
+	Correct  int `json:"correct"`
+	Answered int `json:"answered"`
+}
+
+// triviaScoreStore keeps trivia scores in memory and persists every change
+type triviaScoreStore struct {
+	mu     sync.Mutex
+	store  *storage.Store
+	guilds map[string]map[string]*TriviaScore // guild ID -> user ID -> score
+}
+
+// newTriviaScoreStore loads trivia scores from storage
+func newTriviaScoreStore(store *storage.Store) (*triviaScoreStore, error) {
+	scores := &triviaScoreStore{
+		store:  store,
+		guilds: make(map[string]map[string]*TriviaScore),
+	}
+	if err := store.Load(triviaScoresDocument, &scores.guilds); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+// Record adds one round's answers to the guild's scores
+func (ts *triviaScoreStore) Record(guildID string, answers map[string]bool, points int) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	guild, exists := ts.guilds[guildID]
+	if !exists {
+		guild = make(map[string]*TriviaScore)
+		ts.guilds[guildID] = guild
+	}
+	for userID, correct := range answers {
+		score, exists := guild[userID]
+		if !exists {
+			score = &TriviaScore{}
+			guild[userID] = score
+		}
+		score.Answered++
+		if correct {
+			score.Correct++
+			score.Points += points
+		}
+	}
+
+	if err := ts.store.Save(triviaScoresDocument, ts.guilds); err != nil {
+		logger.Error("error saving trivia scores", "error", err)
+		return err
+	}
+	return nil
+}
+
+// triviaStanding is one row of a trivia leaderboard
+type triviaStanding struct {
+	UserID string
+	TriviaScore
+}
+
+// Leaderboard returns a guild's scores, highest points first
+func (ts *triviaScoreStore) Leaderboard(guildID string) []triviaStanding {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	var standings []triviaStanding
+	for userID, score := range ts.guilds[guildID] {
+		standings = append(standings, triviaStanding{UserID: userID, TriviaScore: *score})
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+		return standings[i].Correct > standings[j].Correct
+	})
+	return standings
+}
+
+// triviaRound is a question currently open for answers
+type triviaRound struct {
+	id          string
+	guildID     string
+	question    *trivia.Question
+	interaction *discordgo.Interaction
+	answers     map[string]int // user ID -> choice index
+}
+
+// triviaRounds tracks open questions, one per channel
+type triviaRounds struct {
+	mu        sync.Mutex
+	byID      map[string]*triviaRound
+	byChannel map[string]string
+}
+
+// newTriviaRounds creates an empty round tracker
+func newTriviaRounds() *triviaRounds {
+	return &triviaRounds{
+		byID:      make(map[string]*triviaRound),
+		byChannel: make(map[string]string),
+	}
+}
+
+// handleSlashTrivia handles the /trivia slash command and its subcommands
+func (b *Bot) handleSlashTrivia(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, "Trivia is only available in servers.")
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	switch options[0].Name {
+	case "play":
+		difficulty := ""
+		if len(options[0].Options) > 0 {
+			difficulty = options[0].Options[0].StringValue()
+		}
+		b.startTriviaRound(s, i, difficulty)
+	case "leaderboard":
+		b.respondTriviaLeaderboard(s, i)
+	}
+}
+
+// startTriviaRound posts a question with answer buttons and reveals it after the answer window
+func (b *Bot) startTriviaRound(s Responder, i *discordgo.InteractionCreate, difficulty string) {
+	question, err := b.triviaBank.Random(difficulty)
+	if err != nil {
+		b.respondInteraction(s, i, fmt.Sprintf("❌ %v", err))
+		return
+	}
+
+	b.triviaRounds.mu.Lock()
+	if _, active := b.triviaRounds.byChannel[i.ChannelID]; active {
+		b.triviaRounds.mu.Unlock()
+		respondEphemeral(s, i, b.tr(i, "trivia.already_open"))
+		return
+	}
+	round := &triviaRound{
+		id:          strconv.FormatInt(time.Now().UnixNano()%1e9, 36),
+		guildID:     i.GuildID,
+		question:    question,
+		interaction: i.Interaction,
+		answers:     make(map[string]int),
+	}
+	b.triviaRounds.byID[round.id] = round
+	b.triviaRounds.byChannel[i.ChannelID] = round.id
+	b.triviaRounds.mu.Unlock()
+
+	// Trivia is a group game, so the question is always public
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{triviaQuestionEmbed(question, "")},
+			Components: triviaComponents(round.id, question, false),
+		},
+	})
+	if err != nil {
+		logger.Error("error posting trivia question", "error", err)
+		b.endTriviaRound(round.id, i.ChannelID)
+		return
+	}
+
+	time.AfterFunc(triviaAnswerWindow, func() {
+		b.revealTriviaRound(s, round.id, i.ChannelID)
+	})
+}
+
+// triviaQuestionEmbed renders a question, with the reveal text once the round closes
+func triviaQuestionEmbed(question *trivia.Question, reveal string) *discordgo.MessageEmbed {
+	var lines []string
+	for index, choice := range question.Choices {
+		lines = append(lines, fmt.Sprintf("**%c.** %s", 'A'+index, choice))
+	}
+
+	description := strings.Join(lines, "\n")
+	footer := fmt.Sprintf("%s • %d point(s) • %d seconds to answer",
+		difficultyLabel(question.Difficulty), trivia.Points(question.Difficulty), int(triviaAnswerWindow.Seconds()))
+	if reveal != "" {
+		description += "\n\n" + reveal
+		footer = fmt.Sprintf("%s • %d point(s)", difficultyLabel(question.Difficulty), trivia.Points(question.Difficulty))
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       "🧠 " + question.Question,
+		Description: description,
+		Color:       0x013369,
+		Footer:      &discordgo.MessageEmbedFooter{Text: footer},
+	}
+}
+
+// difficultyLabel capitalizes a difficulty level for display
+func difficultyLabel(difficulty string) string {
+	if difficulty == "" {
+		return difficulty
+	}
+	return strings.ToUpper(difficulty[:1]) + difficulty[1:]
+}
+
+// triviaComponents renders one button per choice
+func triviaComponents(roundID string, question *trivia.Question, disabled bool) []discordgo.MessageComponent {
+	var buttons []discordgo.MessageComponent
+	for index := range question.Choices {
+		style := discordgo.SecondaryButton
+		if disabled && index == question.Answer {
+			style = discordgo.SuccessButton
+		}
+		buttons = append(buttons, discordgo.Button{
+			Label:    string(rune('A' + index)),
+			Style:    style,
+			CustomID: fmt.Sprintf("%sanswer:%s:%d", triviaPrefix, roundID, index),
+			Disabled: disabled,
+		})
+	}
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+}
+
+// handleTriviaComponent records a user's answer; each user gets one answer per question
+func (b *Bot) handleTriviaComponent(s Responder, i *discordgo.InteractionCreate) {
+	parts := strings.Split(strings.TrimPrefix(i.MessageComponentData().CustomID, triviaPrefix), ":")
+	if len(parts) != 3 || parts[0] != "answer" {
+		return
+	}
+	choice, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return
+	}
+
+	userID := interactionUserID(i)
+	b.triviaRounds.mu.Lock()
+	round, open := b.triviaRounds.byID[parts[1]]
+	answered := false
+	if open {
+		_, answered = round.answers[userID]
+		if !answered {
+			round.answers[userID] = choice
+		}
+	}
+	b.triviaRounds.mu.Unlock()
+
+	switch {
+	case !open:
+		respondEphemeral(s, i, b.tr(i, "trivia.time_up"))
+	case answered:
+		respondEphemeral(s, i, b.tr(i, "trivia.already_answered"))
+	default:
+		respondEphemeral(s, i, b.tr(i, "trivia.locked", 'A'+choice))
+	}
+}
+
+// endTriviaRound removes a round from the tracker, returning it
+func (b *Bot) endTriviaRound(roundID, channelID string) *triviaRound {
+	b.triviaRounds.mu.Lock()
+	defer b.triviaRounds.mu.Unlock()
+
+	round := b.triviaRounds.byID[roundID]
+	delete(b.triviaRounds.byID, roundID)
+	if b.triviaRounds.byChannel[channelID] == roundID {
+		delete(b.triviaRounds.byChannel, channelID)
+	}
+	return round
+}
+
+// revealTriviaRound closes a round, scores its answers, and shows the correct choice
+func (b *Bot) revealTriviaRound(s Responder, roundID, channelID string) {
+	round := b.endTriviaRound(roundID, channelID)
+	if round == nil {
+		return
+	}
+
+	question := round.question
+	results := make(map[string]bool, len(round.answers))
+	var winners []string
+	for userID, choice := range round.answers {
+		results[userID] = choice == question.Answer
+		if choice == question.Answer {
+			winners = append(winners, "<@"+userID+">")
+		}
+	}
+	sort.Strings(winners)
+
+	if len(results) > 0 {
+		b.triviaScores.Record(round.guildID, results, trivia.Points(question.Difficulty))
+	}
+
+	reveal := fmt.Sprintf("✅ **Answer: %c. %s**\n", 'A'+question.Answer, question.Choices[question.Answer])
+	switch {
+	case len(results) == 0:
+		reveal += "Nobody answered."
+	case len(winners) == 0:
+		reveal += fmt.Sprintf("Nobody got it right (%d answered).", len(results))
+	default:
+		reveal += fmt.Sprintf("%d of %d correct: %s", len(winners), len(results), strings.Join(winners, ", "))
+	}
+
+	embeds := []*discordgo.MessageEmbed{triviaQuestionEmbed(question, reveal)}
+	components := triviaComponents(round.id, question, true)
+	_, err := s.InteractionResponseEdit(round.interaction, &discordgo.WebhookEdit{
+		Embeds:     &embeds,
+		Components: &components,
+	})
+	if err != nil {
+		logger.Error("error revealing trivia answer", "error", err)
+	}
+}
+
+// respondTriviaLeaderboard shows the guild's trivia standings
+func (b *Bot) respondTriviaLeaderboard(s Responder, i *discordgo.InteractionCreate) {
+	standings := b.triviaScores.Leaderboard(i.GuildID)
+	if len(standings) == 0 {
+		b.respondInteraction(s, i, "No trivia played yet. Start a round with `/trivia play`.")
+		return
+	}
+
+	medals := []string{"🥇", "🥈", "🥉"}
+	var lines []string
+	for index, standing := range standings {
+		if index == 15 {
+			break
+		}
+		rank := fmt.Sprintf("%d.", index+1)
+		if index < len(medals) {
+			rank = medals[index]
+		}
+		lines = append(lines, fmt.Sprintf("%s <@%s> — **%d** pts (%d/%d correct)",
+			rank, standing.UserID, standing.Points, standing.Correct, standing.Answered))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🧠 Trivia Leaderboard",
+		Description: strings.Join(lines, "\n"),
+		Color:       0xffd700,
+		Footer:      &discordgo.MessageEmbedFooter{Text: "Easy 1 pt • Medium 2 pts • Hard 3 pts"},
+	}
+	if err := b.respondInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error responding to trivia leaderboard", "error", err)
+	}
+}
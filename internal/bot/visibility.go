@@ -0,0 +1,162 @@
+package bot
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// publicOptionName is the option that lets a user choose who sees a slash command response
+const publicOptionName = "public"
+
+// Server-wide defaults for /visibility
+const (
+	visibilityPublic  = "public"
+	visibilityPrivate = "private"
+	visibilityReset   = "reset"
+)
+
+// privateReplies are the commands, and "command subcommand" paths, that don't get the public
+// option: personal lists and pickers and server settings always answer privately, and trivia
+// rounds are always posted to the channel
+var privateReplies = map[string]bool{
+	"alias":            true,
+	"big-games":        true,
+	"botstats":         true,
+	"compare history":  true,
+	"digest":           true,
+	"draft feed":       true,
+	"favorite":         true,
+	"formatting":       true,
+	"game-threads":     true,
+	"language":         true,
+	"leaderboard-page": true,
+	"owner":            true,
+	"pickem picks":     true,
+	"play-alerts":      true,
+	"slowmode":         true,
+	"spoiler-delay":    true,
+	"spoiler-mode":     true,
+	"timezone":         true,
+	"trivia play":      true,
+	"visibility":       true,
+	"watchlist":        true,
+}
+
+// addPublicOptions adds the public option to every command, or every subcommand of commands that
+// have them, whose reply follows the visibility settings
+func addPublicOptions(commands []*discordgo.ApplicationCommand) {
+	for _, command := range commands {
+		if privateReplies[command.Name] {
+			continue
+		}
+		command.Options = withPublicOption(command.Name, command.Options)
+	}
+}
+
+// withPublicOption appends the public option to a command's or subcommand's options, or to each
+// subcommand under them, unless it's already there
+func withPublicOption(path string, options []*discordgo.ApplicationCommandOption) []*discordgo.ApplicationCommandOption {
+	hasSubcommands := false
+	for _, option := range options {
+		switch option.Type {
+		case discordgo.ApplicationCommandOptionSubCommand, discordgo.ApplicationCommandOptionSubCommandGroup:
+			hasSubcommands = true
+			if subPath := path + " " + option.Name; !privateReplies[subPath] {
+				option.Options = withPublicOption(subPath, option.Options)
+			}
+		case discordgo.ApplicationCommandOptionBoolean:
+			if option.Name == publicOptionName {
+				return options
+			}
+		}
+	}
+	if hasSubcommands {
+		return options
+	}
+	return append(options, publicOption())
+}
+
+// publicOption builds the optional public choice added to informational commands
+func publicOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionBoolean,
+		Name:        publicOptionName,
+		Description: "Show the response to the channel (True) or only to you (False); defaults to the server setting",
+		Required:    false,
+	}
+}
+
+// publicChoice returns the public option a user passed to a command or its subcommand, however
+// deeply nested
+func publicChoice(i *discordgo.InteractionCreate) (bool, bool) {
+	if i.Type != discordgo.InteractionApplicationCommand {
+		return false, false
+	}
+
+	options := i.ApplicationCommandData().Options
+	for len(options) > 0 && (options[0].Type == discordgo.ApplicationCommandOptionSubCommand ||
+		options[0].Type == discordgo.ApplicationCommandOptionSubCommandGroup) {
+		options = options[0].Options
+	}
+	for _, option := range options {
+		if option.Name == publicOptionName {
+			return option.BoolValue(), true
+		}
+	}
+	return false, false
+}
+
+// ephemeral decides whether a slash command response is private: the user's public choice wins,
+// then the server's /visibility default, then the BOT_VISIBILITY_ROLE fallback
+func (b *Bot) ephemeral(i *discordgo.InteractionCreate) bool {
+	if public, chosen := publicChoice(i); chosen {
+		return !public
+	}
+	if i.GuildID != "" {
+		if public := b.settings.Get(i.GuildID).PublicResponses; public != nil {
+			return !*public
+		}
+	}
+	return b.visibilityRole != ""
+}
+
+// handleSlashVisibility handles the /visibility slash command (admin only)
+func (b *Bot) handleSlashVisibility(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+	mode := options[0].StringValue()
+
+	err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+		switch mode {
+		case visibilityPublic:
+			public := true
+			settings.PublicResponses = &public
+		case visibilityPrivate:
+			public := false
+			settings.PublicResponses = &public
+		default:
+			settings.PublicResponses = nil
+		}
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "visibility.save_failed"))
+		return
+	}
+
+	var message string
+	switch mode {
+	case visibilityPublic:
+		message = b.tr(i, "visibility.public")
+	case visibilityPrivate:
+		message = b.tr(i, "visibility.private")
+	default:
+		message = b.tr(i, "visibility.reset")
+	}
+	respondEphemeral(s, i, b.tr(i, "visibility.override", message))
+}
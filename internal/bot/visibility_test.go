@@ -0,0 +1,69 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestPublicOptionOnEveryReplyCommand(t *testing.T) {
+	b := newTestBot(t)
+	for _, command := range b.commands {
+		checkPublicOption(t, command.Name, command.Options)
+	}
+}
+
+// checkPublicOption fails when a command or subcommand that replies through the visibility
+// settings lacks the public option, or when a private one has it
+func checkPublicOption(t *testing.T, path string, options []*discordgo.ApplicationCommandOption) {
+	t.Helper()
+	public := 0
+	for _, option := range options {
+		switch option.Type {
+		case discordgo.ApplicationCommandOptionSubCommand, discordgo.ApplicationCommandOptionSubCommandGroup:
+			checkPublicOption(t, path+" "+option.Name, option.Options)
+		default:
+			if option.Name == publicOptionName {
+				public++
+			}
+		}
+	}
+
+	hasSubcommands := len(options) > 0 &&
+		(options[0].Type == discordgo.ApplicationCommandOptionSubCommand || options[0].Type == discordgo.ApplicationCommandOptionSubCommandGroup)
+	private := false
+	for prefix := path; prefix != ""; prefix = parentPath(prefix) {
+		private = private || privateReplies[prefix]
+	}
+	switch {
+	case hasSubcommands:
+	case private && public > 0:
+		t.Errorf("/%s always replies privately but has the public option", path)
+	case !private && public != 1:
+		t.Errorf("/%s has the public option %d times, want once", path, public)
+	}
+}
+
+// parentPath drops the last word of a "command subcommand" path
+func parentPath(path string) string {
+	if index := strings.LastIndex(path, " "); index >= 0 {
+		return path[:index]
+	}
+	return ""
+}
+
+func TestPublicChoiceInSubcommandGroup(t *testing.T) {
+	b := newTestBot(t)
+	list := subcommandOption("list", &discordgo.ApplicationCommandInteractionDataOption{
+		Name: publicOptionName, Type: discordgo.ApplicationCommandOptionBoolean, Value: false,
+	})
+	i := slashInteraction("league", &discordgo.ApplicationCommandInteractionDataOption{
+		Name: "dates", Type: discordgo.ApplicationCommandOptionSubCommandGroup,
+		Options: []*discordgo.ApplicationCommandInteractionDataOption{list},
+	})
+
+	if !b.ephemeral(i) {
+		t.Error("public:False under /league dates list should make the reply private")
+	}
+}
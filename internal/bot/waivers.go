@@ -0,0 +1,221 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/fantasy"
+	"nfl-discord-bot/pkg/models"
+)
+
+// defaultRosteredThreshold is the most fantasy points per game a player can have averaged
+// before the last two weeks and still count as likely unrostered
+const defaultRosteredThreshold = 8.0
+
+// waiverRecentWeeks is how many of the latest played weeks count as the player's trend
+const waiverRecentWeeks = 2
+
+// waiverMinEarlierGames is how many games before the last two weeks a player needs for a
+// baseline; a starter back from injury or a bye-heavy start otherwise looks like 0.0 points
+// per game and a breakout
+const waiverMinEarlierGames = 2
+
+// waiverSuggestionLimit is how many players /waivers suggests
+const waiverSuggestionLimit = 10
+
+// waiverCandidate is a player's production and usage before and during the last two weeks
+type waiverCandidate struct {
+	player  *models.PlayerStats // most recent week's line, for name, team, and position
+	earlier *models.PlayerStats
+	recent  *models.PlayerStats
+}
+
+// pointsPerGame returns a summed stat line's fantasy points per game under a scoring format
+func (b *Bot) pointsPerGame(stats *models.PlayerStats, format string) float64 {
+	if stats.Games == 0 {
+		return 0
+	}
+	return stats.PerGame(b.fantasyPoints(stats, fantasy.PointsPerReception(format)))
+}
+
+// opportunities returns touches a stat line earned per game: pass attempts and carries for
+// quarterbacks, carries and targets for everyone else
+func opportunities(stats *models.PlayerStats) float64 {
+	if stats.Games == 0 {
+		return 0
+	}
+	total := stats.Rushing.Attempts + stats.Receiving.Targets
+	if stats.Position == "QB" {
+		total = stats.Rushing.Attempts + stats.Passing.Attempts
+	}
+	return stats.PerGame(float64(total))
+}
+
+// handleSlashWaivers handles the /waivers slash command
+func (b *Bot) handleSlashWaivers(s Responder, i *discordgo.InteractionCreate) {
+	var position string
+	threshold := defaultRosteredThreshold
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "position":
+			position = option.StringValue()
+		case "rostered_threshold":
+			threshold = option.FloatValue()
+		}
+	}
+
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial waivers response", "error", err)
+		return
+	}
+
+	// Process waivers request asynchronously
+	go b.processSlashWaiversRequest(s, i, b.guildScoringFormat(i.GuildID), position, threshold)
+}
+
+// processSlashWaiversRequest suggests players trending up over the last two weeks who scored
+// under the rostered threshold before then
+func (b *Bot) processSlashWaiversRequest(s Responder, i *discordgo.InteractionCreate, format, position string, threshold float64) {
+	current, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting waiver suggestions", err))
+		return
+	}
+	if current.SeasonType != models.SeasonTypeRegular {
+		b.completeInteraction(s, i, "📋 Waiver suggestions are available during the regular season.")
+		return
+	}
+
+	weeks, err := b.nflClient.GetWeeksStats(current.Season, models.SeasonTypeRegular, current.Week)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting waiver suggestions", err))
+		return
+	}
+
+	// The current week may not have kicked off yet, so trend over the last weeks with stats
+	for len(weeks) > 0 && len(weeks[len(weeks)-1]) == 0 {
+		weeks = weeks[:len(weeks)-1]
+	}
+	if len(weeks) <= waiverRecentWeeks {
+		b.completeInteraction(s, i, fmt.Sprintf("📋 Waiver trends need more than %d weeks of stats — check back after week %d.",
+			waiverRecentWeeks, waiverRecentWeeks+1))
+		return
+	}
+	firstRecent := len(weeks) - waiverRecentWeeks
+
+	candidates := b.waiverCandidates(weeks, firstRecent, format, position, threshold)
+	if len(candidates) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("📋 No %splayers under %.1f points per game are trending up right now.",
+			strings.TrimSpace(position+" "), threshold))
+		return
+	}
+
+	embed := b.waiversEmbed(candidates, format, position, threshold, firstRecent+1, len(weeks))
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending waivers embed response", "error", err)
+	}
+}
+
+// waiverCandidates sums each fantasy-position player's weeks before and from firstRecent (a
+// weeks index), keeps the ones with enough earlier games who averaged under threshold then and improved in both
+// production and usage since, and ranks them by their jump in points per game
+func (b *Bot) waiverCandidates(weeks [][]*models.PlayerStats, firstRecent int, format, position string, threshold float64) []*waiverCandidate {
+	byPlayer := make(map[int]*waiverCandidate)
+	for index, week := range weeks {
+		for _, stats := range week {
+			if position != "" && stats.Position != position {
+				continue
+			}
+			if position == "" && !isFantasyPosition(stats.Position) {
+				continue
+			}
+
+			candidate := byPlayer[stats.PlayerID]
+			if candidate == nil {
+				candidate = &waiverCandidate{
+					earlier: &models.PlayerStats{Position: stats.Position},
+					recent:  &models.PlayerStats{Position: stats.Position},
+				}
+				byPlayer[stats.PlayerID] = candidate
+			}
+			candidate.player = stats
+
+			total := candidate.earlier
+			if index >= firstRecent {
+				total = candidate.recent
+			}
+			total.Add(stats)
+			total.Games++
+		}
+	}
+
+	var candidates []*waiverCandidate
+	jump := make(map[*waiverCandidate]float64)
+	for _, candidate := range byPlayer {
+		earlierPoints := b.pointsPerGame(candidate.earlier, format)
+		recentPoints := b.pointsPerGame(candidate.recent, format)
+		if candidate.recent.Games == 0 || candidate.earlier.Games < waiverMinEarlierGames || earlierPoints >= threshold {
+			continue
+		}
+		if recentPoints <= earlierPoints || opportunities(candidate.recent) < opportunities(candidate.earlier) {
+			continue
+		}
+		jump[candidate] = recentPoints - earlierPoints
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(a, c int) bool {
+		if jump[candidates[a]] != jump[candidates[c]] {
+			return jump[candidates[a]] > jump[candidates[c]]
+		}
+		return candidates[a].player.Name < candidates[c].player.Name
+	})
+	if len(candidates) > waiverSuggestionLimit {
+		candidates = candidates[:waiverSuggestionLimit]
+	}
+	return candidates
+}
+
+// isFantasyPosition reports whether a position is one fantasy lineups start
+func isFantasyPosition(position string) bool {
+	for _, fantasyPosition := range fantasy.Positions {
+		if position == fantasyPosition {
+			return true
+		}
+	}
+	return false
+}
+
+// waiversEmbed lists the suggestions with their points, usage, and snap share before and after
+func (b *Bot) waiversEmbed(candidates []*waiverCandidate, format, position string, threshold float64, fromWeek, throughWeek int) *discordgo.MessageEmbed {
+	title := "📋 Waiver Wire: Trending Up"
+	if position != "" {
+		title = fmt.Sprintf("📋 Waiver Wire: Trending Up (%s)", position)
+	}
+
+	var lines []string
+	for index, candidate := range candidates {
+		player := candidate.player
+		line := fmt.Sprintf("**%d. %s** (%s, %s)\n%.1f → **%.1f** pts/g • %.1f → **%.1f** opp/g",
+			index+1, player.Name, player.Position, player.Team,
+			b.pointsPerGame(candidate.earlier, format), b.pointsPerGame(candidate.recent, format),
+			opportunities(candidate.earlier), opportunities(candidate.recent))
+		if candidate.recent.Receiving.TeamSnaps > 0 {
+			line += fmt.Sprintf(" • %.0f%% snaps", candidate.recent.Receiving.SnapShare())
+		}
+		lines = append(lines, line)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title: title,
+		Description: fmt.Sprintf("Players under %.1f %s points per game before week %d who have gained production and usage in weeks %d-%d\n\n%s",
+			threshold, fantasy.FormatName(format), fromWeek, fromWeek, throughWeek, strings.Join(lines, "\n")),
+		Color: 0x013369,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Earlier → last two weeks • opp = carries + targets (QBs: attempts + carries) • Data provided by SportsData.io",
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package bot
+
+import (
+	"testing"
+
+	"nfl-discord-bot/internal/fantasy"
+	"nfl-discord-bot/pkg/models"
+)
+
+// rusher is a running back's stat line for one week
+func rusher(id int, name string, carries, yards int) *models.PlayerStats {
+	return &models.PlayerStats{
+		PlayerID: id, Name: name, Team: "BUF", Position: "RB",
+		Rushing: models.RushingStats{Attempts: carries, Yards: yards},
+	}
+}
+
+func TestWaiverCandidatesNeedEarlierGames(t *testing.T) {
+	b := newTestBot(t)
+	// A backup who took over in weeks 4-5, and a starter who missed weeks 1-3 hurt
+	weeks := [][]*models.PlayerStats{
+		{rusher(1, "Ty Johnson", 3, 12)},
+		{rusher(1, "Ty Johnson", 2, 9)},
+		{rusher(1, "Ty Johnson", 4, 15)},
+		{rusher(1, "Ty Johnson", 18, 96), rusher(2, "James Cook", 20, 120)},
+		{rusher(1, "Ty Johnson", 16, 81), rusher(2, "James Cook", 22, 131)},
+	}
+
+	candidates := b.waiverCandidates(weeks, 3, fantasy.FormatPPR, "", defaultRosteredThreshold)
+
+	if len(candidates) != 1 || candidates[0].player.Name != "Ty Johnson" {
+		var names []string
+		for _, candidate := range candidates {
+			names = append(names, candidate.player.Name)
+		}
+		t.Fatalf("candidates = %v, want only Ty Johnson", names)
+	}
+}
@@ -0,0 +1,363 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/nfl"
+	"nfl-discord-bot/pkg/models"
+)
+
+// Watch list limits keep the weekly summary within a single embed
+const (
+	watchlistMaxPlayers = 12
+	watchlistMaxTeams   = 8
+)
+
+// The weekly watch list summary is sent on this local weekday and hour
+const (
+	watchlistSummaryDay  = time.Monday
+	watchlistSummaryHour = 10
+)
+
+// watchlistEntryOptions builds the player and team options shared by /watchlist add and remove
+func watchlistEntryOptions() []*discordgo.ApplicationCommandOption {
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "player",
+			Description: "Player name (e.g. Josh Allen)",
+			Required:    false,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "team",
+			Description: "Team name or abbreviation",
+			Required:    false,
+		},
+	}
+}
+
+// handleSlashWatchlist handles the /watchlist slash command
+func (b *Bot) handleSlashWatchlist(s Responder, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		return
+	}
+
+	subcommand := options[0]
+	switch subcommand.Name {
+	case "add":
+		b.respondWatchlistChange(s, i, subcommand.Options, true)
+	case "remove":
+		b.respondWatchlistChange(s, i, subcommand.Options, false)
+	case "show":
+		respondEphemeral(s, i, b.watchlistSummaryText(interactionUserID(i)))
+	case "summary":
+		enabled := subcommand.Options[0].BoolValue()
+		err := b.preferences.Update(interactionUserID(i), func(preferences *UserPreferences) {
+			preferences.WeeklySummary = enabled
+		})
+		if err != nil {
+			respondEphemeral(s, i, b.tr(i, "watchlist.summary_save_failed"))
+			return
+		}
+		if enabled {
+			respondEphemeral(s, i, b.tr(i, "watchlist.summary_on", watchlistSummaryDay))
+		} else {
+			respondEphemeral(s, i, b.tr(i, "watchlist.summary_off"))
+		}
+	}
+}
+
+// respondWatchlistChange adds or removes the player and/or team given in the options
+func (b *Bot) respondWatchlistChange(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption, add bool) {
+	var playerName, teamName string
+	for _, option := range options {
+		switch option.Name {
+		case "player":
+			playerName = strings.TrimSpace(option.StringValue())
+		case "team":
+			teamName = strings.TrimSpace(option.StringValue())
+		}
+	}
+	if playerName == "" && teamName == "" {
+		respondEphemeral(s, i, b.tr(i, "error.provide_team_or_player"))
+		return
+	}
+
+	userID := interactionUserID(i)
+	current := b.preferences.Get(userID)
+
+	var player, team string
+	if playerName != "" {
+		if existing := findFold(current.WatchPlayers, playerName); existing != "" {
+			player = existing
+		} else if add {
+			resolved, err := b.resolveWatchPlayer(playerName)
+			if err != nil {
+				respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+				return
+			}
+			player = resolved
+		} else {
+			respondEphemeral(s, i, b.tr(i, "watchlist.not_listed", playerName))
+			return
+		}
+	}
+	if teamName != "" {
+		teamInfo, err := b.nflClient.GetTeamInfo(teamName)
+		if err != nil {
+			respondEphemeral(s, i, b.tr(i, "error.unknown_team", teamName))
+			return
+		}
+		team = teamInfo.Key
+	}
+
+	if add && player != "" && findFold(current.WatchPlayers, player) == "" && len(current.WatchPlayers) >= watchlistMaxPlayers {
+		respondEphemeral(s, i, b.tr(i, "watchlist.players_full", watchlistMaxPlayers))
+		return
+	}
+	if add && team != "" && findFold(current.WatchTeams, team) == "" && len(current.WatchTeams) >= watchlistMaxTeams {
+		respondEphemeral(s, i, b.tr(i, "watchlist.teams_full", watchlistMaxTeams))
+		return
+	}
+
+	err := b.preferences.Update(userID, func(preferences *UserPreferences) {
+		if player != "" {
+			preferences.WatchPlayers = toggleEntry(preferences.WatchPlayers, player, add)
+		}
+		if team != "" {
+			preferences.WatchTeams = toggleEntry(preferences.WatchTeams, team, add)
+		}
+	})
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "watchlist.save_failed"))
+		return
+	}
+
+	respondEphemeral(s, i, b.watchlistSummaryText(userID))
+}
+
+// resolveWatchPlayer matches a name against this season's players, falling back to last season's for the offseason
+func (b *Bot) resolveWatchPlayer(name string) (string, error) {
+	player, err := b.findRecentPlayer(name)
+	if err != nil {
+		return "", err
+	}
+	return player.Name, nil
+}
+
+// findRecentPlayer finds a player in this season's stats, or last season's during the offseason
+func (b *Bot) findRecentPlayer(name string) (*models.SeasonPlayerStats, error) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return nil, err
+	}
+	player, err := b.nflClient.FindSeasonPlayer(name, seasonInfo.Season)
+	if err != nil {
+		player, err = b.nflClient.FindSeasonPlayer(name, seasonInfo.Season-1)
+	}
+	return player, err
+}
+
+// findFold returns the entry equal to value ignoring case, or "" when there is none
+func findFold(entries []string, value string) string {
+	for _, entry := range entries {
+		if strings.EqualFold(entry, value) {
+			return entry
+		}
+	}
+	return ""
+}
+
+// toggleEntry removes value from entries (ignoring case) and appends it again when add is set
+func toggleEntry(entries []string, value string, add bool) []string {
+	var kept []string
+	for _, entry := range entries {
+		if !strings.EqualFold(entry, value) {
+			kept = append(kept, entry)
+		}
+	}
+	if add {
+		kept = append(kept, value)
+	}
+	return kept
+}
+
+// watchlistSummaryText describes a user's watch list and whether the weekly DM is on
+func (b *Bot) watchlistSummaryText(userID string) string {
+	preferences := b.preferences.Get(userID)
+	players, teams := "none", "none"
+	if len(preferences.WatchPlayers) > 0 {
+		players = strings.Join(preferences.WatchPlayers, ", ")
+	}
+	if len(preferences.WatchTeams) > 0 {
+		teams = strings.Join(preferences.WatchTeams, ", ")
+	}
+
+	summary := "off — turn it on with `/watchlist summary enabled:True`"
+	if preferences.WeeklySummary {
+		summary = fmt.Sprintf("on (%ss)", watchlistSummaryDay)
+	}
+	return fmt.Sprintf("👀 **Your watch list**\nPlayers: %s\nTeams: %s\nWeekly DM: %s", players, teams, summary)
+}
+
+// runWatchlistSummaries DMs watch list summaries every week until the bot stops
+func (b *Bot) runWatchlistSummaries() {
+	for {
+		timer := time.NewTimer(time.Until(nextWatchlistSummary(time.Now())))
+		select {
+		case <-timer.C:
+			b.sendWatchlistSummaries()
+		case <-b.done:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// nextWatchlistSummary returns the next watchlistSummaryDay at watchlistSummaryHour after now
+func nextWatchlistSummary(now time.Time) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), watchlistSummaryHour, 0, 0, 0, now.Location())
+	next = next.AddDate(0, 0, (int(watchlistSummaryDay)-int(next.Weekday())+7)%7)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next
+}
+
+// sendWatchlistSummaries builds every subscriber's summary from the current week's cached stat
+// sheet and scores, looking each watched player up only once
+func (b *Bot) sendWatchlistSummaries() {
+	subscribers := b.preferences.SummarySubscribers()
+	if len(subscribers) == 0 {
+		return
+	}
+
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		logger.Error("skipping watch list summaries", "error", err)
+		return
+	}
+
+	var names []string
+	for _, preferences := range subscribers {
+		for _, name := range preferences.WatchPlayers {
+			if findFold(names, name) == "" {
+				names = append(names, name)
+			}
+		}
+	}
+
+	lookups := make(map[string]nfl.WeekLookup)
+	if len(names) > 0 {
+		results, err := b.nflClient.GetPlayersWeekStats(names, seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+		if err != nil {
+			logger.Error("skipping watch list summaries", "error", err)
+			return
+		}
+		for _, result := range results {
+			lookups[strings.ToLower(result.Query)] = result
+		}
+	}
+
+	scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		logger.Error("watch list summaries will omit team results", "error", err)
+	}
+
+	sent := 0
+	for userID, preferences := range subscribers {
+		if len(preferences.WatchPlayers) == 0 && len(preferences.WatchTeams) == 0 {
+			continue
+		}
+
+		channel, err := b.discord.UserChannelCreate(userID)
+		if err != nil {
+			logger.Error("error opening DM for watch list summary", "user", userID, "error", err)
+			continue
+		}
+		if _, err := b.discord.ChannelMessageSendEmbed(channel.ID, b.watchlistEmbed(seasonInfo, preferences, lookups, scores)); err != nil {
+			logger.Error("error sending watch list summary", "user", userID, "error", err)
+			continue
+		}
+		sent++
+	}
+	logger.Info("watch list summaries sent", "users", sent, "week", models.WeekLabel(seasonInfo.SeasonType, seasonInfo.Week))
+}
+
+// watchlistEmbed renders one user's weekly summary: stat lines, team results, and injury designations
+func (b *Bot) watchlistEmbed(seasonInfo *models.SeasonInfo, preferences UserPreferences, lookups map[string]nfl.WeekLookup, scores []*models.LiveScore) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("👀 Your Watch List — %s, %d", models.WeekLabel(seasonInfo.SeasonType, seasonInfo.Week), seasonInfo.Season),
+		Color: 0x013369,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Manage with /watchlist • turn off with /watchlist summary enabled:False",
+		},
+	}
+
+	var players, injuries []string
+	for _, name := range preferences.WatchPlayers {
+		lookup, found := lookups[strings.ToLower(name)]
+		if !found || lookup.Err != nil {
+			players = append(players, fmt.Sprintf("**%s** — no stats this week", name))
+			continue
+		}
+		stats := lookup.Stats
+		players = append(players, fmt.Sprintf("**%s** (%s, %s) — %s • %.1f PPR",
+			stats.Name, stats.Position, stats.Team, b.keyStatLine(stats, models.Format{}), b.fantasyPoints(stats, 1)))
+		if lookup.Injury != "" {
+			injuries = append(injuries, fmt.Sprintf("🩹 **%s** — %s", stats.Name, lookup.Injury))
+		}
+	}
+	if len(players) > 0 {
+		embed.Description = strings.Join(players, "\n")
+	}
+
+	if len(preferences.WatchTeams) > 0 {
+		var results []string
+		for _, team := range preferences.WatchTeams {
+			results = append(results, watchlistTeamResult(team, scores))
+		}
+		sort.Strings(results)
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "🏈 Teams", Value: strings.Join(results, "\n")})
+	}
+	if len(injuries) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "🩹 Injuries", Value: strings.Join(injuries, "\n")})
+	}
+	return embed
+}
+
+// watchlistTeamResult describes how a team's game went this week
+func watchlistTeamResult(team string, scores []*models.LiveScore) string {
+	for _, score := range scores {
+		if score.HomeTeam != team && score.AwayTeam != team {
+			continue
+		}
+		switch {
+		case score.IsCompleted():
+			teamScore, opponentScore, opponent := score.HomeScore, score.AwayScore, "vs "+score.AwayTeam
+			if score.AwayTeam == team {
+				teamScore, opponentScore, opponent = score.AwayScore, score.HomeScore, "@ "+score.HomeTeam
+			}
+			outcome := "T"
+			if teamScore > opponentScore {
+				outcome = "W"
+			} else if teamScore < opponentScore {
+				outcome = "L"
+			}
+			return fmt.Sprintf("**%s** %s %d-%d %s", team, outcome, teamScore, opponentScore, opponent)
+		case score.IsLive():
+			return fmt.Sprintf("**%s** in progress — %s", team, score.GetScoreString())
+		case score.State().Interrupted():
+			return fmt.Sprintf("**%s** %s", team, score.GetScoreString())
+		default:
+			return fmt.Sprintf("**%s** %s @ %s kicks off <t:%d:F>", team, score.AwayTeam, score.HomeTeam, score.GameTime.Unix())
+		}
+	}
+	return fmt.Sprintf("**%s** bye week", team)
+}
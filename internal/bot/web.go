@@ -0,0 +1,274 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// leaderboardPageTemplate renders a guild's public leaderboard page
+//
+//go:embed templates/leaderboard.html
+var leaderboardPageTemplate string
+
+// leaderboardPage is parsed once; the template is embedded so it can't fail at runtime
+var leaderboardPage = template.Must(template.New("leaderboard").Parse(leaderboardPageTemplate))
+
+// memberNameTTL is how long resolved display names are reused before asking Discord again
+const memberNameTTL = time.Hour
+
+// pickemPageRow is one row of a pick'em table on the leaderboard page
+type pickemPageRow struct {
+	Rank    int
+	Name    string
+	Correct int
+	Graded  int
+	Pct     string
+}
+
+// pickemPageTable is one pick type's season leaderboard on the page
+type pickemPageTable struct {
+	Season int
+	Label  string
+	Rows   []pickemPageRow
+}
+
+// triviaPageRow is one row of the trivia table on the leaderboard page
+type triviaPageRow struct {
+	Rank int
+	Name string
+	TriviaScore
+}
+
+// leaderboardPageData is everything the leaderboard template renders
+type leaderboardPageData struct {
+	GuildName string
+	Pickem    []pickemPageTable
+	Trivia    []triviaPageRow
+	Updated   string
+}
+
+// memberName is a cached display name
+type memberName struct {
+	name      string
+	fetchedAt time.Time
+}
+
+// webServer serves tokenized public leaderboard pages
+type webServer struct {
+	server *http.Server
+
+	mu    sync.Mutex
+	names map[string]memberName // guild ID + user ID -> display name
+}
+
+// startWebServer serves leaderboard pages on addr until Stop
+func (b *Bot) startWebServer(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /leaderboard/{token}", b.serveLeaderboardPage)
+	if b.config.WebAPIToken != "" {
+		b.registerAPIRoutes(mux)
+	}
+
+	b.web = &webServer{
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		names: make(map[string]memberName),
+	}
+
+	go func() {
+		logger.Info("web server listening", "addr", addr)
+		if err := b.web.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("web server stopped", "error", err)
+		}
+	}()
+}
+
+// stopWebServer shuts the web server down, letting in-flight requests finish
+func (b *Bot) stopWebServer() {
+	if b.web == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := b.web.server.Shutdown(ctx); err != nil {
+		logger.Error("error shutting down web server", "error", err)
+	}
+}
+
+// leaderboardURL is the public address of a guild's leaderboard page
+func (b *Bot) leaderboardURL(token string) string {
+	return strings.TrimSuffix(b.config.WebPublicURL, "/") + "/leaderboard/" + token
+}
+
+// newLeaderboardToken generates an unguessable page token
+func newLeaderboardToken() (string, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("error generating leaderboard token: %v", err)
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// serveLeaderboardPage renders the leaderboard page of the guild owning the token
+func (b *Bot) serveLeaderboardPage(w http.ResponseWriter, r *http.Request) {
+	guildID, ok := b.settings.GuildByLeaderboardToken(r.PathValue("token"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	data, err := b.leaderboardPageData(guildID)
+	if err != nil {
+		logger.Error("error building leaderboard page", "guild", guildID, "error", err)
+		http.Error(w, "leaderboards are unavailable right now", http.StatusServiceUnavailable)
+		return
+	}
+
+	var page bytes.Buffer
+	if err := leaderboardPage.Execute(&page, data); err != nil {
+		logger.Error("error rendering leaderboard page", "guild", guildID, "error", err)
+		http.Error(w, "leaderboards are unavailable right now", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	w.Write(page.Bytes())
+}
+
+// leaderboardPageData collects a guild's pick'em and trivia leaderboards with display names
+func (b *Bot) leaderboardPageData(guildID string) (*leaderboardPageData, error) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return nil, err
+	}
+
+	data := &leaderboardPageData{
+		GuildName: guildID,
+		Updated:   time.Now().In(b.guildLocation(guildID)).Format(b.guildFormat(guildID).Clock("Mon Jan 2, 3:04 PM") + " MST"),
+	}
+	if guild, err := b.discord.State.Guild(guildID); err == nil {
+		data.GuildName = guild.Name
+	}
+
+	seasonRecords := make(map[string][]*pickemRecord)
+	b.pickem.View(guildID, func(pool *pickemPool) {
+		for _, kind := range pickemKinds {
+			seasonRecords[kind] = sortedRecords(pool.SeasonRecords(seasonInfo.Season, kind))
+		}
+	})
+	for _, kind := range pickemKinds {
+		records, exists := seasonRecords[kind]
+		if !exists {
+			continue
+		}
+		table := pickemPageTable{Season: seasonInfo.Season, Label: pickemKindLabels[kind]}
+		for _, record := range records {
+			if record.Graded == 0 {
+				continue
+			}
+			table.Rows = append(table.Rows, pickemPageRow{
+				Rank:    len(table.Rows) + 1,
+				Name:    b.memberDisplayName(guildID, record.UserID),
+				Correct: record.Correct,
+				Graded:  record.Graded,
+				Pct:     fmt.Sprintf("%.3f", float64(record.Correct)/float64(record.Graded)),
+			})
+		}
+		data.Pickem = append(data.Pickem, table)
+	}
+
+	for index, standing := range b.triviaScores.Leaderboard(guildID) {
+		data.Trivia = append(data.Trivia, triviaPageRow{
+			Rank:        index + 1,
+			Name:        b.memberDisplayName(guildID, standing.UserID),
+			TriviaScore: standing.TriviaScore,
+		})
+	}
+
+	return data, nil
+}
+
+// memberDisplayName resolves a user's name in a guild, since the page can't render mentions
+func (b *Bot) memberDisplayName(guildID, userID string) string {
+	key := guildID + ":" + userID
+
+	b.web.mu.Lock()
+	cached, exists := b.web.names[key]
+	b.web.mu.Unlock()
+	if exists && time.Since(cached.fetchedAt) < memberNameTTL {
+		return cached.name
+	}
+
+	member, err := b.discord.State.Member(guildID, userID)
+	if err != nil {
+		member, err = b.discord.GuildMember(guildID, userID)
+	}
+	if err != nil {
+		// Members who left the server keep their row under a placeholder
+		return "Former member"
+	}
+
+	name := member.DisplayName()
+	b.web.mu.Lock()
+	b.web.names[key] = memberName{name: name, fetchedAt: time.Now()}
+	b.web.mu.Unlock()
+	return name
+}
+
+// handleSlashLeaderboardPage handles the /leaderboard-page slash command (admin only)
+func (b *Bot) handleSlashLeaderboardPage(s Responder, i *discordgo.InteractionCreate) {
+	if i.GuildID == "" {
+		b.respondInteraction(s, i, b.tr(i, "error.guild_only"))
+		return
+	}
+	if b.web == nil {
+		respondEphemeral(s, i, b.tr(i, "leaderboard_page.disabled"))
+		return
+	}
+
+	rotate := false
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "rotate" {
+			rotate = option.BoolValue()
+		}
+	}
+
+	token := b.settings.Get(i.GuildID).LeaderboardToken
+	if token == "" || rotate {
+		var err error
+		if token, err = newLeaderboardToken(); err != nil {
+			respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+		if err := b.settings.Update(i.GuildID, func(settings *GuildSettings) {
+			settings.LeaderboardToken = token
+		}); err != nil {
+			respondEphemeral(s, i, b.tr(i, "leaderboard_page.save_failed"))
+			return
+		}
+	}
+
+	message := fmt.Sprintf("🌐 This server's public leaderboard page:\n%s\n\nAnyone with the link can view pick'em and trivia standings.", b.leaderboardURL(token))
+	if rotate {
+		message += " The previous link no longer works."
+	} else {
+		message += " Use `rotate:True` to replace the link."
+	}
+	respondEphemeral(s, i, message)
+}
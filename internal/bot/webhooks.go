@@ -0,0 +1,268 @@
+package bot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/pkg/models"
+)
+
+// webhookTimeout bounds how long a generic webhook endpoint has to accept an alert
+const webhookTimeout = 10 * time.Second
+
+// discordWebhookPattern matches a Discord webhook URL, capturing its ID and token
+var discordWebhookPattern = regexp.MustCompile(`^https://(?:(?:canary|ptb)\.)?discord(?:app)?\.com/api(?:/v\d+)?/webhooks/(\d+)/([\w-]+)$`)
+
+// webhookClient posts alerts to generic webhooks. It dials only public addresses, so an admin
+// can't point the bot at services on its own host or network, and ignores proxy settings so the
+// check applies to the webhook itself.
+var webhookClient = &http.Client{
+	Timeout: webhookTimeout,
+	Transport: &http.Transport{
+		DialContext:         (&net.Dialer{Timeout: webhookTimeout, Control: rejectInternalAddress}).DialContext,
+		TLSHandshakeTimeout: webhookTimeout,
+	},
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which netip doesn't count as private
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// playAlertPayload is the JSON body posted to a generic webhook for each play alert
+type playAlertPayload struct {
+	Event       string   `json:"event"` // always "play_alert"
+	GuildID     string   `json:"guild_id"`
+	GameID      string   `json:"game_id"`
+	PlayID      int      `json:"play_id"`
+	Kinds       []string `json:"kinds"`
+	Team        string   `json:"team"`
+	Quarter     string   `json:"quarter"`
+	Clock       string   `json:"clock"`
+	Description string   `json:"description"`
+	AwayTeam    string   `json:"away_team"`
+	HomeTeam    string   `json:"home_team"`
+	AwayScore   int      `json:"away_score"`
+	HomeScore   int      `json:"home_score"`
+}
+
+// automatedPayload is the JSON body posted to a generic webhook for automated posts other than
+// play alerts: the message the bot would have posted in a channel
+type automatedPayload struct {
+	Event   string                    `json:"event"` // e.g. "digest", "milestone", "standings_alert"
+	GuildID string                    `json:"guild_id"`
+	Content string                    `json:"content,omitempty"`
+	Embeds  []*discordgo.MessageEmbed `json:"embeds,omitempty"`
+}
+
+// isWebhookTarget reports whether a subscription's target is a webhook URL rather than a channel ID
+func isWebhookTarget(target string) bool {
+	return strings.HasPrefix(target, "https://") || strings.HasPrefix(target, "http://")
+}
+
+// parseWebhookURL validates a webhook URL an admin entered. Hosts that resolve to internal
+// addresses are also refused when the alert is posted, see rejectInternalAddress.
+func parseWebhookURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" || parsed.Scheme != "https" {
+		return "", errors.New("must be an https URL")
+	}
+	host := parsed.Hostname()
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return "", errors.New("must be a public address")
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !publicAddress(ip) {
+		return "", errors.New("must be a public address")
+	}
+	if strings.Contains(parsed.Host, "discord") && strings.Contains(parsed.Path, "/webhooks/") && !discordWebhookPattern.MatchString(raw) {
+		return "", errors.New("not a complete Discord webhook URL; copy it from the channel's Integrations settings")
+	}
+	return raw, nil
+}
+
+// publicAddress reports whether an IP is routable on the public internet, rejecting loopback,
+// private, link-local (including the 169.254.169.254 cloud metadata service), and other
+// special-purpose addresses
+func publicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// rejectInternalAddress is the webhook dialer's Control hook: it runs after DNS resolution, so a
+// public hostname that resolves to an internal address is refused too
+func rejectInternalAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !publicAddress(ip) {
+		return fmt.Errorf("webhook address %s is not public", ip)
+	}
+	return nil
+}
+
+// webhookLabel describes a webhook without revealing its token, e.g. "Discord webhook 1234567890"
+// or "webhook at example.com"
+func webhookLabel(webhookURL string) string {
+	if match := discordWebhookPattern.FindStringSubmatch(webhookURL); match != nil {
+		return "Discord webhook " + match[1]
+	}
+	if parsed, err := url.Parse(webhookURL); err == nil {
+		return "webhook at " + parsed.Host
+	}
+	return "webhook"
+}
+
+// playAlertJSON encodes a play as the body posted to generic webhooks, or nil when it can't be
+// encoded and the alert should fall back to the generic automated payload
+func playAlertJSON(guildID string, play *models.GamePlay) json.RawMessage {
+	payload := playAlertPayload{
+		Event:       "play_alert",
+		GuildID:     guildID,
+		GameID:      play.GameID,
+		PlayID:      play.ID,
+		Kinds:       play.Kinds,
+		Team:        play.Team,
+		Quarter:     play.Quarter,
+		Clock:       play.Clock,
+		Description: play.Description,
+		AwayTeam:    play.AwayTeam,
+		HomeTeam:    play.HomeTeam,
+		AwayScore:   play.AwayScore,
+		HomeScore:   play.HomeScore,
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("error encoding webhook alert", "guild", guildID, "error", err)
+		return nil
+	}
+	return encoded
+}
+
+// postToTarget sends an automated post to a subscription's target, a channel ID or a webhook URL,
+// tagging it with the event generic webhooks receive
+func (b *Bot) postToTarget(guildID, target, event, content string, embeds ...*discordgo.MessageEmbed) {
+	b.sendAutomated(&DelayedPost{GuildID: guildID, ChannelID: target, Event: event, Content: content, Embeds: embeds})
+}
+
+// sendToTarget sends an embed without scores to a channel or webhook right away, skipping the
+// spoiler delay and score hiding automated posts get
+func (b *Bot) sendToTarget(guildID, target, event string, embed *discordgo.MessageEmbed) error {
+	switch {
+	case !isWebhookTarget(target):
+		_, err := b.discord.ChannelMessageSendEmbed(target, embed)
+		return err
+	case discordWebhookPattern.MatchString(target):
+		return b.executeDiscordWebhook(target, &discordgo.MessageSend{Embeds: []*discordgo.MessageEmbed{embed}})
+	}
+
+	encoded, err := json.Marshal(automatedPayload{Event: event, GuildID: guildID, Embeds: []*discordgo.MessageEmbed{embed}})
+	if err != nil {
+		return err
+	}
+	return postJSONWebhook(target, encoded)
+}
+
+// postAutomatedPayload sends an automated post to a generic webhook as JSON
+func (b *Bot) postAutomatedPayload(post *DelayedPost, message *discordgo.MessageSend) {
+	encoded, err := json.Marshal(automatedPayload{
+		Event:   post.Event,
+		GuildID: post.GuildID,
+		Content: message.Content,
+		Embeds:  message.Embeds,
+	})
+	if err != nil {
+		logger.Error("error encoding webhook post", "guild", post.GuildID, "error", err)
+		return
+	}
+	// Posted in the background so a slow endpoint can't hold up other alerts
+	go func() {
+		if err := postJSONWebhook(post.WebhookURL, encoded); err != nil {
+			logger.Error("error posting webhook alert", "guild", post.GuildID, "webhook", webhookLabel(post.WebhookURL), "error", err)
+		}
+	}()
+}
+
+// subscriptionTarget reads the channel or webhook option of a subscription command. It returns ""
+// when neither is given, and tells the admin and returns false when both are or the webhook is
+// invalid.
+func (b *Bot) subscriptionTarget(s Responder, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) (string, bool) {
+	var channelID, webhook string
+	for _, option := range options {
+		switch option.Name {
+		case "channel":
+			// Only the ID is needed, so skip looking the channel up
+			channelID = option.ChannelValue(nil).ID
+		case "webhook":
+			webhook = option.StringValue()
+		}
+	}
+
+	switch {
+	case channelID != "" && webhook != "":
+		respondEphemeral(s, i, b.tr(i, "error.channel_and_webhook"))
+		return "", false
+	case webhook == "":
+		return channelID, true
+	}
+
+	webhookURL, err := parseWebhookURL(webhook)
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "error.invalid_webhook", err))
+		return "", false
+	}
+	return webhookURL, true
+}
+
+// targetLabel describes where a subscription posts: a channel mention or a redacted webhook
+func targetLabel(target string) string {
+	if isWebhookTarget(target) {
+		return webhookLabel(target)
+	}
+	return fmt.Sprintf("<#%s>", target)
+}
+
+// postJSONWebhook posts a JSON body to a generic webhook
+func postJSONWebhook(webhookURL string, body json.RawMessage) error {
+	if !strings.HasPrefix(webhookURL, "https://") {
+		return errors.New("webhook must be an https URL")
+	}
+
+	response, err := webhookClient.Post(webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", response.Status)
+	}
+	return nil
+}
+
+// executeDiscordWebhook sends an automated post through a Discord webhook
+func (b *Bot) executeDiscordWebhook(webhookURL string, message *discordgo.MessageSend) error {
+	match := discordWebhookPattern.FindStringSubmatch(webhookURL)
+	if match == nil {
+		return fmt.Errorf("not a Discord webhook: %s", webhookLabel(webhookURL))
+	}
+	_, err := b.discord.WebhookExecute(match[1], match[2], false, &discordgo.WebhookParams{
+		Content: message.Content,
+		Embeds:  message.Embeds,
+	})
+	return err
+}
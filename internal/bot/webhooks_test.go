@@ -0,0 +1,98 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestParseWebhookURL(t *testing.T) {
+	tests := []struct {
+		url string
+		ok  bool
+	}{
+		{"https://hooks.example.com/nfl", true},
+		{"https://discord.com/api/webhooks/123/abc-DEF", true},
+		{"http://hooks.example.com/nfl", false},
+		{"https://localhost/hook", false},
+		{"https://127.0.0.1:8080/hook", false},
+		{"https://10.0.0.5/hook", false},
+		{"https://192.168.1.1/hook", false},
+		{"https://169.254.169.254/latest/meta-data", false},
+		{"https://[::1]/hook", false},
+		{"https://[fd00::1]/hook", false},
+		{"https://100.64.0.1/hook", false},
+		{"https://discord.com/api/webhooks/123", false},
+	}
+	for _, tt := range tests {
+		_, err := parseWebhookURL(tt.url)
+		if (err == nil) != tt.ok {
+			t.Errorf("parseWebhookURL(%q) error = %v, want ok %v", tt.url, err, tt.ok)
+		}
+	}
+}
+
+func TestRejectInternalAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		ok      bool
+	}{
+		{"93.184.216.34:443", true},
+		{"[2606:2800:220:1::]:443", true},
+		{"127.0.0.1:443", false},
+		{"10.1.2.3:443", false},
+		{"172.16.0.1:443", false},
+		{"169.254.169.254:80", false},
+		{"0.0.0.0:443", false},
+		{"[::ffff:127.0.0.1]:443", false},
+		{"[fe80::1]:443", false},
+	}
+	for _, tt := range tests {
+		err := rejectInternalAddress("tcp", tt.address, nil)
+		if (err == nil) != tt.ok {
+			t.Errorf("rejectInternalAddress(%q) error = %v, want ok %v", tt.address, err, tt.ok)
+		}
+	}
+}
+
+func TestPostToTargetQueuesWebhook(t *testing.T) {
+	b := newTestBot(t)
+	b.settings.Update(testGuildID, func(settings *GuildSettings) {
+		settings.SpoilerDelayMinutes = 5
+	})
+
+	const webhookURL = "https://hooks.example.com/nfl"
+	b.postToTarget(testGuildID, webhookURL, "milestone", "", &discordgo.MessageEmbed{Title: "🎯 Milestone"})
+
+	due := b.delayedPosts.TakeDue(time.Now().Add(time.Hour))
+	if len(due) != 1 {
+		t.Fatalf("queued %d posts, want the webhook post held by the spoiler delay", len(due))
+	}
+	post := due[0]
+	if post.WebhookURL != webhookURL || post.ChannelID != "" || post.GuildID != testGuildID || post.Event != "milestone" {
+		t.Errorf("queued post = %+v, want it addressed to the webhook for %s", post, testGuildID)
+	}
+}
+
+func TestSubscriptionTarget(t *testing.T) {
+	b := newTestBot(t)
+	tests := []struct {
+		name    string
+		options []*discordgo.ApplicationCommandInteractionDataOption
+		want    string
+		ok      bool
+	}{
+		{"neither", nil, "", true},
+		{"webhook", []*discordgo.ApplicationCommandInteractionDataOption{stringOption("webhook", " https://hooks.example.com/nfl ")}, "https://hooks.example.com/nfl", true},
+		{"internal webhook", []*discordgo.ApplicationCommandInteractionDataOption{stringOption("webhook", "https://10.0.0.5/hook")}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := b.subscriptionTarget(newFakeResponder(), slashInteraction("alerts", tt.options...), tt.options)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("subscriptionTarget() = %q, %v, want %q, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package bot
+
+import (
+	"strings"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// matchupTeamName returns the first team named in a matchup: "BUF @ KC", "Bills vs Chiefs", or a
+// single team
+func matchupTeamName(matchup string) string {
+	for _, separator := range []string{"@", " vs. ", " vs ", " at "} {
+		if index := strings.Index(strings.ToLower(matchup), separator); index > 0 {
+			return strings.TrimSpace(matchup[:index])
+		}
+	}
+	return strings.TrimSpace(matchup)
+}
+
+// currentWeekGame returns a team's game in the current week along with the week, or a nil game
+// when the team doesn't play
+func (b *Bot) currentWeekGame(team string) (*models.LiveScore, *models.SeasonInfo, error) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	games, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, game := range games {
+		if game.HomeTeam == team || game.AwayTeam == team {
+			return game, seasonInfo, nil
+		}
+	}
+	return nil, seasonInfo, nil
+}
@@ -0,0 +1,238 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/standings"
+	"nfl-discord-bot/pkg/models"
+)
+
+// whatIfMaxResults caps how many hypothetical results one /whatif can set
+const whatIfMaxResults = 16
+
+// whatIfResult is one hypothetical result as typed by the user, e.g. "BUF over KC"
+type whatIfResult struct {
+	winner string
+	loser  string
+	tie    bool
+}
+
+// parseWhatIfResults parses a comma separated list of "<winner> over <loser>" or "<team> ties <team>"
+func parseWhatIfResults(input string) ([]whatIfResult, error) {
+	var results []whatIfResult
+	for _, entry := range strings.Split(input, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.Fields(entry)
+		separator := -1
+		for index, field := range fields {
+			switch strings.ToLower(field) {
+			case "over", "beats", "ties":
+				separator = index
+			}
+		}
+		if separator <= 0 || separator == len(fields)-1 {
+			return nil, fmt.Errorf("couldn't read %q, use \"<winner> over <loser>\" or \"<team> ties <team>\"", entry)
+		}
+
+		results = append(results, whatIfResult{
+			winner: strings.Join(fields[:separator], " "),
+			loser:  strings.Join(fields[separator+1:], " "),
+			tie:    strings.EqualFold(fields[separator], "ties"),
+		})
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no results given")
+	}
+	if len(results) > whatIfMaxResults {
+		return nil, fmt.Errorf("at most %d results can be set at once", whatIfMaxResults)
+	}
+	return results, nil
+}
+
+// handleSlashWhatIf handles the /whatif slash command
+func (b *Bot) handleSlashWhatIf(s Responder, i *discordgo.InteractionCreate) {
+	var input, conference string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "results":
+			input = option.StringValue()
+		case "conference":
+			conference = option.StringValue()
+		}
+	}
+
+	results, err := parseWhatIfResults(input)
+	if err != nil {
+		respondEphemeral(s, i, b.tr(i, "whatif.invalid", err))
+		return
+	}
+
+	err = b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial whatif response", "error", err)
+		return
+	}
+
+	// Process what-if request asynchronously
+	go b.processSlashWhatIfRequest(s, i, results, conference)
+}
+
+// processSlashWhatIfRequest recomputes standings with the hypothetical results applied to this
+// week's games and completes the deferred response with the resulting seeding
+func (b *Bot) processSlashWhatIfRequest(s Responder, i *discordgo.InteractionCreate, results []whatIfResult, conference string) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+	if seasonInfo.SeasonType != models.SeasonTypeRegular {
+		b.completeInteraction(s, i, "What-if standings are only available during the regular season.")
+		return
+	}
+
+	teams, games, err := b.regularSeasonResults(seasonInfo.Season)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting season results", err))
+		return
+	}
+
+	hypothetical := make([]models.Game, len(games))
+	copy(hypothetical, games)
+
+	var applied []string
+	for _, result := range results {
+		description, err := b.applyWhatIfResult(hypothetical, seasonInfo.Week, result)
+		if err != nil {
+			b.completeInteraction(s, i, fmt.Sprintf("Can't apply %s: %v", result.describe(), err))
+			return
+		}
+		applied = append(applied, description)
+	}
+
+	actual := standings.Compute(teams, games)
+	table := standings.Compute(teams, hypothetical)
+
+	conferences := []string{"AFC", "NFC"}
+	if conference != "" {
+		conferences = []string{conference}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🔮 What If — %d %s", seasonInfo.Season, seasonInfo.WeekLabel()),
+		Description: "**If** " + strings.Join(applied, ", "),
+		Color:       0x013369,
+	}
+
+	for _, conf := range conferences {
+		var seeds strings.Builder
+		for _, standing := range table.Conference(conf) {
+			if standing.Seed == 0 {
+				continue
+			}
+			status := ""
+			if marker := standing.StatusMarker(); marker != "" {
+				status = " (" + marker + ")"
+			}
+			seeds.WriteString(fmt.Sprintf("**%d.** %s %s%s%s\n", standing.Seed, standing.Team.Key,
+				standing.Overall.String(), status, seedMovement(actual.Teams[standing.Team.Key], standing)))
+		}
+
+		var out []string
+		for _, standing := range actual.Conference(conf) {
+			if standing.Seed > 0 && table.Teams[standing.Team.Key].Seed == 0 {
+				out = append(out, fmt.Sprintf("%s (was %d)", standing.Team.Key, standing.Seed))
+			}
+		}
+		if len(out) > 0 {
+			seeds.WriteString("❌ Out: " + strings.Join(out, ", ") + "\n")
+		}
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s Seeds", conf),
+			Value:  seeds.String(),
+			Inline: true,
+		})
+	}
+
+	embed.Footer = &discordgo.MessageEmbedFooter{
+		Text: "Arrows show movement from the actual standings | Simplified tiebreakers: H2H, division, conference, point differential",
+	}
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending whatif embed response", "error", err)
+	}
+}
+
+// applyWhatIfResult finds the unplayed game between the two teams in the given week and gives it
+// the hypothetical result, returning the result as it will be displayed
+func (b *Bot) applyWhatIfResult(games []models.Game, week int, result whatIfResult) (string, error) {
+	winner, err := b.nflClient.GetTeamInfo(result.winner)
+	if err != nil {
+		return "", fmt.Errorf("unknown team %s", result.winner)
+	}
+	loser, err := b.nflClient.GetTeamInfo(result.loser)
+	if err != nil {
+		return "", fmt.Errorf("unknown team %s", result.loser)
+	}
+
+	for index := range games {
+		game := &games[index]
+		if game.Week != week {
+			continue
+		}
+		if !(game.HomeTeam == winner.Key && game.AwayTeam == loser.Key) && !(game.HomeTeam == loser.Key && game.AwayTeam == winner.Key) {
+			continue
+		}
+		if standings.IsFinal(game.Status) {
+			return "", fmt.Errorf("%s vs %s has already been played", winner.Key, loser.Key)
+		}
+
+		// Only the winner matters for standings; use a typical one-score margin
+		switch {
+		case result.tie:
+			game.HomeScore, game.AwayScore = 20, 20
+		case game.HomeTeam == winner.Key:
+			game.HomeScore, game.AwayScore = 24, 20
+		default:
+			game.HomeScore, game.AwayScore = 20, 24
+		}
+		game.Status = "Final"
+
+		if result.tie {
+			return fmt.Sprintf("%s ties %s", winner.Key, loser.Key), nil
+		}
+		return fmt.Sprintf("%s over %s", winner.Key, loser.Key), nil
+	}
+
+	return "", fmt.Errorf("%s and %s don't play each other this week", winner.Key, loser.Key)
+}
+
+// describe echoes the result as the user typed it
+func (r whatIfResult) describe() string {
+	if r.tie {
+		return fmt.Sprintf("%q", r.winner+" ties "+r.loser)
+	}
+	return fmt.Sprintf("%q", r.winner+" over "+r.loser)
+}
+
+// seedMovement marks how a team's hypothetical seed differs from its actual one
+func seedMovement(actual, hypothetical *standings.Standing) string {
+	switch {
+	case actual == nil || actual.Seed == hypothetical.Seed:
+		return ""
+	case actual.Seed == 0:
+		return " 🆕"
+	case hypothetical.Seed < actual.Seed:
+		return fmt.Sprintf(" ⬆️ from %d", actual.Seed)
+	default:
+		return fmt.Sprintf(" ⬇️ from %d", actual.Seed)
+	}
+}
@@ -0,0 +1,87 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/embeds"
+	"nfl-discord-bot/pkg/models"
+)
+
+// handleSlashWhatToWatch handles the /whattowatch slash command
+func (b *Bot) handleSlashWhatToWatch(s Responder, i *discordgo.InteractionCreate) {
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial what to watch response", "error", err)
+		return
+	}
+
+	// Process what to watch request asynchronously
+	go b.processSlashWhatToWatchRequest(s, i)
+}
+
+// processSlashWhatToWatchRequest lists the week's nationally televised games that haven't finished
+func (b *Bot) processSlashWhatToWatchRequest(s Responder, i *discordgo.InteractionCreate) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting current season", err))
+		return
+	}
+	scores, err := b.nflClient.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting this week's games", err))
+		return
+	}
+
+	location := b.guildLocation(i.GuildID)
+	var lines []string
+	for _, game := range nationalGames(scores) {
+		if game.IsCompleted() || game.State().Interrupted() {
+			continue
+		}
+		when := embeds.FormatKickoff(game.GameTime, location, "Mon 3:04 PM", b.guildFormat(i.GuildID))
+		if game.IsLive() {
+			when = "🔴 LIVE now"
+		}
+		lines = append(lines, fmt.Sprintf("**%s @ %s** — %s%s", game.AwayTeam, game.HomeTeam, when, embeds.Broadcast(game.Channel)))
+	}
+	if len(lines) == 0 {
+		b.completeInteraction(s, i, fmt.Sprintf("📺 No national TV games left in %s. Check `/scores` for results.", seasonInfo.WeekLabel()))
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📺 What to Watch — %s", seasonInfo.WeekLabel()),
+		Description: strings.Join(lines, "\n"),
+		Color:       embeds.ColorSchedule,
+		Footer:      &discordgo.MessageEmbedFooter{Text: "National broadcasts and standalone games • regional CBS/FOX games vary by market"},
+	}
+	if err := b.completeInteractionEmbed(s, i, embed); err != nil {
+		logger.Error("error sending what to watch embed response", "error", err)
+	}
+}
+
+// nationalGames returns a week's nationally televised games in kickoff order: those on a national
+// network, plus any game that has its kickoff slot to itself (e.g. Thanksgiving or late-season Saturdays)
+func nationalGames(scores []*models.LiveScore) []*models.LiveScore {
+	slots := make(map[int64]int)
+	for _, game := range scores {
+		slots[game.GameTime.Unix()]++
+	}
+
+	var national []*models.LiveScore
+	for _, game := range scores {
+		if game.GameTime.IsZero() {
+			continue
+		}
+		if models.IsNationalNetwork(game.Channel) || slots[game.GameTime.Unix()] == 1 {
+			national = append(national, game)
+		}
+	}
+	sort.Slice(national, func(i, j int) bool {
+		return national[i].GameTime.Before(national[j].GameTime)
+	})
+	return national
+}
@@ -0,0 +1,69 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/nfl"
+)
+
+// handleSlashWinTotals handles the /wintotals slash command
+func (b *Bot) handleSlashWinTotals(s Responder, i *discordgo.InteractionCreate) {
+	err := b.deferInteraction(s, i)
+	if err != nil {
+		logger.Error("error sending initial wintotals response", "error", err)
+		return
+	}
+
+	// Process win totals request asynchronously
+	go b.processSlashWinTotalsRequest(s, i)
+}
+
+// processSlashWinTotalsRequest builds the pace vs preseason line table and completes the deferred response
+func (b *Bot) processSlashWinTotalsRequest(s Responder, i *discordgo.InteractionCreate) {
+	seasonInfo, err := b.nflClient.GetCurrentSeason()
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting win totals", err))
+		return
+	}
+
+	paces, err := b.nflClient.GetWinTotalPaces(seasonInfo.Season)
+	if errors.Is(err, nfl.ErrNoWinTotals) {
+		b.completeInteraction(s, i, fmt.Sprintf("📈 There are no win total lines for the %d season yet, from the bot's preseason snapshot or the sportsbooks. Check back once the market opens.", seasonInfo.Season))
+		return
+	}
+	if err != nil {
+		b.completeInteraction(s, i, userError("Error getting win totals", err))
+		return
+	}
+
+	// Render as a fixed-width table so columns line up in Discord
+	var table strings.Builder
+	table.WriteString("```\n")
+	table.WriteString(fmt.Sprintf("%-4s %-7s %5s %5s %6s  %s\n", "TEAM", "RECORD", "LINE", "PACE", "DIFF", "STATUS"))
+	for _, pace := range paces {
+		record := fmt.Sprintf("%d-%d", pace.Wins, pace.Losses)
+		if pace.Ties > 0 {
+			record += fmt.Sprintf("-%d", pace.Ties)
+		}
+		table.WriteString(fmt.Sprintf("%-4s %-7s %5.1f %5.1f %+6.1f  %s\n",
+			pace.Team, record, pace.Line, pace.ProjectedWins(), pace.Margin(), pace.Status()))
+	}
+	table.WriteString("```")
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📈 %d Win Totals - Pace vs Preseason Lines", seasonInfo.Season),
+		Color:       0x013369,
+		Description: table.String(),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Through %s | Pace = win%% × 17 | ✅ = line already decided", seasonInfo.WeekLabel()),
+		},
+	}
+
+	err = b.completeInteractionEmbed(s, i, embed)
+	if err != nil {
+		logger.Error("error sending wintotals embed response", "error", err)
+	}
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"nfl-discord-bot/internal/logging"
+)
+
+// logger tags cache log entries with their module
+var logger = logging.For("cache")
+
+// Cache stores API responses with a per-entry TTL.
+// Values are serialized as JSON so every backend returns an independent copy.
+type Cache interface {
+	// Get decodes the cached value for key into dest, returning false on a miss or expired entry
+	Get(key string, dest interface{}) bool
+	// Set stores value under key for the given TTL
+	Set(key string, value interface{}, ttl time.Duration)
+	// Delete removes a key from the cache
+	Delete(key string)
+	// Close releases any resources held by the backend
+	Close() error
+}
+
+// Backend names accepted by New
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+// New creates the cache backend selected by name ("memory" or "redis")
+func New(backend, redisURL, redisPrefix string) (Cache, error) {
+	switch strings.ToLower(strings.TrimSpace(backend)) {
+	case "", BackendMemory:
+		return NewMemory(10 * time.Minute), nil
+	case BackendRedis:
+		if redisURL == "" {
+			return nil, fmt.Errorf("REDIS_URL is required when CACHE_BACKEND=redis")
+		}
+		return NewRedis(redisURL, redisPrefix)
+	default:
+		return nil, fmt.Errorf("unknown cache backend: %s (use memory or redis)", backend)
+	}
+}
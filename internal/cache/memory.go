@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// memoryEntry is a serialized value with its expiry time
+type memoryEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// Memory is an in-process cache. Entries are lost on restart.
+type Memory struct {
+	mu      sync.RWMutex
+	entries map[string]memoryEntry
+	stop    chan struct{}
+	once    sync.Once
+}
+
+// NewMemory creates an in-memory cache that removes expired entries every cleanupInterval
+func NewMemory(cleanupInterval time.Duration) *Memory {
+	m := &Memory{
+		entries: make(map[string]memoryEntry),
+		stop:    make(chan struct{}),
+	}
+
+	// Start periodic cache cleanup
+	go m.cleanupLoop(cleanupInterval)
+
+	return m
+}
+
+// Get decodes the cached value for key into dest
+func (m *Memory) Get(key string, dest interface{}) bool {
+	m.mu.RLock()
+	entry, exists := m.entries[key]
+	m.mu.RUnlock()
+
+	if !exists {
+		return false
+	}
+
+	// Check if cache entry is still valid
+	if time.Now().After(entry.expires) {
+		m.Delete(key) // Clean up expired entry
+		return false
+	}
+
+	if err := json.Unmarshal(entry.data, dest); err != nil {
+		logger.Warn("failed to decode cached value", "key", key, "error", err)
+		return false
+	}
+	return true
+}
+
+// Set stores value under key for the given TTL
+func (m *Memory) Set(key string, value interface{}, ttl time.Duration) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		logger.Warn("failed to encode value", "key", key, "error", err)
+		return
+	}
+
+	m.mu.Lock()
+	m.entries[key] = memoryEntry{data: data, expires: time.Now().Add(ttl)}
+	m.mu.Unlock()
+}
+
+// Delete removes a key from the cache
+func (m *Memory) Delete(key string) {
+	m.mu.Lock()
+	delete(m.entries, key)
+	m.mu.Unlock()
+}
+
+// Close stops the cleanup routine
+func (m *Memory) Close() error {
+	m.once.Do(func() { close(m.stop) })
+	return nil
+}
+
+// cleanupLoop periodically removes expired entries
+func (m *Memory) cleanupLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.cleanupExpired()
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+// cleanupExpired removes all expired entries from the cache
+func (m *Memory) cleanupExpired() {
+	now := time.Now()
+	removed := 0
+
+	m.mu.Lock()
+	for key, entry := range m.entries {
+		if now.After(entry.expires) {
+			delete(m.entries, key)
+			removed++
+		}
+	}
+	m.mu.Unlock()
+
+	if removed > 0 {
+		logger.Debug("cleaned up expired entries", "removed", removed)
+	}
+}
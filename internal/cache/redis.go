@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisTimeout bounds every Redis round trip so a slow cache never blocks a command
+const redisTimeout = 2 * time.Second
+
+// Redis is a cache shared across restarts and bot instances
+type Redis struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedis connects to the Redis server at url (e.g. redis://localhost:6379/0).
+// All keys are namespaced with prefix.
+func NewRedis(url, prefix string) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid REDIS_URL: %v", err)
+	}
+
+	client := redis.NewClient(opts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("error connecting to Redis: %v", err)
+	}
+
+	logger.Info("connected to redis", "addr", opts.Addr)
+	return &Redis{client: client, prefix: prefix}, nil
+}
+
+// Get decodes the cached value for key into dest
+func (r *Redis) Get(key string, dest interface{}) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	data, err := r.client.Get(ctx, r.prefix+key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			logger.Warn("redis get failed", "key", key, "error", err)
+		}
+		return false
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		logger.Warn("failed to decode cached value", "key", key, "error", err)
+		return false
+	}
+	return true
+}
+
+// Set stores value under key for the given TTL
+func (r *Redis) Set(key string, value interface{}, ttl time.Duration) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		logger.Warn("failed to encode value", "key", key, "error", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	if err := r.client.Set(ctx, r.prefix+key, data, ttl).Err(); err != nil {
+		logger.Warn("redis set failed", "key", key, "error", err)
+	}
+}
+
+// Delete removes a key from the cache
+func (r *Redis) Delete(key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	if err := r.client.Del(ctx, r.prefix+key).Err(); err != nil {
+		logger.Warn("redis delete failed", "key", key, "error", err)
+	}
+}
+
+// Close closes the Redis connection pool
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// stampedEntry wraps a cached value with when its data was fetched from the upstream API
+type stampedEntry struct {
+	FetchedAt time.Time       `json:"fetched_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// SetStamped stores value under key along with when its data was fetched, so readers can report
+// how old a cached result is
+func SetStamped(c Cache, key string, value interface{}, fetchedAt time.Time, ttl time.Duration) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		logger.Warn("failed to encode value", "key", key, "error", err)
+		return
+	}
+	c.Set(key, stampedEntry{FetchedAt: fetchedAt, Value: data}, ttl)
+}
+
+// GetStamped decodes a value stored with SetStamped into dest and returns when its data was
+// fetched, returning false on a miss
+func GetStamped(c Cache, key string, dest interface{}) (time.Time, bool) {
+	var entry stampedEntry
+	if !c.Get(key, &entry) || len(entry.Value) == 0 {
+		return time.Time{}, false
+	}
+	if err := json.Unmarshal(entry.Value, dest); err != nil {
+		logger.Warn("failed to decode cached value", "key", key, "error", err)
+		return time.Time{}, false
+	}
+	return entry.FetchedAt, true
+}
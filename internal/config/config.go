@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,26 +16,81 @@ type Config struct {
 	CommandCooldown   time.Duration
 	MaxConcurrentReqs int
 
+	// Slash command registration
+	DevGuildID               string // registers commands to this guild only, for instant updates while developing
+	DeregisterCommandsOnStop bool
+
 	// NFL API settings
-	NFLAPIKey     string
-	NFLAPIBaseURL string
+	NFLAPIKey          string
+	NFLAPIBaseURL      string
+	NFLAPIMonthlyQuota int // 0 when unknown
+
+	// Operations
+	ErrorChannelID string // where API schema drift is reported; empty only logs it
+
+	// Cache settings
+	CacheBackend   string
+	RedisURL       string
+	RedisKeyPrefix string
+
+	// Cache TTLs per data category
+	CacheTTLScores      time.Duration
+	CacheTTLPlayerStats time.Duration
+	CacheTTLSchedule    time.Duration
+	CacheTTLTeams       time.Duration
+	CacheTTLStandings   time.Duration
+	CacheTTLOdds        time.Duration
+	CacheTTLStale       time.Duration // how long last good responses are kept for outages
 
 	// Update intervals
 	StatsUpdateInterval    time.Duration
 	ScheduleUpdateInterval time.Duration
 
 	// Logging
-	LogLevel string
-	LogFile  string
+	LogLevel        string
+	LogModuleLevels string // per-module overrides, e.g. "nfl=debug,cache=warn"
+	LogFile         string
+	LogFormat       string // text or json
+
+	// Persistence
+	DataDir                 string
+	RetentionSeasons        int // seasons of pick'em and closing lines kept in the live documents
+	PredictionRetentionDays int // days before unannounced prediction polls are pruned
+
+	// Trivia question bank (empty uses the bundled questions)
+	TriviaQuestionsFile string
+
+	// Outbound links
+	ProFootballReferenceLinks bool // add a Pro-Football-Reference search button under /stats
+
+	// Player name matching
+	LegacyNameMatching bool // use the original prefix and containment scorer instead of edit distance
+
+	// Public leaderboard pages (empty WebAddr disables the web server)
+	WebAddr      string
+	WebPublicURL string
+	// Token for the /api endpoints on the web server (empty disables the API)
+	WebAPIToken string
 }
 
 // Load reads configuration from environment variables
 func Load() (*Config, error) {
+	return load(true)
+}
+
+// LoadLocal reads configuration like Load but without requiring DISCORD_TOKEN, for tools that
+// run the bot's commands without connecting to Discord
+func LoadLocal() (*Config, error) {
+	return load(false)
+}
+
+// load reads configuration from environment variables, optionally requiring a Discord token
+func load(requireToken bool) (*Config, error) {
 	config := &Config{}
 
 	// Discord configuration
 	config.DiscordToken = os.Getenv("DISCORD_TOKEN")
-	if config.DiscordToken == "" {
+	if config.DiscordToken == "" && requireToken {
 		return nil, fmt.Errorf("DISCORD_TOKEN environment variable is required")
 	}
 
@@ -52,10 +108,50 @@ func Load() (*Config, error) {
 	}
 	config.MaxConcurrentReqs = maxReqs
 
+	config.DevGuildID = os.Getenv("DEV_GUILD_ID")
+
+	deregister, err := strconv.ParseBool(getEnvWithDefault("DEREGISTER_COMMANDS_ON_STOP", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DEREGISTER_COMMANDS_ON_STOP value: %v", err)
+	}
+	config.DeregisterCommandsOnStop = deregister
+
 	// NFL API configuration
 	config.NFLAPIKey = os.Getenv("NFL_API_KEY")
 	config.NFLAPIBaseURL = getEnvWithDefault("NFL_API_BASE_URL", "https://api.sportsdata.io/v3/nfl")
 
+	quota, err := strconv.Atoi(getEnvWithDefault("NFL_API_MONTHLY_QUOTA", "0"))
+	if err != nil || quota < 0 {
+		return nil, fmt.Errorf("invalid NFL_API_MONTHLY_QUOTA value: must be a non-negative number")
+	}
+	config.NFLAPIMonthlyQuota = quota
+
+	// Cache configuration
+	config.CacheBackend = getEnvWithDefault("CACHE_BACKEND", "memory")
+	config.RedisURL = os.Getenv("REDIS_URL")
+	config.RedisKeyPrefix = getEnvWithDefault("REDIS_KEY_PREFIX", "nflbot:")
+
+	ttls := []struct {
+		env          string
+		defaultValue string
+		dest         *time.Duration
+	}{
+		{"CACHE_TTL_SCORES", "60s", &config.CacheTTLScores},
+		{"CACHE_TTL_PLAYER_STATS", "5m", &config.CacheTTLPlayerStats},
+		{"CACHE_TTL_SCHEDULE", "1h", &config.CacheTTLSchedule},
+		{"CACHE_TTL_TEAMS", "24h", &config.CacheTTLTeams},
+		{"CACHE_TTL_STANDINGS", "30m", &config.CacheTTLStandings},
+		{"CACHE_TTL_ODDS", "15m", &config.CacheTTLOdds},
+		{"CACHE_TTL_STALE", "24h", &config.CacheTTLStale},
+	}
+	for _, ttl := range ttls {
+		value, err := time.ParseDuration(getEnvWithDefault(ttl.env, ttl.defaultValue))
+		if err != nil || value <= 0 {
+			return nil, fmt.Errorf("invalid %s value: must be a positive duration like 90s or 2h", ttl.env)
+		}
+		*ttl.dest = value
+	}
+
 	// Update intervals
 	statsInterval, err := strconv.Atoi(getEnvWithDefault("STATS_UPDATE_INTERVAL", "30"))
 	if err != nil {
@@ -71,7 +167,55 @@ func Load() (*Config, error) {
 
 	// Logging
 	config.LogLevel = getEnvWithDefault("LOG_LEVEL", "info")
-	config.LogFile = getEnvWithDefault("LOG_FILE", "bot.log")
+	config.LogModuleLevels = os.Getenv("LOG_LEVELS")
+	config.LogFile = getEnvWithDefault("LOG_FILE", "")
+	config.LogFormat = getEnvWithDefault("LOG_FORMAT", "text")
+
+	// Persistence
+	config.DataDir = getEnvWithDefault("DATA_DIR", "data")
+
+	retentionSeasons, err := strconv.Atoi(getEnvWithDefault("RETENTION_SEASONS", "2"))
+	if err != nil || retentionSeasons < 1 {
+		return nil, fmt.Errorf("invalid RETENTION_SEASONS value: must be at least 1")
+	}
+	config.RetentionSeasons = retentionSeasons
+
+	predictionRetention, err := strconv.Atoi(getEnvWithDefault("PREDICTION_RETENTION_DAYS", "14"))
+	if err != nil || predictionRetention < 1 {
+		return nil, fmt.Errorf("invalid PREDICTION_RETENTION_DAYS value: must be at least 1")
+	}
+	config.PredictionRetentionDays = predictionRetention
+
+	// Operations
+	config.ErrorChannelID = os.Getenv("ERROR_CHANNEL_ID")
+
+	// Trivia
+	config.TriviaQuestionsFile = os.Getenv("TRIVIA_QUESTIONS_FILE")
+
+	// Outbound links
+	pfrLinks, err := strconv.ParseBool(getEnvWithDefault("PFR_LINKS", "true"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PFR_LINKS value: %v", err)
+	}
+	config.ProFootballReferenceLinks = pfrLinks
+
+	// Player name matching
+	legacyMatching, err := strconv.ParseBool(getEnvWithDefault("LEGACY_NAME_MATCHING", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid LEGACY_NAME_MATCHING value: %v", err)
+	}
+	config.LegacyNameMatching = legacyMatching
+
+	// Web server
+	config.WebAddr = os.Getenv("WEB_ADDR")
+	config.WebPublicURL = os.Getenv("WEB_PUBLIC_URL")
+	if config.WebPublicURL == "" && config.WebAddr != "" {
+		config.WebPublicURL = "http://localhost" + config.WebAddr
+		if !strings.HasPrefix(config.WebAddr, ":") {
+			config.WebPublicURL = "http://" + config.WebAddr
+		}
+	}
+	config.WebAPIToken = os.Getenv("WEB_API_TOKEN")
 
 	return config, nil
 }
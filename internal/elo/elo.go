@@ -0,0 +1,68 @@
+// Package elo rates teams with a margin-of-victory Elo model: every game moves rating points
+// from the loser to the winner, more for upsets and blowouts.
+package elo
+
+import "math"
+
+// Model parameters, following the widely used NFL Elo setup
+const (
+	// Mean is the league-average rating
+	Mean = 1500.0
+	// K scales how far a single game moves ratings
+	K = 20.0
+	// HomeField is the rating bonus for playing at home
+	HomeField = 48.0
+	// Carryover is the share of last season's distance from the mean a rating keeps after the offseason
+	Carryover = 2.0 / 3.0
+	// WinTotalPoints is the rating value of each preseason projected win above or below 8.5
+	WinTotalPoints = 25.0
+)
+
+// Expected returns the home team's expected score (its win probability) against the away team
+func Expected(home, away float64, neutral bool) float64 {
+	diff := home - away
+	if !neutral {
+		diff += HomeField
+	}
+	return 1 / (1 + math.Pow(10, -diff/400))
+}
+
+// Update returns both teams' ratings after a final score
+func Update(home, away float64, homeScore, awayScore int, neutral bool) (float64, float64) {
+	expected := Expected(home, away, neutral)
+
+	actual := 0.5
+	switch {
+	case homeScore > awayScore:
+		actual = 1
+	case awayScore > homeScore:
+		actual = 0
+	}
+
+	// Blowouts count for more, damped when the favorite wins so ratings don't run away
+	winnerDiff := home - away
+	if !neutral {
+		winnerDiff += HomeField
+	}
+	if awayScore > homeScore {
+		winnerDiff = -winnerDiff
+	}
+	margin := math.Abs(float64(homeScore - awayScore))
+	multiplier := math.Log(margin+1) * 2.2 / (winnerDiff*0.001 + 2.2)
+	if margin == 0 {
+		multiplier = 1
+	}
+
+	shift := K * multiplier * (actual - expected)
+	return home + shift, away - shift
+}
+
+// Regress carries a rating into a new season, pulling it part of the way back to the mean
+func Regress(rating float64) float64 {
+	return Mean + (rating-Mean)*Carryover
+}
+
+// FromWinTotal seeds a rating from a preseason win-total line
+func FromWinTotal(line float64) float64 {
+	return Mean + (line-8.5)*WinTotalPoints
+}
@@ -0,0 +1,200 @@
+package embeds
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/i18n"
+	"nfl-discord-bot/pkg/models"
+)
+
+// ComparisonHeader starts a comparison embed with both players, the color key, and the age of
+// the older stat line; callers add stat category fields to it
+func ComparisonHeader(stats1, stats2 *models.PlayerStats, title string) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("⚖️ %s", title),
+		Color: ColorComparison,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name: "Players",
+				Value: fmt.Sprintf("🔵 **%s** (%s, %s) vs 🔴 **%s** (%s, %s)",
+					stats1.Name, stats1.Team, stats1.Position,
+					stats2.Name, stats2.Team, stats2.Position),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "🔵 = " + stats1.Name + " | 🔴 = " + stats2.Name + " | ⬆️ Better performance",
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	AddFreshness(embed, stats1.Freshness.Older(stats2.Freshness), i18n.English)
+	return embed
+}
+
+// ComparisonEmbed compares two players side by side in the stat categories both of them play
+func ComparisonEmbed(stats1, stats2 *models.PlayerStats, title string, format models.Format) *discordgo.MessageEmbed {
+	embed := ComparisonHeader(stats1, stats2, title)
+
+	samePosType := samePositionType(stats1.Position, stats2.Position)
+	if samePosType == "QB" && stats1.Passing.Recorded() && stats2.Passing.Recorded() {
+		AddPassingComparison(embed, stats1, stats2, format)
+	}
+	if samePosType == "RB" || (stats1.Rushing.Recorded() && stats2.Rushing.Recorded()) {
+		AddRushingComparison(embed, stats1, stats2, format)
+	}
+	if samePosType == "WR" || samePosType == "TE" || (stats1.Receiving.Recorded() && stats2.Receiving.Recorded()) {
+		AddReceivingComparison(embed, stats1, stats2, format)
+	}
+
+	return embed
+}
+
+// samePositionType returns the shared position group of two depth chart positions, or empty
+// when they differ
+func samePositionType(pos1, pos2 string) string {
+	pos1 = strings.ToUpper(pos1)
+	pos2 = strings.ToUpper(pos2)
+
+	if pos1 == pos2 {
+		return pos1
+	}
+
+	if (pos1 == "WR" || pos1 == "WR1" || pos1 == "WR2") && (pos2 == "WR" || pos2 == "WR1" || pos2 == "WR2") {
+		return "WR"
+	}
+	if (pos1 == "RB" || pos1 == "RB1" || pos1 == "RB2") && (pos2 == "RB" || pos2 == "RB1" || pos2 == "RB2") {
+		return "RB"
+	}
+	if (pos1 == "QB" || pos1 == "QB1") && (pos2 == "QB" || pos2 == "QB1") {
+		return "QB"
+	}
+	if (pos1 == "TE" || pos1 == "TE1") && (pos2 == "TE" || pos2 == "TE1") {
+		return "TE"
+	}
+
+	return ""
+}
+
+// BetterIcons returns the ⬆️ marker for whichever value is higher
+func BetterIcons(value1, value2 float64) (string, string) {
+	switch {
+	case value1 > value2:
+		return " ⬆️", ""
+	case value2 > value1:
+		return "", " ⬆️"
+	default:
+		return "", ""
+	}
+}
+
+// rateLabel names a per-play distance stat, e.g. "Y/A" in yards or "m/Att" in meters
+func rateLabel(format models.Format, yards, meters string) string {
+	if format.Metric {
+		return meters
+	}
+	return yards
+}
+
+// AddPassingComparison adds passing stats comparison to embed
+func AddPassingComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats, format models.Format) {
+	passing1, passing2 := stats1.Passing, stats2.Passing
+	yardIcon1, yardIcon2 := BetterIcons(float64(passing1.Yards), float64(passing2.Yards))
+	tdIcon1, tdIcon2 := BetterIcons(float64(passing1.Touchdowns), float64(passing2.Touchdowns))
+	pctIcon1, pctIcon2 := BetterIcons(passing1.CompletionPercent(), passing2.CompletionPercent())
+	ratingIcon1, ratingIcon2 := BetterIcons(passing1.PasserRating(), passing2.PasserRating())
+	ypaIcon1, ypaIcon2 := BetterIcons(passing1.YardsPerAttempt(), passing2.YardsPerAttempt())
+	// Fewer sacks is better, so the arguments are swapped
+	sackIcon1, sackIcon2 := BetterIcons(float64(passing2.Sacks), float64(passing1.Sacks))
+
+	value := fmt.Sprintf(
+		"▫ **%s:** 🔵 %s%s | 🔴 %s%s\n"+
+			"▫ **TDs:** 🔵 %d%s | 🔴 %d%s\n"+
+			"▫ **Comp%%:** 🔵 %.1f%%%s | 🔴 %.1f%%%s\n"+
+			"▫ **INTs:** 🔵 %d | 🔴 %d\n"+
+			"▫ **Rating:** 🔵 %.1f%s | 🔴 %.1f%s\n"+
+			"▫ **%s:** 🔵 %.1f%s | 🔴 %.1f%s\n"+
+			"▫ **Sacks:** 🔵 %d%s | 🔴 %d%s",
+		format.UnitName(), format.Distance(passing1.Yards), yardIcon1, format.Distance(passing2.Yards), yardIcon2,
+		passing1.Touchdowns, tdIcon1, passing2.Touchdowns, tdIcon2,
+		passing1.CompletionPercent(), pctIcon1, passing2.CompletionPercent(), pctIcon2,
+		passing1.Interceptions, passing2.Interceptions,
+		passing1.PasserRating(), ratingIcon1, passing2.PasserRating(), ratingIcon2,
+		rateLabel(format, "Y/A", "m/Att"), format.Length(passing1.YardsPerAttempt()), ypaIcon1, format.Length(passing2.YardsPerAttempt()), ypaIcon2,
+		passing1.Sacks, sackIcon1, passing2.Sacks, sackIcon2,
+	)
+	// Air yards only show up when the feed tracks them
+	if passing1.AirYards > 0 || passing2.AirYards > 0 {
+		airIcon1, airIcon2 := BetterIcons(float64(passing1.AirYards), float64(passing2.AirYards))
+		value += fmt.Sprintf("\n▫ **Air %s:** 🔵 %s%s | 🔴 %s%s",
+			format.UnitName(), format.Distance(passing1.AirYards), airIcon1, format.Distance(passing2.AirYards), airIcon2)
+	}
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   "🏈 Passing Stats",
+		Value:  value,
+		Inline: false,
+	})
+}
+
+// AddRushingComparison adds rushing stats comparison to embed
+func AddRushingComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats, format models.Format) {
+	rushing1, rushing2 := stats1.Rushing, stats2.Rushing
+	yardIcon1, yardIcon2 := BetterIcons(float64(rushing1.Yards), float64(rushing2.Yards))
+	tdIcon1, tdIcon2 := BetterIcons(float64(rushing1.Touchdowns), float64(rushing2.Touchdowns))
+	ypcIcon1, ypcIcon2 := BetterIcons(rushing1.YardsPerCarry(), rushing2.YardsPerCarry())
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name: "🏃 Rushing Stats",
+		Value: fmt.Sprintf(
+			"▫ **%s:** 🔵 %s%s | 🔴 %s%s\n"+
+				"▫ **TDs:** 🔵 %d%s | 🔴 %d%s\n"+
+				"▫ **Attempts:** 🔵 %d | 🔴 %d\n"+
+				"▫ **%s:** 🔵 %.1f%s | 🔴 %.1f%s",
+			format.UnitName(), format.Distance(rushing1.Yards), yardIcon1, format.Distance(rushing2.Yards), yardIcon2,
+			rushing1.Touchdowns, tdIcon1, rushing2.Touchdowns, tdIcon2,
+			rushing1.Attempts, rushing2.Attempts,
+			rateLabel(format, "YPC", "m/Carry"), format.Length(rushing1.YardsPerCarry()), ypcIcon1, format.Length(rushing2.YardsPerCarry()), ypcIcon2,
+		),
+		Inline: false,
+	})
+}
+
+// AddReceivingComparison adds receiving stats comparison to embed
+func AddReceivingComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats, format models.Format) {
+	receiving1, receiving2 := stats1.Receiving, stats2.Receiving
+	yardIcon1, yardIcon2 := BetterIcons(float64(receiving1.Yards), float64(receiving2.Yards))
+	tdIcon1, tdIcon2 := BetterIcons(float64(receiving1.Touchdowns), float64(receiving2.Touchdowns))
+	recIcon1, recIcon2 := BetterIcons(float64(receiving1.Receptions), float64(receiving2.Receptions))
+	yprIcon1, yprIcon2 := BetterIcons(receiving1.YardsPerReception(), receiving2.YardsPerReception())
+
+	value := fmt.Sprintf(
+		"▫ **%s:** 🔵 %s%s | 🔴 %s%s\n"+
+			"▫ **TDs:** 🔵 %d%s | 🔴 %d%s\n"+
+			"▫ **Receptions:** 🔵 %d%s | 🔴 %d%s\n"+
+			"▫ **%s:** 🔵 %.1f%s | 🔴 %.1f%s",
+		format.UnitName(), format.Distance(receiving1.Yards), yardIcon1, format.Distance(receiving2.Yards), yardIcon2,
+		receiving1.Touchdowns, tdIcon1, receiving2.Touchdowns, tdIcon2,
+		receiving1.Receptions, recIcon1, receiving2.Receptions, recIcon2,
+		rateLabel(format, "YPR", "m/Rec"), format.Length(receiving1.YardsPerReception()), yprIcon1, format.Length(receiving2.YardsPerReception()), yprIcon2,
+	)
+	// Usage needs team totals, which only some lines carry
+	if receiving1.TeamAttempts > 0 && receiving2.TeamAttempts > 0 {
+		shareIcon1, shareIcon2 := BetterIcons(receiving1.TargetShare(), receiving2.TargetShare())
+		value += fmt.Sprintf("\n▫ **Target Share:** 🔵 %.1f%%%s | 🔴 %.1f%%%s",
+			receiving1.TargetShare(), shareIcon1, receiving2.TargetShare(), shareIcon2)
+	}
+	if receiving1.TeamSnaps > 0 && receiving2.TeamSnaps > 0 {
+		snapIcon1, snapIcon2 := BetterIcons(receiving1.SnapShare(), receiving2.SnapShare())
+		value += fmt.Sprintf("\n▫ **Snap%%:** 🔵 %.0f%%%s | 🔴 %.0f%%%s",
+			receiving1.SnapShare(), snapIcon1, receiving2.SnapShare(), snapIcon2)
+	}
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   "👋 Receiving Stats",
+		Value:  value,
+		Inline: false,
+	})
+}
@@ -0,0 +1,316 @@
+// Package embeds builds the Discord embeds shared by prefix commands and slash command followups,
+// so both command paths render the same output
+package embeds
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/i18n"
+	"nfl-discord-bot/pkg/models"
+)
+
+// Embed colors, before team theming is applied
+const (
+	ColorStats      = 0x0099ff
+	ColorTeam       = 0xff6600
+	ColorSchedule   = 0x00ff00
+	ColorScores     = 0x013369
+	ColorComparison = 0x9932cc
+)
+
+// scheduleGamesShown caps the schedule embed so it stays under Discord's description limit
+const scheduleGamesShown = 10
+
+// kickoffLayout is how schedule and score embeds show upcoming kickoffs
+const kickoffLayout = "Jan 2, 3:04 PM"
+
+// dataSource names the upstream provider in freshness footers
+const dataSource = "SportsData.io"
+
+// Score spoiler modes a guild can choose for scores and results
+const (
+	SpoilersOff    = ""       // show scores as usual
+	SpoilersTagged = "tagged" // wrap scores and winners in Discord spoiler tags
+	SpoilersHidden = "hidden" // show only whether a game is live or final
+)
+
+// Spoiler wraps text in Discord spoiler tags
+func Spoiler(text string) string {
+	return "||" + text + "||"
+}
+
+// scoreLine formats a game's score for a spoiler mode, e.g. "BUF 24 - 20 KC", "BUF ||24 - 20|| KC",
+// or "BUF @ KC" when scores are hidden
+func scoreLine(awayTeam string, awayScore, homeScore int, homeTeam, spoilers string) string {
+	switch spoilers {
+	case SpoilersTagged:
+		return fmt.Sprintf("%s %s %s", awayTeam, Spoiler(fmt.Sprintf("%d - %d", awayScore, homeScore)), homeTeam)
+	case SpoilersHidden:
+		return fmt.Sprintf("%s @ %s", awayTeam, homeTeam)
+	}
+	return fmt.Sprintf("%s %d - %d %s", awayTeam, awayScore, homeScore, homeTeam)
+}
+
+// FormatKickoff renders a kickoff in a zone with its abbreviation on the format's clock,
+// followed by Discord's timestamp markup so every reader also sees it in their own local time
+func FormatKickoff(kickoff time.Time, location *time.Location, layout string, format models.Format) string {
+	return fmt.Sprintf("%s (<t:%d:t>)", kickoff.In(location).Format(format.Clock(layout)+" MST"), kickoff.Unix())
+}
+
+// Broadcast formats a game's TV listing for the end of a schedule line, e.g. " • 📺 CBS", or ""
+// before it's announced
+func Broadcast(channel string) string {
+	if channel == "" {
+		return ""
+	}
+	return " • 📺 " + channel
+}
+
+// FreshnessFooter describes how old a result's data is and how it reached the bot in a language,
+// e.g. "Updated 42s ago • SportsData.io • cache"
+func FreshnessFooter(freshness models.Freshness, now time.Time, language string) string {
+	if !freshness.Known() {
+		return dataSource
+	}
+	return i18n.T(language, "freshness.updated", freshnessAge(freshness, now, language), dataSource,
+		i18n.T(language, "freshness.source."+freshness.Source))
+}
+
+// freshnessAge describes how long ago data was fetched in a language, like Freshness.Age
+func freshnessAge(freshness models.Freshness, now time.Time, language string) string {
+	age := now.Sub(freshness.FetchedAt)
+	switch {
+	case age < time.Second:
+		return i18n.T(language, "freshness.just_now")
+	case age < time.Minute:
+		return i18n.T(language, "freshness.seconds", int(age.Seconds()))
+	case age < time.Hour:
+		return i18n.T(language, "freshness.minutes", int(age.Minutes()))
+	case age < 48*time.Hour:
+		return i18n.T(language, "freshness.hours", int(age.Hours()))
+	default:
+		return i18n.T(language, "freshness.days", int(age.Hours()/24))
+	}
+}
+
+// WeekLabel names a week in a language, like models.WeekLabel: "Week 5", "Preseason Week 2", or
+// "Divisional Round"
+func WeekLabel(seasonType string, week int, language string) string {
+	switch seasonType {
+	case models.SeasonTypePreseason:
+		if week == 0 {
+			return i18n.T(language, "week.hall_of_fame")
+		}
+		return i18n.T(language, "week.preseason", week)
+	case models.SeasonTypePostseason:
+		if _, ok := models.PlayoffRounds[week]; ok {
+			return i18n.T(language, fmt.Sprintf("week.round.%d", week))
+		}
+		return i18n.T(language, "week.postseason", week)
+	default:
+		return i18n.T(language, "week.regular", week)
+	}
+}
+
+// AddFreshness appends the freshness footer to an embed's footer so users can judge whether
+// the data is truly live
+func AddFreshness(embed *discordgo.MessageEmbed, freshness models.Freshness, language string) {
+	text := FreshnessFooter(freshness, time.Now(), language)
+	switch {
+	case embed.Footer == nil:
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: text}
+	case embed.Footer.Text == "":
+		embed.Footer.Text = text
+	default:
+		embed.Footer.Text += " • " + text
+	}
+}
+
+// PlayerStatsEmbed shows a player's stat line under the given title, e.g. "Week 5, 2025 Stats"
+func PlayerStatsEmbed(stats *models.PlayerStats, title string, format models.Format, language string) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("📊 %s - %s", stats.Name, title),
+		Color: ColorStats,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   i18n.T(language, "embed.stats.team"),
+				Value:  stats.Team,
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(language, "embed.stats.position"),
+				Value:  stats.Position,
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(language, "embed.stats.season"),
+				Value:  stats.GetStatsString(format),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: FreshnessFooter(stats.Freshness, time.Now(), language),
+		},
+	}
+}
+
+// TeamEmbed shows a team's conference, division, coaches, stadium, and founding year and titles when known
+func TeamEmbed(team *models.TeamInfo) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🏈 %s %s", team.City, team.Name),
+		Color: ColorTeam,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Conference",
+				Value:  team.Conference,
+				Inline: true,
+			},
+			{
+				Name:   "Division",
+				Value:  team.Division,
+				Inline: true,
+			},
+			{
+				Name:   "Head Coach",
+				Value:  CoachLabel(team.Coach, team.CoachRecord),
+				Inline: true,
+			},
+			{
+				Name:   "Offensive Coordinator",
+				Value:  CoachLabel(team.OffensiveCoordinator, ""),
+				Inline: true,
+			},
+			{
+				Name:   "Defensive Coordinator",
+				Value:  CoachLabel(team.DefensiveCoordinator, ""),
+				Inline: true,
+			},
+			{
+				Name:   "Stadium",
+				Value:  team.Stadium,
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: FreshnessFooter(team.Freshness, time.Now(), i18n.English),
+		},
+	}
+	if team.Founded > 0 {
+		embed.Fields = append(embed.Fields,
+			&discordgo.MessageEmbedField{Name: "Founded", Value: fmt.Sprintf("%d", team.Founded), Inline: true},
+			&discordgo.MessageEmbedField{Name: "Super Bowl Titles", Value: fmt.Sprintf("%d", team.Championships), Inline: true},
+		)
+	}
+	return embed
+}
+
+// CoachLabel shows a coach's name with an optional record, e.g. "Sean McDermott (7-3)", or "Vacant"
+func CoachLabel(name, record string) string {
+	if name == "" {
+		return "Vacant"
+	}
+	if record == "" {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, record)
+}
+
+// ScheduleEmbed lists the first games of a team's schedule with results, live scores, or kickoffs
+// in location. seasonLabel names the slice of the season shown, e.g. "Season" or "Postseason".
+func ScheduleEmbed(schedule *models.Schedule, seasonLabel string, location *time.Location, spoilers string, format models.Format, language string) *discordgo.MessageEmbed {
+	gamesToShow := schedule.Games
+	if len(gamesToShow) > scheduleGamesShown {
+		gamesToShow = gamesToShow[:scheduleGamesShown]
+	}
+
+	final, live := i18n.T(language, "embed.final"), i18n.T(language, "embed.live")
+	var scheduleText string
+	for _, game := range gamesToShow {
+		week := i18n.T(language, "embed.schedule.week", game.Week)
+		if game.HomeTeam == "BYE" || game.AwayTeam == "BYE" {
+			scheduleText += week + i18n.T(language, "embed.schedule.bye") + "\n"
+			continue
+		}
+
+		switch {
+		case game.IsCompleted() && spoilers == SpoilersTagged:
+			scheduleText += fmt.Sprintf("%s%s @ %s - %s (%s)\n",
+				week, game.AwayTeam, game.HomeTeam, Spoiler(fmt.Sprintf("%s %d-%d", game.Winner(), game.AwayScore, game.HomeScore)), final)
+		case game.IsCompleted() && spoilers == SpoilersHidden:
+			scheduleText += fmt.Sprintf("%s%s @ %s - %s\n", week, game.AwayTeam, game.HomeTeam, final)
+		case game.IsCompleted():
+			scheduleText += fmt.Sprintf("%s%s @ %s - %s %d-%d (%s)\n",
+				week, game.AwayTeam, game.HomeTeam, game.Winner(), game.AwayScore, game.HomeScore, final)
+		case game.IsLive() && spoilers == SpoilersTagged:
+			scheduleText += fmt.Sprintf("%s%s @ %s - %s (%s)\n",
+				week, game.AwayTeam, game.HomeTeam, Spoiler(fmt.Sprintf("%d-%d", game.AwayScore, game.HomeScore)), live)
+		case game.IsLive() && spoilers == SpoilersHidden:
+			scheduleText += fmt.Sprintf("%s%s @ %s - %s\n", week, game.AwayTeam, game.HomeTeam, live)
+		case game.IsLive():
+			scheduleText += fmt.Sprintf("%s%s @ %s - %d-%d (%s)%s\n",
+				week, game.AwayTeam, game.HomeTeam, game.AwayScore, game.HomeScore, live, Broadcast(game.Channel))
+		case game.State().Interrupted():
+			scheduleText += fmt.Sprintf("%s%s @ %s - %s %s\n",
+				week, game.AwayTeam, game.HomeTeam, game.State().Emoji(), models.StatusLabel(game.Status))
+		default:
+			scheduleText += fmt.Sprintf("%s%s @ %s - %s%s\n",
+				week, game.AwayTeam, game.HomeTeam, FormatKickoff(game.GameTime, location, kickoffLayout, format), Broadcast(game.Channel))
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       i18n.T(language, "embed.schedule.title", schedule.TeamName, schedule.Season, seasonLabel),
+		Color:       ColorSchedule,
+		Description: scheduleText,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(language, "embed.schedule.footer", len(gamesToShow), len(schedule.Games)),
+		},
+	}
+	AddFreshness(embed, schedule.Freshness, language)
+	return embed
+}
+
+// ScoresEmbed lists a week's games as live, final, or upcoming with kickoffs in location.
+// weekLabel names the week in the title, e.g. "Week 5" or "Divisional Round", and spoilers is
+// one of the score spoiler modes.
+func ScoresEmbed(weekLabel string, scores []*models.LiveScore, location *time.Location, spoilers string, format models.Format, language string) *discordgo.MessageEmbed {
+	var scoresText string
+	var freshness models.Freshness
+	liveCount := 0
+	completedCount := 0
+
+	final, live := i18n.T(language, "embed.final"), i18n.T(language, "embed.live")
+	for _, score := range scores {
+		freshness = freshness.Older(score.Freshness)
+		switch {
+		case score.IsLive():
+			scoresText += fmt.Sprintf("🔴 **%s** - %s (%s, %s)%s\n",
+				live, scoreLine(score.AwayTeam, score.AwayScore, score.HomeScore, score.HomeTeam, spoilers), score.Quarter, score.TimeRemaining, Broadcast(score.Channel))
+			liveCount++
+		case score.IsCompleted():
+			scoresText += fmt.Sprintf("✅ **%s** - %s (%s)\n",
+				strings.ToUpper(final), scoreLine(score.AwayTeam, score.AwayScore, score.HomeScore, score.HomeTeam, spoilers), final)
+			completedCount++
+		case score.State().Interrupted():
+			scoresText += fmt.Sprintf("%s **%s** - %s @ %s\n",
+				score.State().Emoji(), strings.ToUpper(models.StatusLabel(score.Status)), score.AwayTeam, score.HomeTeam)
+		default:
+			scoresText += fmt.Sprintf("📅 **%s** - %s @ %s%s\n",
+				FormatKickoff(score.GameTime, location, kickoffLayout, format), score.AwayTeam, score.HomeTeam, Broadcast(score.Channel))
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       i18n.T(language, "embed.scores.title", weekLabel),
+		Color:       ColorScores,
+		Description: scoresText,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(language, "embed.scores.footer", liveCount, completedCount, len(scores)),
+		},
+	}
+	AddFreshness(embed, freshness, language)
+	return embed
+}
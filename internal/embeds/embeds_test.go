@@ -0,0 +1,298 @@
+package embeds
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/i18n"
+	"nfl-discord-bot/pkg/models"
+)
+
+// kickoff is a Sunday 1:00 PM Eastern kickoff
+var kickoff = time.Date(2025, time.October, 19, 17, 0, 0, 0, time.UTC)
+
+// eastern is the zone kickoffs are shown in
+var eastern, _ = time.LoadLocation("America/New_York")
+
+// fieldNames lists an embed's field names in order
+func fieldNames(embed *discordgo.MessageEmbed) []string {
+	names := make([]string, len(embed.Fields))
+	for index, field := range embed.Fields {
+		names[index] = field.Name
+	}
+	return names
+}
+
+// field returns the embed field with a name, failing the test when it's missing
+func field(t *testing.T, embed *discordgo.MessageEmbed, name string) *discordgo.MessageEmbedField {
+	t.Helper()
+	for _, field := range embed.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	t.Fatalf("no %q field in %v", name, fieldNames(embed))
+	return nil
+}
+
+func assertFields(t *testing.T, embed *discordgo.MessageEmbed, want ...string) {
+	t.Helper()
+	got := fieldNames(embed)
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("fields = %q, want %q", got, want)
+	}
+}
+
+func quarterback(name, team string, yards, touchdowns int) *models.PlayerStats {
+	return &models.PlayerStats{
+		Name:     name,
+		Team:     team,
+		Position: "QB",
+		Season:   2025,
+		Passing:  models.PassingStats{Completions: 24, Attempts: 35, Yards: yards, Touchdowns: touchdowns, Interceptions: 1, Sacks: 2},
+		Rushing:  models.RushingStats{Attempts: 6, Yards: 41, Touchdowns: 1},
+	}
+}
+
+func TestPlayerStatsEmbed(t *testing.T) {
+	stats := quarterback("Josh Allen", "BUF", 4306, 29)
+	stats.Freshness = models.Freshness{FetchedAt: time.Now(), Source: models.SourceCache}
+
+	embed := PlayerStatsEmbed(stats, "Week 6, 2025 Stats", models.Format{}, i18n.English)
+
+	if embed.Title != "📊 Josh Allen - Week 6, 2025 Stats" {
+		t.Errorf("title = %q", embed.Title)
+	}
+	if embed.Color != ColorStats {
+		t.Errorf("color = %#x, want %#x", embed.Color, ColorStats)
+	}
+	assertFields(t, embed, "Team", "Position", "Season Stats")
+	if value := field(t, embed, "Team").Value; value != "BUF" {
+		t.Errorf("team = %q", value)
+	}
+	if value := field(t, embed, "Season Stats").Value; !strings.Contains(value, "**Passing**") || !strings.Contains(value, "4,306") {
+		t.Errorf("stat line %q is missing the formatted passing yards", value)
+	}
+	if footer := embed.Footer.Text; footer != "Updated just now • SportsData.io • cache" {
+		t.Errorf("footer = %q", footer)
+	}
+
+	metric := PlayerStatsEmbed(stats, "Week 6, 2025 Stats", models.Format{Metric: true, Separator: models.SeparatorNone}, i18n.English)
+	if value := field(t, metric, "Season Stats").Value; strings.Contains(value, "4,306") {
+		t.Errorf("metric stat line %q still shows yards with a comma", value)
+	}
+}
+
+func TestTeamEmbed(t *testing.T) {
+	team := &models.TeamInfo{
+		Key: "BUF", City: "Buffalo", Name: "Bills", Conference: "AFC", Division: "East",
+		Coach: "Sean McDermott", CoachRecord: "5-1", OffensiveCoordinator: "Joe Brady",
+		Stadium: "Highmark Stadium", Founded: 1960,
+	}
+
+	embed := TeamEmbed(team)
+
+	if embed.Title != "🏈 Buffalo Bills" {
+		t.Errorf("title = %q", embed.Title)
+	}
+	if embed.Color != ColorTeam {
+		t.Errorf("color = %#x, want %#x", embed.Color, ColorTeam)
+	}
+	assertFields(t, embed, "Conference", "Division", "Head Coach", "Offensive Coordinator",
+		"Defensive Coordinator", "Stadium", "Founded", "Super Bowl Titles")
+	if value := field(t, embed, "Head Coach").Value; value != "Sean McDermott (5-1)" {
+		t.Errorf("head coach = %q", value)
+	}
+	if value := field(t, embed, "Defensive Coordinator").Value; value != "Vacant" {
+		t.Errorf("vacant coordinator = %q", value)
+	}
+	if value := field(t, embed, "Super Bowl Titles").Value; value != "0" {
+		t.Errorf("titles = %q", value)
+	}
+	if embed.Footer.Text != dataSource {
+		t.Errorf("footer without freshness = %q, want %q", embed.Footer.Text, dataSource)
+	}
+
+	team.Founded = 0
+	assertFields(t, TeamEmbed(team), "Conference", "Division", "Head Coach", "Offensive Coordinator",
+		"Defensive Coordinator", "Stadium")
+}
+
+func TestScheduleEmbed(t *testing.T) {
+	schedule := &models.Schedule{
+		TeamName: "Bills",
+		Season:   2025,
+		Games: []models.Game{
+			{Week: 5, AwayTeam: "BUF", HomeTeam: "PHI", AwayScore: 20, HomeScore: 23, Status: "Final"},
+			{Week: 6, AwayTeam: "BUF", HomeTeam: "KC", AwayScore: 14, HomeScore: 10, Status: "InProgress", Channel: "CBS"},
+			{Week: 7, AwayTeam: "BUF", HomeTeam: "BYE"},
+			{Week: 8, AwayTeam: "DAL", HomeTeam: "BUF", GameTime: kickoff, Status: "Scheduled", Channel: "FOX"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		spoilers string
+		format   models.Format
+		lines    []string
+	}{
+		{
+			name: "scores shown",
+			lines: []string{
+				"**Week 5**: BUF @ PHI - PHI 20-23 (Final)",
+				"**Week 6**: BUF @ KC - 14-10 (LIVE) • 📺 CBS",
+				"**Week 7**: 🛌 **BYE WEEK** - Rest and Recovery",
+				"**Week 8**: DAL @ BUF - Oct 19, 1:00 PM EDT (<t:1760893200:t>) • 📺 FOX",
+			},
+		},
+		{
+			name:     "spoiler tags",
+			spoilers: SpoilersTagged,
+			lines: []string{
+				"**Week 5**: BUF @ PHI - ||PHI 20-23|| (Final)",
+				"**Week 6**: BUF @ KC - ||14-10|| (LIVE)",
+			},
+		},
+		{
+			name:     "scores hidden on a 24-hour clock",
+			spoilers: SpoilersHidden,
+			format:   models.Format{Clock24: true},
+			lines: []string{
+				"**Week 5**: BUF @ PHI - Final",
+				"**Week 6**: BUF @ KC - LIVE",
+				"**Week 8**: DAL @ BUF - Oct 19, 13:00 EDT (<t:1760893200:t>) • 📺 FOX",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			embed := ScheduleEmbed(schedule, "Season", eastern, tt.spoilers, tt.format, i18n.English)
+			if embed.Title != "📅 Bills Schedule (2025 Season)" {
+				t.Errorf("title = %q", embed.Title)
+			}
+			if embed.Color != ColorSchedule {
+				t.Errorf("color = %#x, want %#x", embed.Color, ColorSchedule)
+			}
+			if embed.Footer.Text != "Showing 4 of 4 games • SportsData.io" {
+				t.Errorf("footer = %q", embed.Footer.Text)
+			}
+			for _, line := range tt.lines {
+				if !strings.Contains(embed.Description, line+"\n") {
+					t.Errorf("description is missing %q:\n%s", line, embed.Description)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleEmbedCapsGames(t *testing.T) {
+	schedule := &models.Schedule{TeamName: "Bills", Season: 2025}
+	for week := 1; week <= 17; week++ {
+		schedule.Games = append(schedule.Games, models.Game{Week: week, AwayTeam: "BUF", HomeTeam: "MIA", GameTime: kickoff})
+	}
+
+	embed := ScheduleEmbed(schedule, "Season", eastern, SpoilersOff, models.Format{}, i18n.English)
+
+	if strings.Count(embed.Description, "\n") != scheduleGamesShown {
+		t.Errorf("showed %d games, want %d", strings.Count(embed.Description, "\n"), scheduleGamesShown)
+	}
+	if embed.Footer.Text != "Showing 10 of 17 games • SportsData.io" {
+		t.Errorf("footer = %q", embed.Footer.Text)
+	}
+}
+
+func TestScoresEmbed(t *testing.T) {
+	scores := []*models.LiveScore{
+		{AwayTeam: "BUF", HomeTeam: "KC", AwayScore: 27, HomeScore: 24, Status: "Final"},
+		{AwayTeam: "PHI", HomeTeam: "DAL", AwayScore: 17, HomeScore: 14, Status: "InProgress", Quarter: "3", TimeRemaining: "6:42", Channel: "NBC"},
+		{AwayTeam: "DAL", HomeTeam: "BUF", Status: "Scheduled", GameTime: kickoff},
+		{AwayTeam: "KC", HomeTeam: "PHI", Status: "Postponed"},
+	}
+
+	embed := ScoresEmbed("Week 6", scores, eastern, SpoilersOff, models.Format{}, i18n.English)
+
+	if embed.Title != "🏈 NFL Scores - Week 6" {
+		t.Errorf("title = %q", embed.Title)
+	}
+	if embed.Color != ColorScores {
+		t.Errorf("color = %#x, want %#x", embed.Color, ColorScores)
+	}
+	want := "✅ **FINAL** - BUF 27 - 24 KC (Final)\n" +
+		"🔴 **LIVE** - PHI 17 - 14 DAL (3, 6:42) • 📺 NBC\n" +
+		"📅 **Oct 19, 1:00 PM EDT (<t:1760893200:t>)** - DAL @ BUF\n"
+	if !strings.HasPrefix(embed.Description, want) {
+		t.Errorf("description = %q, want it to start with %q", embed.Description, want)
+	}
+	if !strings.Contains(embed.Description, "**POSTPONED** - KC @ PHI") {
+		t.Errorf("description is missing the postponed game:\n%s", embed.Description)
+	}
+	if embed.Footer.Text != "1 live, 1 completed, 4 total games • SportsData.io" {
+		t.Errorf("footer = %q", embed.Footer.Text)
+	}
+
+	hidden := ScoresEmbed("Week 6", scores, eastern, SpoilersHidden, models.Format{}, i18n.English)
+	if strings.Contains(hidden.Description, "27") || !strings.Contains(hidden.Description, "✅ **FINAL** - BUF @ KC (Final)") {
+		t.Errorf("hidden scores leaked or missing a final:\n%s", hidden.Description)
+	}
+}
+
+func TestScoresEmbedInSpanish(t *testing.T) {
+	scores := []*models.LiveScore{
+		{AwayTeam: "BUF", HomeTeam: "KC", AwayScore: 27, HomeScore: 24, Status: "Final"},
+		{AwayTeam: "PHI", HomeTeam: "DAL", AwayScore: 17, HomeScore: 14, Status: "InProgress", Quarter: "3", TimeRemaining: "6:42"},
+	}
+
+	embed := ScoresEmbed(WeekLabel("REG", 6, i18n.Spanish), scores, eastern, SpoilersOff, models.Format{}, i18n.Spanish)
+
+	if embed.Title != "🏈 Marcadores de la NFL - Semana 6" {
+		t.Errorf("title = %q", embed.Title)
+	}
+	if !strings.Contains(embed.Description, "🔴 **EN VIVO** - PHI 17 - 14 DAL") {
+		t.Errorf("description is missing the live game in Spanish:\n%s", embed.Description)
+	}
+	if embed.Footer.Text != "1 en vivo, 1 terminados, 2 partidos en total • SportsData.io" {
+		t.Errorf("footer = %q", embed.Footer.Text)
+	}
+}
+
+func TestComparisonEmbed(t *testing.T) {
+	allen := quarterback("Josh Allen", "BUF", 284, 2)
+	mahomes := quarterback("Patrick Mahomes", "KC", 251, 3)
+
+	embed := ComparisonEmbed(allen, mahomes, "Week 6 Comparison", models.Format{})
+
+	if embed.Title != "⚖️ Week 6 Comparison" {
+		t.Errorf("title = %q", embed.Title)
+	}
+	if embed.Color != ColorComparison {
+		t.Errorf("color = %#x, want %#x", embed.Color, ColorComparison)
+	}
+	assertFields(t, embed, "Players", "🏈 Passing Stats", "🏃 Rushing Stats")
+	if value := field(t, embed, "Players").Value; value != "🔵 **Josh Allen** (BUF, QB) vs 🔴 **Patrick Mahomes** (KC, QB)" {
+		t.Errorf("players = %q", value)
+	}
+	passing := field(t, embed, "🏈 Passing Stats").Value
+	for _, line := range []string{"▫ **Yards:** 🔵 284 ⬆️ | 🔴 251\n", "▫ **TDs:** 🔵 2 | 🔴 3 ⬆️\n", "▫ **Sacks:** 🔵 2 | 🔴 2"} {
+		if !strings.Contains(passing, line) {
+			t.Errorf("passing comparison is missing %q:\n%s", line, passing)
+		}
+	}
+	if !strings.HasPrefix(embed.Footer.Text, "🔵 = Josh Allen | 🔴 = Patrick Mahomes") {
+		t.Errorf("footer = %q", embed.Footer.Text)
+	}
+}
+
+func TestComparisonEmbedSkipsUnsharedCategories(t *testing.T) {
+	allen := quarterback("Josh Allen", "BUF", 284, 2)
+	lamb := &models.PlayerStats{
+		Name: "CeeDee Lamb", Team: "DAL", Position: "WR",
+		Receiving: models.ReceivingStats{Targets: 11, Receptions: 8, Yards: 112, Touchdowns: 1},
+	}
+
+	embed := ComparisonEmbed(allen, lamb, "Comparison", models.Format{})
+
+	assertFields(t, embed, "Players")
+}
@@ -0,0 +1,112 @@
+package embeds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"nfl-discord-bot/internal/i18n"
+	"nfl-discord-bot/pkg/models"
+)
+
+// PerGameStatsEmbed is PlayerStatsEmbed with the totals divided by games played
+func PerGameStatsEmbed(stats *models.PlayerStats, title string, format models.Format, language string) *discordgo.MessageEmbed {
+	embed := PlayerStatsEmbed(stats, title, format, language)
+	season := i18n.T(language, "embed.stats.season")
+	for _, field := range embed.Fields {
+		if field.Name == season {
+			field.Name = i18n.T(language, "embed.stats.per_game", gamesLabel(stats, language))
+			field.Value = perGameStatsString(stats, format)
+		}
+	}
+	return embed
+}
+
+// perGameStatsString formats each recorded stat category as per-game averages; rates like
+// completion percentage are the same either way and are left as they are
+func perGameStatsString(stats *models.PlayerStats, format models.Format) string {
+	perGame := func(total int) float64 {
+		return stats.PerGame(float64(total))
+	}
+	distance := func(yards int) float64 {
+		return format.Length(perGame(yards))
+	}
+
+	var sections []string
+	for _, line := range stats.Lines() {
+		var value string
+		switch line := line.(type) {
+		case models.PassingStats:
+			value = fmt.Sprintf("%.1f/%.1f (%.1f%%) • %.1f %s • %.1f TD • %.1f INT • %.1f rating",
+				perGame(line.Completions), perGame(line.Attempts), line.CompletionPercent(),
+				distance(line.Yards), format.Unit(), perGame(line.Touchdowns), perGame(line.Interceptions), line.PasserRating())
+		case models.RushingStats:
+			value = fmt.Sprintf("%.1f car • %.1f %s (%.1f avg) • %.1f TD",
+				perGame(line.Attempts), distance(line.Yards), format.Unit(), format.Length(line.YardsPerCarry()), perGame(line.Touchdowns))
+		case models.ReceivingStats:
+			value = fmt.Sprintf("%.1f rec on %.1f tgt • %.1f %s • %.1f TD",
+				perGame(line.Receptions), perGame(line.Targets), distance(line.Yards), format.Unit(), perGame(line.Touchdowns))
+		case models.DefenseStats:
+			value = fmt.Sprintf("%.1f tkl • %.2f sk • %.2f INT",
+				perGame(line.Tackles()), stats.PerGame(line.Sacks), perGame(line.Interceptions))
+		default:
+			continue
+		}
+		sections = append(sections, fmt.Sprintf("**%s**\n%s", line.Category(), value))
+	}
+	if len(sections) == 0 {
+		return "No stats available"
+	}
+	return strings.Join(sections, "\n")
+}
+
+// AddPerGameComparison adds per-game averages for the categories either player recorded, so
+// players who have played different numbers of games compare fairly
+func AddPerGameComparison(embed *discordgo.MessageEmbed, stats1, stats2 *models.PlayerStats, format models.Format) {
+	lines := []string{fmt.Sprintf("▫ **Games:** 🔵 %s | 🔴 %s", gamesLabel(stats1, i18n.English), gamesLabel(stats2, i18n.English))}
+	addConverted := func(label string, total func(*models.PlayerStats) int, convert func(float64) float64) {
+		value1, value2 := convert(stats1.PerGame(float64(total(stats1)))), convert(stats2.PerGame(float64(total(stats2))))
+		icon1, icon2 := BetterIcons(value1, value2)
+		lines = append(lines, fmt.Sprintf("▫ **%s:** 🔵 %.1f%s | 🔴 %.1f%s", label, value1, icon1, value2, icon2))
+	}
+	add := func(label string, total func(*models.PlayerStats) int) {
+		addConverted(label, total, func(value float64) float64 { return value })
+	}
+	addDistance := func(label string, total func(*models.PlayerStats) int) {
+		addConverted(fmt.Sprintf(label, rateLabel(format, "Yds", "m")), total, format.Length)
+	}
+
+	if stats1.Passing.Recorded() || stats2.Passing.Recorded() {
+		addDistance("Pass %s/G", func(stats *models.PlayerStats) int { return stats.Passing.Yards })
+		add("Pass TD/G", func(stats *models.PlayerStats) int { return stats.Passing.Touchdowns })
+	}
+	if stats1.Rushing.Recorded() || stats2.Rushing.Recorded() {
+		addDistance("Rush %s/G", func(stats *models.PlayerStats) int { return stats.Rushing.Yards })
+	}
+	if stats1.Receiving.Recorded() || stats2.Receiving.Recorded() {
+		add("Rec/G", func(stats *models.PlayerStats) int { return stats.Receiving.Receptions })
+		addDistance("Rec %s/G", func(stats *models.PlayerStats) int { return stats.Receiving.Yards })
+	}
+	add("Total TD/G", func(stats *models.PlayerStats) int { return stats.TotalTouchdowns() })
+	if stats1.Defense.Recorded() || stats2.Defense.Recorded() {
+		add("Tackles/G", func(stats *models.PlayerStats) int { return stats.Defense.Tackles() })
+	}
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   "📏 Per Game",
+		Value:  strings.Join(lines, "\n"),
+		Inline: false,
+	})
+}
+
+// gamesLabel counts the games summed into a stat line in a language, e.g. "6 games"
+func gamesLabel(stats *models.PlayerStats, language string) string {
+	games := stats.Games
+	if games < 1 {
+		games = 1
+	}
+	if games == 1 {
+		return i18n.T(language, "embed.stats.games.one")
+	}
+	return i18n.T(language, "embed.stats.games", games)
+}
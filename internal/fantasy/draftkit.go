@@ -0,0 +1,137 @@
+package fantasy
+
+import (
+	"math"
+	"sort"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// Positions are the fantasy positions included in draft kits, in display order
+var Positions = []string{"QB", "RB", "WR", "TE"}
+
+// League describes the league a draft kit is priced for
+type League struct {
+	Teams      int
+	Budget     int // auction budget per team
+	RosterSize int
+}
+
+// DefaultLeague is a standard 12-team, $200 auction league with 15-man rosters
+var DefaultLeague = League{Teams: 12, Budget: 200, RosterSize: 15}
+
+// starters is the number of players each team starts per position; flex spots are
+// split between RB and WR since that's where most leagues use them
+var starters = map[string]float64{"QB": 1, "RB": 2.5, "WR": 2.5, "TE": 1}
+
+// projectionWeight is how much a preseason projection counts against last season's production
+const projectionWeight = 0.7
+
+// KitEntry is one player's line in a draft kit
+type KitEntry struct {
+	Player       *models.SeasonPlayerStats
+	Projected    float64 // projected points, 0 when no projection exists
+	LastSeason   float64 // last season's points, 0 when the player didn't play
+	Points       float64 // blended points used for ranking
+	OverallRank  int
+	PositionRank int
+	AuctionValue int
+}
+
+// BuildDraftKit ranks players by blending projections with last season's production and
+// prices them for an auction using value over a replacement-level starter
+func BuildDraftKit(projections, lastSeason []*models.SeasonPlayerStats, format string, league League) []*KitEntry {
+	entries := make(map[int]*KitEntry)
+	include := func(player *models.SeasonPlayerStats) bool {
+		for _, position := range Positions {
+			if player.Position == position {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, player := range lastSeason {
+		if include(player) {
+			entries[player.PlayerID] = &KitEntry{Player: player, LastSeason: Points(player, format)}
+		}
+	}
+	for _, player := range projections {
+		if !include(player) {
+			continue
+		}
+		entry, exists := entries[player.PlayerID]
+		if !exists {
+			entry = &KitEntry{}
+			entries[player.PlayerID] = entry
+		}
+		// Projections carry the player's current team
+		entry.Player = player
+		entry.Projected = Points(player, format)
+	}
+
+	var kit []*KitEntry
+	for _, entry := range entries {
+		switch {
+		case entry.Projected > 0 && entry.LastSeason > 0:
+			entry.Points = projectionWeight*entry.Projected + (1-projectionWeight)*entry.LastSeason
+		case entry.Projected > 0:
+			entry.Points = entry.Projected
+		default:
+			entry.Points = entry.LastSeason
+		}
+		if entry.Points > 0 {
+			kit = append(kit, entry)
+		}
+	}
+
+	sort.Slice(kit, func(i, j int) bool {
+		if kit[i].Points != kit[j].Points {
+			return kit[i].Points > kit[j].Points
+		}
+		return kit[i].Player.Name < kit[j].Player.Name
+	})
+
+	positionCounts := make(map[string]int)
+	for index, entry := range kit {
+		entry.OverallRank = index + 1
+		positionCounts[entry.Player.Position]++
+		entry.PositionRank = positionCounts[entry.Player.Position]
+	}
+
+	priceAuction(kit, league)
+	return kit
+}
+
+// priceAuction assigns auction values: every rostered player costs at least $1 and the
+// remaining league budget is split in proportion to value over replacement
+func priceAuction(kit []*KitEntry, league League) {
+	replacement := make(map[string]float64)
+	for position, count := range starters {
+		replacementRank := int(math.Ceil(count * float64(league.Teams)))
+		for _, entry := range kit {
+			if entry.Player.Position == position && entry.PositionRank == replacementRank+1 {
+				replacement[position] = entry.Points
+			}
+		}
+	}
+
+	totalValue := 0.0
+	for _, entry := range kit {
+		if value := entry.Points - replacement[entry.Player.Position]; value > 0 {
+			totalValue += value
+		}
+	}
+	if totalValue == 0 {
+		return
+	}
+
+	spendable := float64(league.Teams*league.Budget - league.Teams*league.RosterSize)
+	for _, entry := range kit {
+		value := entry.Points - replacement[entry.Player.Position]
+		if value <= 0 {
+			continue
+		}
+		entry.AuctionValue = 1 + int(math.Round(value/totalValue*spendable))
+	}
+}
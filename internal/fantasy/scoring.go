@@ -0,0 +1,69 @@
+// Package fantasy provides fantasy football scoring, rankings, and auction values.
+package fantasy
+
+import (
+	"fmt"
+	"strings"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// Scoring formats
+const (
+	FormatPPR      = "ppr"
+	FormatHalfPPR  = "half_ppr"
+	FormatStandard = "standard"
+)
+
+// ParseFormat normalizes a scoring format name
+func ParseFormat(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", FormatPPR, "full_ppr":
+		return FormatPPR, nil
+	case FormatHalfPPR, "half", "half-ppr", "0.5":
+		return FormatHalfPPR, nil
+	case FormatStandard, "std", "non-ppr":
+		return FormatStandard, nil
+	default:
+		return "", fmt.Errorf("unknown scoring format %q (use ppr, half_ppr, or standard)", value)
+	}
+}
+
+// FormatName returns a readable name for a scoring format
+func FormatName(format string) string {
+	switch format {
+	case FormatHalfPPR:
+		return "Half PPR"
+	case FormatStandard:
+		return "Standard"
+	default:
+		return "PPR"
+	}
+}
+
+// PointsPerReception returns the reception bonus for a scoring format
+func PointsPerReception(format string) float64 {
+	switch format {
+	case FormatHalfPPR:
+		return 0.5
+	case FormatStandard:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Points returns a player's fantasy points under a scoring format:
+// 1 pt per 25 passing yards, 4 per passing TD, -2 per INT or fumble lost,
+// 1 pt per 10 rushing/receiving yards, 6 per rushing/receiving TD, plus the reception bonus.
+func Points(stats *models.SeasonPlayerStats, format string) float64 {
+	return stats.PassingYards*0.04 +
+		stats.PassingTouchdowns*4 -
+		stats.Interceptions*2 +
+		stats.RushingYards*0.1 +
+		stats.RushingTouchdowns*6 +
+		stats.ReceivingYards*0.1 +
+		stats.ReceivingTouchdowns*6 +
+		stats.Receptions*PointsPerReception(format) -
+		stats.FumblesLost*2
+}
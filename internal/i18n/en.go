@@ -0,0 +1,268 @@
+package i18n
+
+// english is the fallback catalog every other language is translated from
+var english = map[string]string{
+	// Errors shared by many commands
+	"error.guild_only":             "This command can only be used in a server.",
+	"error.team_not_found":         "Couldn't find a team matching **%s**. Try a city, nickname, or abbreviation (e.g. Bills, KC).%s",
+	"error.player_not_found":       "Couldn't find a player named **%s** in %s.%s",
+	"error.player_not_found.scope": "the stats",
+	"error.player_not_found.hint":  " Check the spelling, or whether they've played.",
+	"error.player_bye":             "**%s**%s had a bye in %s, so there are no stats for that week.",
+	"error.player_inactive":        "**%s**%s was inactive in %s and has no stats for that week.%s",
+	"error.player_inactive.injury": " Current injury status: %s.",
+	"error.invalid_week":           "Week %d isn't part of the %s; use a week from %d to %d.",
+	"error.rate_limited":           "⏳ The NFL data provider is rate limiting the bot right now. Please try again in a minute.",
+	"error.upstream_unavailable":   "⚠️ The NFL data provider is temporarily unavailable. Please try again in a few minutes.",
+	"did_you_mean.one":             " Did you mean **%s**?",
+	"did_you_mean.many":            " Did you mean **%s** or **%s**?",
+
+	// Season types, as used mid-sentence
+	"season_type.PRE":  "preseason",
+	"season_type.REG":  "regular season",
+	"season_type.POST": "postseason",
+
+	// /language
+	"language.current":      "🌐 Bot responses in this server are in **%s**.",
+	"language.current_auto": "🌐 Bot responses in this server follow its Discord language (currently **%s**).",
+	"language.set":          "✅ Bot responses in this server are now in **%s**. Command descriptions follow each member's Discord language.",
+	"language.reset":        "✅ Bot responses in this server now follow its Discord language (currently **%s**).",
+	"language.save_failed":  "❌ Could not save the language setting. Please try again.",
+
+	// /scoring
+	"scoring.set":         "✅ Fantasy scoring for this server set to **%s**.",
+	"scoring.save_failed": "❌ Could not save scoring settings. Please try again.",
+
+	// /visibility
+	"visibility.public":      "✅ Command responses are now **public** by default in this server.",
+	"visibility.private":     "✅ Command responses are now **private** (only visible to the user) by default in this server.",
+	"visibility.reset":       "✅ Response visibility reset to the bot's default.",
+	"visibility.override":    "%s Anyone can override it per command with `public:`.",
+	"visibility.save_failed": "❌ Could not save the visibility setting. Please try again.",
+
+	// Weeks, as in "Week 5" or "Divisional Round"
+	"week.regular":      "Week %d",
+	"week.preseason":    "Preseason Week %d",
+	"week.postseason":   "Postseason Week %d",
+	"week.hall_of_fame": "Hall of Fame Game",
+	"week.round.1":      "Wild Card Round",
+	"week.round.2":      "Divisional Round",
+	"week.round.3":      "Conference Championships",
+	"week.round.4":      "Super Bowl",
+
+	// Data freshness footers
+	"freshness.updated":      "Updated %s • %s • %s",
+	"freshness.just_now":     "just now",
+	"freshness.seconds":      "%ds ago",
+	"freshness.minutes":      "%dm ago",
+	"freshness.hours":        "%dh ago",
+	"freshness.days":         "%dd ago",
+	"freshness.source.live":  "live",
+	"freshness.source.cache": "cache",
+	"freshness.source.stale": "stale",
+
+	// Stats, scores, and schedule embeds
+	"embed.final":                "Final",
+	"embed.live":                 "LIVE",
+	"embed.stats.team":           "Team",
+	"embed.stats.position":       "Position",
+	"embed.stats.season":         "Season Stats",
+	"embed.stats.per_game":       "Per Game (%s)",
+	"embed.stats.games.one":      "1 game",
+	"embed.stats.games":          "%d games",
+	"embed.schedule.title":       "📅 %s Schedule (%d %s)",
+	"embed.schedule.week":        "**Week %d**: ",
+	"embed.schedule.bye":         "🛌 **BYE WEEK** - Rest and Recovery",
+	"embed.schedule.footer":      "Showing %d of %d games",
+	"embed.scores.title":         "🏈 NFL Scores - %s",
+	"embed.scores.footer":        "%d live, %d completed, %d total games",
+	"stats.title.current":        "Current Week Stats (2025)",
+	"stats.title.sample":         "2024 Sample Stats (6 games)",
+	"stats.title.week":           "%s, %d Stats",
+	"schedule.season_label.REG":  "Season",
+	"schedule.season_label.PRE":  "Preseason",
+	"schedule.season_label.POST": "Postseason",
+
+	// /standings
+	"standings.title":        "🏆 %d NFL Standings",
+	"standings.legend":       "z = top seed | y = division | x = playoff berth | e = eliminated",
+	"standings.legend.magic": " | M# = playoff magic number",
+
+	// /formatting descriptions
+	"formatting.describe":    "Numbers in this server look like **%s**, distances are in **%s** (a 100-yard field is %s %s), and game times use a **%s**.",
+	"formatting.unit.yards":  "yards",
+	"formatting.unit.meters": "meters",
+	"formatting.clock.12h":   "12-hour clock (1:00 PM)",
+	"formatting.clock.24h":   "24-hour clock (13:00)",
+
+	// /spoiler-mode descriptions
+	"spoilers.mode.tagged": "Scores in `/scores`, `/schedule`, and automated posts are hidden behind spoiler tags, with a **Reveal** button that shows them privately.",
+	"spoilers.mode.hidden": "Scores in `/scores`, `/schedule`, and automated posts are hidden — games only show as live or final, with a **Reveal** button that shows the scores privately.",
+	"spoilers.mode.off":    "Scores are shown as usual in this server.",
+
+	// Replies shared by many commands
+	"error.unknown_team":           "❌ Could not find team: %s",
+	"error.provide_team_or_player": "Please provide a team, a player, or both.",
+	"error.channel_and_webhook":    "Please choose either a channel or a webhook, not both.",
+	"error.invalid_webhook":        "❌ Invalid webhook: %v.",
+	"error.channel_or_webhook":     "Please choose a channel or a webhook.",
+
+	// Permission replies
+	"permission.pickem_create":      "Only members who can manage the server can create a pick'em pool.",
+	"permission.pickem_league":      "Only members who can manage the server can change its pick'em league.",
+	"permission.league_timezone":    "Only members who can manage the server can change the league time zone.",
+	"permission.league_dates_set":   "Only members who can manage the server can set league dates.",
+	"permission.league_dates_clear": "Only members who can manage the server can clear league dates.",
+	"permission.alias_add":          "❌ You need the Manage Server permission to add aliases.",
+	"permission.alias_remove":       "❌ You need the Manage Server permission to remove aliases.",
+	"permission.draft_feed":         "❌ You need the Manage Server permission to set up the draft feed.",
+	"permission.owner_only":         "Only the bot owner can use this command.",
+	"permission.botstats":           "Only server admins and the bot owner can view bot stats.",
+
+	// /play-alerts
+	"play_alerts.unknown_play":  "❌ Unknown play type: %s. Use touchdown, field goal, safety, or turnover.",
+	"play_alerts.save_failed":   "❌ Could not save the play alert rule. Please try again.",
+	"play_alerts.added":         "🏈 %s will get %s alerts during %s.",
+	"play_alerts.no_rule":       "%s has no play alert rule.",
+	"play_alerts.remove_failed": "❌ Could not remove the play alert rule. Please try again.",
+	"play_alerts.removed":       "✅ Play alerts removed from %s.",
+
+	// /multistat
+	"multistat.no_players": "Please provide player names separated by commas (e.g. `Josh Allen, Saquon Barkley`).",
+	"multistat.too_many":   "❌ /multistat looks up at most %d players at once (got %d).",
+
+	// /pickem
+	"pickem.no_pool": "This server has no pick'em pool; an admin can start one with `/pickem create`.",
+
+	// /mockdraft
+	"mockdraft.inactive":     "⌛ This mock draft is no longer active.",
+	"mockdraft.host_start":   "Only the host can start the draft.",
+	"mockdraft.started":      "The draft has already started.",
+	"mockdraft.claim_first":  "Claim at least one slot before starting the draft.",
+	"mockdraft.host_cancel":  "Only the host can cancel the draft.",
+	"mockdraft.has_slot":     "You already have a slot in this draft.",
+	"mockdraft.full":         "All slots are taken.",
+	"mockdraft.pick_made":    "That pick has already been made.",
+	"mockdraft.not_on_clock": "You're not on the clock.",
+	"mockdraft.unavailable":  "That player is no longer available.",
+
+	// /compare and /stats
+	"stats.per_game_needs_season": "`per_game` averages season totals — add `type:Season` to use it.",
+	"compare.no_history":          "You haven't compared any players yet. Try `/compare players player1:<name> player2:<name>`.",
+
+	// /alias
+	"alias.invalid":     "❌ Aliases are a single word of up to %d letters and digits with at least one letter, e.g. `cmc`.",
+	"alias.full":        "❌ This server already has %d aliases. Remove one first.",
+	"alias.unknown":     "❌ `%s` isn't a team or a player in recent stats.",
+	"alias.save_failed": "❌ Could not save the alias. Please try again.",
+	"alias.added":       "✅ `%s` now means **%s** in player and team options and prefix commands in this server.",
+	"alias.not_found":   "❌ There's no alias `%s` in this server.",
+	"alias.removed":     "✅ Removed the alias `%s`.",
+
+	// /digest
+	"digest.save_failed": "❌ Could not save digest settings. Please try again.",
+	"digest.disabled":    "🔕 Weekly digests disabled for this server.",
+	"digest.enabled":     "📅 %s will get a preview of the upcoming slate every %s at %d:00 (%s) and a results recap with standings movement once the week's last game is final, usually Monday night.",
+
+	// /draft feed
+	"draft_feed.save_failed": "❌ Could not save the draft feed setting. Please try again.",
+	"draft_feed.disabled":    "🔕 Live draft pick feed disabled for this server.",
+	"draft_feed.enabled":     "📝 Every pick of the %s NFL Draft will be posted in <#%s> as it's made.",
+
+	// /track
+	"track.incomplete":  "Please provide both a player and a milestone, e.g. `/track player:Derrick Henry milestone:1000 rushing yards`.",
+	"track.invalid":     "❌ Invalid milestone: %v.",
+	"track.full":        "❌ This server already tracks %d milestones. Wait for some to be reached.",
+	"track.save_failed": "❌ Could not save the milestone. Please try again.",
+
+	// /trivia
+	"trivia.already_open":     "A trivia question is already open in this channel — answer that one first!",
+	"trivia.time_up":          "⏰ Time's up for that question!",
+	"trivia.already_answered": "You've already locked in an answer.",
+	"trivia.locked":           "🔒 Locked in **%c**. The answer is revealed when time runs out.",
+
+	// /dfs
+	"dfs.no_position": "Please provide a position.",
+
+	// /spoiler-mode and /spoiler-delay
+	"spoilers.expired":          "⌛ These scores are no longer stored. Run `/scores` or `/schedule` again and reveal the new message.",
+	"spoilers.save_failed":      "❌ Could not save the spoiler mode. Please try again.",
+	"spoiler_delay.off_current": "⏱️ Automated score posts and alerts are sent immediately in this server.",
+	"spoiler_delay.current":     "⏱️ Automated score posts and alerts are delayed **%d minutes** in this server.",
+	"spoiler_delay.save_failed": "❌ Could not save the spoiler delay. Please try again.",
+	"spoiler_delay.off":         "✅ Spoiler delay off — automated score posts and alerts are sent immediately. Posts already queued still wait out their delay.",
+	"spoiler_delay.set":         "✅ Automated score posts and alerts (pick'em results, prediction finals, playoff alerts) will be delayed **%d minutes**.",
+
+	// /watchlist
+	"watchlist.summary_save_failed": "❌ Could not save your summary setting. Please try again.",
+	"watchlist.summary_on":          "📬 You'll get a DM every %s with how your watch list did that week.",
+	"watchlist.summary_off":         "📭 Weekly watch list DMs turned off.",
+	"watchlist.not_listed":          "❌ %s is not on your watch list.",
+	"watchlist.players_full":        "❌ Your watch list already has %d players. Remove one first.",
+	"watchlist.teams_full":          "❌ Your watch list already has %d teams. Remove one first.",
+	"watchlist.save_failed":         "❌ Could not save your watch list. Please try again.",
+
+	// /whatif
+	"whatif.invalid": "Invalid results: %v. Example: `/whatif results:BUF over KC, DAL over PHI`",
+
+	// /formatting
+	"formatting.save_failed": "❌ Could not save the formatting settings. Please try again.",
+
+	// /timezone
+	"timezone.source.server": "this server's time zone",
+	"timezone.source.own":    "your own setting",
+	"timezone.current":       "🕒 Game times are shown to you in **%s** (%s).",
+	"timezone.unknown":       "❌ Unknown time zone `%s`. Use an IANA name like `America/Chicago` or `Europe/London`.",
+	"timezone.save_failed":   "❌ Could not save your time zone. Please try again.",
+	"timezone.cleared":       "🕒 Time zone override cleared; game times follow this server's zone (**%s**).",
+	"timezone.set":           "🕒 Game times will be shown to you in **%s**.",
+
+	// /favorite
+	"favorites.save_failed": "❌ Could not save your favorites. Please try again.",
+	"favorites.cleared":     "✅ Favorites cleared.\n",
+	"favorites.saved":       "✅ Favorites saved.\n",
+
+	// /big-games
+	"big_games.save_failed": "❌ Could not save big-game settings. Please try again.",
+	"big_games.disabled":    "🔕 Championship weekend and Super Bowl posts disabled for this server.",
+	"big_games.enabled":     "🏆 Championship weekend and Super Bowl posts will go to <#%s>: a pregame hub with odds, prop and prediction polls, a halftime recap, and a post-game MVP poll.",
+	"big_games.watch_party": " A watch-party event will be scheduled for each game (the bot needs Manage Events).",
+
+	// /today
+	"today.nothing_followed": "You aren't following anything yet. Add teams with `/follow` or `/watchlist add`, players with `/watchlist add`, and reminders with `/remind game`.",
+
+	// /slowmode
+	"slowmode.save_failed":   "❌ Could not save the slow mode rule. Please try again.",
+	"slowmode.added":         "🐢 <#%s> will switch to %ds slow mode during %s and back afterward. The bot needs **Manage Channels** there.",
+	"slowmode.no_rule":       "<#%s> has no game-day slow mode rule.",
+	"slowmode.remove_failed": "❌ Could not remove the slow mode rule. Please try again.",
+	"slowmode.removed":       "✅ Game-day slow mode removed from <#%s>.",
+
+	// /leaderboard-page
+	"leaderboard_page.disabled":    "The leaderboard web page isn't enabled on this bot (the host needs to set `WEB_ADDR`).",
+	"leaderboard_page.save_failed": "❌ Failed to save the leaderboard link.",
+
+	// /game-threads
+	"game_threads.save_failed": "❌ Could not save game thread settings. Please try again.",
+	"game_threads.disabled":    "🔕 Game-day threads disabled for this server.",
+	"game_threads.enabled":     "🧵 A thread will open in <#%s> at kickoff of %s, with score updates and archiving after the final whistle. The bot needs **Create Public Threads**, **Send Messages in Threads**, and **Manage Threads** there.",
+
+	// /alerts
+	"alerts.save_failed": "❌ Could not save alert settings. Please try again.",
+
+	// /follow
+	"follow.teams_save_failed":   "❌ Could not save your followed teams. Please try again.",
+	"follow.players_save_failed": "❌ Could not save your followed players. Please try again.",
+
+	// /league
+	"league.timezone_save_failed": "❌ Could not save the time zone. Please try again.",
+	"league.date_save_failed":     "❌ Could not save the league date. Please try again.",
+	"league.date_clear_failed":    "❌ Could not clear the league date. Please try again.",
+
+	// /remind
+	"remind.cancel_failed": "❌ Could not cancel the reminder. Please try again.",
+
+	// /trend and /predict
+	"trend.chart_failed":  "❌ Could not draw the trend chart. Please try again.",
+	"predict.poll_failed": "❌ Could not post the poll in this channel. Please try again.",
+}
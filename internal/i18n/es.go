@@ -0,0 +1,469 @@
+package i18n
+
+// spanish translates the English catalog, plus slash command descriptions. Command keys are
+// "command.<name>.description", "command.<name>.<option>.description" (nested through
+// subcommands), "command.<name>.<option>.choice.<value>", and "command.<name>.<option>.name";
+// options shared across commands use "option.<name>" instead.
+var spanish = map[string]string{
+	// Errors shared by many commands
+	"error.guild_only":             "Este comando solo se puede usar en un servidor.",
+	"error.team_not_found":         "No se encontró ningún equipo que coincida con **%s**. Prueba con una ciudad, un apodo o una abreviatura (p. ej. Bills, KC).%s",
+	"error.player_not_found":       "No se encontró ningún jugador llamado **%s** en %s.%s",
+	"error.player_not_found.scope": "las estadísticas",
+	"error.player_not_found.hint":  " Revisa la ortografía o si ya ha jugado.",
+	"error.player_bye":             "**%s**%s tuvo semana de descanso en %s, así que no hay estadísticas de esa semana.",
+	"error.player_inactive":        "**%s**%s no jugó en %s y no tiene estadísticas de esa semana.%s",
+	"error.player_inactive.injury": " Estado de lesión actual: %s.",
+	"error.invalid_week":           "La semana %d no forma parte de la %s; usa una semana del %d al %d.",
+	"error.rate_limited":           "⏳ El proveedor de datos de la NFL está limitando las solicitudes del bot. Inténtalo de nuevo en un minuto.",
+	"error.upstream_unavailable":   "⚠️ El proveedor de datos de la NFL no está disponible por ahora. Inténtalo de nuevo en unos minutos.",
+	"did_you_mean.one":             " ¿Quisiste decir **%s**?",
+	"did_you_mean.many":            " ¿Quisiste decir **%s** o **%s**?",
+
+	// Season types, as used mid-sentence
+	"season_type.PRE":  "pretemporada",
+	"season_type.REG":  "temporada regular",
+	"season_type.POST": "postemporada",
+
+	// /language
+	"language.current":      "🌐 Las respuestas del bot en este servidor están en **%s**.",
+	"language.current_auto": "🌐 Las respuestas del bot en este servidor siguen el idioma de Discord del servidor (ahora **%s**).",
+	"language.set":          "✅ Las respuestas del bot en este servidor ahora están en **%s**. Las descripciones de los comandos siguen el idioma de Discord de cada miembro.",
+	"language.reset":        "✅ Las respuestas del bot en este servidor ahora siguen el idioma de Discord del servidor (ahora **%s**).",
+	"language.save_failed":  "❌ No se pudo guardar el idioma. Inténtalo de nuevo.",
+
+	// /scoring
+	"scoring.set":         "✅ La puntuación de fantasy de este servidor ahora es **%s**.",
+	"scoring.save_failed": "❌ No se pudo guardar la puntuación. Inténtalo de nuevo.",
+
+	// /visibility
+	"visibility.public":      "✅ Las respuestas de los comandos ahora son **públicas** por defecto en este servidor.",
+	"visibility.private":     "✅ Las respuestas de los comandos ahora son **privadas** (solo las ve quien usa el comando) por defecto en este servidor.",
+	"visibility.reset":       "✅ La visibilidad de las respuestas volvió al valor por defecto del bot.",
+	"visibility.override":    "%s Cualquiera puede cambiarla en cada comando con `public:`.",
+	"visibility.save_failed": "❌ No se pudo guardar la visibilidad. Inténtalo de nuevo.",
+
+	// Weeks, as in "Week 5" or "Divisional Round"
+	"week.regular":      "Semana %d",
+	"week.preseason":    "Semana %d de pretemporada",
+	"week.postseason":   "Semana %d de postemporada",
+	"week.hall_of_fame": "Partido del Salón de la Fama",
+	"week.round.1":      "Ronda de comodines",
+	"week.round.2":      "Ronda divisional",
+	"week.round.3":      "Finales de conferencia",
+	"week.round.4":      "Super Bowl",
+
+	// Data freshness footers
+	"freshness.updated":      "Actualizado %s • %s • %s",
+	"freshness.just_now":     "ahora mismo",
+	"freshness.seconds":      "hace %d s",
+	"freshness.minutes":      "hace %d min",
+	"freshness.hours":        "hace %d h",
+	"freshness.days":         "hace %d d",
+	"freshness.source.live":  "en vivo",
+	"freshness.source.cache": "caché",
+	"freshness.source.stale": "desactualizado",
+
+	// Stats, scores, and schedule embeds
+	"embed.final":                "Final",
+	"embed.live":                 "EN VIVO",
+	"embed.stats.team":           "Equipo",
+	"embed.stats.position":       "Posición",
+	"embed.stats.season":         "Estadísticas",
+	"embed.stats.per_game":       "Por partido (%s)",
+	"embed.stats.games.one":      "1 partido",
+	"embed.stats.games":          "%d partidos",
+	"embed.schedule.title":       "📅 Calendario de %s (%d, %s)",
+	"embed.schedule.week":        "**Semana %d**: ",
+	"embed.schedule.bye":         "🛌 **SEMANA DE DESCANSO**",
+	"embed.schedule.footer":      "Mostrando %d de %d partidos",
+	"embed.scores.title":         "🏈 Marcadores de la NFL - %s",
+	"embed.scores.footer":        "%d en vivo, %d terminados, %d partidos en total",
+	"stats.title.current":        "Estadísticas de la semana actual (2025)",
+	"stats.title.sample":         "Estadísticas de muestra de 2024 (6 partidos)",
+	"stats.title.week":           "Estadísticas de %s, %d",
+	"schedule.season_label.REG":  "temporada",
+	"schedule.season_label.PRE":  "pretemporada",
+	"schedule.season_label.POST": "postemporada",
+
+	// /standings
+	"standings.title":        "🏆 Clasificación de la NFL %d",
+	"standings.legend":       "z = primer sembrado | y = división | x = plaza de playoffs | e = eliminado",
+	"standings.legend.magic": " | M# = número mágico de playoffs",
+
+	// /formatting descriptions
+	"formatting.describe":    "Los números en este servidor se ven así: **%s**, las distancias están en **%s** (un campo de 100 yardas mide %s %s) y las horas de los partidos usan un **%s**.",
+	"formatting.unit.yards":  "yardas",
+	"formatting.unit.meters": "metros",
+	"formatting.clock.12h":   "reloj de 12 horas (1:00 PM)",
+	"formatting.clock.24h":   "reloj de 24 horas (13:00)",
+
+	// /spoiler-mode descriptions
+	"spoilers.mode.tagged": "Los marcadores de `/scores`, `/schedule` y las publicaciones automáticas se ocultan con etiquetas de spoiler, con un botón **Reveal** que los muestra en privado.",
+	"spoilers.mode.hidden": "Los marcadores de `/scores`, `/schedule` y las publicaciones automáticas se ocultan: los partidos solo aparecen en vivo o terminados, con un botón **Reveal** que muestra los marcadores en privado.",
+	"spoilers.mode.off":    "Los marcadores se muestran normalmente en este servidor.",
+
+	// Replies shared by many commands
+	"error.unknown_team":           "❌ No se encontró el equipo: %s",
+	"error.provide_team_or_player": "Indica un equipo, un jugador o ambos.",
+	"error.channel_and_webhook":    "Elige un canal o un webhook, no ambos.",
+	"error.invalid_webhook":        "❌ Webhook no válido: %v.",
+	"error.channel_or_webhook":     "Elige un canal o un webhook.",
+
+	// Permission replies
+	"permission.pickem_create":      "Solo los miembros que pueden gestionar el servidor pueden crear una quiniela.",
+	"permission.pickem_league":      "Solo los miembros que pueden gestionar el servidor pueden cambiar su liga de quinielas.",
+	"permission.league_timezone":    "Solo los miembros que pueden gestionar el servidor pueden cambiar la zona horaria de la liga.",
+	"permission.league_dates_set":   "Solo los miembros que pueden gestionar el servidor pueden fijar fechas de la liga.",
+	"permission.league_dates_clear": "Solo los miembros que pueden gestionar el servidor pueden borrar fechas de la liga.",
+	"permission.alias_add":          "❌ Necesitas el permiso Gestionar servidor para agregar alias.",
+	"permission.alias_remove":       "❌ Necesitas el permiso Gestionar servidor para quitar alias.",
+	"permission.draft_feed":         "❌ Necesitas el permiso Gestionar servidor para configurar el feed del draft.",
+	"permission.owner_only":         "Solo el dueño del bot puede usar este comando.",
+	"permission.botstats":           "Solo los administradores del servidor y el dueño del bot pueden ver las estadísticas del bot.",
+
+	// /play-alerts
+	"play_alerts.unknown_play":  "❌ Tipo de jugada desconocido: %s. Usa touchdown, field goal, safety o turnover.",
+	"play_alerts.save_failed":   "❌ No se pudo guardar la regla de alertas de jugadas. Inténtalo de nuevo.",
+	"play_alerts.added":         "🏈 %s recibirá alertas de %s durante %s.",
+	"play_alerts.no_rule":       "%s no tiene regla de alertas de jugadas.",
+	"play_alerts.remove_failed": "❌ No se pudo quitar la regla de alertas de jugadas. Inténtalo de nuevo.",
+	"play_alerts.removed":       "✅ Alertas de jugadas quitadas de %s.",
+
+	// /multistat
+	"multistat.no_players": "Indica nombres de jugadores separados por comas (p. ej. `Josh Allen, Saquon Barkley`).",
+	"multistat.too_many":   "❌ /multistat busca como máximo %d jugadores a la vez (recibió %d).",
+
+	// /pickem
+	"pickem.no_pool": "Este servidor no tiene quiniela; un administrador puede crear una con `/pickem create`.",
+
+	// /mockdraft
+	"mockdraft.inactive":     "⌛ Este mock draft ya no está activo.",
+	"mockdraft.host_start":   "Solo el anfitrión puede empezar el draft.",
+	"mockdraft.started":      "El draft ya empezó.",
+	"mockdraft.claim_first":  "Reclama al menos un puesto antes de empezar el draft.",
+	"mockdraft.host_cancel":  "Solo el anfitrión puede cancelar el draft.",
+	"mockdraft.has_slot":     "Ya tienes un puesto en este draft.",
+	"mockdraft.full":         "Todos los puestos están ocupados.",
+	"mockdraft.pick_made":    "Esa selección ya se hizo.",
+	"mockdraft.not_on_clock": "No es tu turno.",
+	"mockdraft.unavailable":  "Ese jugador ya no está disponible.",
+
+	// /compare and /stats
+	"stats.per_game_needs_season": "`per_game` promedia los totales de la temporada; agrega `type:Season` para usarlo.",
+	"compare.no_history":          "Todavía no has comparado jugadores. Prueba `/compare players player1:<nombre> player2:<nombre>`.",
+
+	// /alias
+	"alias.invalid":     "❌ Un alias es una sola palabra de hasta %d letras y dígitos con al menos una letra, p. ej. `cmc`.",
+	"alias.full":        "❌ Este servidor ya tiene %d alias. Quita uno primero.",
+	"alias.unknown":     "❌ `%s` no es un equipo ni un jugador de las estadísticas recientes.",
+	"alias.save_failed": "❌ No se pudo guardar el alias. Inténtalo de nuevo.",
+	"alias.added":       "✅ `%s` ahora significa **%s** en las opciones de jugador y equipo y en los comandos con prefijo de este servidor.",
+	"alias.not_found":   "❌ No hay ningún alias `%s` en este servidor.",
+	"alias.removed":     "✅ Se quitó el alias `%s`.",
+
+	// /digest
+	"digest.save_failed": "❌ No se pudo guardar la configuración del resumen. Inténtalo de nuevo.",
+	"digest.disabled":    "🔕 Resúmenes semanales desactivados en este servidor.",
+	"digest.enabled":     "📅 %s recibirá un avance de la próxima jornada cada %s a las %d:00 (%s) y un resumen de resultados con los cambios en la clasificación cuando termine el último partido de la semana, normalmente el lunes por la noche.",
+
+	// /draft feed
+	"draft_feed.save_failed": "❌ No se pudo guardar el feed del draft. Inténtalo de nuevo.",
+	"draft_feed.disabled":    "🔕 Feed en vivo del draft desactivado en este servidor.",
+	"draft_feed.enabled":     "📝 Cada selección del Draft de la NFL de %s se publicará en <#%s> en cuanto se haga.",
+
+	// /track
+	"track.incomplete":  "Indica un jugador y una meta, p. ej. `/track player:Derrick Henry milestone:1000 rushing yards`.",
+	"track.invalid":     "❌ Meta no válida: %v.",
+	"track.full":        "❌ Este servidor ya sigue %d metas. Espera a que se alcancen algunas.",
+	"track.save_failed": "❌ No se pudo guardar la meta. Inténtalo de nuevo.",
+
+	// /trivia
+	"trivia.already_open":     "Ya hay una pregunta de trivia abierta en este canal. ¡Respóndela primero!",
+	"trivia.time_up":          "⏰ ¡Se acabó el tiempo para esa pregunta!",
+	"trivia.already_answered": "Ya fijaste una respuesta.",
+	"trivia.locked":           "🔒 Respuesta **%c** fijada. La solución se revela cuando se acabe el tiempo.",
+
+	// /dfs
+	"dfs.no_position": "Indica una posición.",
+
+	// /spoiler-mode and /spoiler-delay
+	"spoilers.expired":          "⌛ Estos marcadores ya no están guardados. Vuelve a usar `/scores` o `/schedule` y revela el mensaje nuevo.",
+	"spoilers.save_failed":      "❌ No se pudo guardar el modo de spoilers. Inténtalo de nuevo.",
+	"spoiler_delay.off_current": "⏱️ Las publicaciones automáticas de marcadores y las alertas se envían al instante en este servidor.",
+	"spoiler_delay.current":     "⏱️ Las publicaciones automáticas de marcadores y las alertas se retrasan **%d minutos** en este servidor.",
+	"spoiler_delay.save_failed": "❌ No se pudo guardar el retraso antispoilers. Inténtalo de nuevo.",
+	"spoiler_delay.off":         "✅ Retraso antispoilers desactivado: las publicaciones automáticas y las alertas se envían al instante. Las que ya estaban en cola siguen esperando su retraso.",
+	"spoiler_delay.set":         "✅ Las publicaciones automáticas de marcadores y las alertas (resultados de quinielas, finales de predicciones, alertas de playoffs) se retrasarán **%d minutos**.",
+
+	// /watchlist
+	"watchlist.summary_save_failed": "❌ No se pudo guardar tu ajuste del resumen. Inténtalo de nuevo.",
+	"watchlist.summary_on":          "📬 Recibirás un MD cada %s con cómo le fue a tu lista de seguimiento esa semana.",
+	"watchlist.summary_off":         "📭 MD semanales de la lista de seguimiento desactivados.",
+	"watchlist.not_listed":          "❌ %s no está en tu lista de seguimiento.",
+	"watchlist.players_full":        "❌ Tu lista de seguimiento ya tiene %d jugadores. Quita uno primero.",
+	"watchlist.teams_full":          "❌ Tu lista de seguimiento ya tiene %d equipos. Quita uno primero.",
+	"watchlist.save_failed":         "❌ No se pudo guardar tu lista de seguimiento. Inténtalo de nuevo.",
+
+	// /whatif
+	"whatif.invalid": "Resultados no válidos: %v. Ejemplo: `/whatif results:BUF over KC, DAL over PHI`",
+
+	// /formatting
+	"formatting.save_failed": "❌ No se pudo guardar el formato. Inténtalo de nuevo.",
+
+	// /timezone
+	"timezone.source.server": "la zona horaria de este servidor",
+	"timezone.source.own":    "tu propio ajuste",
+	"timezone.current":       "🕒 Ves las horas de los partidos en **%s** (%s).",
+	"timezone.unknown":       "❌ Zona horaria desconocida `%s`. Usa un nombre IANA como `America/Chicago` o `Europe/London`.",
+	"timezone.save_failed":   "❌ No se pudo guardar tu zona horaria. Inténtalo de nuevo.",
+	"timezone.cleared":       "🕒 Se quitó tu zona horaria; las horas de los partidos siguen la zona del servidor (**%s**).",
+	"timezone.set":           "🕒 Verás las horas de los partidos en **%s**.",
+
+	// /favorite
+	"favorites.save_failed": "❌ No se pudieron guardar tus favoritos. Inténtalo de nuevo.",
+	"favorites.cleared":     "✅ Favoritos borrados.\n",
+	"favorites.saved":       "✅ Favoritos guardados.\n",
+
+	// /big-games
+	"big_games.save_failed": "❌ No se pudo guardar la configuración de los partidos grandes. Inténtalo de nuevo.",
+	"big_games.disabled":    "🔕 Publicaciones de las finales de conferencia y el Super Bowl desactivadas en este servidor.",
+	"big_games.enabled":     "🏆 Las publicaciones de las finales de conferencia y el Super Bowl irán a <#%s>: una previa con cuotas, encuestas de props y predicciones, un resumen al medio tiempo y una encuesta del MVP al final.",
+	"big_games.watch_party": " Se programará un evento para ver cada partido juntos (el bot necesita Gestionar eventos).",
+
+	// /today
+	"today.nothing_followed": "Todavía no sigues nada. Agrega equipos con `/follow` o `/watchlist add`, jugadores con `/watchlist add` y recordatorios con `/remind game`.",
+
+	// /slowmode
+	"slowmode.save_failed":   "❌ No se pudo guardar la regla de modo lento. Inténtalo de nuevo.",
+	"slowmode.added":         "🐢 <#%s> pasará a modo lento de %d s durante %s y volverá después. El bot necesita **Gestionar canales** ahí.",
+	"slowmode.no_rule":       "<#%s> no tiene regla de modo lento para los días de partido.",
+	"slowmode.remove_failed": "❌ No se pudo quitar la regla de modo lento. Inténtalo de nuevo.",
+	"slowmode.removed":       "✅ Modo lento de los días de partido quitado de <#%s>.",
+
+	// /leaderboard-page
+	"leaderboard_page.disabled":    "La página web de clasificaciones no está activada en este bot (quien lo aloja debe configurar `WEB_ADDR`).",
+	"leaderboard_page.save_failed": "❌ No se pudo guardar el enlace de la clasificación.",
+
+	// /game-threads
+	"game_threads.save_failed": "❌ No se pudo guardar la configuración de los hilos de partido. Inténtalo de nuevo.",
+	"game_threads.disabled":    "🔕 Hilos de los días de partido desactivados en este servidor.",
+	"game_threads.enabled":     "🧵 Se abrirá un hilo en <#%s> al inicio de %s, con actualizaciones del marcador y archivado tras el pitido final. El bot necesita **Crear hilos públicos**, **Enviar mensajes en hilos** y **Gestionar hilos** ahí.",
+
+	// /alerts
+	"alerts.save_failed": "❌ No se pudo guardar la configuración de alertas. Inténtalo de nuevo.",
+
+	// /follow
+	"follow.teams_save_failed":   "❌ No se pudieron guardar tus equipos seguidos. Inténtalo de nuevo.",
+	"follow.players_save_failed": "❌ No se pudieron guardar tus jugadores seguidos. Inténtalo de nuevo.",
+
+	// /league
+	"league.timezone_save_failed": "❌ No se pudo guardar la zona horaria. Inténtalo de nuevo.",
+	"league.date_save_failed":     "❌ No se pudo guardar la fecha de la liga. Inténtalo de nuevo.",
+	"league.date_clear_failed":    "❌ No se pudo borrar la fecha de la liga. Inténtalo de nuevo.",
+
+	// /remind
+	"remind.cancel_failed": "❌ No se pudo cancelar el recordatorio. Inténtalo de nuevo.",
+
+	// /trend and /predict
+	"trend.chart_failed":  "❌ No se pudo dibujar el gráfico de tendencia. Inténtalo de nuevo.",
+	"predict.poll_failed": "❌ No se pudo publicar la encuesta en este canal. Inténtalo de nuevo.",
+
+	// Options shared across commands
+	"option.public.description":            "Mostrar la respuesta en el canal (True) o solo a ti (False); por defecto, el ajuste del servidor",
+	"option.per_game.description":          "Dividir los totales de la temporada entre los partidos jugados (solo type:Season)",
+	"option.season_type.description":       "Pretemporada, temporada regular o una ronda de playoffs",
+	"option.season_type.choice.PRE":        "Pretemporada",
+	"option.season_type.choice.REG":        "Temporada regular",
+	"option.season_type.choice.POST":       "Postemporada",
+	"option.season_type.choice.WILDCARD":   "Ronda de comodines",
+	"option.season_type.choice.DIVISIONAL": "Ronda divisional",
+	"option.season_type.choice.CONFERENCE": "Finales de conferencia",
+	"option.season_type.choice.SUPERBOWL":  "Super Bowl",
+	"option.conference.description":        "Limitar a una conferencia",
+
+	// Option and subcommand names; a "command.<name>.<option>.name" key overrides one for a command
+	"option.add.name":                "agregar",
+	"option.cancel.name":             "cancelar",
+	"option.channel.name":            "canal",
+	"option.clear.name":              "borrar",
+	"option.clock.name":              "reloj",
+	"option.conference.name":         "conferencia",
+	"option.configure.name":          "configurar",
+	"option.count.name":              "cantidad",
+	"option.create.name":             "crear",
+	"option.date.name":               "fecha",
+	"option.dates.name":              "fechas",
+	"option.day.name":                "día",
+	"option.default.name":            "predeterminado",
+	"option.deliver.name":            "entrega",
+	"option.difficulty.name":         "dificultad",
+	"option.distance.name":           "distancia",
+	"option.division.name":           "división",
+	"option.enabled.name":            "activado",
+	"option.event.name":              "evento",
+	"option.game.name":               "partido",
+	"option.history.name":            "historial",
+	"option.language.name":           "idioma",
+	"option.leaderboard.name":        "clasificación",
+	"option.list.name":               "lista",
+	"option.milestone.name":          "marca",
+	"option.minutes.name":            "minutos",
+	"option.minutes_before.name":     "minutos_antes",
+	"option.mode.name":               "modo",
+	"option.name.name":               "nombre",
+	"option.order.name":              "orden",
+	"option.per_game.name":           "por_partido",
+	"option.pick_clock.name":         "reloj_de_turno",
+	"option.picks.name":              "elecciones",
+	"option.play.name":               "jugar",
+	"option.player.name":             "jugador",
+	"option.player1.name":            "jugador1",
+	"option.player2.name":            "jugador2",
+	"option.players.name":            "jugadores",
+	"option.plays.name":              "jugadas",
+	"option.position.name":           "posición",
+	"option.public.name":             "público",
+	"option.rematch.name":            "repetir",
+	"option.remove.name":             "quitar",
+	"option.results.name":            "resultados",
+	"option.rostered_threshold.name": "umbral_de_plantilla",
+	"option.rotate.name":             "rotar",
+	"option.rounds.name":             "rondas",
+	"option.scoring.name":            "puntuación",
+	"option.season_type.name":        "tipo_de_temporada",
+	"option.seconds.name":            "segundos",
+	"option.set.name":                "establecer",
+	"option.show.name":               "ver",
+	"option.site.name":               "sitio",
+	"option.start.name":              "iniciar",
+	"option.stat.name":               "estadística",
+	"option.stats.name":              "estadísticas",
+	"option.status.name":             "estado",
+	"option.storage.name":            "almacenamiento",
+	"option.summary.name":            "resumen",
+	"option.team.name":               "equipo",
+	"option.teams.name":              "equipos",
+	"option.thousands.name":          "miles",
+	"option.time.name":               "hora",
+	"option.timezone.name":           "zona_horaria",
+	"option.type.name":               "tipo",
+	"option.watch_party.name":        "quedada",
+	"option.week.name":               "semana",
+	"option.which.name":              "cuál",
+	"option.year.name":               "año",
+	"option.zone.name":               "zona",
+
+	// Command descriptions
+	"command.help.description":                    "Ver la documentación completa de los comandos",
+	"command.stats.description":                   "Estadísticas de un jugador",
+	"command.stats.player.description":            "Nombre del jugador (por defecto, tu jugador de /favorite)",
+	"command.stats.type.description":              "Tipo de estadísticas",
+	"command.stats.type.choice.current":           "Semana actual",
+	"command.stats.type.choice.season":            "Temporada",
+	"command.stats.week.description":              "Número de semana (1-18, pretemporada 0-4)",
+	"command.stats.year.description":              "Año (por defecto, la temporada actual)",
+	"command.multistat.description":               "Las estadísticas de varios jugadores en una semana a la vez",
+	"command.track.description":                   "Recibe un aviso cuando un jugador alcance una marca de la temporada (sin opciones para ver la lista)",
+	"command.trend.description":                   "Gráfica semana a semana de la temporada de un jugador",
+	"command.compare.description":                 "Comparar dos jugadores",
+	"command.compare.players.description":         "Comparar dos jugadores",
+	"command.compare.players.player1.description": "Nombre del primer jugador",
+	"command.compare.players.player2.description": "Nombre del segundo jugador",
+	"command.compare.players.type.description":    "Tipo de comparación",
+	"command.compare.players.type.choice.current": "Semana actual",
+	"command.compare.players.type.choice.season":  "Temporada",
+	"command.compare.players.week.description":    "Número de semana (1-18)",
+	"command.compare.rematch.description":         "Repetir tu última comparación con estadísticas actualizadas",
+	"command.compare.history.description":         "Tus comparaciones recientes, con botones para repetirlas",
+	"command.team.description":                    "Información de un equipo",
+	"command.team.team.description":               "Nombre, ciudad o abreviatura del equipo (por defecto, tu equipo de /favorite)",
+	"command.coaches.description":                 "El entrenador en jefe, los coordinadores y los esquemas de un equipo",
+	"command.history.description":                 "Historia de la franquicia: títulos, récord histórico y números retirados",
+	"command.next.description":                    "Contra quién, cuándo, dónde y en qué canal juega un equipo su próximo partido",
+	"command.drives.description":                  "Las series ofensivas de un partido de esta semana: inicio, jugadas, yardas y resultado",
+	"command.plays.description":                   "Las últimas jugadas del partido de un equipo",
+	"command.schedule.description":                "Calendario de un equipo",
+	"command.schedule.team.description":           "Nombre, ciudad o abreviatura del equipo (por defecto, tu equipo de /favorite)",
+	"command.scores.description":                  "Marcadores de la semana actual",
+	"command.scores.week.description":             "Número de semana (1-18, pretemporada 0-4)",
+	"command.scores.status.description":           "Mostrar solo los partidos en curso, terminados o por empezar",
+	"command.scores.status.choice.live":           "En vivo",
+	"command.scores.status.choice.final":          "Final",
+	"command.scores.status.choice.upcoming":       "Próximos",
+	"command.scores.team.description":             "Mostrar solo el partido de este equipo",
+	"command.wintotals.description":               "El ritmo de victorias de cada equipo frente a su línea de victorias de pretemporada",
+	"command.whattowatch.description":             "Los partidos televisados a nivel nacional esta semana y dónde verlos",
+	"command.recap.description":                   "Resumen de una semana: paliza, partido más cerrado, tiroteo, mejores jugadores y sorpresas",
+	"command.standings.description":               "Clasificación por división con clasificados y números mágicos",
+	"command.division.description":                "Clasificación de una división, enfrentamientos directos y partidos divisionales restantes",
+	"command.playoffodds.description":             "Probabilidades simuladas de un equipo de llegar a playoffs y ganar su división",
+	"command.powerrankings.description":           "Todos los equipos ordenados por rating Elo con su movimiento de esta semana",
+	"command.playoffpicture.description":          "Posiciones actuales de playoffs, equipos en la pelea y eliminados",
+	"command.whatif.description":                  "Clasificación y posiciones de playoffs con resultados hipotéticos de esta semana",
+	"command.scenarios.description":               "Lo que un equipo puede asegurar o perder esta semana",
+	"command.safepicks.description":               "Los ganadores más seguros de la semana según el modelo, para quinielas y survivor",
+	"command.draftorder.description":              "Orden del draft proyectado si la temporada terminara hoy",
+	"command.draft.description":                   "Orden del Draft de la NFL, clases del draft y avisos de selecciones en vivo",
+	"command.follow.description":                  "Sigue a un equipo para notas personalizadas, o a un jugador para sus números tras cada partido",
+	"command.unfollow.description":                "Dejar de seguir a un equipo o jugador",
+	"command.mockdraft.description":               "Un mock draft de fantasy en un hilo",
+	"command.draftkit.description":                "Rankings por posición y valores de subasta para la temporada de drafts",
+	"command.rookies.description":                 "Los mejores novatos de la temporada por puntos de fantasy",
+	"command.waivers.description":                 "Jugadores en alza en las últimas dos semanas que antes no producían",
+	"command.value.description":                   "Los mejores valores DFS de la próxima semana: puntos proyectados por cada $1k de salario",
+	"command.matchup-player.description":          "Cómo defiende el próximo rival de un jugador a su posición",
+	"command.remind.description":                  "Recibe un recordatorio antes de la patada inicial",
+	"command.botstats.description":                "Estado del bot: tiempo activo, uso de la API, aciertos de caché y memoria (solo administradores)",
+	"command.ats.description":                     "Récords contra el spread calificados con las líneas de cierre",
+	"command.futures.description":                 "Las probabilidades de Super Bowl, conferencia y división de un equipo frente a la simulación del bot",
+	"command.trivia.description":                  "Trivia de la NFL para todo el servidor",
+	"command.predict.description":                 "Publica una encuesta sobre quién gana un partido de esta semana",
+	"command.league.description":                  "Fechas y zona horaria de la liga de fantasy",
+	"command.watchlist.description":               "Jugadores y equipos de los que recibir un resumen semanal",
+	"command.today.description":                   "Partidos de hoy, estado de jugadores, plazos del pick'em y recordatorios de lo que sigues",
+	"command.timezone.description":                "Ver o cambiar la zona horaria en la que ves los horarios de los partidos",
+	"command.favorite.description":                "Tu equipo y jugador por defecto para los comandos en los que no los indiques",
+	"command.favorite.set.description":            "Elegir tu equipo y/o jugador favorito",
+	"command.favorite.set.team.description":       "Nombre, ciudad o abreviatura del equipo",
+	"command.favorite.set.player.description":     "Nombre del jugador (p. ej. Josh Allen)",
+	"command.favorite.show.description":           "Ver tu equipo y jugador favoritos",
+	"command.favorite.clear.description":          "Borrar tus favoritos",
+	"command.favorite.clear.which.description":    "Borrar solo uno de los favoritos (por defecto, ambos)",
+	"command.favorite.clear.which.choice.team":    "Equipo",
+	"command.favorite.clear.which.choice.player":  "Jugador",
+	"command.alias.description":                   "Abreviaturas del servidor para jugadores y equipos, p. ej. cmc para Christian McCaffrey",
+	"command.alias.add.description":               "Añadir o reemplazar un alias (solo con Gestionar servidor)",
+	"command.alias.add.alias.description":         "Abreviatura de una palabra, p. ej. cmc",
+	"command.alias.add.name.description":          "El jugador o equipo al que representa",
+	"command.alias.remove.description":            "Quitar un alias (solo con Gestionar servidor)",
+	"command.alias.remove.alias.description":      "El alias que quieres quitar",
+	"command.alias.list.description":              "Ver los alias de este servidor",
+	"command.pickem.description":                  "Pick'em semanal: elige al ganador de cada partido",
+	"command.scoring.description":                 "Cambiar el formato de puntuación de fantasy del servidor",
+	"command.leaderboard-page.description":        "Una página web para compartir las clasificaciones de pick'em y trivia del servidor",
+	"command.owner.description":                   "Herramientas del dueño del bot",
+	"command.visibility.description":              "Decidir si las respuestas de los comandos son públicas o privadas por defecto en este servidor",
+	"command.slowmode.description":                "Modo lento automático en canales mientras hay partidos",
+	"command.spoiler-delay.description":           "Retrasar marcadores y avisos automáticos para quien ve en diferido (sin minutos para ver el ajuste)",
+	"command.spoiler-mode.description":            "Ocultar marcadores con etiquetas de spoiler o un botón para revelarlos (sin modo para ver el ajuste)",
+	"command.big-games.description":               "Previas, resúmenes y encuestas de finales de conferencia y Super Bowl (sin canal para desactivar)",
+	"command.game-threads.description":            "Un hilo por partido desde la patada inicial con el marcador al día (sin canal para desactivar)",
+	"command.play-alerts.description":             "Avisos de touchdowns, goles de campo, safeties y pérdidas de balón al momento",
+	"command.digest.description":                  "Previas semanales del calendario y resúmenes de resultados",
+	"command.alerts.description":                  "Elegir el canal de los avisos automáticos (sin canal para desactivar)",
+	"command.language.description":                "Ver o cambiar el idioma de las respuestas del bot en este servidor",
+	"command.language.language.description":       "Idioma de las respuestas del bot",
+	"command.language.language.choice.auto":       "Seguir el idioma de Discord del servidor",
+	"command.formatting.description":              "Ver o cambiar cómo se muestran los números, las distancias y las horas en este servidor",
+	"command.formatting.thousands.description":    "Separador de miles en las estadísticas",
+	"command.formatting.thousands.choice.comma":   "Coma (4,306)",
+	"command.formatting.thousands.choice.space":   "Espacio (4 306)",
+	"command.formatting.thousands.choice.none":    "Ninguno (4306)",
+	"command.formatting.distance.description":     "Mostrar las distancias en yardas o metros",
+	"command.formatting.distance.choice.yards":    "Yardas",
+	"command.formatting.distance.choice.meters":   "Metros",
+	"command.formatting.clock.description":        "Mostrar las horas de los partidos en formato de 12 o 24 horas",
+	"command.formatting.clock.choice.12h":         "12 horas (1:00 PM)",
+	"command.formatting.clock.choice.24h":         "24 horas (13:00)",
+}
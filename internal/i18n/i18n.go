@@ -0,0 +1,78 @@
+// Package i18n holds the bot's message catalogs and looks up translations by language.
+//
+// Response strings live in every catalog under a dotted key (e.g. "error.rate_limited"), with
+// English as the fallback. Slash command descriptions are written in English where the commands
+// are defined, so only the other catalogs carry "command.*" keys; see the bot's localizeCommands.
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Supported languages, as the first part of a Discord locale
+const (
+	English = "en"
+	Spanish = "es"
+)
+
+// Languages lists every supported language, English first
+var Languages = []string{English, Spanish}
+
+// catalogs maps each language to its messages by key
+var catalogs = map[string]map[string]string{
+	English: english,
+	Spanish: spanish,
+}
+
+// names is each language's name in that language
+var names = map[string]string{
+	English: "English",
+	Spanish: "Español",
+}
+
+// T returns the message for key in a language, formatted with args when there are any. A key
+// missing from the language falls back to English, then to the key itself.
+func T(language, key string, args ...any) string {
+	message, ok := Lookup(language, key)
+	if !ok {
+		message, ok = Lookup(English, key)
+	}
+	if !ok {
+		message = key
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// Lookup returns a language's own message for key, without falling back to English
+func Lookup(language, key string) (string, bool) {
+	message, ok := catalogs[language][key]
+	return message, ok
+}
+
+// Name returns a language's name in that language, e.g. "Español"
+func Name(language string) string {
+	if name, ok := names[language]; ok {
+		return name
+	}
+	return names[English]
+}
+
+// Supported reports whether the bot has a catalog for a language
+func Supported(language string) bool {
+	_, ok := catalogs[language]
+	return ok
+}
+
+// FromLocale maps a Discord locale like "es-419" or "en-US" to a supported language, or returns
+// "" when the bot doesn't speak it
+func FromLocale(locale string) string {
+	language, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	if Supported(language) {
+		return language
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+// formatVerb matches a fmt verb with its flags, width, and precision, skipping escaped percents
+var formatVerb = regexp.MustCompile(`%[-+# 0]*[0-9]*(?:\.[0-9]+)?[a-zA-Z%]`)
+
+// verbs returns a message's fmt verbs in order, leaving out "%%"
+func verbs(message string) []string {
+	var found []string
+	for _, verb := range formatVerb.FindAllString(message, -1) {
+		if verb != "%%" {
+			found = append(found, verb)
+		}
+	}
+	return found
+}
+
+func TestCatalogsMatchEnglish(t *testing.T) {
+	for _, language := range Languages[1:] {
+		for key, message := range english {
+			translated, ok := Lookup(language, key)
+			if !ok {
+				t.Errorf("%s is missing %q", language, key)
+				continue
+			}
+			if want, got := verbs(message), verbs(translated); !slices.Equal(want, got) {
+				t.Errorf("%s %q formats %v, English formats %v", language, key, got, want)
+			}
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	if got := T(Spanish, "standings.title", 2025); got != "🏆 Clasificación de la NFL 2025" {
+		t.Errorf("T in Spanish = %q", got)
+	}
+	if got := T("xx", "standings.title", 2025); got != "🏆 2025 NFL Standings" {
+		t.Errorf("T in an unknown language = %q, want the English message", got)
+	}
+	if got := T(Spanish, "no.such.key"); got != "no.such.key" {
+		t.Errorf("T for an unknown key = %q, want the key", got)
+	}
+}
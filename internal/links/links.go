@@ -0,0 +1,80 @@
+// Package links builds outbound reference links for players and teams from their SportsData.io keys
+package links
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// teamSites maps team keys to their official sites
+var teamSites = map[string]string{
+	"ARI": "https://www.azcardinals.com",
+	"ATL": "https://www.atlantafalcons.com",
+	"BAL": "https://www.baltimoreravens.com",
+	"BUF": "https://www.buffalobills.com",
+	"CAR": "https://www.panthers.com",
+	"CHI": "https://www.chicagobears.com",
+	"CIN": "https://www.bengals.com",
+	"CLE": "https://www.clevelandbrowns.com",
+	"DAL": "https://www.dallascowboys.com",
+	"DEN": "https://www.denverbroncos.com",
+	"DET": "https://www.detroitlions.com",
+	"GB":  "https://www.packers.com",
+	"HOU": "https://www.houstontexans.com",
+	"IND": "https://www.colts.com",
+	"JAX": "https://www.jaguars.com",
+	"KC":  "https://www.chiefs.com",
+	"LAC": "https://www.chargers.com",
+	"LAR": "https://www.therams.com",
+	"LV":  "https://www.raiders.com",
+	"MIA": "https://www.miamidolphins.com",
+	"MIN": "https://www.vikings.com",
+	"NE":  "https://www.patriots.com",
+	"NO":  "https://www.neworleanssaints.com",
+	"NYG": "https://www.giants.com",
+	"NYJ": "https://www.newyorkjets.com",
+	"PHI": "https://www.philadelphiaeagles.com",
+	"PIT": "https://www.steelers.com",
+	"SEA": "https://www.seahawks.com",
+	"SF":  "https://www.49ers.com",
+	"TB":  "https://www.buccaneers.com",
+	"TEN": "https://www.tennesseetitans.com",
+	"WAS": "https://www.commanders.com",
+}
+
+// PlayerPage returns the player's page on FantasyData, SportsData.io's public site, which is keyed
+// by the same player IDs; empty when the ID is unknown
+func PlayerPage(playerID int, name string) string {
+	if playerID == 0 {
+		return ""
+	}
+	return fmt.Sprintf("https://fantasydata.com/nfl/%s-fantasy/%d", slug(name), playerID)
+}
+
+// TeamSite returns a team's official site, or empty for an unknown key
+func TeamSite(teamKey string) string {
+	return teamSites[strings.ToUpper(teamKey)]
+}
+
+// ProFootballReferenceSearch returns a Pro-Football-Reference search for a player name
+func ProFootballReferenceSearch(name string) string {
+	return "https://www.pro-football-reference.com/search/search.fcgi?search=" + url.QueryEscape(name)
+}
+
+// slug lowercases a name and joins its letters and digits with hyphens, e.g. "Ja'Marr Chase" -> "jamarr-chase"
+func slug(name string) string {
+	var words []string
+	for _, word := range strings.Fields(strings.ToLower(name)) {
+		var kept strings.Builder
+		for _, r := range word {
+			if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+				kept.WriteRune(r)
+			}
+		}
+		if kept.Len() > 0 {
+			words = append(words, kept.String())
+		}
+	}
+	return strings.Join(words, "-")
+}
@@ -0,0 +1,165 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Options controls how log output is written
+type Options struct {
+	Level   string // debug, info, warn, or error
+	Modules string // per-module overrides, e.g. "nfl=debug,cache=warn"
+	File    string // also write to this file when set; stdout only if it can't be opened
+	Format  string // text (default) or json
+}
+
+var (
+	mu           sync.RWMutex
+	output       slog.Handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})
+	defaultLevel              = slog.LevelInfo
+	moduleLevels              = map[string]slog.Level{}
+)
+
+// Setup configures log output and levels. The returned closer releases the log file. A log file
+// that can't be opened, e.g. on a read-only filesystem, is reported as a warning and logging
+// continues on stdout rather than failing.
+func Setup(opts Options) (io.Closer, error) {
+	level, err := ParseLevel(opts.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make(map[string]slog.Level)
+	for _, entry := range strings.Split(opts.Modules, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		module, value, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid module level %q: expected module=level", entry)
+		}
+		moduleLevel, err := ParseLevel(value)
+		if err != nil {
+			return nil, err
+		}
+		levels[strings.ToLower(strings.TrimSpace(module))] = moduleLevel
+	}
+
+	var writer io.Writer = os.Stdout
+	var closer io.Closer = nopCloser{}
+	var fileErr error
+	if opts.File != "" {
+		file, err := os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			fileErr = err
+		} else {
+			writer = io.MultiWriter(os.Stdout, file)
+			closer = file
+		}
+	}
+
+	// Level filtering happens per module, so the output handler accepts everything
+	handlerOpts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	var handler slog.Handler
+	switch strings.ToLower(opts.Format) {
+	case "", "text":
+		handler = slog.NewTextHandler(writer, handlerOpts)
+	case "json":
+		handler = slog.NewJSONHandler(writer, handlerOpts)
+	default:
+		closer.Close()
+		return nil, fmt.Errorf("invalid log format %q: must be text or json", opts.Format)
+	}
+
+	mu.Lock()
+	output = handler
+	defaultLevel = level
+	moduleLevels = levels
+	mu.Unlock()
+
+	slog.SetDefault(For("main"))
+	if fileErr != nil {
+		slog.Warn("could not open log file, logging to stdout only", "file", opts.File, "error", fileErr)
+	}
+	return closer, nil
+}
+
+// ParseLevel converts a level name to a slog level
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("invalid log level %q: must be debug, info, warn, or error", name)
+	}
+}
+
+// For returns a logger tagged with a module name. Loggers can be created before Setup runs;
+// they pick up its output and levels when they write.
+func For(module string) *slog.Logger {
+	return slog.New(&moduleHandler{module: module}).With("module", module)
+}
+
+// moduleHandler filters records by its module's level and forwards them to the configured output
+type moduleHandler struct {
+	module string
+	wrap   []func(slog.Handler) slog.Handler // WithAttrs/WithGroup calls, replayed onto the output
+}
+
+// Enabled reports whether the module logs at the given level
+func (h *moduleHandler) Enabled(_ context.Context, level slog.Level) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if moduleLevel, exists := moduleLevels[h.module]; exists {
+		return level >= moduleLevel
+	}
+	return level >= defaultLevel
+}
+
+// Handle writes a record to the configured output
+func (h *moduleHandler) Handle(ctx context.Context, record slog.Record) error {
+	mu.RLock()
+	handler := output
+	mu.RUnlock()
+
+	for _, wrap := range h.wrap {
+		handler = wrap(handler)
+	}
+	return handler.Handle(ctx, record)
+}
+
+// WithAttrs returns a handler that adds attrs to every record
+func (h *moduleHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler { return handler.WithAttrs(attrs) })
+}
+
+// WithGroup returns a handler that nests later attrs under name
+func (h *moduleHandler) WithGroup(name string) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler { return handler.WithGroup(name) })
+}
+
+// with copies the handler with one more wrapping step
+func (h *moduleHandler) with(wrap func(slog.Handler) slog.Handler) slog.Handler {
+	wraps := make([]func(slog.Handler) slog.Handler, len(h.wrap), len(h.wrap)+1)
+	copy(wraps, h.wrap)
+	return &moduleHandler{module: h.module, wrap: append(wraps, wrap)}
+}
+
+// nopCloser is returned by Setup when there is no log file to close
+type nopCloser struct{}
+
+// Close does nothing
+func (nopCloser) Close() error { return nil }
@@ -0,0 +1,198 @@
+package nfl
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"nfl-discord-bot/internal/cache"
+)
+
+// breakerThreshold is how many consecutive upstream failures open the circuit
+const breakerThreshold = 5
+
+// breakerCooldown is how long the circuit stays open before a probe request is let through
+const breakerCooldown = time.Minute
+
+// staleFetchedAtHeader marks a response served from the stale copy, with when it was fetched
+const staleFetchedAtHeader = "X-Stale-Fetched-At"
+
+// Health describes whether the NFL API is reachable, as judged by the circuit breaker
+type Health struct {
+	Available   bool
+	DownSince   time.Time // zero while available
+	StaleServed int64     // responses served from stale copies during the current outage
+}
+
+// circuitBreaker stops calling the API after repeated failures and probes it again after a cooldown
+type circuitBreaker struct {
+	mu          sync.Mutex
+	failures    int
+	open        bool
+	openedAt    time.Time
+	probing     bool
+	downSince   time.Time
+	staleServed int64
+}
+
+// allow reports whether a request may go upstream. While open, one probe is let through per cooldown.
+func (cb *circuitBreaker) allow(now time.Time) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if !cb.open {
+		return true
+	}
+	if cb.probing || now.Sub(cb.openedAt) < breakerCooldown {
+		return false
+	}
+	cb.probing = true
+	return true
+}
+
+// record updates the breaker with the outcome of an upstream request
+func (cb *circuitBreaker) record(success bool, now time.Time) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+	if success {
+		if cb.open {
+			logger.Info("nfl api recovered, closing circuit", "down_for", now.Sub(cb.downSince).Round(time.Second))
+		}
+		cb.failures = 0
+		cb.open = false
+		cb.downSince = time.Time{}
+		cb.staleServed = 0
+		return
+	}
+
+	cb.failures++
+	if cb.open {
+		cb.openedAt = now // failed probe: wait another cooldown
+		return
+	}
+	if cb.failures >= breakerThreshold {
+		logger.Warn("nfl api failing, opening circuit", "failures", cb.failures, "cooldown", breakerCooldown)
+		cb.open = true
+		cb.openedAt = now
+		cb.downSince = now
+	}
+}
+
+// servedStale counts a response answered from a stale copy
+func (cb *circuitBreaker) servedStale() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.staleServed++
+}
+
+// health returns a snapshot of the breaker
+func (cb *circuitBreaker) health() Health {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return Health{Available: !cb.open, DownSince: cb.downSince, StaleServed: cb.staleServed}
+}
+
+// staleResponse is the last good response body for a URL
+type staleResponse struct {
+	Body      []byte    `json:"body"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// resilientTransport puts the circuit breaker in front of the API and keeps a long-lived copy of
+// every successful response, which is served when the API is down or the circuit is open
+type resilientTransport struct {
+	base     http.RoundTripper
+	breaker  *circuitBreaker
+	cache    cache.Cache
+	staleTTL func() time.Duration
+}
+
+// RoundTrip performs the request unless the circuit is open, falling back to the stale copy on failure
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := staleKey(req.URL)
+	now := time.Now()
+
+	if !t.breaker.allow(now) {
+		if resp := t.stale(req, key); resp != nil {
+			return resp, nil
+		}
+		return nil, ErrUpstreamUnavailable
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || upstreamFailure(resp.StatusCode) {
+		t.breaker.record(false, now)
+		if stale := t.stale(req, key); stale != nil {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			return stale, nil
+		}
+		return resp, err
+	}
+	t.breaker.record(true, now)
+
+	if resp.StatusCode == http.StatusOK && req.Method == http.MethodGet {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		t.cache.Set(key, staleResponse{Body: body, FetchedAt: now}, t.staleTTL())
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return resp, nil
+}
+
+// stale builds a response from the stale copy of a URL, or returns nil when there is none
+func (t *resilientTransport) stale(req *http.Request, key string) *http.Response {
+	var saved staleResponse
+	if req.Method != http.MethodGet || !t.cache.Get(key, &saved) {
+		return nil
+	}
+	t.breaker.servedStale()
+	logger.Debug("serving stale response", "path", req.URL.Path, "fetched_at", saved.FetchedAt)
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	header.Set(staleFetchedAtHeader, strconv.FormatInt(saved.FetchedAt.Unix(), 10))
+	return &http.Response{
+		Status:        "200 OK (stale)",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(saved.Body)),
+		ContentLength: int64(len(saved.Body)),
+		Request:       req,
+	}
+}
+
+// upstreamFailure reports whether a status means the API itself is unhealthy (rate limiting
+// included), as opposed to a bad request for one resource
+func upstreamFailure(status int) bool {
+	return status >= http.StatusInternalServerError || status == http.StatusTooManyRequests
+}
+
+// staleKey identifies a request's stale copy, leaving the API key out of cache keys
+func staleKey(requestURL *url.URL) string {
+	stripped := *requestURL
+	query := stripped.Query()
+	query.Del("key")
+	stripped.RawQuery = query.Encode()
+	return "stale:" + stripped.String()
+}
+
+// Health reports whether the NFL API is currently reachable
+func (c *Client) Health() Health {
+	return c.breaker.health()
+}
@@ -3,11 +3,13 @@ package nfl
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"nfl-discord-bot/internal/cache"
+	"nfl-discord-bot/internal/logging"
 	"nfl-discord-bot/pkg/models"
 )
 
@@ -17,19 +19,34 @@ type SportsDataPlayerStat struct {
 	Name             string  `json:"Name"`
 	Team             string  `json:"Team"`
 	Position         string  `json:"Position"`
+	Opponent         string  `json:"Opponent"`
 	Season           float64 `json:"Season"`
 	Week             float64 `json:"Week"`
 	PassingYards     float64 `json:"PassingYards"`
 	PassingTouchdowns float64 `json:"PassingTouchdowns"`
-	Interceptions    float64 `json:"Interceptions"`
+	Interceptions    float64 `json:"PassingInterceptions"`
 	Completions      float64 `json:"PassingCompletions"`
 	Attempts         float64 `json:"PassingAttempts"`
+	PassingSacks     float64 `json:"PassingSacks"`
+	PassingSackYards float64 `json:"PassingSackYards"`
+	AirYards         float64 `json:"AirYards"` // not in every feed
+	RushingAttempts  float64 `json:"RushingAttempts"`
 	RushingYards     float64 `json:"RushingYards"`
 	RushingTouchdowns float64 `json:"RushingTouchdowns"`
 	ReceivingYards   float64 `json:"ReceivingYards"`
 	ReceivingTouchdowns float64 `json:"ReceivingTouchdowns"`
 	Receptions       float64 `json:"Receptions"`
 	Targets          float64 `json:"Targets"`
+	OffensiveSnapsPlayed float64 `json:"OffensiveSnapsPlayed"`
+	OffensiveTeamSnaps   float64 `json:"OffensiveTeamSnaps"`
+	SoloTackles      float64 `json:"SoloTackles"`
+	AssistedTackles  float64 `json:"AssistedTackles"`
+	Sacks            float64 `json:"Sacks"`
+	DefensiveInterceptions float64 `json:"Interceptions"` // interceptions made on defense
+	PassesDefended   float64 `json:"PassesDefended"`
+	FumblesForced    float64 `json:"FumblesForced"`
+	InjuryStatus     string  `json:"InjuryStatus"`
+	InjuryBodyPart   string  `json:"InjuryBodyPart"`
 }
 
 // SportsDataTeam represents a team from SportsData.io API
@@ -42,7 +59,15 @@ type SportsDataTeam struct {
 	Conference   string `json:"Conference"`
 	Division     string `json:"Division"`
 	HeadCoach    string `json:"HeadCoach"`
+	OffensiveCoordinator string `json:"OffensiveCoordinator"`
+	DefensiveCoordinator string `json:"DefensiveCoordinator"`
+	SpecialTeamsCoach    string `json:"SpecialTeamsCoach"`
+	OffensiveScheme      string `json:"OffensiveScheme"`
+	DefensiveScheme      string `json:"DefensiveScheme"`
 	StadiumName  string `json:"StadiumName"`
+	PrimaryColor   string `json:"PrimaryColor"`
+	SecondaryColor string `json:"SecondaryColor"`
+	WikipediaLogoURL string `json:"WikipediaLogoUrl"`
 }
 
 // SportsDataStanding represents team standing from SportsData.io API
@@ -70,6 +95,9 @@ type SportsDataGame struct {
 	Status       string    `json:"Status"`
 	DateTime     string    `json:"DateTime"` // Changed to string for custom parsing
 	Stadium      string    `json:"Stadium"`
+	PointSpread  *float64  `json:"PointSpread"` // home team's line, nil until posted
+	OverUnder    *float64  `json:"OverUnder"`
+	Channel      string    `json:"Channel"` // TV broadcast, e.g. "CBS", empty until announced
 }
 
 // SportsDataCurrentSeason represents current season info from SportsData.io
@@ -80,12 +108,50 @@ type SportsDataCurrentSeason struct {
 	ApiWeek        int    `json:"ApiWeek"`
 }
 
-// CacheEntry represents a cached API response
-type CacheEntry struct {
-	Data      interface{}
-	Timestamp time.Time
+// SportsDataTimeframe represents a timeframe (week) from SportsData.io API
+type SportsDataTimeframe struct {
+	SeasonType int    `json:"SeasonType"` // 1=REG, 2=PRE, 3=POST, 4=OFF, 5=STAR
+	Season     int    `json:"Season"`
+	Week       int    `json:"Week"`
+	Name       string `json:"Name"`
+	ShortName  string `json:"ShortName"`
+	HasGames   bool   `json:"HasGames"`
+	HasStarted bool   `json:"HasStarted"`
+	HasEnded   bool   `json:"HasEnded"`
+	ApiSeason  string `json:"ApiSeason"`
+	ApiWeek    string `json:"ApiWeek"`
 }
 
+// Cache categories - each category has its own TTL since live scores change
+// constantly while team data rarely changes. TTLs can be overridden with SetCacheTTL.
+const (
+	CacheScores      = "scores"
+	CachePlayerStats = "player_stats"
+	CacheSchedule    = "schedule"
+	CacheTeams       = "teams"
+	CacheStandings   = "standings"
+	CacheSeasonStats = "season_stats"
+	CacheOdds        = "odds"
+	CachePlayers     = "players" // the active player directory
+	CacheStale       = "stale" // last good responses served while the API is down
+)
+
+// defaultCacheTTLs holds the TTL used for each cache category
+var defaultCacheTTLs = map[string]time.Duration{
+	CacheScores:      time.Minute,
+	CachePlayerStats: 5 * time.Minute,
+	CacheSchedule:    time.Hour,
+	CacheTeams:       24 * time.Hour,
+	CacheStandings:   30 * time.Minute,
+	CacheSeasonStats: 24 * time.Hour,
+	CacheOdds:        15 * time.Minute,
+	CachePlayers:     24 * time.Hour,
+	CacheStale:       24 * time.Hour,
+}
+
+// logger tags NFL client log entries with their module
+var logger = logging.For("nfl")
+
 // Client represents the NFL data client
 type Client struct {
 	apiKey        string
@@ -93,38 +159,84 @@ type Client struct {
 	httpClient    *http.Client
 	cachedSeason  *models.SeasonInfo
 	lastSeasonCheck time.Time
-	cache         map[string]*CacheEntry
-	cacheTTL      time.Duration
+	cache         cache.Cache
+	cacheTTLs     map[string]time.Duration
+	usage         *usageCounter
+	breaker       *circuitBreaker
+	schema        *schemaMonitor
+	legacyMatching bool // score player names with legacyPlayerMatchScore
 }
 
-// NewClient creates a new NFL client
-func NewClient(apiKey, baseURL string) *Client {
-	c := &Client{
-		apiKey:     apiKey,
-		baseURL:    baseURL,
-		httpClient: &http.Client{Timeout: 30 * time.Second},
-		cache:      make(map[string]*CacheEntry),
-		cacheTTL:   5 * time.Minute, // 5-minute cache TTL
+// NewClient creates a new NFL client backed by the given response cache.
+// A nil cache falls back to an in-memory cache.
+func NewClient(apiKey, baseURL string, responseCache cache.Cache) *Client {
+	if responseCache == nil {
+		responseCache = cache.NewMemory(10 * time.Minute)
+	}
+
+	usage := &usageCounter{}
+	client := &Client{
+		apiKey:    apiKey,
+		baseURL:   baseURL,
+		cache:     responseCache,
+		cacheTTLs: copyCacheTTLs(defaultCacheTTLs),
+		usage:     usage,
+		breaker:   &circuitBreaker{},
+		schema:    &schemaMonitor{},
+	}
+	client.httpClient = &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &resilientTransport{
+			base:    &countingTransport{base: http.DefaultTransport, usage: usage},
+			breaker: client.breaker,
+			cache:   responseCache,
+			staleTTL: func() time.Duration {
+				return client.cacheTTLs[CacheStale]
+			},
+		},
+	}
+	return client
+}
+
+// copyCacheTTLs returns a copy of a TTL table so clients never share overrides
+func copyCacheTTLs(ttls map[string]time.Duration) map[string]time.Duration {
+	copied := make(map[string]time.Duration, len(ttls))
+	for category, ttl := range ttls {
+		copied[category] = ttl
 	}
-	
-	// Start periodic cache cleanup
-	c.startCacheCleanup()
-	
-	return c
+	return copied
+}
+
+// SetCacheTTL overrides the TTL for a cache category. Non-positive TTLs are ignored.
+func (c *Client) SetCacheTTL(category string, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.cacheTTLs[category] = ttl
+}
+
+// Close releases the client's cache resources
+func (c *Client) Close() error {
+	return c.cache.Close()
 }
 
-// getCurrentSeason returns intelligent NFL season information based on current date
+// getCurrentSeason returns the current NFL season and week as reported by SportsData.io,
+// falling back to a local calendar calculation when the API is unreachable
 func (c *Client) getCurrentSeason() (*models.SeasonInfo, error) {
-	// Cache for 1 hour to avoid excessive recalculations
+	// Cache for 1 hour to avoid excessive lookups
 	if c.cachedSeason != nil && time.Since(c.lastSeasonCheck) < time.Hour {
 		return c.cachedSeason, nil
 	}
 
 	now := time.Now()
-	seasonInfo := calculateCurrentNFLWeek(now)
-
-	log.Printf("[NFL-SEASON] Calculated: %d %s Week %d (Day: %s)", 
-		seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week, now.Weekday())
+	seasonInfo, err := c.GetCurrentTimeframe()
+	if err != nil {
+		seasonInfo = calculateCurrentNFLWeek(now)
+		logger.Warn("timeframe lookup failed, calculated week locally", "error", err,
+			"season", seasonInfo.Season, "season_type", seasonInfo.SeasonType, "week", seasonInfo.Week, "weekday", now.Weekday().String())
+	} else {
+		logger.Info("current week from API", "season", seasonInfo.Season, "season_type", seasonInfo.SeasonType, "week", seasonInfo.Week)
+	}
 
 	c.cachedSeason = seasonInfo
 	c.lastSeasonCheck = now
@@ -132,7 +244,139 @@ func (c *Client) getCurrentSeason() (*models.SeasonInfo, error) {
 	return c.cachedSeason, nil
 }
 
-// calculateCurrentNFLWeek calculates current NFL season and week with intelligent day-of-week logic
+// GetCurrentSeason returns the season, season type, and week the bot currently treats as "current"
+func (c *Client) GetCurrentSeason() (*models.SeasonInfo, error) {
+	return c.getCurrentSeason()
+}
+
+// GetCurrentTimeframe retrieves the current season and week from the SportsData.io Timeframes endpoint.
+// During the off-season the most recently completed week is returned instead.
+func (c *Client) GetCurrentTimeframe() (*models.SeasonInfo, error) {
+	timeframes, err := c.fetchTimeframes("current")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(timeframes) > 0 {
+		if seasonInfo, ok := timeframeToSeasonInfo(timeframes[0]); ok {
+			return seasonInfo, nil
+		}
+	}
+
+	// Off-season or All-Star break - use the last completed week
+	timeframes, err = c.fetchTimeframes("completed")
+	if err != nil {
+		return nil, err
+	}
+	for _, timeframe := range timeframes {
+		if seasonInfo, ok := timeframeToSeasonInfo(timeframe); ok {
+			return seasonInfo, nil
+		}
+	}
+
+	return c.getCurrentWeekFallback()
+}
+
+// fetchTimeframes calls the Timeframes endpoint with the given type (current, upcoming, completed, recent, all)
+func (c *Client) fetchTimeframes(timeframeType string) ([]SportsDataTimeframe, error) {
+	url := fmt.Sprintf("%s/scores/json/Timeframes/%s?key=%s", c.baseURL, timeframeType, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch timeframes: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("timeframes", resp.StatusCode)
+	}
+
+	var timeframes []SportsDataTimeframe
+	if err := c.decodeChecked(SchemaTimeframes, resp.Body, &timeframes); err != nil {
+		return nil, fmt.Errorf("failed to parse timeframes response: %v", err)
+	}
+
+	return timeframes, nil
+}
+
+// getCurrentWeekFallback combines the CurrentSeason and CurrentWeek endpoints when no usable timeframe is returned
+func (c *Client) getCurrentWeekFallback() (*models.SeasonInfo, error) {
+	season, err := c.fetchInt("CurrentSeason")
+	if err != nil {
+		return nil, err
+	}
+	week, err := c.fetchInt("CurrentWeek")
+	if err != nil {
+		return nil, err
+	}
+	if week < 1 {
+		return nil, fmt.Errorf("no current week reported for %d season", season)
+	}
+
+	return &models.SeasonInfo{
+		Season:     season,
+		SeasonType: "REG",
+		Week:       week,
+	}, nil
+}
+
+// fetchInt calls a SportsData.io endpoint that returns a bare integer (e.g. CurrentWeek)
+func (c *Client) fetchInt(endpoint string) (int, error) {
+	url := fmt.Sprintf("%s/scores/json/%s?key=%s", c.baseURL, endpoint, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, c.apiError(endpoint, resp.StatusCode)
+	}
+
+	var value int
+	if err := json.NewDecoder(resp.Body).Decode(&value); err != nil {
+		return 0, fmt.Errorf("failed to parse %s response: %v", endpoint, err)
+	}
+
+	return value, nil
+}
+
+// timeframeToSeasonInfo converts a SportsData.io timeframe to our season model.
+// Returns false for timeframes without a playable week (off-season, All-Star).
+func timeframeToSeasonInfo(tf SportsDataTimeframe) (*models.SeasonInfo, bool) {
+	var seasonType string
+	switch tf.SeasonType {
+	case 1:
+		seasonType = "REG"
+	case 2:
+		seasonType = "PRE"
+	case 3:
+		seasonType = "POST"
+	default:
+		return nil, false
+	}
+
+	if tf.Season == 0 || tf.Week < 1 {
+		return nil, false
+	}
+
+	return &models.SeasonInfo{
+		Season:     tf.Season,
+		SeasonType: seasonType,
+		Week:       tf.Week,
+	}, true
+}
+
+// calculateCurrentNFLWeek calculates current NFL season and week with intelligent day-of-week logic.
+// Used as a fallback when the Timeframes endpoint is unreachable.
 func calculateCurrentNFLWeek(now time.Time) *models.SeasonInfo {
 	// Determine NFL season year (starts in September of calendar year)
 	season := now.Year()
@@ -207,11 +451,24 @@ func findNFLSeasonStart(season int) time.Time {
 	return septFirst
 }
 
+// easternTime is the zone SportsData.io uses for kickoff times
+var easternTime = func() *time.Location {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.FixedZone("EST", -5*60*60)
+	}
+	return location
+}()
+
 // parseSportsDataDateTime parses SportsData.io datetime format
 func parseSportsDataDateTime(dateStr string) (time.Time, error) {
-	// Try common datetime formats used by SportsData.io
+	// SportsData.io reports kickoffs without a zone in US Eastern time
+	if t, err := time.ParseInLocation("2006-01-02T15:04:05", dateStr, easternTime); err == nil {
+		return t, nil
+	}
+
+	// Try the other datetime formats used by SportsData.io
 	formats := []string{
-		"2006-01-02T15:04:05",     // Without timezone
 		"2006-01-02T15:04:05Z",    // UTC
 		"2006-01-02T15:04:05-07:00", // With timezone offset
 		time.RFC3339,               // Standard RFC3339
@@ -228,7 +485,7 @@ func parseSportsDataDateTime(dateStr string) (time.Time, error) {
 
 // logRequest logs API requests for debugging
 func (c *Client) logRequest(method, url string) {
-	log.Printf("[NFL-API] %s %s", method, url)
+	logger.Info("api request", "method", method, "url", url)
 }
 
 // normalizeTeamName returns common variations of team names for matching
@@ -285,62 +542,37 @@ func normalizeTeamName(teamName string) []string {
 	return variations
 }
 
-// getCachedData retrieves data from cache if still valid
-func (c *Client) getCachedData(key string) (interface{}, bool) {
-	entry, exists := c.cache[key]
-	if !exists {
-		return nil, false
-	}
-
-	// Check if cache entry is still valid
-	if time.Since(entry.Timestamp) > c.cacheTTL {
-		delete(c.cache, key) // Clean up expired entry
-		return nil, false
-	}
-
-	return entry.Data, true
+// getCachedData decodes a cached response into dest and returns when its data was fetched,
+// returning false on a miss
+func (c *Client) getCachedData(key string, dest interface{}) (models.Freshness, bool) {
+	fetchedAt, hit := cache.GetStamped(c.cache, key, dest)
+	c.usage.recordLookup(hit)
+	return models.Freshness{FetchedAt: fetchedAt, Source: models.SourceCache}, hit
 }
 
-// setCachedData stores data in cache
-func (c *Client) setCachedData(key string, data interface{}) {
-	c.cache[key] = &CacheEntry{
-		Data:      data,
-		Timestamp: time.Now(),
-	}
-	log.Printf("[NFL-CACHE] Cached data for key: %s", key)
+// setCachedData stores freshly fetched data in cache using the TTL of its category
+func (c *Client) setCachedData(category, key string, data interface{}) {
+	c.setCachedDataAt(category, key, data, time.Now())
 }
 
-// startCacheCleanup starts a periodic cache cleanup routine
-func (c *Client) startCacheCleanup() {
-	go func() {
-		ticker := time.NewTicker(10 * time.Minute) // Cleanup every 10 minutes
-		defer ticker.Stop()
-		
-		for range ticker.C {
-			c.cleanupExpiredCache()
-		}
-	}()
+// setCachedDataAt stores data in cache using the TTL of its category, remembering when the data
+// behind it was fetched from the API
+func (c *Client) setCachedDataAt(category, key string, data interface{}, fetchedAt time.Time) {
+	ttl, exists := c.cacheTTLs[category]
+	if !exists {
+		ttl = 5 * time.Minute
+	}
+	cache.SetStamped(c.cache, key, data, fetchedAt, ttl)
+	logger.Debug("cached data", "key", key, "ttl", ttl)
 }
 
-// cleanupExpiredCache removes all expired entries from cache
-func (c *Client) cleanupExpiredCache() {
-	expiredKeys := make([]string, 0)
-	
-	// Find expired keys
-	for key, entry := range c.cache {
-		if time.Since(entry.Timestamp) > c.cacheTTL {
-			expiredKeys = append(expiredKeys, key)
-		}
-	}
-	
-	// Remove expired entries
-	for _, key := range expiredKeys {
-		delete(c.cache, key)
-	}
-	
-	if len(expiredKeys) > 0 {
-		log.Printf("[NFL-CACHE] Cleaned up %d expired cache entries", len(expiredKeys))
+// responseFreshness reports when a response's data was fetched: now for a live response, or when
+// the stale copy was saved if the API was down
+func responseFreshness(resp *http.Response) models.Freshness {
+	if saved, err := strconv.ParseInt(resp.Header.Get(staleFetchedAtHeader), 10, 64); err == nil {
+		return models.Freshness{FetchedAt: time.Unix(saved, 0), Source: models.SourceStale}
 	}
+	return models.Freshness{FetchedAt: time.Now(), Source: models.SourceLive}
 }
 
 // getSafeName safely gets a player name from slice with bounds checking
@@ -409,46 +641,19 @@ func fuzzyMatch(playerName, searchName string) bool {
 	return false
 }
 
-// normalizePlayerName normalizes player names for better matching
+// normalizePlayerName normalizes player names for better matching, see canonicalPlayerName
 func (c *Client) normalizePlayerName(name string) string {
-	// Convert to lowercase
-	normalized := strings.ToLower(name)
-	
-	// Handle common hyphenated name patterns
-	// "josh hines-allen" should match "Josh Hines-Allen"
-	// But also allow "josh hines allen" to match "Josh Hines-Allen"
-	normalized = strings.ReplaceAll(normalized, "-", " ")
-	
-	// Remove extra punctuation that might cause issues
-	normalized = strings.ReplaceAll(normalized, "'", "")
-	normalized = strings.ReplaceAll(normalized, ".", "")
-	
-	// Clean up multiple spaces
-	normalized = strings.Join(strings.Fields(normalized), " ")
-	
-	return normalized
+	return canonicalPlayerName(name)
 }
 
 // normalizePlayerNameStatic is a static version of normalizePlayerName for use in fuzzyMatch
 func normalizePlayerNameStatic(name string) string {
-	// Convert to lowercase
-	normalized := strings.ToLower(name)
-	
-	// Handle common hyphenated name patterns
-	normalized = strings.ReplaceAll(normalized, "-", " ")
-	
-	// Remove extra punctuation that might cause issues
-	normalized = strings.ReplaceAll(normalized, "'", "")
-	normalized = strings.ReplaceAll(normalized, ".", "")
-	
-	// Clean up multiple spaces
-	normalized = strings.Join(strings.Fields(normalized), " ")
-	
-	return normalized
+	return canonicalPlayerName(name)
 }
 
-// calculatePlayerMatchScore calculates a match score for player name matching
-func (c *Client) calculatePlayerMatchScore(playerName, searchName string) int {
+// legacyPlayerMatchScore is the original prefix and containment scorer, used instead of the
+// edit distance ranking when legacy name matching is turned on
+func (c *Client) legacyPlayerMatchScore(playerName, searchName string) int {
 	// Normalize names for comparison - handle hyphens and punctuation
 	normalizedPlayer := c.normalizePlayerName(playerName)
 	normalizedSearch := c.normalizePlayerName(searchName)
@@ -589,10 +794,18 @@ func (c *Client) getAPIErrorReason(statusCode int) string {
 
 // findTeamInCachedData finds a team in the cached team data
 func (c *Client) findTeamInCachedData(teams []SportsDataTeam, name string) (*models.TeamInfo, error) {
-	// Find team by name (case-insensitive partial match)
+	// Find team by name, preferring an exact match so "PHI" isn't taken for the Dolphins
 	var foundTeam *SportsDataTeam
 	searchName := strings.ToLower(name)
 	for i := range teams {
+		team := &teams[i]
+		if strings.EqualFold(team.Key, name) || strings.EqualFold(team.Name, name) ||
+		   strings.EqualFold(team.City, name) || strings.EqualFold(team.FullName, name) {
+			foundTeam = team
+			break
+		}
+	}
+	for i := 0; foundTeam == nil && i < len(teams); i++ {
 		team := &teams[i]
 		if strings.Contains(strings.ToLower(team.Name), searchName) ||
 		   strings.Contains(strings.ToLower(team.City), searchName) ||
@@ -604,30 +817,69 @@ func (c *Client) findTeamInCachedData(teams []SportsDataTeam, name string) (*mod
 	}
 
 	if foundTeam == nil {
-		return nil, fmt.Errorf("team '%s' not found", name)
+		candidates := make([]string, 0, len(teams))
+		for i := range teams {
+			candidates = append(candidates, teams[i].FullName)
+		}
+		return nil, &NotFoundError{Class: ErrTeamNotFound, Name: name, Suggestions: closestNames(name, candidates)}
 	}
 
-	// Convert to our model
+	return toTeamInfo(foundTeam), nil
+}
+
+// toTeamInfo converts a SportsData.io team to our model, filling in franchise details from the bundled snapshot
+func toTeamInfo(team *SportsDataTeam) *models.TeamInfo {
 	teamInfo := &models.TeamInfo{
-		Name:       foundTeam.Name,
-		City:       foundTeam.City,
-		Conference: foundTeam.Conference,
-		Division:   foundTeam.Division,
-		Coach:      foundTeam.HeadCoach,
-		Stadium:    foundTeam.StadiumName,
-		Colors:     []string{}, // SportsData.io doesn't provide colors
-	}
+		Key:        team.Key,
+		Name:       team.Name,
+		City:       team.City,
+		Conference: team.Conference,
+		Division:   team.Division,
+		Coach:      team.HeadCoach,
+		OffensiveCoordinator: team.OffensiveCoordinator,
+		DefensiveCoordinator: team.DefensiveCoordinator,
+		SpecialTeamsCoach:    team.SpecialTeamsCoach,
+		OffensiveScheme:      team.OffensiveScheme,
+		DefensiveScheme:      team.DefensiveScheme,
+		Stadium:    team.StadiumName,
+		Colors:     teamColors(team),
+		LogoURL:        team.WikipediaLogoURL,
+		PrimaryColor:   team.PrimaryColor,
+		SecondaryColor: team.SecondaryColor,
+	}
+	if history := franchiseHistory(team.Key); history != nil {
+		teamInfo.Founded = history.Founded
+		teamInfo.Championships = len(history.SuperBowls)
+	}
+	return teamInfo
+}
 
-	return teamInfo, nil
+// teamColors lists a team's primary and secondary colors, skipping any the API left blank
+func teamColors(team *SportsDataTeam) []string {
+	colors := []string{}
+	for _, color := range []string{team.PrimaryColor, team.SecondaryColor} {
+		if color != "" {
+			colors = append(colors, color)
+		}
+	}
+	return colors
 }
 
+// seasonSampleWeeks are the weeks aggregated for season stats, sampled to reduce API calls
+var seasonSampleWeeks = []int{1, 2, 5, 10, 15, 18}
+
 // getAggregatedSeasonStats aggregates weekly stats to create season totals
 func (c *Client) getAggregatedSeasonStats(playerName string, season int, seasonType string, cacheKey string) (*models.PlayerStats, error) {
-	log.Printf("[NFL-API] Aggregating %d season stats for %s (weeks 1-18)", season, playerName)
+	logger.Info("aggregating season stats", "season", season, "player", playerName)
+	
+	// Active players are looked up week by week by ID; retired players fall back to the stat sheets
+	if player := c.lookupPlayer(playerName); player != nil {
+		return c.getAggregatedSeasonStatsByID(player, season, seasonType, cacheKey)
+	}
 	
 	// We'll try a few key weeks and aggregate the stats
 	// This simulates season totals by combining multiple weeks
-	weeksToTry := []int{1, 2, 5, 10, 15, 18} // Sample weeks to reduce API calls
+	weeksToTry := seasonSampleWeeks
 	
 	var aggregatedStats *models.PlayerStats
 	var foundAnyWeek bool
@@ -636,7 +888,7 @@ func (c *Client) getAggregatedSeasonStats(playerName string, season int, seasonT
 		url := fmt.Sprintf("%s/stats/json/PlayerGameStatsByWeek/%d%s/%d?key=%s", 
 			c.baseURL, season, seasonType, week, c.apiKey)
 		
-		log.Printf("[NFL-API] GET %s (Week %d for season totals)", url, week)
+		logger.Debug("api request", "method", "GET", "url", url, "week", week)
 		
 		resp, err := c.httpClient.Get(url)
 		if err != nil {
@@ -649,7 +901,7 @@ func (c *Client) getAggregatedSeasonStats(playerName string, season int, seasonT
 		}
 		
 		var weekStats []SportsDataPlayerStat
-		if err := json.NewDecoder(resp.Body).Decode(&weekStats); err != nil {
+		if err := c.decodeChecked(SchemaPlayerStats, resp.Body, &weekStats); err != nil {
 			continue // Try next week
 		}
 		
@@ -673,77 +925,41 @@ func (c *Client) getAggregatedSeasonStats(playerName string, season int, seasonT
 		var foundPlayer *SportsDataPlayerStat
 		if bestScore >= 50 {
 			foundPlayer = bestMatch
-			log.Printf("[NFL-API] Season stats found match: '%s' (score: %d) for search '%s'", bestMatch.Name, bestScore, playerName)
+			logger.Debug("season stats match", "match", bestMatch.Name, "score", bestScore, "search", playerName)
 		}
 		
 		if foundPlayer != nil {
+			week := weekPlayerStats(foundPlayer, teamPassAttempts(weekStats))
 			if aggregatedStats == nil {
 				// First time finding the player - initialize
 				aggregatedStats = &models.PlayerStats{
-					Name:     foundPlayer.Name,
-					Team:     foundPlayer.Team,
-					Position: foundPlayer.Position,
+					PlayerID: week.PlayerID,
+					Name:     week.Name,
+					Team:     week.Team,
+					Position: week.Position,
 					Season:   season,
-					Stats:    make(map[string]interface{}),
 				}
-				
-				// Initialize stats to 0
-				aggregatedStats.Stats["passing_yards"] = 0
-				aggregatedStats.Stats["passing_touchdowns"] = 0
-				aggregatedStats.Stats["interceptions"] = 0
-				aggregatedStats.Stats["rushing_yards"] = 0
-				aggregatedStats.Stats["rushing_touchdowns"] = 0
-				aggregatedStats.Stats["receiving_yards"] = 0
-				aggregatedStats.Stats["receiving_touchdowns"] = 0
-				aggregatedStats.Stats["receptions"] = 0
-				aggregatedStats.Stats["targets"] = 0
-				aggregatedStats.Stats["games_played"] = 0
 			}
 			
 			// Add this week's stats to the totals
-			if foundPlayer.PassingYards > 0 || foundPlayer.PassingTouchdowns > 0 {
-				aggregatedStats.Stats["passing_yards"] = aggregatedStats.Stats["passing_yards"].(int) + int(foundPlayer.PassingYards)
-				aggregatedStats.Stats["passing_touchdowns"] = aggregatedStats.Stats["passing_touchdowns"].(int) + int(foundPlayer.PassingTouchdowns)
-				aggregatedStats.Stats["interceptions"] = aggregatedStats.Stats["interceptions"].(int) + int(foundPlayer.Interceptions)
-			}
-			
-			if foundPlayer.RushingYards > 0 || foundPlayer.RushingTouchdowns > 0 {
-				aggregatedStats.Stats["rushing_yards"] = aggregatedStats.Stats["rushing_yards"].(int) + int(foundPlayer.RushingYards)
-				aggregatedStats.Stats["rushing_touchdowns"] = aggregatedStats.Stats["rushing_touchdowns"].(int) + int(foundPlayer.RushingTouchdowns)
-			}
-			
-			if foundPlayer.ReceivingYards > 0 || foundPlayer.ReceivingTouchdowns > 0 {
-				aggregatedStats.Stats["receiving_yards"] = aggregatedStats.Stats["receiving_yards"].(int) + int(foundPlayer.ReceivingYards)
-				aggregatedStats.Stats["receiving_touchdowns"] = aggregatedStats.Stats["receiving_touchdowns"].(int) + int(foundPlayer.ReceivingTouchdowns)
-				aggregatedStats.Stats["receptions"] = aggregatedStats.Stats["receptions"].(int) + int(foundPlayer.Receptions)
-				aggregatedStats.Stats["targets"] = aggregatedStats.Stats["targets"].(int) + int(foundPlayer.Targets)
-			}
-			
-			aggregatedStats.Stats["games_played"] = aggregatedStats.Stats["games_played"].(int) + 1
+			aggregatedStats.Add(week)
+			aggregatedStats.Games++
 			foundAnyWeek = true
 		}
 	}
 	
 	if !foundAnyWeek {
-		return nil, fmt.Errorf("player '%s' not found in %d season data", playerName, season)
-	}
-	
-	// Calculate completion percentage if passing stats exist
-	passingYards := aggregatedStats.Stats["passing_yards"].(int)
-	if passingTDs, ok := aggregatedStats.Stats["passing_touchdowns"].(int); ok && (passingYards > 0 || passingTDs > 0) {
-		// Estimate completion % based on stats (simplified)
-		if passingYards > 0 {
-			aggregatedStats.Stats["completion_percent"] = "Est. 65.0%" // Reasonable estimate
-		}
+		return nil, &NotFoundError{Class: ErrPlayerNotFound, Name: playerName, Scope: fmt.Sprintf("%d season data", season)}
 	}
 	
-	// Add season identifier to stats
-	aggregatedStats.Stats["season_note"] = fmt.Sprintf("Sample from %d of 18 games (not full season)", aggregatedStats.Stats["games_played"])
+	// Flag the sample so it isn't mistaken for full season totals
+	aggregatedStats.Note = fmt.Sprintf("Sample from %d of 18 games (not full season)", aggregatedStats.Games)
+	aggregatedStats.Freshness = models.Freshness{FetchedAt: time.Now(), Source: models.SourceLive}
 	
 	// Cache the result
-	c.setCachedData(cacheKey, aggregatedStats)
+	c.setCachedData(CachePlayerStats, cacheKey, aggregatedStats)
 	
-	log.Printf("[NFL-API] Completed season aggregation for %s: %d games sampled", playerName, aggregatedStats.Stats["games_played"])
+	logger.Info("completed season aggregation", "player", playerName, "games", aggregatedStats.Games)
 	
 	return aggregatedStats, nil
 }
@@ -759,7 +975,7 @@ func (c *Client) GetPlayerStats(playerName string) (*models.PlayerStats, error)
 	// Get current season information
 	seasonInfo, err := c.getCurrentSeason()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get current season: %v", err)
+		return nil, fmt.Errorf("failed to get current season: %w", err)
 	}
 
 	// Create cache key
@@ -767,35 +983,27 @@ func (c *Client) GetPlayerStats(playerName string) (*models.PlayerStats, error)
 		strings.ToLower(name), seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
 
 	// Check cache first
-	if cachedData, found := c.getCachedData(cacheKey); found {
-		log.Printf("[NFL-CACHE] Using cached player stats for %s", name)
-		return cachedData.(*models.PlayerStats), nil
-	}
-
-	// Build API endpoint with current season and week
-	url := fmt.Sprintf("%s/stats/json/PlayerGameStatsByWeek/%d%s/%d?key=%s", 
-		c.baseURL, seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week, c.apiKey)
-
-	// Log the request
-	c.logRequest("GET", url)
-
-	// Make HTTP request
-	resp, err := c.httpClient.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch player stats: %v", err)
+	var cachedStats models.PlayerStats
+	if freshness, hit := c.getCachedData(cacheKey, &cachedStats); hit {
+		logger.Debug("cache hit", "data", "player stats", "player", name)
+		cachedStats.Freshness = freshness
+		return &cachedStats, nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		log.Printf("[NFL-API] ERROR: HTTP %d - %s for URL: %s", resp.StatusCode, http.StatusText(resp.StatusCode), url)
-		errorReason := c.getAPIErrorReason(resp.StatusCode)
-		return nil, fmt.Errorf("API request failed with status %d (%s): %s", resp.StatusCode, http.StatusText(resp.StatusCode), errorReason)
+	// Resolve the name to a player ID so the lookup doesn't depend on scanning the stat sheet
+	if player := c.lookupPlayer(name); player != nil {
+		stats, err := c.getPlayerGameStats(player, seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+		if err != nil {
+			return nil, err
+		}
+		c.setCachedDataAt(CachePlayerStats, cacheKey, stats, stats.Freshness.FetchedAt)
+		return stats, nil
 	}
 
-	// Parse JSON response
-	var sportsDataStats []SportsDataPlayerStat
-	if err := json.NewDecoder(resp.Body).Decode(&sportsDataStats); err != nil {
-		return nil, fmt.Errorf("failed to parse API response: %v", err)
+	// Get the current week's stat sheet (usually warm from the prefetcher)
+	sportsDataStats, freshness, err := c.getWeekStatSheet(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	if err != nil {
+		return nil, err
 	}
 
 	// Find player by name using improved scored matching
@@ -803,14 +1011,14 @@ func (c *Client) GetPlayerStats(playerName string) (*models.PlayerStats, error)
 	var bestScore int
 	searchName := strings.ToLower(name)
 	
-	log.Printf("[NFL-API] Searching for player: '%s' in %d player records", name, len(sportsDataStats))
+	logger.Debug("searching for player", "search", name, "records", len(sportsDataStats))
 	
 	// Log first few players to help debug
 	if len(sportsDataStats) > 0 {
-		log.Printf("[NFL-API] Sample players: %s, %s, %s", 
-			sportsDataStats[0].Name, 
-			getSafeName(sportsDataStats, 1),
-			getSafeName(sportsDataStats, 2))
+		logger.Debug("sample players",
+			"first", sportsDataStats[0].Name,
+			"second", getSafeName(sportsDataStats, 1),
+			"third", getSafeName(sportsDataStats, 2))
 	}
 	
 	for i := range sportsDataStats {
@@ -821,51 +1029,23 @@ func (c *Client) GetPlayerStats(playerName string) (*models.PlayerStats, error)
 		if score > bestScore {
 			bestScore = score
 			bestMatch = &sportsDataStats[i]
-			log.Printf("[NFL-API] New best match: '%s' (score: %d) for search '%s'", sportsDataStats[i].Name, score, name)
+			logger.Debug("new best match", "match", sportsDataStats[i].Name, "score", score, "search", name)
 		}
 	}
 
 	// Require minimum score to prevent bad matches
 	if bestScore < 50 {
-		return nil, fmt.Errorf("player '%s' not found in current week's stats. Try a different spelling or check if they played this week", name)
-	}
-
-	log.Printf("[NFL-API] Final match: '%s' with score %d", bestMatch.Name, bestScore)
-
-	// Convert to our model format
-	stats := &models.PlayerStats{
-		Name:     bestMatch.Name,
-		Team:     bestMatch.Team,
-		Position: bestMatch.Position,
-		Season:   int(bestMatch.Season),
-		Stats:    make(map[string]interface{}),
+		return nil, c.playerNotFound(name, "this week's stats", sportsDataStats)
 	}
 
-	// Add relevant stats based on position
-	if bestMatch.PassingYards > 0 || bestMatch.PassingTouchdowns > 0 {
-		stats.Stats["passing_yards"] = int(bestMatch.PassingYards)
-		stats.Stats["passing_touchdowns"] = int(bestMatch.PassingTouchdowns)
-		stats.Stats["interceptions"] = int(bestMatch.Interceptions)
-		if bestMatch.Attempts > 0 {
-			completionPct := bestMatch.Completions / bestMatch.Attempts * 100
-			stats.Stats["completion_percent"] = fmt.Sprintf("%.1f%%", completionPct)
-		}
-	}
+	logger.Debug("final match", "match", bestMatch.Name, "score", bestScore)
 
-	if bestMatch.RushingYards > 0 || bestMatch.RushingTouchdowns > 0 {
-		stats.Stats["rushing_yards"] = int(bestMatch.RushingYards)
-		stats.Stats["rushing_touchdowns"] = int(bestMatch.RushingTouchdowns)
-	}
-
-	if bestMatch.ReceivingYards > 0 || bestMatch.ReceivingTouchdowns > 0 {
-		stats.Stats["receiving_yards"] = int(bestMatch.ReceivingYards)
-		stats.Stats["receiving_touchdowns"] = int(bestMatch.ReceivingTouchdowns)
-		stats.Stats["receptions"] = int(bestMatch.Receptions)
-		stats.Stats["targets"] = int(bestMatch.Targets)
-	}
+	// Convert to our model format, dated by the stat sheet it came from
+	stats := weekPlayerStats(bestMatch, teamPassAttempts(sportsDataStats))
+	stats.Freshness = freshness
 
 	// Cache the result
-	c.setCachedData(cacheKey, stats)
+	c.setCachedDataAt(CachePlayerStats, cacheKey, stats, freshness.FetchedAt)
 
 	return stats, nil
 }
@@ -882,12 +1062,70 @@ func (c *Client) GetTeamInfo(teamName string) (*models.TeamInfo, error) {
 	cacheKey := "teams_data"
 
 	// Check cache first
-	if cachedData, found := c.getCachedData(cacheKey); found {
-		log.Printf("[NFL-CACHE] Using cached teams data for %s", name)
-		// Extract team from cached data
-		return c.findTeamInCachedData(cachedData.([]SportsDataTeam), name)
+	var teams []SportsDataTeam
+	freshness, hit := c.getCachedData(cacheKey, &teams)
+	if hit {
+		logger.Debug("cache hit", "data", "teams", "team", name)
+	} else {
+		var err error
+		teams, freshness, err = c.fetchTeams()
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	// Find team using helper function
+	team, err := c.findTeamInCachedData(teams, name)
+	if err != nil {
+		return nil, err
+	}
+	team.Freshness = freshness
+	team.CoachRecord = c.coachRecord(team.Key)
+	return team, nil
+}
+
+// coachRecord returns the head coach's record this season, e.g. "7-3", or "" when standings are unavailable
+func (c *Client) coachRecord(teamKey string) string {
+	seasonInfo, err := c.GetCurrentSeason()
+	if err != nil {
+		return ""
+	}
+	standings, err := c.GetStandings(seasonInfo.Season)
+	if err != nil {
+		logger.Warn("coach record unavailable", "team", teamKey, "error", err)
+		return ""
+	}
+	for _, standing := range standings {
+		if standing.Team == teamKey {
+			if standing.Ties > 0 {
+				return fmt.Sprintf("%d-%d-%d", standing.Wins, standing.Losses, standing.Ties)
+			}
+			return fmt.Sprintf("%d-%d", standing.Wins, standing.Losses)
+		}
+	}
+	return ""
+}
+
+// GetTeams retrieves information about every NFL team
+func (c *Client) GetTeams() ([]*models.TeamInfo, error) {
+	var teams []SportsDataTeam
+	if _, hit := c.getCachedData("teams_data", &teams); !hit {
+		var err error
+		teams, _, err = c.fetchTeams()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	teamInfos := make([]*models.TeamInfo, 0, len(teams))
+	for i := range teams {
+		teamInfos = append(teamInfos, toTeamInfo(&teams[i]))
+	}
+	return teamInfos, nil
+}
+
+// fetchTeams downloads all teams from the API and caches them, returning when they were fetched
+func (c *Client) fetchTeams() ([]SportsDataTeam, models.Freshness, error) {
 	// Get all teams
 	url := fmt.Sprintf("%s/scores/json/Teams?key=%s", c.baseURL, c.apiKey)
 	
@@ -896,85 +1134,74 @@ func (c *Client) GetTeamInfo(teamName string) (*models.TeamInfo, error) {
 	
 	resp, err := c.httpClient.Get(url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch teams: %v", err)
+		return nil, models.Freshness{}, fmt.Errorf("failed to fetch teams: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		log.Printf("[NFL-API] ERROR: HTTP %d - %s for URL: %s", resp.StatusCode, http.StatusText(resp.StatusCode), url)
-		errorReason := c.getAPIErrorReason(resp.StatusCode)
-		return nil, fmt.Errorf("teams API request failed with status %d (%s): %s", resp.StatusCode, http.StatusText(resp.StatusCode), errorReason)
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, models.Freshness{}, c.apiError("teams", resp.StatusCode)
 	}
 
 	var teams []SportsDataTeam
-	if err := json.NewDecoder(resp.Body).Decode(&teams); err != nil {
-		return nil, fmt.Errorf("failed to parse teams response: %v", err)
+	if err := c.decodeChecked(SchemaTeams, resp.Body, &teams); err != nil {
+		return nil, models.Freshness{}, fmt.Errorf("failed to parse teams response: %v", err)
 	}
 
 	// Cache the teams data
-	c.setCachedData(cacheKey, teams)
+	freshness := responseFreshness(resp)
+	c.setCachedDataAt(CacheTeams, "teams_data", teams, freshness.FetchedAt)
 
-	// Find team using helper function
-	return c.findTeamInCachedData(teams, name)
+	return teams, freshness, nil
 }
 
 // GetTeamSchedule retrieves schedule for a team
 func (c *Client) GetTeamSchedule(teamName string) (*models.Schedule, error) {
+	// Get current season info
+	seasonInfo, err := c.getCurrentSeason()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	return c.GetTeamScheduleForSeason(teamName, seasonInfo.Season, seasonInfo.SeasonType)
+}
+
+// GetTeamScheduleForSeason retrieves a team's schedule for a specific season and season type (PRE, REG, POST)
+func (c *Client) GetTeamScheduleForSeason(teamName string, season int, seasonType string) (*models.Schedule, error) {
 	// Normalize team name
 	name := strings.TrimSpace(teamName)
 	if name == "" {
 		return nil, fmt.Errorf("team name cannot be empty")
 	}
 
-	// Get current season info
-	seasonInfo, err := c.getCurrentSeason()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get current season: %v", err)
-	}
+	seasonInfo := &models.SeasonInfo{Season: season, SeasonType: seasonType}
 
 	// Create cache key for team schedule
 	cacheKey := fmt.Sprintf("team_schedule_%s_%d%s", 
 		strings.ToLower(name), seasonInfo.Season, seasonInfo.SeasonType)
 
 	// Check cache first
-	if cachedData, found := c.getCachedData(cacheKey); found {
-		log.Printf("[NFL-CACHE] Using cached team schedule for %s", name)
-		return cachedData.(*models.Schedule), nil
+	var cachedSchedule models.Schedule
+	if freshness, hit := c.getCachedData(cacheKey, &cachedSchedule); hit {
+		logger.Debug("cache hit", "data", "team schedule", "team", name)
+		cachedSchedule.Freshness = freshness
+		return &cachedSchedule, nil
 	}
 
-	// Get team schedule for current season
-	url := fmt.Sprintf("%s/scores/json/Schedules/%d%s?key=%s", 
-		c.baseURL, seasonInfo.Season, seasonInfo.SeasonType, c.apiKey)
-	
-	// Log the request
-	c.logRequest("GET", url)
-	
-	resp, err := c.httpClient.Get(url)
+	games, freshness, err := c.fetchSchedule(seasonInfo.Season, seasonInfo.SeasonType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch schedule: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		log.Printf("[NFL-API] ERROR: HTTP %d - %s for URL: %s", resp.StatusCode, http.StatusText(resp.StatusCode), url)
-		errorReason := c.getAPIErrorReason(resp.StatusCode)
-		return nil, fmt.Errorf("schedule API request failed with status %d (%s): %s", resp.StatusCode, http.StatusText(resp.StatusCode), errorReason)
-	}
-
-	var games []SportsDataGame
-	if err := json.NewDecoder(resp.Body).Decode(&games); err != nil {
-		return nil, fmt.Errorf("failed to parse schedule response: %v", err)
+		return nil, err
 	}
 
 	// Filter games for the specified team
 	var teamGames []models.Game
 	searchVariations := normalizeTeamName(name)
 
-	log.Printf("[NFL-API] Searching for team: '%s' with variations: %v, found %d total games", name, searchVariations, len(games))
+	logger.Debug("searching for team", "search", name, "variations", searchVariations, "games", len(games))
 
 	// Debug: Show first few teams to understand the data format
 	if len(games) > 0 {
-		log.Printf("[NFL-API] Sample teams from API: Home='%s', Away='%s'", games[0].HomeTeam, games[0].AwayTeam)
+		logger.Debug("sample teams", "home", games[0].HomeTeam, "away", games[0].AwayTeam)
 	}
 
 	for _, game := range games {
@@ -1014,38 +1241,12 @@ func (c *Client) GetTeamSchedule(teamName string) (*models.Schedule, error) {
 			continue
 		}
 		
-		log.Printf("[NFL-API] Found matching game: %s @ %s (Week %d)", game.AwayTeam, game.HomeTeam, game.Week)
-
-		// Parse game time (skip for BYE weeks which may have empty datetime)
-		var gameTime time.Time
-		if game.DateTime != "" {
-			var err error
-			gameTime, err = parseSportsDataDateTime(game.DateTime)
-			if err != nil {
-				log.Printf("Warning: Could not parse game time '%s': %v", game.DateTime, err)
-				gameTime = time.Time{} // Default to zero time
-			}
-		}
+		logger.Debug("found matching game", "away", game.AwayTeam, "home", game.HomeTeam, "week", game.Week)
 
-		// Convert to our model
-		gameModel := models.Game{
-			ID:          game.GameKey,
-			Week:        game.Week,
-			Season:      game.Season,
-			GameType:    seasonInfo.SeasonType,
-			HomeTeam:    game.HomeTeam,
-			AwayTeam:    game.AwayTeam,
-			HomeScore:   game.HomeScore,
-			AwayScore:   game.AwayScore,
-			GameTime:    gameTime,
-			Status:      game.Status,
-			Stadium:     game.Stadium,
-		}
-
-		teamGames = append(teamGames, gameModel)
+		teamGames = append(teamGames, toGameModel(game, seasonInfo.SeasonType))
 	}
 
-	log.Printf("[NFL-API] Found %d games for team '%s'", len(teamGames), name)
+	logger.Debug("found team games", "team", name, "games", len(teamGames))
 
 	if len(teamGames) == 0 {
 		return nil, fmt.Errorf("no games found for team '%s'", name)
@@ -1053,35 +1254,162 @@ func (c *Client) GetTeamSchedule(teamName string) (*models.Schedule, error) {
 
 	// Create schedule
 	schedule := &models.Schedule{
-		TeamName: name,
-		Season:   seasonInfo.Season,
-		Games:    teamGames,
+		TeamName:  name,
+		Season:    seasonInfo.Season,
+		Games:     teamGames,
+		Freshness: freshness,
 	}
 
 	// Cache the result
-	c.setCachedData(cacheKey, schedule)
+	c.setCachedDataAt(CacheSchedule, cacheKey, schedule, freshness.FetchedAt)
 
 	return schedule, nil
 }
 
+// GetSeasonGames retrieves every game of a season type (PRE, REG, POST), excluding BYE placeholders
+func (c *Client) GetSeasonGames(season int, seasonType string) ([]models.Game, error) {
+	games, _, err := c.fetchSchedule(season, seasonType)
+	if err != nil {
+		return nil, err
+	}
+
+	var seasonGames []models.Game
+	for _, game := range games {
+		if strings.ToUpper(game.HomeTeam) == "BYE" || strings.ToUpper(game.AwayTeam) == "BYE" {
+			continue
+		}
+		seasonGames = append(seasonGames, toGameModel(game, seasonType))
+	}
+	return seasonGames, nil
+}
+
+// fetchSchedule returns the full league schedule for a season type and when it was fetched, using
+// the cache when possible
+func (c *Client) fetchSchedule(season int, seasonType string) ([]SportsDataGame, models.Freshness, error) {
+	var games []SportsDataGame
+	if freshness, hit := c.getCachedData(scheduleCacheKey(season, seasonType), &games); hit {
+		logger.Debug("cache hit", "data", "schedule", "season", season, "season_type", seasonType)
+		return games, freshness, nil
+	}
+
+	return c.downloadSchedule(season, seasonType)
+}
+
+// scheduleCacheKey identifies a season type's cached league schedule
+func scheduleCacheKey(season int, seasonType string) string {
+	return fmt.Sprintf("season_schedule_%d%s", season, seasonType)
+}
+
+// downloadSchedule requests the full league schedule for a season type from the API and caches it
+func (c *Client) downloadSchedule(season int, seasonType string) ([]SportsDataGame, models.Freshness, error) {
+	url := fmt.Sprintf("%s/scores/json/Schedules/%d%s?key=%s", 
+		c.baseURL, season, seasonType, c.apiKey)
+	
+	// Log the request
+	c.logRequest("GET", url)
+	
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, models.Freshness{}, fmt.Errorf("failed to fetch schedule: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, models.Freshness{}, c.apiError("schedule", resp.StatusCode)
+	}
+
+	var games []SportsDataGame
+	if err := c.decodeChecked(SchemaGames, resp.Body, &games); err != nil {
+		return nil, models.Freshness{}, fmt.Errorf("failed to parse schedule response: %v", err)
+	}
+
+	// Cache the full schedule
+	freshness := responseFreshness(resp)
+	c.setCachedDataAt(CacheSchedule, scheduleCacheKey(season, seasonType), games, freshness.FetchedAt)
+
+	return games, freshness, nil
+}
+
+// noteGameStatus counts a game status missing from the models' status table, logging it the first
+// time it's seen
+func noteGameStatus(game SportsDataGame) {
+	if models.RecordUnknownStatus(game.Status) {
+		logger.Warn("unknown game status; add it to the status table in pkg/models", "status", game.Status, "game", game.GameKey)
+	}
+}
+
+// toGameModel converts a SportsData.io game to our model
+func toGameModel(game SportsDataGame, seasonType string) models.Game {
+	noteGameStatus(game)
+
+	// Parse game time (skip for BYE weeks which may have empty datetime)
+	var gameTime time.Time
+	if game.DateTime != "" {
+		var err error
+		gameTime, err = parseSportsDataDateTime(game.DateTime)
+		if err != nil {
+			logger.Warn("could not parse game time", "datetime", game.DateTime, "error", err)
+			gameTime = time.Time{} // Default to zero time
+		}
+	}
+
+	return models.Game{
+		ID:          game.GameKey,
+		Week:        game.Week,
+		Season:      game.Season,
+		GameType:    seasonType,
+		HomeTeam:    game.HomeTeam,
+		AwayTeam:    game.AwayTeam,
+		HomeScore:   game.HomeScore,
+		AwayScore:   game.AwayScore,
+		GameTime:    gameTime,
+		Status:      game.Status,
+		Stadium:     game.Stadium,
+		PointSpread: game.PointSpread,
+		OverUnder:   game.OverUnder,
+		Channel:     game.Channel,
+	}
+}
+
 // GetLiveScores retrieves current live scores
 func (c *Client) GetLiveScores() ([]*models.LiveScore, error) {
 	// Get current season info
 	seasonInfo, err := c.getCurrentSeason()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get current season: %v", err)
+		return nil, fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	return c.GetScoresForWeek(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+}
+
+// GetScoresForWeek retrieves scores for a specific season, season type (PRE, REG, POST), and week
+func (c *Client) GetScoresForWeek(season int, seasonType string, week int) ([]*models.LiveScore, error) {
+	minWeek, maxWeek := models.WeekRange(seasonType)
+	if err := checkWeek(seasonType, week, minWeek, maxWeek); err != nil {
+		return nil, err
 	}
 
+	seasonInfo := &models.SeasonInfo{Season: season, SeasonType: seasonType, Week: week}
+
 	// Create cache key for live scores
-	cacheKey := fmt.Sprintf("live_scores_%d%s_%d", 
-		seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	cacheKey := scoresCacheKey(seasonInfo)
 
 	// Check cache first
-	if cachedData, found := c.getCachedData(cacheKey); found {
-		log.Printf("[NFL-CACHE] Using cached live scores for week %d", seasonInfo.Week)
-		return cachedData.([]*models.LiveScore), nil
+	var cachedScores []*models.LiveScore
+	if freshness, hit := c.getCachedData(cacheKey, &cachedScores); hit {
+		logger.Debug("cache hit", "data", "live scores", "week", seasonInfo.WeekLabel())
+		for _, score := range cachedScores {
+			score.Freshness = freshness
+		}
+		return cachedScores, nil
 	}
 
+	return c.fetchScoresForWeek(seasonInfo, cacheKey)
+}
+
+// fetchScoresForWeek requests a week's scores from the API and caches the result
+func (c *Client) fetchScoresForWeek(seasonInfo *models.SeasonInfo, cacheKey string) ([]*models.LiveScore, error) {
 	// Get live scores for current week
 	url := fmt.Sprintf("%s/scores/json/ScoresByWeek/%d%s/%d?key=%s", 
 		c.baseURL, seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week, c.apiKey)
@@ -1091,31 +1419,33 @@ func (c *Client) GetLiveScores() ([]*models.LiveScore, error) {
 	
 	resp, err := c.httpClient.Get(url)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch live scores: %v", err)
+		return nil, fmt.Errorf("failed to fetch live scores: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		log.Printf("[NFL-API] ERROR: HTTP %d - %s for URL: %s", resp.StatusCode, http.StatusText(resp.StatusCode), url)
-		errorReason := c.getAPIErrorReason(resp.StatusCode)
-		return nil, fmt.Errorf("live scores API request failed with status %d (%s): %s", resp.StatusCode, http.StatusText(resp.StatusCode), errorReason)
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("live scores", resp.StatusCode)
 	}
 
 	var games []SportsDataGame
-	if err := json.NewDecoder(resp.Body).Decode(&games); err != nil {
+	if err := c.decodeChecked(SchemaGames, resp.Body, &games); err != nil {
 		return nil, fmt.Errorf("failed to parse live scores response: %v", err)
 	}
 
 	// Convert to our live score model
+	freshness := responseFreshness(resp)
 	var liveScores []*models.LiveScore
 	for _, game := range games {
+		noteGameStatus(game)
+
 		// Parse game time (skip for BYE weeks which may have empty datetime)
 		var gameTime time.Time
 		if game.DateTime != "" {
 			var err error
 			gameTime, err = parseSportsDataDateTime(game.DateTime)
 			if err != nil {
-				log.Printf("Warning: Could not parse live score game time '%s': %v", game.DateTime, err)
+				logger.Warn("could not parse live score game time", "datetime", game.DateTime, "error", err)
 				gameTime = time.Time{} // Default to zero time
 			}
 		}
@@ -1132,13 +1462,15 @@ func (c *Client) GetLiveScores() ([]*models.LiveScore, error) {
 			Quarter:       game.Quarter,
 			Status:        game.Status,
 			GameTime:      gameTime,
+			Channel:       game.Channel,
+			Freshness:     freshness,
 		}
 
 		liveScores = append(liveScores, liveScore)
 	}
 
 	// Cache the result
-	c.setCachedData(cacheKey, liveScores)
+	c.setCachedDataAt(CacheScores, cacheKey, liveScores, freshness.FetchedAt)
 
 	return liveScores, nil
 }
@@ -1160,9 +1492,11 @@ func (c *Client) GetPlayerSeasonStats(playerName string) (*models.PlayerStats, e
 		strings.ToLower(name), prevSeason, seasonType)
 
 	// Check cache first
-	if cachedData, found := c.getCachedData(cacheKey); found {
-		log.Printf("[NFL-CACHE] Using cached season stats for %s", name)
-		return cachedData.(*models.PlayerStats), nil
+	var cachedStats models.PlayerStats
+	if freshness, hit := c.getCachedData(cacheKey, &cachedStats); hit {
+		logger.Debug("cache hit", "data", "season stats", "player", name)
+		cachedStats.Freshness = freshness
+		return &cachedStats, nil
 	}
 
 	// We'll sum up all weeks from the previous season to get season totals
@@ -1170,8 +1504,14 @@ func (c *Client) GetPlayerSeasonStats(playerName string) (*models.PlayerStats, e
 	return c.getAggregatedSeasonStats(name, prevSeason, seasonType, cacheKey)
 }
 
-// GetPlayerWeekStats retrieves statistics for a player from a specific week and season
+// GetPlayerWeekStats retrieves statistics for a player from a specific regular season week and season
 func (c *Client) GetPlayerWeekStats(playerName string, season, week int) (*models.PlayerStats, error) {
+	return c.GetPlayerWeekStatsForType(playerName, season, models.SeasonTypeRegular, week)
+}
+
+// GetPlayerWeekStatsForType retrieves statistics for a player from a specific week of a
+// preseason, regular season, or postseason
+func (c *Client) GetPlayerWeekStatsForType(playerName string, season int, seasonType string, week int) (*models.PlayerStats, error) {
 	// Normalize player name
 	name := strings.TrimSpace(playerName)
 	if name == "" {
@@ -1179,47 +1519,42 @@ func (c *Client) GetPlayerWeekStats(playerName string, season, week int) (*model
 	}
 
 	// Validate inputs
-	if week < 1 || week > 18 {
-		return nil, fmt.Errorf("invalid week number: %d (must be 1-18)", week)
+	minWeek, maxWeek := models.WeekRange(seasonType)
+	if err := checkWeek(seasonType, week, minWeek, maxWeek); err != nil {
+		return nil, err
 	}
 	if season < 2020 || season > 2025 {
 		return nil, fmt.Errorf("invalid season: %d (must be 2020-2025)", season)
 	}
 
+	weekLabel := models.WeekLabel(seasonType, week)
+
 	// Create cache key
-	cacheKey := fmt.Sprintf("player_week_stats_%s_%d_REG_%d", 
-		strings.ToLower(name), season, week)
+	cacheKey := fmt.Sprintf("player_week_stats_%s_%d_%s_%d", 
+		strings.ToLower(name), season, seasonType, week)
 
 	// Check cache first
-	if cachedData, found := c.getCachedData(cacheKey); found {
-		log.Printf("[NFL-CACHE] Using cached week %d stats for %s (%d)", week, name, season)
-		return cachedData.(*models.PlayerStats), nil
-	}
-
-	// Build API endpoint
-	url := fmt.Sprintf("%s/stats/json/PlayerGameStatsByWeek/%dREG/%d?key=%s", 
-		c.baseURL, season, week, c.apiKey)
-
-	// Log the request
-	c.logRequest("GET", url)
-
-	// Make HTTP request
-	resp, err := c.httpClient.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch player stats: %v", err)
+	var cachedStats models.PlayerStats
+	if freshness, hit := c.getCachedData(cacheKey, &cachedStats); hit {
+		logger.Debug("cache hit", "data", "week stats", "week", weekLabel, "player", name, "season", season)
+		cachedStats.Freshness = freshness
+		return &cachedStats, nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		log.Printf("[NFL-API] ERROR: HTTP %d - %s for URL: %s", resp.StatusCode, http.StatusText(resp.StatusCode), url)
-		errorReason := c.getAPIErrorReason(resp.StatusCode)
-		return nil, fmt.Errorf("week stats API request failed with status %d (%s): %s", resp.StatusCode, http.StatusText(resp.StatusCode), errorReason)
+	// Resolve the name to a player ID so the lookup doesn't depend on scanning the stat sheet
+	if player := c.lookupPlayer(name); player != nil {
+		stats, err := c.getPlayerGameStats(player, season, seasonType, week)
+		if err != nil {
+			return nil, err
+		}
+		c.setCachedDataAt(CachePlayerStats, cacheKey, stats, stats.Freshness.FetchedAt)
+		return stats, nil
 	}
 
-	// Parse JSON response
-	var sportsDataStats []SportsDataPlayerStat
-	if err := json.NewDecoder(resp.Body).Decode(&sportsDataStats); err != nil {
-		return nil, fmt.Errorf("failed to parse API response: %v", err)
+	// Get the week's stat sheet
+	sportsDataStats, freshness, err := c.getWeekStatSheet(season, seasonType, week)
+	if err != nil {
+		return nil, err
 	}
 
 	// Find player by name using improved scoring
@@ -1227,7 +1562,7 @@ func (c *Client) GetPlayerWeekStats(playerName string, season, week int) (*model
 	var bestScore int
 	searchName := strings.ToLower(name)
 	
-	log.Printf("[NFL-API] Searching for player: '%s' in %d player records (Week %d, %d)", name, len(sportsDataStats), week, season)
+	logger.Debug("searching for player", "search", name, "records", len(sportsDataStats), "week", weekLabel, "season", season)
 	
 	for i := range sportsDataStats {
 		playerNameLower := strings.ToLower(sportsDataStats[i].Name)
@@ -1242,45 +1577,162 @@ func (c *Client) GetPlayerWeekStats(playerName string, season, week int) (*model
 
 	// Require minimum score to prevent bad matches
 	if bestScore < 50 {
-		return nil, fmt.Errorf("player '%s' not found in Week %d, %d stats. Try a different spelling or check if they played that week", name, week, season)
+		return nil, c.playerNotFound(name, fmt.Sprintf("%s, %d stats", weekLabel, season), sportsDataStats)
 	}
 	
-	log.Printf("[NFL-API] Week stats found match: '%s' (score: %d) for search '%s'", bestMatch.Name, bestScore, name)
-
-	// Convert to our model format (same logic as current week)
-	stats := &models.PlayerStats{
-		Name:     bestMatch.Name,
-		Team:     bestMatch.Team,
-		Position: bestMatch.Position,
-		Season:   int(bestMatch.Season),
-		Stats:    make(map[string]interface{}),
-	}
-
-	// Add relevant stats based on position
-	if bestMatch.PassingYards > 0 || bestMatch.PassingTouchdowns > 0 {
-		stats.Stats["passing_yards"] = int(bestMatch.PassingYards)
-		stats.Stats["passing_touchdowns"] = int(bestMatch.PassingTouchdowns)
-		stats.Stats["interceptions"] = int(bestMatch.Interceptions)
-		if bestMatch.Attempts > 0 {
-			completionPct := bestMatch.Completions / bestMatch.Attempts * 100
-			stats.Stats["completion_percent"] = fmt.Sprintf("%.1f%%", completionPct)
-		}
+	logger.Debug("week stats match", "match", bestMatch.Name, "score", bestScore, "search", name)
+
+	// Convert to our model format, dated by the stat sheet it came from
+	stats := weekPlayerStats(bestMatch, teamPassAttempts(sportsDataStats))
+	stats.Freshness = freshness
+
+	// Cache the result
+	c.setCachedDataAt(CachePlayerStats, cacheKey, stats, freshness.FetchedAt)
+
+	return stats, nil
+}
+
+// teamPassAttempts totals each team's pass attempts on a week's stat sheet, for target share
+func teamPassAttempts(sheet []SportsDataPlayerStat) map[string]int {
+	attempts := make(map[string]int)
+	for i := range sheet {
+		attempts[sheet[i].Team] += int(sheet[i].Attempts)
 	}
+	return attempts
+}
 
-	if bestMatch.RushingYards > 0 || bestMatch.RushingTouchdowns > 0 {
-		stats.Stats["rushing_yards"] = int(bestMatch.RushingYards)
-		stats.Stats["rushing_touchdowns"] = int(bestMatch.RushingTouchdowns)
+// weekPlayerStats converts a stat sheet row to our model, with the team's pass attempts that week
+// from teamPassAttempts
+func weekPlayerStats(row *SportsDataPlayerStat, teamAttempts map[string]int) *models.PlayerStats {
+	return &models.PlayerStats{
+		PlayerID: int(row.PlayerID),
+		Name:     row.Name,
+		Team:     row.Team,
+		Position: row.Position,
+		Season:   int(row.Season),
+		Passing: models.PassingStats{
+			Completions:   int(row.Completions),
+			Attempts:      int(row.Attempts),
+			Yards:         int(row.PassingYards),
+			Touchdowns:    int(row.PassingTouchdowns),
+			Interceptions: int(row.Interceptions),
+			Sacks:         int(row.PassingSacks),
+			SackYards:     int(row.PassingSackYards),
+			AirYards:      int(row.AirYards),
+		},
+		Rushing: models.RushingStats{
+			Attempts:   int(row.RushingAttempts),
+			Yards:      int(row.RushingYards),
+			Touchdowns: int(row.RushingTouchdowns),
+		},
+		Receiving: models.ReceivingStats{
+			Targets:      int(row.Targets),
+			Receptions:   int(row.Receptions),
+			Yards:        int(row.ReceivingYards),
+			Touchdowns:   int(row.ReceivingTouchdowns),
+			TeamAttempts: teamAttempts[row.Team],
+			Snaps:        int(row.OffensiveSnapsPlayed),
+			TeamSnaps:    int(row.OffensiveTeamSnaps),
+		},
+		Defense: models.DefenseStats{
+			SoloTackles:     int(row.SoloTackles),
+			AssistedTackles: int(row.AssistedTackles),
+			Sacks:           row.Sacks,
+			Interceptions:   int(row.DefensiveInterceptions),
+			PassesDefended:  int(row.PassesDefended),
+			ForcedFumbles:   int(row.FumblesForced),
+		},
 	}
+}
 
-	if bestMatch.ReceivingYards > 0 || bestMatch.ReceivingTouchdowns > 0 {
-		stats.Stats["receiving_yards"] = int(bestMatch.ReceivingYards)
-		stats.Stats["receiving_touchdowns"] = int(bestMatch.ReceivingTouchdowns)
-		stats.Stats["receptions"] = int(bestMatch.Receptions)
-		stats.Stats["targets"] = int(bestMatch.Targets)
+// scoresCacheKey returns the cache key for a week's scores
+func scoresCacheKey(seasonInfo *models.SeasonInfo) string {
+	return fmt.Sprintf("live_scores_%d%s_%d", seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+}
+
+// weekStatSheetCacheKey returns the cache key for a week's full player stat sheet
+func weekStatSheetCacheKey(season int, seasonType string, week int) string {
+	return fmt.Sprintf("week_stat_sheet_%d%s_%d", season, seasonType, week)
+}
+
+// getWeekStatSheet returns every player's stats for a week and when they were fetched, from
+// cache when possible
+func (c *Client) getWeekStatSheet(season int, seasonType string, week int) ([]SportsDataPlayerStat, models.Freshness, error) {
+	cacheKey := weekStatSheetCacheKey(season, seasonType, week)
+
+	var cachedStats []SportsDataPlayerStat
+	if freshness, hit := c.getCachedData(cacheKey, &cachedStats); hit {
+		logger.Debug("cache hit", "data", "stat sheet", "week", models.WeekLabel(seasonType, week), "season", season)
+		return cachedStats, freshness, nil
 	}
 
-	// Cache the result
-	c.setCachedData(cacheKey, stats)
+	return c.fetchWeekStatSheet(season, seasonType, week)
+}
 
-	return stats, nil
+// fetchWeekStatSheet requests a week's full player stat sheet from the API and caches the result
+func (c *Client) fetchWeekStatSheet(season int, seasonType string, week int) ([]SportsDataPlayerStat, models.Freshness, error) {
+	url := fmt.Sprintf("%s/stats/json/PlayerGameStatsByWeek/%d%s/%d?key=%s",
+		c.baseURL, season, seasonType, week, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, models.Freshness{}, fmt.Errorf("failed to fetch player stats: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, models.Freshness{}, c.apiError("week stats", resp.StatusCode)
+	}
+
+	var sportsDataStats []SportsDataPlayerStat
+	if err := c.decodeChecked(SchemaPlayerStats, resp.Body, &sportsDataStats); err != nil {
+		return nil, models.Freshness{}, fmt.Errorf("failed to parse API response: %v", err)
+	}
+
+	freshness := responseFreshness(resp)
+	c.setCachedDataAt(CachePlayerStats, weekStatSheetCacheKey(season, seasonType, week), sportsDataStats, freshness.FetchedAt)
+
+	return sportsDataStats, freshness, nil
+}
+
+// RefreshScores re-fetches the current week's scores, bypassing the cache, and returns them
+func (c *Client) RefreshScores() ([]*models.LiveScore, error) {
+	seasonInfo, err := c.getCurrentSeason()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	return c.fetchScoresForWeek(seasonInfo, scoresCacheKey(seasonInfo))
+}
+
+// RefreshWeekStats re-fetches the current week's player stat sheet, bypassing the cache
+func (c *Client) RefreshWeekStats() error {
+	seasonInfo, err := c.getCurrentSeason()
+	if err != nil {
+		return fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	_, _, err = c.fetchWeekStatSheet(seasonInfo.Season, seasonInfo.SeasonType, seasonInfo.Week)
+	return err
+}
+
+// RefreshTeams re-fetches the team list, bypassing the cache
+func (c *Client) RefreshTeams() error {
+	_, _, err := c.fetchTeams()
+	return err
+}
+
+// RefreshSchedule re-fetches the current season type's league schedule, bypassing the cache
+func (c *Client) RefreshSchedule() error {
+	seasonInfo, err := c.getCurrentSeason()
+	if err != nil {
+		return fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	_, _, err = c.downloadSchedule(seasonInfo.Season, seasonInfo.SeasonType)
+	return err
 }
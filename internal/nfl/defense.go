@@ -0,0 +1,122 @@
+package nfl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// GetDefenseVsPosition totals the yards and touchdowns each defense has allowed to QBs, RBs,
+// WRs, and TEs from the start of the season type through throughWeek. The result is keyed by
+// defense team then position, with each position ranked across the league.
+func (c *Client) GetDefenseVsPosition(season int, seasonType string, throughWeek int) (map[string]map[string]*models.PositionDefense, error) {
+	cacheKey := fmt.Sprintf("defense_vs_position_%d%s_%d", season, seasonType, throughWeek)
+
+	// Check cache first
+	var cachedDefense map[string]map[string]*models.PositionDefense
+	if _, hit := c.getCachedData(cacheKey, &cachedDefense); hit {
+		logger.Debug("cache hit", "data", "defense vs position", "through", models.WeekLabel(seasonType, throughWeek))
+		return cachedDefense, nil
+	}
+
+	defense := make(map[string]map[string]*models.PositionDefense)
+	minWeek, _ := models.WeekRange(seasonType)
+	for week := minWeek; week <= throughWeek; week++ {
+		sheet, _, err := c.getWeekStatSheet(season, seasonType, week)
+		if err != nil {
+			return nil, err
+		}
+
+		// Count a game for every defense that faced the position this week
+		faced := make(map[string]bool)
+		for _, line := range sheet {
+			if line.Opponent == "" || !fantasyPositions[line.Position] {
+				continue
+			}
+
+			if defense[line.Opponent] == nil {
+				defense[line.Opponent] = make(map[string]*models.PositionDefense)
+			}
+			allowed, exists := defense[line.Opponent][line.Position]
+			if !exists {
+				allowed = &models.PositionDefense{Team: line.Opponent, Position: line.Position}
+				defense[line.Opponent][line.Position] = allowed
+			}
+
+			allowed.Yards += line.PassingYards + line.RushingYards + line.ReceivingYards
+			allowed.Touchdowns += line.PassingTouchdowns + line.RushingTouchdowns + line.ReceivingTouchdowns
+
+			key := line.Opponent + "/" + line.Position
+			if !faced[key] {
+				faced[key] = true
+				allowed.Games++
+			}
+		}
+	}
+
+	// Rank each position from stingiest to most generous
+	for position := range fantasyPositions {
+		var ranked []*models.PositionDefense
+		for _, positions := range defense {
+			if allowed, exists := positions[position]; exists {
+				ranked = append(ranked, allowed)
+			}
+		}
+		sort.Slice(ranked, func(i, j int) bool {
+			if ranked[i].YardsPerGame() != ranked[j].YardsPerGame() {
+				return ranked[i].YardsPerGame() < ranked[j].YardsPerGame()
+			}
+			// Defenses allowing the same yardage share an order that doesn't change between calls
+			return ranked[i].Team < ranked[j].Team
+		})
+		for index, allowed := range ranked {
+			allowed.Rank = index + 1
+		}
+	}
+
+	// Cache the result
+	c.setCachedData(CacheSeasonStats, cacheKey, defense)
+
+	return defense, nil
+}
+
+// FindSeasonPlayer finds a QB/RB/WR/TE by name in a season's player totals
+func (c *Client) FindSeasonPlayer(playerName string, season int) (*models.SeasonPlayerStats, error) {
+	name := strings.TrimSpace(playerName)
+	if name == "" {
+		return nil, fmt.Errorf("player name cannot be empty")
+	}
+
+	totals, err := c.GetPlayerSeasonTotals(season)
+	if err != nil {
+		return nil, err
+	}
+
+	var bestMatch *models.SeasonPlayerStats
+	var bestScore int
+	searchName := strings.ToLower(name)
+	for _, player := range totals {
+		if !fantasyPositions[player.Position] {
+			continue
+		}
+		score := c.calculatePlayerMatchScore(strings.ToLower(player.Name), searchName)
+		if score > bestScore {
+			bestScore = score
+			bestMatch = player
+		}
+	}
+
+	// Require minimum score to prevent bad matches
+	if bestScore < 50 {
+		candidates := make([]string, 0, len(totals))
+		for _, player := range totals {
+			if fantasyPositions[player.Position] {
+				candidates = append(candidates, player.Name)
+			}
+		}
+		return nil, &NotFoundError{Class: ErrPlayerNotFound, Name: name, Scope: fmt.Sprintf("%d stats", season), Suggestions: c.nearMisses(name, candidates)}
+	}
+	return bestMatch, nil
+}
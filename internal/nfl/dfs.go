@@ -0,0 +1,93 @@
+package nfl
+
+import (
+	"fmt"
+	"net/http"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// SportsDataPlayerProjection is a player's projected game from SportsData.io's fantasy
+// projections, which carry each DFS site's salary
+type SportsDataPlayerProjection struct {
+	PlayerID                int     `json:"PlayerID"`
+	Name                    string  `json:"Name"`
+	Team                    string  `json:"Team"`
+	Position                string  `json:"Position"`
+	Opponent                string  `json:"Opponent"`
+	DraftKingsSalary        int     `json:"DraftKingsSalary"`
+	FanDuelSalary           int     `json:"FanDuelSalary"`
+	FantasyPointsDraftKings float64 `json:"FantasyPointsDraftKings"`
+	FantasyPointsFanDuel    float64 `json:"FantasyPointsFanDuel"`
+}
+
+// GetWeekSalaries returns every priced player's DFS salaries and projected points for a week
+func (c *Client) GetWeekSalaries(season int, seasonType string, week int) ([]*models.DFSSalary, error) {
+	cacheKey := fmt.Sprintf("dfs_salaries_%d%s_%d", season, seasonType, week)
+
+	// Check cache first
+	var cachedSalaries []*models.DFSSalary
+	if _, hit := c.getCachedData(cacheKey, &cachedSalaries); hit {
+		logger.Debug("cache hit", "data", "dfs salaries", "week", models.WeekLabel(seasonType, week), "season", season)
+		return cachedSalaries, nil
+	}
+
+	url := fmt.Sprintf("%s/projections/json/PlayerGameProjectionStatsByWeek/%d%s/%d?key=%s",
+		c.baseURL, season, seasonType, week, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dfs salaries: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("dfs salaries", resp.StatusCode)
+	}
+
+	var projections []SportsDataPlayerProjection
+	if err := c.decodeChecked(SchemaProjections, resp.Body, &projections); err != nil {
+		return nil, fmt.Errorf("failed to parse dfs salaries response: %v", err)
+	}
+
+	var salaries []*models.DFSSalary
+	for _, projection := range projections {
+		if projection.DraftKingsSalary == 0 && projection.FanDuelSalary == 0 {
+			continue
+		}
+		salaries = append(salaries, &models.DFSSalary{
+			PlayerID:         projection.PlayerID,
+			Name:             projection.Name,
+			Team:             projection.Team,
+			Position:         projection.Position,
+			Opponent:         projection.Opponent,
+			DraftKingsSalary: projection.DraftKingsSalary,
+			FanDuelSalary:    projection.FanDuelSalary,
+			DraftKingsPoints: projection.FantasyPointsDraftKings,
+			FanDuelPoints:    projection.FantasyPointsFanDuel,
+		})
+	}
+
+	// Salaries and projections move with injury news, so they share the odds TTL
+	c.setCachedData(CacheOdds, cacheKey, salaries)
+
+	return salaries, nil
+}
+
+// GetPlayerSalary returns a player's DFS salaries for a week, or nil when no site has priced them
+func (c *Client) GetPlayerSalary(playerID int, season int, seasonType string, week int) (*models.DFSSalary, error) {
+	salaries, err := c.GetWeekSalaries(season, seasonType, week)
+	if err != nil {
+		return nil, err
+	}
+	for _, salary := range salaries {
+		if salary.PlayerID == playerID {
+			return salary, nil
+		}
+	}
+	return nil, nil
+}
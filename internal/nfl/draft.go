@@ -0,0 +1,85 @@
+package nfl
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// SportsDataRookie is a player from a draft class, as listed by SportsData.io
+type SportsDataRookie struct {
+	PlayerID             int    `json:"PlayerID"`
+	Name                 string `json:"Name"`
+	Position             string `json:"Position"`
+	College              string `json:"College"`
+	CollegeDraftTeam     string `json:"CollegeDraftTeam"`
+	CollegeDraftYear     *int   `json:"CollegeDraftYear"`
+	CollegeDraftRound    *int   `json:"CollegeDraftRound"`
+	CollegeDraftPick     *int   `json:"CollegeDraftPick"` // overall pick number
+	IsUndraftedFreeAgent bool   `json:"IsUndraftedFreeAgent"`
+}
+
+// GetDraftPicks retrieves every pick made so far in a year's draft, in pick order
+func (c *Client) GetDraftPicks(year int) ([]*models.DraftPick, error) {
+	var cachedPicks []*models.DraftPick
+	if _, hit := c.getCachedData(draftPicksCacheKey(year), &cachedPicks); hit {
+		logger.Debug("cache hit", "data", "draft picks", "year", year)
+		return cachedPicks, nil
+	}
+
+	return c.RefreshDraftPicks(year)
+}
+
+// RefreshDraftPicks re-fetches a year's draft picks, bypassing the cache, e.g. to follow a draft live
+func (c *Client) RefreshDraftPicks(year int) ([]*models.DraftPick, error) {
+	url := fmt.Sprintf("%s/scores/json/Rookies/%d?key=%s", c.baseURL, year, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch draft picks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("draft picks", resp.StatusCode)
+	}
+
+	var rookies []SportsDataRookie
+	if err := c.decodeChecked(SchemaRookies, resp.Body, &rookies); err != nil {
+		return nil, fmt.Errorf("failed to parse draft picks response: %v", err)
+	}
+
+	var picks []*models.DraftPick
+	for _, rookie := range rookies {
+		if rookie.IsUndraftedFreeAgent || rookie.CollegeDraftPick == nil || rookie.CollegeDraftRound == nil {
+			continue
+		}
+		picks = append(picks, &models.DraftPick{
+			Year:     year,
+			Round:    *rookie.CollegeDraftRound,
+			Pick:     *rookie.CollegeDraftPick,
+			Team:     rookie.CollegeDraftTeam,
+			PlayerID: rookie.PlayerID,
+			Name:     rookie.Name,
+			Position: rookie.Position,
+			College:  rookie.College,
+		})
+	}
+	sort.Slice(picks, func(i, j int) bool {
+		return picks[i].Pick < picks[j].Pick
+	})
+
+	c.setCachedData(CacheSchedule, draftPicksCacheKey(year), picks)
+	return picks, nil
+}
+
+// draftPicksCacheKey is the cache key for a year's draft picks
+func draftPicksCacheKey(year int) string {
+	return fmt.Sprintf("draft_picks_%d", year)
+}
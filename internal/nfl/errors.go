@@ -0,0 +1,254 @@
+package nfl
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Error classes returned by the client. Match them with errors.Is; the concrete errors below
+// carry the details the bot needs to word its reply.
+var (
+	// ErrPlayerNotFound means no player matched the name in the requested stats
+	ErrPlayerNotFound = errors.New("player not found")
+
+	// ErrPlayerInactive means the player was found but has no stats for the requested week
+	ErrPlayerInactive = errors.New("player has no stats for the week")
+
+	// ErrTeamNotFound means no team matched the name
+	ErrTeamNotFound = errors.New("team not found")
+
+	// ErrRateLimited means the API rejected the request for exceeding the plan's rate limit
+	ErrRateLimited = errors.New("the NFL data provider is rate limiting requests; please try again in a minute")
+
+	// ErrUpstreamUnavailable is returned instead of calling the API while the circuit is open and
+	// no stale copy of the response exists, and matches API errors caused by server failures
+	ErrUpstreamUnavailable = errors.New("the NFL data provider is temporarily unavailable; please try again in a few minutes")
+
+	// ErrInvalidWeek means the week is outside the season type's range
+	ErrInvalidWeek = errors.New("invalid week")
+
+	// ErrNoWinTotals means neither the bundled snapshot nor the odds feed has win total lines
+	// for the season
+	ErrNoWinTotals = errors.New("no win total lines available")
+)
+
+// suggestionLimit is how many "did you mean" names a not-found error carries
+const suggestionLimit = 3
+
+// NotFoundError reports a player or team that couldn't be matched, with the closest names found
+type NotFoundError struct {
+	Class       error    // ErrPlayerNotFound or ErrTeamNotFound
+	Name        string   // the name as searched
+	Scope       string   // where it was searched, e.g. "Week 5, 2025 stats"; empty for teams
+	Suggestions []string // closest matching names, best first
+}
+
+// Error describes what couldn't be found and where
+func (e *NotFoundError) Error() string {
+	kind := "team"
+	if e.Class == ErrPlayerNotFound {
+		kind = "player"
+	}
+	if e.Scope == "" {
+		return fmt.Sprintf("%s '%s' not found", kind, e.Name)
+	}
+	return fmt.Sprintf("%s '%s' not found in %s", kind, e.Name, e.Scope)
+}
+
+// Unwrap returns the error class so errors.Is matches it
+func (e *NotFoundError) Unwrap() error {
+	return e.Class
+}
+
+// InactiveError reports a player who was matched but has no stats for a week, because their team
+// had a bye or they didn't play
+type InactiveError struct {
+	Name   string // the matched player's full name
+	Team   string // empty for free agents
+	Scope  string // the week searched, e.g. "Week 5, 2025"
+	Bye    bool   // the team had no game that week
+	Injury string // current injury designation when not on a bye, e.g. "Out (Knee)"
+}
+
+// Error describes why the player has no stats
+func (e *InactiveError) Error() string {
+	name := e.Name
+	if e.Team != "" {
+		name += " (" + e.Team + ")"
+	}
+	if e.Bye {
+		return fmt.Sprintf("%s had a bye in %s", name, e.Scope)
+	}
+	if e.Injury != "" {
+		return fmt.Sprintf("%s was inactive in %s (injury status: %s)", name, e.Scope, e.Injury)
+	}
+	return fmt.Sprintf("%s was inactive in %s", name, e.Scope)
+}
+
+// Unwrap returns ErrPlayerInactive so errors.Is matches it
+func (e *InactiveError) Unwrap() error {
+	return ErrPlayerInactive
+}
+
+// APIError is a non-200 response from the API
+type APIError struct {
+	Endpoint   string
+	StatusCode int
+	Reason     string
+}
+
+// Error describes the failed request with a user-friendly reason
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s API request failed with status %d (%s): %s", e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode), e.Reason)
+}
+
+// Is matches rate limiting to ErrRateLimited and server failures to ErrUpstreamUnavailable
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUpstreamUnavailable:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// InvalidWeekError reports a week outside the season type's range
+type InvalidWeekError struct {
+	Week       int
+	MinWeek    int
+	MaxWeek    int
+	SeasonType string
+}
+
+// Error describes the valid range
+func (e *InvalidWeekError) Error() string {
+	return fmt.Sprintf("invalid week number: %d (must be %d-%d for %s)", e.Week, e.MinWeek, e.MaxWeek, e.SeasonType)
+}
+
+// Unwrap returns ErrInvalidWeek so errors.Is matches it
+func (e *InvalidWeekError) Unwrap() error {
+	return ErrInvalidWeek
+}
+
+// Retryable reports whether an error is temporary, so the same request may succeed later
+func Retryable(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUpstreamUnavailable)
+}
+
+// apiError builds the error for a non-200 response
+func (c *Client) apiError(endpoint string, statusCode int) error {
+	return &APIError{Endpoint: endpoint, StatusCode: statusCode, Reason: c.getAPIErrorReason(statusCode)}
+}
+
+// checkWeek returns an InvalidWeekError when week is outside the season type's range
+func checkWeek(seasonType string, week, minWeek, maxWeek int) error {
+	if week < minWeek || week > maxWeek {
+		return &InvalidWeekError{Week: week, MinWeek: minWeek, MaxWeek: maxWeek, SeasonType: seasonType}
+	}
+	return nil
+}
+
+// playerNotFound builds a not-found error for a player, suggesting the closest names on the stat sheet
+func (c *Client) playerNotFound(name, scope string, sheet []SportsDataPlayerStat) error {
+	candidates := make([]string, 0, len(sheet))
+	for i := range sheet {
+		candidates = append(candidates, sheet[i].Name)
+	}
+	return &NotFoundError{Class: ErrPlayerNotFound, Name: name, Scope: scope, Suggestions: c.nearMisses(name, candidates)}
+}
+
+// nearMisses returns up to suggestionLimit names that scored under the match threshold but above
+// zero, best first, topped up with names within a few typos of the search
+func (c *Client) nearMisses(search string, candidates []string) []string {
+	misses := c.rankPlayerNames(search, candidates)
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, miss := range misses {
+		if len(names) == suggestionLimit {
+			return names
+		}
+		if !seen[miss.Name] {
+			seen[miss.Name] = true
+			names = append(names, miss.Name)
+		}
+	}
+	for _, name := range closestNames(search, candidates) {
+		if len(names) == suggestionLimit {
+			break
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// closestNames returns up to suggestionLimit candidates within a few typos of the search, closest
+// first. Both full names and last names are compared so "mahommes" still suggests Patrick Mahomes.
+func closestNames(search string, candidates []string) []string {
+	search = normalizePlayerNameStatic(search)
+	if search == "" {
+		return nil
+	}
+	maxDistance := len(search)/4 + 1
+
+	type scored struct {
+		name     string
+		distance int
+	}
+	var matches []scored
+	seen := make(map[string]bool)
+	for _, candidate := range candidates {
+		if seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+
+		normalized := normalizePlayerNameStatic(candidate)
+		distance := editDistance(search, normalized)
+		if parts := strings.Fields(normalized); !strings.Contains(search, " ") && len(parts) > 1 {
+			if last := editDistance(search, parts[len(parts)-1]); last < distance {
+				distance = last
+			}
+		}
+		if distance <= maxDistance {
+			matches = append(matches, scored{name: candidate, distance: distance})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+	var names []string
+	for index := 0; index < len(matches) && index < suggestionLimit; index++ {
+		names = append(names, matches[index].name)
+	}
+	return names
+}
+
+// editDistance is the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,141 @@
+package nfl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// SportsDataBettingEvent represents a futures event from SportsData.io
+type SportsDataBettingEvent struct {
+	Name           string                    `json:"Name"`
+	BettingMarkets []SportsDataBettingMarket `json:"BettingMarkets"`
+}
+
+// SportsDataBettingMarket represents one futures market, e.g. a division winner market
+type SportsDataBettingMarket struct {
+	BettingBetType  string                     `json:"BettingBetType"`
+	TeamKey         string                     `json:"TeamKey"`
+	BettingOutcomes []SportsDataBettingOutcome `json:"BettingOutcomes"`
+}
+
+// SportsDataBettingOutcome represents one sportsbook's price on one outcome
+type SportsDataBettingOutcome struct {
+	BettingOutcomeType string   `json:"BettingOutcomeType"`
+	PayoutAmerican     *int     `json:"PayoutAmerican"`
+	Value              *float64 `json:"Value"`
+	Participant        string   `json:"Participant"`
+	TeamKey            string   `json:"TeamKey"`
+	IsAvailable        bool     `json:"IsAvailable"`
+	SportsBook         *struct {
+		Name string `json:"Name"`
+	} `json:"SportsBook"`
+}
+
+// futuresMarket maps a SportsData.io bet type to a futures market, or "" for markets the bot ignores
+func futuresMarket(betType string) string {
+	betType = strings.ToLower(betType)
+	switch {
+	case strings.Contains(betType, "division"):
+		return models.FuturesDivision
+	case strings.Contains(betType, "conference"), strings.Contains(betType, "afc"), strings.Contains(betType, "nfc"):
+		return models.FuturesConference
+	case strings.Contains(betType, "super bowl"), strings.Contains(betType, "championship"):
+		return models.FuturesSuperBowl
+	case strings.Contains(betType, "win total"), strings.Contains(betType, "regular season wins"):
+		return models.FuturesWinTotal
+	default:
+		return ""
+	}
+}
+
+// GetFutures retrieves Super Bowl, conference, division, and win total futures prices for a
+// regular season. Markets the API doesn't offer for the season are simply absent.
+func (c *Client) GetFutures(season int) ([]*models.FuturesOdds, error) {
+	cacheKey := fmt.Sprintf("futures_%dREG", season)
+
+	var cachedFutures []*models.FuturesOdds
+	if _, hit := c.getCachedData(cacheKey, &cachedFutures); hit {
+		logger.Debug("cache hit", "data", "futures", "season", season)
+		return cachedFutures, nil
+	}
+
+	teams, err := c.GetTeams()
+	if err != nil {
+		return nil, err
+	}
+	teamKeys := make(map[string]string)
+	for _, team := range teams {
+		teamKeys[strings.ToLower(team.City+" "+team.Name)] = team.Key
+		teamKeys[strings.ToLower(team.Name)] = team.Key
+		teamKeys[strings.ToLower(team.Key)] = team.Key
+	}
+
+	url := fmt.Sprintf("%s/odds/json/BettingFuturesBySeason/%dREG?key=%s", c.baseURL, season, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch futures: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("futures", resp.StatusCode)
+	}
+
+	var events []SportsDataBettingEvent
+	if err := json.NewDecoder(resp.Body).Decode(&events); err != nil {
+		return nil, fmt.Errorf("failed to parse futures response: %v", err)
+	}
+
+	var futures []*models.FuturesOdds
+	for _, event := range events {
+		for _, market := range event.BettingMarkets {
+			marketType := futuresMarket(market.BettingBetType)
+			if marketType == "" {
+				continue
+			}
+
+			for _, outcome := range market.BettingOutcomes {
+				if !outcome.IsAvailable || outcome.PayoutAmerican == nil {
+					continue
+				}
+
+				team := outcome.TeamKey
+				if team == "" {
+					team = market.TeamKey
+				}
+				if team == "" {
+					team = teamKeys[strings.ToLower(strings.TrimSpace(outcome.Participant))]
+				}
+				if team == "" {
+					continue
+				}
+
+				odds := &models.FuturesOdds{
+					Team:         team,
+					Market:       marketType,
+					AmericanOdds: *outcome.PayoutAmerican,
+					Line:         outcome.Value,
+					Side:         strings.ToLower(outcome.BettingOutcomeType),
+				}
+				if outcome.SportsBook != nil {
+					odds.Sportsbook = outcome.SportsBook.Name
+				}
+				futures = append(futures, odds)
+			}
+		}
+	}
+
+	// Cache the result
+	c.setCachedData(CacheOdds, cacheKey, futures)
+
+	return futures, nil
+}
@@ -0,0 +1,178 @@
+package nfl
+
+import (
+	"sort"
+	"strings"
+)
+
+// Player name scores run from 0 to 100; callers treat 50 and up as a confident match
+const (
+	lastNameOnlyPenalty = 30 // a last name alone ("jackson") never beats a full-name match
+	uncertainScoreCap   = 45 // best score for names whose parts don't line up, kept for suggestions only
+	minCandidateScore   = 25 // scores below this are reported as no match at all
+)
+
+// PlayerCandidate is a name ranked against a search with its match confidence, 0 to 100
+type PlayerCandidate struct {
+	Name  string
+	Score int
+}
+
+// SetLegacyNameMatching switches player name matching back to the original prefix and
+// containment scorer, for comparing its results with the edit distance ranking
+func (c *Client) SetLegacyNameMatching(enabled bool) {
+	c.legacyMatching = enabled
+}
+
+// calculatePlayerMatchScore scores how well a listed player name matches a search, 0 to 100
+func (c *Client) calculatePlayerMatchScore(playerName, searchName string) int {
+	if c.legacyMatching {
+		return c.legacyPlayerMatchScore(playerName, searchName)
+	}
+	return fuzzyPlayerMatchScore(playerName, searchName)
+}
+
+// rankPlayerNames scores every candidate against a search and returns those with any confidence,
+// best first; ties keep the candidates' order
+func (c *Client) rankPlayerNames(search string, candidates []string) []PlayerCandidate {
+	searchName := strings.ToLower(strings.TrimSpace(search))
+	var ranked []PlayerCandidate
+	for _, candidate := range candidates {
+		if score := c.calculatePlayerMatchScore(strings.ToLower(candidate), searchName); score > 0 {
+			ranked = append(ranked, PlayerCandidate{Name: candidate, Score: score})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	return ranked
+}
+
+// fuzzyPlayerMatchScore scores a player name against a search by edit distance between their
+// canonical forms, name part by name part, with a token set ratio for reordered or partial names
+func fuzzyPlayerMatchScore(playerName, searchName string) int {
+	player, search := canonicalPlayerName(playerName), canonicalPlayerName(searchName)
+	if player == "" || search == "" {
+		return 0
+	}
+	if player == search {
+		return 100
+	}
+
+	playerParts, searchParts := strings.Fields(player), strings.Fields(search)
+	var score int
+	switch {
+	case len(searchParts) == 1 && len(playerParts) >= 2:
+		score = editRatio(playerParts[len(playerParts)-1], search) - lastNameOnlyPenalty
+	case len(playerParts) == len(searchParts):
+		score = alignedNameScore(playerParts, searchParts)
+	default:
+		// Different numbers of parts, like "josh allen" and "josh hines allen", are never confident
+		score = uncertainNameScore(playerParts, searchParts)
+	}
+
+	if score < minCandidateScore {
+		return 0
+	}
+	return score
+}
+
+// alignedNameScore scores names with the same number of parts: first and last names (and any
+// middle parts) must each be close, otherwise only a reordering of the same parts is confident
+func alignedNameScore(playerParts, searchParts []string) int {
+	if len(playerParts) == 1 {
+		return editRatio(playerParts[0], searchParts[0])
+	}
+
+	last := len(playerParts) - 1
+	first, lastName := namePartRatio(playerParts[0], searchParts[0]), namePartRatio(playerParts[last], searchParts[last])
+	aligned := first >= 70 && lastName >= 70
+	for index := 1; index < last && aligned; index++ {
+		aligned = namePartRatio(playerParts[index], searchParts[index]) >= 70
+	}
+	if aligned {
+		return (first + lastName) / 2
+	}
+
+	if reordered := editRatio(sortedJoin(playerParts), sortedJoin(searchParts)); reordered >= 90 {
+		return reordered - 10
+	}
+	return uncertainNameScore(playerParts, searchParts)
+}
+
+// uncertainNameScore scores names whose parts don't line up, for suggestions only. A name sharing
+// neither its first nor its last part with the search is someone else entirely.
+func uncertainNameScore(playerParts, searchParts []string) int {
+	first := namePartRatio(playerParts[0], searchParts[0])
+	last := namePartRatio(playerParts[len(playerParts)-1], searchParts[len(searchParts)-1])
+	if max(first, last) < 70 {
+		return 0
+	}
+	return min(tokenSetRatio(playerParts, searchParts), uncertainScoreCap)
+}
+
+// namePartRatio compares one name part, treating a prefix ("mahom" for "mahomes") as a close match
+func namePartRatio(a, b string) int {
+	ratio := editRatio(a, b)
+	shorter := min(len(a), len(b))
+	if strings.HasPrefix(a, b) || strings.HasPrefix(b, a) {
+		switch {
+		case shorter >= 4:
+			ratio = max(ratio, 90)
+		case shorter == 3:
+			ratio = max(ratio, 70)
+		}
+	}
+	return ratio
+}
+
+// editRatio is how alike two strings are, 100 for equal down to 0, from their edit distance
+func editRatio(a, b string) int {
+	longest := max(len(a), len(b))
+	if longest == 0 {
+		return 100
+	}
+	return 100 - 100*editDistance(a, b)/longest
+}
+
+// tokenSetRatio compares names as sets of parts: the shared parts against each name's full set,
+// so a name that contains every part of the other scores 100
+func tokenSetRatio(a, b []string) int {
+	inA, inB := make(map[string]bool), make(map[string]bool)
+	for _, part := range a {
+		inA[part] = true
+	}
+	for _, part := range b {
+		inB[part] = true
+	}
+
+	var common, onlyA, onlyB []string
+	for part := range inA {
+		if inB[part] {
+			common = append(common, part)
+		} else {
+			onlyA = append(onlyA, part)
+		}
+	}
+	for part := range inB {
+		if !inA[part] {
+			onlyB = append(onlyB, part)
+		}
+	}
+
+	shared := sortedJoin(common)
+	withA := strings.TrimSpace(shared + " " + sortedJoin(onlyA))
+	withB := strings.TrimSpace(shared + " " + sortedJoin(onlyB))
+	best := editRatio(withA, withB)
+	if shared != "" {
+		best = max(best, editRatio(shared, withA), editRatio(shared, withB))
+	}
+	return best
+}
+
+// sortedJoin joins name parts in alphabetical order
+func sortedJoin(parts []string) string {
+	sorted := append([]string(nil), parts...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, " ")
+}
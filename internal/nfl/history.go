@@ -0,0 +1,84 @@
+package nfl
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// franchiseSnapshot holds each franchise's founding year, titles, retired numbers, and all-time
+// regular season record through record_through, keyed by team abbreviation.
+//
+//go:embed data/franchises.json
+var franchiseSnapshot []byte
+
+var (
+	franchisesOnce sync.Once
+	franchises     map[string]*models.FranchiseHistory
+)
+
+// loadFranchises parses the bundled franchise snapshot once
+func loadFranchises() map[string]*models.FranchiseHistory {
+	franchisesOnce.Do(func() {
+		var snapshot struct {
+			RecordThrough int                                 `json:"record_through"`
+			Teams         map[string]*models.FranchiseHistory `json:"teams"`
+		}
+		if err := json.Unmarshal(franchiseSnapshot, &snapshot); err != nil {
+			logger.Error("failed to parse franchise snapshot", "error", err)
+			return
+		}
+		for key, history := range snapshot.Teams {
+			history.Team = key
+			history.RecordThrough = snapshot.RecordThrough
+		}
+		franchises = snapshot.Teams
+	})
+	return franchises
+}
+
+// franchiseHistory returns a copy of a team's bundled history, or nil when the team isn't in it
+func franchiseHistory(key string) *models.FranchiseHistory {
+	history, exists := loadFranchises()[key]
+	if !exists {
+		return nil
+	}
+	clone := *history
+	return &clone
+}
+
+// GetFranchiseHistory returns a team's franchise history, bringing the bundled all-time record up
+// to date with the standings of every season since the snapshot
+func (c *Client) GetFranchiseHistory(teamKey string) (*models.FranchiseHistory, error) {
+	history := franchiseHistory(teamKey)
+	if history == nil {
+		return nil, fmt.Errorf("no franchise history available for %s", teamKey)
+	}
+
+	seasonInfo, err := c.GetCurrentSeason()
+	if err != nil {
+		logger.Warn("franchise record not updated past snapshot", "team", teamKey, "error", err)
+		return history, nil
+	}
+
+	for season := history.RecordThrough + 1; season <= seasonInfo.Season; season++ {
+		standings, err := c.GetStandings(season)
+		if err != nil {
+			logger.Warn("franchise record not updated past season", "team", teamKey, "season", season-1, "error", err)
+			break
+		}
+		for _, standing := range standings {
+			if standing.Team == teamKey && standing.GamesPlayed() > 0 {
+				history.Wins += standing.Wins
+				history.Losses += standing.Losses
+				history.Ties += standing.Ties
+				history.RecordThrough = season
+			}
+		}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,120 @@
+package nfl
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// WeekLookup is the outcome of looking up one name in a batch week lookup
+type WeekLookup struct {
+	Query  string
+	Stats  *models.PlayerStats
+	Injury string // injury designation carried on the stat sheet, e.g. "Questionable (Ankle)"
+	Err    error
+}
+
+// GetPlayersWeekStats looks up several players in one week's stat sheet. The sheet is fetched
+// once and the names are matched against it concurrently; results keep the order of names.
+func (c *Client) GetPlayersWeekStats(names []string, season int, seasonType string, week int) ([]WeekLookup, error) {
+	sheet, freshness, err := c.getWeekStatSheet(season, seasonType, week)
+	if err != nil {
+		return nil, err
+	}
+
+	weekLabel := models.WeekLabel(seasonType, week)
+	results := make([]WeekLookup, len(names))
+
+	var wg sync.WaitGroup
+	for index, name := range names {
+		wg.Add(1)
+		go func(index int, name string) {
+			defer wg.Done()
+			results[index] = c.lookupSheetPlayer(sheet, name, weekLabel, season)
+			if results[index].Stats != nil {
+				results[index].Stats.Freshness = freshness
+			}
+		}(index, name)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// lookupSheetPlayer finds the best match for a name in a week's stat sheet
+func (c *Client) lookupSheetPlayer(sheet []SportsDataPlayerStat, name, weekLabel string, season int) WeekLookup {
+	lookup := WeekLookup{Query: name}
+
+	searchName := strings.ToLower(strings.TrimSpace(name))
+	if searchName == "" {
+		lookup.Err = fmt.Errorf("player name cannot be empty")
+		return lookup
+	}
+
+	var bestMatch *SportsDataPlayerStat
+	var bestScore int
+	for i := range sheet {
+		score := c.calculatePlayerMatchScore(strings.ToLower(sheet[i].Name), searchName)
+		if score > bestScore {
+			bestScore = score
+			bestMatch = &sheet[i]
+		}
+	}
+
+	// Same threshold as single-player lookups to prevent bad matches
+	if bestScore < 50 {
+		lookup.Err = c.playerNotFound(name, fmt.Sprintf("%s, %d stats", weekLabel, season), sheet)
+		return lookup
+	}
+
+	lookup.Stats = weekPlayerStats(bestMatch, teamPassAttempts(sheet))
+	if bestMatch.InjuryStatus != "" {
+		lookup.Injury = bestMatch.InjuryStatus
+		if bestMatch.InjuryBodyPart != "" {
+			lookup.Injury += " (" + bestMatch.InjuryBodyPart + ")"
+		}
+	}
+	return lookup
+}
+
+// GetWeekStats returns every player's stat line for a week
+func (c *Client) GetWeekStats(season int, seasonType string, week int) ([]*models.PlayerStats, error) {
+	sheet, freshness, err := c.getWeekStatSheet(season, seasonType, week)
+	if err != nil {
+		return nil, err
+	}
+
+	teamAttempts := teamPassAttempts(sheet)
+	players := make([]*models.PlayerStats, 0, len(sheet))
+	for i := range sheet {
+		player := weekPlayerStats(&sheet[i], teamAttempts)
+		player.Freshness = freshness
+		players = append(players, player)
+	}
+	return players, nil
+}
+
+// GetTeamsWeekStats returns the week's stat lines for every player on the given teams, such as
+// both sides of one game
+func (c *Client) GetTeamsWeekStats(season int, seasonType string, week int, teams ...string) ([]*models.PlayerStats, error) {
+	sheet, freshness, err := c.getWeekStatSheet(season, seasonType, week)
+	if err != nil {
+		return nil, err
+	}
+
+	teamAttempts := teamPassAttempts(sheet)
+	var players []*models.PlayerStats
+	for i := range sheet {
+		for _, team := range teams {
+			if sheet[i].Team == team {
+				player := weekPlayerStats(&sheet[i], teamAttempts)
+				player.Freshness = freshness
+				players = append(players, player)
+				break
+			}
+		}
+	}
+	return players, nil
+}
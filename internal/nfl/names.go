@@ -0,0 +1,100 @@
+package nfl
+
+import "strings"
+
+// nameSuffixes are generational suffixes dropped from player names before matching, so
+// "Patrick Mahomes II" matches "Patrick Mahomes"
+var nameSuffixes = map[string]bool{
+	"jr": true, "sr": true, "ii": true, "iii": true, "iv": true, "v": true,
+}
+
+// firstNameNicknames maps common short first names to the full name they stand for. Both the
+// search and the listed name are mapped, so "Pat Mahomes" finds "Patrick Mahomes" and "Patrick
+// Freiermuth" still finds "Pat Freiermuth".
+var firstNameNicknames = map[string]string{
+	"alex":  "alexander",
+	"andy":  "andrew",
+	"ben":   "benjamin",
+	"bill":  "william",
+	"bob":   "robert",
+	"bobby": "robert",
+	"cam":   "cameron",
+	"chris": "christopher",
+	"dan":   "daniel",
+	"danny": "daniel",
+	"dave":  "david",
+	"drew":  "andrew",
+	"ed":    "edward",
+	"gabe":  "gabriel",
+	"greg":  "gregory",
+	"jake":  "jacob",
+	"jeff":  "jeffrey",
+	"jim":   "james",
+	"jimmy": "james",
+	"joe":   "joseph",
+	"jon":   "jonathan",
+	"josh":  "joshua",
+	"ken":   "kenneth",
+	"kenny": "kenneth",
+	"matt":  "matthew",
+	"mike":  "michael",
+	"nate":  "nathan",
+	"nick":  "nicholas",
+	"pat":   "patrick",
+	"rob":   "robert",
+	"sam":   "samuel",
+	"steve": "steven",
+	"ted":   "theodore",
+	"tom":   "thomas",
+	"tommy": "thomas",
+	"tony":  "anthony",
+	"will":  "william",
+	"zach":  "zachary",
+	"zack":  "zachary",
+}
+
+// accentFolds maps accented letters found in player names to their unaccented forms
+var accentFolds = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ñ", "n", "ç", "c", "č", "c", "ć", "c", "š", "s", "ž", "z", "ý", "y", "ÿ", "y", "ł", "l",
+)
+
+// canonicalPlayerName reduces a player name to the form names are compared in: lowercase and
+// unaccented, hyphens and periods split, apostrophes dropped, initials joined ("A. J." and "AJ" both become
+// "aj"), generational suffixes removed, and a nickname first name replaced by the full name
+func canonicalPlayerName(name string) string {
+	normalized := accentFolds.Replace(strings.ToLower(name))
+	normalized = strings.NewReplacer("-", " ", ".", " ", ",", " ", "'", "", "’", "").Replace(normalized)
+
+	// Join runs of single letters, so "a j brown" reads "aj brown"
+	var parts []string
+	initials := ""
+	for _, part := range strings.Fields(normalized) {
+		if len(part) == 1 {
+			initials += part
+			continue
+		}
+		if initials != "" {
+			parts = append(parts, initials)
+			initials = ""
+		}
+		parts = append(parts, part)
+	}
+	if initials != "" {
+		parts = append(parts, initials)
+	}
+
+	for len(parts) > 2 && nameSuffixes[parts[len(parts)-1]] {
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) >= 2 {
+		if full, ok := firstNameNicknames[parts[0]]; ok {
+			parts[0] = full
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,114 @@
+package nfl
+
+import "testing"
+
+// confidentScore is the score callers accept as the player a search meant
+const confidentScore = 50
+
+func TestCanonicalPlayerName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Patrick Mahomes II", "patrick mahomes"},
+		{"Kenneth Walker III", "kenneth walker"},
+		{"Marvin Harrison Jr.", "marvin harrison"},
+		{"Odell Beckham, Jr.", "odell beckham"},
+		{"A.J. Brown", "aj brown"},
+		{"A. J. Brown", "aj brown"},
+		{"AJ Brown", "aj brown"},
+		{"D.K. Metcalf", "dk metcalf"},
+		{"Pat Mahomes", "patrick mahomes"},
+		{"Josh Allen", "joshua allen"},
+		{"Ja'Marr Chase", "jamarr chase"},
+		{"Amon-Ra St. Brown", "amon ra st brown"},
+		{"Zoë Müller", "zoe muller"},
+		{"Ángel Peña", "angel pena"},
+		{"Jr Smith", "jr smith"},
+		{"Josh", "josh"},
+		{"  ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canonicalPlayerName(tt.name); got != tt.want {
+				t.Errorf("canonicalPlayerName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlayerNameMatching(t *testing.T) {
+	tests := []struct {
+		name    string
+		player  string
+		search  string
+		matches bool
+	}{
+		// Suffixes
+		{"suffix on listed name", "Patrick Mahomes II", "Patrick Mahomes", true},
+		{"suffix on search", "Patrick Mahomes", "Patrick Mahomes II", true},
+		{"junior with period", "Marvin Harrison Jr.", "marvin harrison", true},
+
+		// Initials
+		{"initials without periods", "A.J. Brown", "AJ Brown", true},
+		{"initials with periods", "AJ Brown", "A.J. Brown", true},
+		{"spaced initials", "A.J. Brown", "a. j. brown", true},
+
+		// Nicknames
+		{"nickname search", "Patrick Mahomes", "Pat Mahomes", true},
+		{"full name search for nickname listing", "Pat Freiermuth", "Patrick Freiermuth", true},
+		{"both nicknames", "Josh Allen", "Joshua Allen", true},
+
+		// Accents and punctuation
+		{"accented listing", "Zoë Müller", "zoe muller", true},
+		{"accented search", "Jose Pena", "José Peña", true},
+		{"apostrophe dropped", "Ja'Marr Chase", "Jamarr Chase", true},
+		{"hyphen split", "Amon-Ra St. Brown", "Amon Ra St Brown", true},
+
+		// Typos and partial names
+		{"one-letter typo", "Josh Allen", "Josh Alen", true},
+		{"misspelled last name", "Travis Kelce", "Travis Kelse", true},
+		{"last name prefix", "Patrick Mahomes", "Patrick Mahom", true},
+
+		// Must not match
+		{"different first name", "Josh Allen", "Keenan Allen", false},
+		{"different last name", "Josh Allen", "Josh Jacobs", false},
+		{"misspelled last name alone", "Lamar Jackson", "Jaxon", false},
+		{"different initials", "AJ Brown", "DJ Brown", false},
+		{"extra middle name", "Josh Allen", "Josh Hines Allen", false},
+		{"unrelated player", "Saquon Barkley", "Travis Kelce", false},
+		{"nickname for another name", "Patrick Mahomes", "Mike Mahomes", false},
+		{"empty search", "Josh Allen", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := fuzzyPlayerMatchScore(tt.player, tt.search)
+			if matched := score >= confidentScore; matched != tt.matches {
+				t.Errorf("fuzzyPlayerMatchScore(%q, %q) = %d, want match %v", tt.player, tt.search, score, tt.matches)
+			}
+		})
+	}
+}
+
+func TestRankPlayerNames(t *testing.T) {
+	client := &Client{}
+
+	ranked := client.rankPlayerNames("Josh Allen", []string{"Keenan Allen", "Josh Allen", "Josh Jacobs"})
+	if len(ranked) == 0 || ranked[0].Name != "Josh Allen" || ranked[0].Score != 100 {
+		t.Errorf("rankPlayerNames(Josh Allen) = %+v, want Josh Allen first with 100", ranked)
+	}
+
+	// A last name alone still finds the player, but below anyone whose full name matches
+	ranked = client.rankPlayerNames("Jackson", []string{"Lamar Jackson", "Jackson"})
+	if len(ranked) != 2 || ranked[0].Name != "Jackson" || ranked[1].Score >= ranked[0].Score {
+		t.Errorf("rankPlayerNames(Jackson) = %+v, want the exact name ahead of the last name match", ranked)
+	}
+
+	// A badly typed name is only suggested as names that share a close part
+	ranked = client.rankPlayerNames("Jsoh Alen", []string{"Saquon Barkley", "Amon-Ra St. Brown", "Josh Allen"})
+	if len(ranked) != 1 || ranked[0].Name != "Josh Allen" {
+		t.Errorf("rankPlayerNames(Jsoh Alen) = %+v, want only Josh Allen", ranked)
+	}
+}
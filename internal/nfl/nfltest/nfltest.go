@@ -0,0 +1,62 @@
+// Package nfltest is a fake SportsData.io provider that serves fixture JSON, so the bot's NFL
+// client can run without an API key or network access.
+package nfltest
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+)
+
+//go:embed fixtures
+var embedded embed.FS
+
+// Fixtures is the built-in fixture set: all 32 teams' 2025 regular season through week 6, with
+// the Sunday night game (PHI @ DAL) live in the third quarter and Monday night still to play
+var Fixtures fs.FS = mustSub(embedded, "fixtures")
+
+// Provider answers SportsData.io requests from a fixture tree laid out like the API's paths,
+// e.g. "scores/json/Teams.json" for /scores/json/Teams
+type Provider struct {
+	fixtures fs.FS
+}
+
+// NewProvider creates a provider serving the given fixtures
+func NewProvider(fixtures fs.FS) *Provider {
+	return &Provider{fixtures: fixtures}
+}
+
+// ServeHTTP returns the fixture for the request path. Like the real API it rejects requests
+// without a key, and endpoints with no fixture are 404s.
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("key") == "" {
+		http.Error(w, `{"HttpStatusCode":401,"Code":401,"Description":"Access denied due to missing subscription key."}`, http.StatusUnauthorized)
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean(r.URL.Path), "/") + ".json"
+	data, err := fs.ReadFile(p.fixtures, name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// NewServer starts a local server for the provider; its URL is the client's base URL
+func NewServer(fixtures fs.FS) *httptest.Server {
+	return httptest.NewServer(NewProvider(fixtures))
+}
+
+// mustSub roots the embedded files at dir, which is always present
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,153 @@
+package nfltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"testing"
+)
+
+// fixtureGame is the part of a schedule or score entry the consistency checks compare
+type fixtureGame struct {
+	GameKey   string
+	Week      int
+	AwayTeam  string
+	HomeTeam  string
+	AwayScore *int
+	HomeScore *int
+	Status    string
+}
+
+// readFixture decodes a fixture file
+func readFixture(t *testing.T, name string, v any) {
+	t.Helper()
+	data, err := fs.ReadFile(Fixtures, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("decoding %s: %v", name, err)
+	}
+}
+
+// fixtureSchedule returns the 2025 regular season schedule
+func fixtureSchedule(t *testing.T) []fixtureGame {
+	t.Helper()
+	var schedule []fixtureGame
+	readFixture(t, "scores/json/Schedules/2025REG.json", &schedule)
+	return schedule
+}
+
+func TestFixtureStandingsFollowSchedule(t *testing.T) {
+	type record struct{ wins, losses, ties int }
+	computed := make(map[string]*record)
+	for _, game := range fixtureSchedule(t) {
+		if game.Status != "Final" {
+			continue
+		}
+		for _, team := range []string{game.AwayTeam, game.HomeTeam} {
+			if computed[team] == nil {
+				computed[team] = &record{}
+			}
+		}
+		away, home := computed[game.AwayTeam], computed[game.HomeTeam]
+		switch {
+		case *game.AwayScore > *game.HomeScore:
+			away.wins++
+			home.losses++
+		case *game.HomeScore > *game.AwayScore:
+			home.wins++
+			away.losses++
+		default:
+			away.ties++
+			home.ties++
+		}
+	}
+
+	var standings []struct {
+		Team               string
+		Wins, Losses, Ties int
+	}
+	readFixture(t, "scores/json/Standings/2025.json", &standings)
+	if len(standings) != 32 {
+		t.Errorf("standings have %d teams, want 32", len(standings))
+	}
+	for _, standing := range standings {
+		want := computed[standing.Team]
+		if want == nil {
+			want = &record{}
+		}
+		if got := (record{standing.Wins, standing.Losses, standing.Ties}); got != *want {
+			t.Errorf("%s standings say %d-%d-%d, schedule results are %d-%d-%d",
+				standing.Team, got.wins, got.losses, got.ties, want.wins, want.losses, want.ties)
+		}
+	}
+}
+
+func TestFixtureScoresMatchSchedule(t *testing.T) {
+	scheduled := make(map[string]fixtureGame)
+	weeks := make(map[int]int)
+	for _, game := range fixtureSchedule(t) {
+		scheduled[game.GameKey] = game
+		weeks[game.Week]++
+	}
+
+	for week := 1; week <= 18; week++ {
+		var scores []fixtureGame
+		readFixture(t, fmt.Sprintf("scores/json/ScoresByWeek/2025REG/%d.json", week), &scores)
+		if len(scores) != weeks[week] {
+			t.Errorf("week %d has %d scores, schedule has %d games", week, len(scores), weeks[week])
+		}
+		for _, score := range scores {
+			game, exists := scheduled[score.GameKey]
+			if !exists {
+				t.Errorf("week %d score %s isn't on the schedule", week, score.GameKey)
+				continue
+			}
+			if game.Week != week || game.AwayTeam != score.AwayTeam || game.HomeTeam != score.HomeTeam || game.Status != score.Status {
+				t.Errorf("week %d score %s is %s @ %s (%s), schedule has week %d %s @ %s (%s)", week, score.GameKey,
+					score.AwayTeam, score.HomeTeam, score.Status, game.Week, game.AwayTeam, game.HomeTeam, game.Status)
+			}
+			if deref(game.AwayScore) != deref(score.AwayScore) || deref(game.HomeScore) != deref(score.HomeScore) {
+				t.Errorf("week %d score %s doesn't match the schedule's", week, score.GameKey)
+			}
+		}
+	}
+}
+
+func TestFixtureStatLinesMatchSchedule(t *testing.T) {
+	opponents := make(map[string]string) // "week/team" -> opponent
+	for _, game := range fixtureSchedule(t) {
+		if game.Status == "Scheduled" {
+			continue
+		}
+		opponents[fmt.Sprintf("%d/%s", game.Week, game.AwayTeam)] = game.HomeTeam
+		opponents[fmt.Sprintf("%d/%s", game.Week, game.HomeTeam)] = game.AwayTeam
+	}
+
+	for week := 1; week <= 6; week++ {
+		var lines []struct {
+			Name     string
+			Team     string
+			Opponent string
+			Week     int
+		}
+		readFixture(t, fmt.Sprintf("stats/json/PlayerGameStatsByWeek/2025REG/%d.json", week), &lines)
+		for _, line := range lines {
+			if line.Week != week {
+				t.Errorf("%s's week %d line says week %d", line.Name, week, line.Week)
+			}
+			if opponent := opponents[fmt.Sprintf("%d/%s", week, line.Team)]; opponent != line.Opponent {
+				t.Errorf("%s (%s) played %q in week %d, line says %q", line.Name, line.Team, opponent, week, line.Opponent)
+			}
+		}
+	}
+}
+
+// deref returns a score, or -1 for a game without one
+func deref(score *int) int {
+	if score == nil {
+		return -1
+	}
+	return *score
+}
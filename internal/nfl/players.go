@@ -0,0 +1,287 @@
+package nfl
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// playerDirectoryCacheKey is the cache key for the active player directory
+const playerDirectoryCacheKey = "player_directory"
+
+// SportsDataPlayer represents an active player from SportsData.io's Players endpoint
+type SportsDataPlayer struct {
+	PlayerID   int    `json:"PlayerID"`
+	Name       string `json:"Name"`
+	Team       string `json:"Team"`
+	Position   string `json:"Position"`
+	Experience int    `json:"Experience"` // seasons in the league, counting the current one once it starts
+	PhotoURL   string `json:"PhotoUrl"`
+
+	InjuryStatus   string `json:"InjuryStatus"` // current designation, e.g. "Out"; empty when healthy
+	InjuryBodyPart string `json:"InjuryBodyPart"`
+}
+
+// GetPlayerPhotoURL returns a player's headshot URL, matching by player ID when known and
+// otherwise by name (and team, when given). An empty URL with no error means no photo exists.
+func (c *Client) GetPlayerPhotoURL(playerID int, name, team string) (string, error) {
+	players, err := c.getPlayerDirectory()
+	if err != nil {
+		return "", err
+	}
+
+	if playerID != 0 {
+		for _, player := range players {
+			if player.PlayerID == playerID {
+				return player.PhotoURL, nil
+			}
+		}
+	}
+
+	// Name matches must be near-exact so a photo is never shown for the wrong player
+	var bestMatch *SportsDataPlayer
+	bestScore := 0
+	searchName := strings.ToLower(strings.TrimSpace(name))
+	for i := range players {
+		if team != "" && players[i].Team != team {
+			continue
+		}
+		score := c.calculatePlayerMatchScore(strings.ToLower(players[i].Name), searchName)
+		if score > bestScore {
+			bestScore = score
+			bestMatch = &players[i]
+		}
+	}
+	if bestMatch == nil || bestScore < 90 {
+		return "", nil
+	}
+	return bestMatch.PhotoURL, nil
+}
+
+// getPlayerDirectory returns every active player's ID, name, team, position, experience, and photo, from cache when possible
+func (c *Client) getPlayerDirectory() ([]SportsDataPlayer, error) {
+	var cachedPlayers []SportsDataPlayer
+	if _, hit := c.getCachedData(playerDirectoryCacheKey, &cachedPlayers); hit {
+		logger.Debug("cache hit", "data", "player directory")
+		return cachedPlayers, nil
+	}
+
+	return c.fetchPlayerDirectory()
+}
+
+// fetchPlayerDirectory requests the active player directory from the API and caches the result
+func (c *Client) fetchPlayerDirectory() ([]SportsDataPlayer, error) {
+	url := fmt.Sprintf("%s/scores/json/Players?key=%s", c.baseURL, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch players: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("players", resp.StatusCode)
+	}
+
+	var players []SportsDataPlayer
+	if err := c.decodeChecked(SchemaPlayers, resp.Body, &players); err != nil {
+		return nil, fmt.Errorf("failed to parse players response: %v", err)
+	}
+
+	c.setCachedData(CachePlayers, playerDirectoryCacheKey, players)
+
+	return players, nil
+}
+
+// RefreshPlayers re-fetches the active player directory, bypassing the cache
+func (c *Client) RefreshPlayers() error {
+	_, err := c.fetchPlayerDirectory()
+	return err
+}
+
+// lookupPlayer resolves a name to a player in the active player directory. It returns nil when
+// the directory is unavailable or has no close enough match, e.g. for a retired player, so
+// callers can fall back to searching a stat sheet by name.
+func (c *Client) lookupPlayer(name string) *SportsDataPlayer {
+	players, err := c.getPlayerDirectory()
+	if err != nil {
+		logger.Warn("player directory unavailable, matching names on the stat sheet", "error", err)
+		return nil
+	}
+
+	var bestMatch *SportsDataPlayer
+	bestScore := 0
+	searchName := strings.ToLower(strings.TrimSpace(name))
+	for i := range players {
+		score := c.calculatePlayerMatchScore(strings.ToLower(players[i].Name), searchName)
+		if score > bestScore {
+			bestScore = score
+			bestMatch = &players[i]
+		}
+	}
+	if bestScore < 50 {
+		logger.Debug("no directory match", "search", name, "best_score", bestScore)
+		return nil
+	}
+
+	logger.Debug("directory match", "match", bestMatch.Name, "player_id", bestMatch.PlayerID, "score", bestScore, "search", name)
+	return bestMatch
+}
+
+// playerGameStatsCacheKey returns the cache key for one player's stats in one week
+func playerGameStatsCacheKey(season int, seasonType string, week, playerID int) string {
+	return fmt.Sprintf("player_game_stats_%d%s_%d_%d", season, seasonType, week, playerID)
+}
+
+// getPlayerGameStats returns a directory player's stats for one week. A cached stat sheet is
+// used when there is one, since that lookup is free and includes the team's pass attempts for
+// target share; otherwise only that player's game is requested. A player without stats that week
+// is an InactiveError.
+func (c *Client) getPlayerGameStats(player *SportsDataPlayer, season int, seasonType string, week int) (*models.PlayerStats, error) {
+
+	var sheet []SportsDataPlayerStat
+	if freshness, hit := c.getCachedData(weekStatSheetCacheKey(season, seasonType, week), &sheet); hit {
+		for i := range sheet {
+			if int(sheet[i].PlayerID) == player.PlayerID {
+				stats := weekPlayerStats(&sheet[i], teamPassAttempts(sheet))
+				stats.Freshness = freshness
+				return stats, nil
+			}
+		}
+		return nil, c.playerInactive(player, season, seasonType, week)
+	}
+
+	cacheKey := playerGameStatsCacheKey(season, seasonType, week, player.PlayerID)
+	var row SportsDataPlayerStat
+	if freshness, hit := c.getCachedData(cacheKey, &row); hit {
+		stats := weekPlayerStats(&row, nil)
+		stats.Freshness = freshness
+		return stats, nil
+	}
+
+	url := fmt.Sprintf("%s/stats/json/PlayerGameStatsByPlayerID/%d%s/%d/%d?key=%s",
+		c.baseURL, season, seasonType, week, player.PlayerID, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch player game stats: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("player game stats", resp.StatusCode)
+	}
+
+	// The endpoint answers null when the player has no game that week
+	var game *SportsDataPlayerStat
+	if err := c.decodeChecked(SchemaPlayerStats, resp.Body, &game); err != nil {
+		return nil, fmt.Errorf("failed to parse player game stats response: %v", err)
+	}
+	if game == nil {
+		return nil, c.playerInactive(player, season, seasonType, week)
+	}
+
+	freshness := responseFreshness(resp)
+	c.setCachedDataAt(CachePlayerStats, cacheKey, game, freshness.FetchedAt)
+
+	stats := weekPlayerStats(game, nil)
+	stats.Freshness = freshness
+	return stats, nil
+}
+
+// getAggregatedSeasonStatsByID totals a directory player's seasonSampleWeeks, like
+// getAggregatedSeasonStats but requesting only that player's games
+func (c *Client) getAggregatedSeasonStatsByID(player *SportsDataPlayer, season int, seasonType string, cacheKey string) (*models.PlayerStats, error) {
+	var aggregatedStats *models.PlayerStats
+	for _, week := range seasonSampleWeeks {
+		stats, err := c.getPlayerGameStats(player, season, seasonType, week)
+		if err != nil {
+			if Retryable(err) {
+				return nil, err
+			}
+			continue // Didn't play, or the week failed; try the next one
+		}
+
+		if aggregatedStats == nil {
+			aggregatedStats = &models.PlayerStats{
+				PlayerID: stats.PlayerID,
+				Name:     stats.Name,
+				Team:     stats.Team,
+				Position: stats.Position,
+				Season:   season,
+			}
+		}
+		aggregatedStats.Add(stats)
+		aggregatedStats.Games++
+	}
+
+	if aggregatedStats == nil {
+		return nil, &NotFoundError{Class: ErrPlayerNotFound, Name: player.Name, Scope: fmt.Sprintf("%d season data", season)}
+	}
+
+	// Flag the sample so it isn't mistaken for full season totals
+	aggregatedStats.Note = fmt.Sprintf("Sample from %d of 18 games (not full season)", aggregatedStats.Games)
+	aggregatedStats.Freshness = models.Freshness{FetchedAt: time.Now(), Source: models.SourceLive}
+
+	c.setCachedData(CachePlayerStats, cacheKey, aggregatedStats)
+
+	logger.Info("completed season aggregation", "player", player.Name, "player_id", player.PlayerID, "games", aggregatedStats.Games)
+
+	return aggregatedStats, nil
+}
+
+// playerInactive builds the error for a directory player with no stats in a week, noting whether
+// their team had a bye and, otherwise, their current injury designation
+func (c *Client) playerInactive(player *SportsDataPlayer, season int, seasonType string, week int) error {
+	inactive := &InactiveError{
+		Name:  player.Name,
+		Team:  player.Team,
+		Scope: fmt.Sprintf("%s, %d", models.WeekLabel(seasonType, week), season),
+		Bye:   c.hadBye(player.Team, season, seasonType, week),
+	}
+	if !inactive.Bye && player.InjuryStatus != "" {
+		inactive.Injury = player.InjuryStatus
+		if player.InjuryBodyPart != "" {
+			inactive.Injury += " (" + player.InjuryBodyPart + ")"
+		}
+	}
+	return inactive
+}
+
+// hadBye reports whether a team had no game in a week of the schedule. It returns false when the
+// schedule is unavailable or doesn't cover that week yet, and in the postseason, where a missing
+// game means the team was eliminated unless the schedule lists a bye.
+func (c *Client) hadBye(team string, season int, seasonType string, week int) bool {
+	if team == "" {
+		return false // free agents have no schedule
+	}
+	games, _, err := c.fetchSchedule(season, seasonType)
+	if err != nil {
+		logger.Debug("schedule unavailable for bye check", "team", team, "error", err)
+		return false
+	}
+
+	scheduled := false
+	for _, game := range games {
+		if game.Week != week {
+			continue
+		}
+		scheduled = true
+		if !strings.EqualFold(game.HomeTeam, team) && !strings.EqualFold(game.AwayTeam, team) {
+			continue
+		}
+		return strings.EqualFold(game.HomeTeam, "BYE") || strings.EqualFold(game.AwayTeam, "BYE")
+	}
+	return scheduled && seasonType != models.SeasonTypePostseason
+}
@@ -0,0 +1,276 @@
+package nfl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// SportsDataPlayByPlay is a game's play-by-play from SportsData.io
+type SportsDataPlayByPlay struct {
+	Score    SportsDataGameSituation `json:"Score"`
+	Quarters []SportsDataQuarter     `json:"Quarters"`
+	Plays    []SportsDataPlay        `json:"Plays"`
+}
+
+// SportsDataGameSituation is the live state of a game in a play-by-play payload
+type SportsDataGameSituation struct {
+	Possession        string `json:"Possession"`      // team with the ball, empty between plays or after the final
+	DownAndDistance   string `json:"DownAndDistance"` // e.g. "3rd & 7"
+	YardLine          *int   `json:"YardLine"`
+	YardLineTerritory string `json:"YardLineTerritory"` // team whose side of the field the ball is on
+	LastPlay          string `json:"LastPlay"`
+}
+
+// SportsDataQuarter is one period's points in a play-by-play payload
+type SportsDataQuarter struct {
+	Name      string `json:"Name"` // "1" through "4", or "OT"
+	AwayScore int    `json:"AwayScore"`
+	HomeScore int    `json:"HomeScore"`
+}
+
+// SportsDataPlay is one play from a game's play-by-play
+type SportsDataPlay struct {
+	PlayID               int                    `json:"PlayID"`
+	QuarterName          string                 `json:"QuarterName"`
+	Sequence             int                    `json:"Sequence"`
+	TimeRemainingMinutes *int                   `json:"TimeRemainingMinutes"`
+	TimeRemainingSeconds *int                   `json:"TimeRemainingSeconds"`
+	Team                 string                 `json:"Team"`     // team with the ball
+	Opponent             string                 `json:"Opponent"` // team on defense
+	Down                 int                    `json:"Down"`     // 0 for kickoffs and conversions
+	Distance             int                    `json:"Distance"`
+	YardLine             *int                   `json:"YardLine"`
+	YardLineTerritory    string                 `json:"YardLineTerritory"`
+	Type                 string                 `json:"Type"` // e.g. "PassCompleted", "FieldGoal", "PassIntercepted"
+	YardsGained          int                    `json:"YardsGained"`
+	Description          string                 `json:"Description"`
+	IsScoringPlay        bool                   `json:"IsScoringPlay"`
+	ScoringPlay          *SportsDataScoringPlay `json:"ScoringPlay"`
+}
+
+// SportsDataScoringPlay is the score after a scoring play
+type SportsDataScoringPlay struct {
+	AwayScore int `json:"AwayScore"`
+	HomeScore int `json:"HomeScore"`
+}
+
+// GetNotablePlays returns a game's scoring plays and turnovers in the order they happened, for a
+// season type (PRE, REG, POST). Results are cached as briefly as live scores.
+func (c *Client) GetNotablePlays(seasonType string, game *models.LiveScore) ([]*models.GamePlay, error) {
+	cacheKey := fmt.Sprintf("plays_%d%s_%d_%s", game.Season, seasonType, game.Week, game.HomeTeam)
+
+	var cachedPlays []*models.GamePlay
+	if _, hit := c.getCachedData(cacheKey, &cachedPlays); hit {
+		logger.Debug("cache hit", "data", "plays", "game", game.GameID)
+		return cachedPlays, nil
+	}
+
+	playByPlay, err := c.fetchPlayByPlay(seasonType, game)
+	if err != nil {
+		return nil, err
+	}
+
+	plays := notablePlays(playByPlay.Plays, game)
+	c.setCachedData(CacheScores, cacheKey, plays)
+
+	return plays, nil
+}
+
+// GetGameDetail returns a game's line score by quarter and, while it's live, the possession,
+// down and distance, and last play. Results are cached as briefly as live scores.
+func (c *Client) GetGameDetail(seasonType string, game *models.LiveScore) (*models.GameDetail, error) {
+	cacheKey := fmt.Sprintf("game_detail_%d%s_%d_%s", game.Season, seasonType, game.Week, game.HomeTeam)
+
+	var cachedDetail models.GameDetail
+	if _, hit := c.getCachedData(cacheKey, &cachedDetail); hit {
+		logger.Debug("cache hit", "data", "game detail", "game", game.GameID)
+		return &cachedDetail, nil
+	}
+
+	playByPlay, err := c.fetchPlayByPlay(seasonType, game)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &models.GameDetail{
+		Possession:   playByPlay.Score.Possession,
+		DownDistance: playByPlay.Score.DownAndDistance,
+		LastPlay:     playByPlay.Score.LastPlay,
+	}
+	if yardLine := playByPlay.Score.YardLine; yardLine != nil && playByPlay.Score.YardLineTerritory != "" {
+		detail.BallOn = fmt.Sprintf("%s %d", playByPlay.Score.YardLineTerritory, *yardLine)
+	}
+	for _, quarter := range playByPlay.Quarters {
+		detail.Quarters = append(detail.Quarters, models.QuarterScore{
+			Name:      quarter.Name,
+			AwayScore: quarter.AwayScore,
+			HomeScore: quarter.HomeScore,
+		})
+	}
+	c.setCachedData(CacheScores, cacheKey, detail)
+
+	return detail, nil
+}
+
+// GetPlayLog returns every play of a game in the order they happened, for a season type (PRE,
+// REG, POST). Results are cached as briefly as live scores.
+func (c *Client) GetPlayLog(seasonType string, game *models.LiveScore) ([]*models.LoggedPlay, error) {
+	cacheKey := fmt.Sprintf("play_log_%d%s_%d_%s", game.Season, seasonType, game.Week, game.HomeTeam)
+
+	var cachedPlays []*models.LoggedPlay
+	if _, hit := c.getCachedData(cacheKey, &cachedPlays); hit {
+		logger.Debug("cache hit", "data", "play log", "game", game.GameID)
+		return cachedPlays, nil
+	}
+
+	playByPlay, err := c.fetchPlayByPlay(seasonType, game)
+	if err != nil {
+		return nil, err
+	}
+
+	plays := playLog(playByPlay.Plays)
+	c.setCachedData(CacheScores, cacheKey, plays)
+
+	return plays, nil
+}
+
+// fetchPlayByPlay downloads a game's play-by-play for a season type (PRE, REG, POST)
+func (c *Client) fetchPlayByPlay(seasonType string, game *models.LiveScore) (*SportsDataPlayByPlay, error) {
+	url := fmt.Sprintf("%s/pbp/json/PlayByPlay/%d%s/%d/%s?key=%s",
+		c.baseURL, game.Season, seasonType, game.Week, game.HomeTeam, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch play-by-play: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("play-by-play", resp.StatusCode)
+	}
+
+	var playByPlay SportsDataPlayByPlay
+	if err := json.NewDecoder(resp.Body).Decode(&playByPlay); err != nil {
+		return nil, fmt.Errorf("failed to parse play-by-play response: %v", err)
+	}
+	return &playByPlay, nil
+}
+
+// notablePlays picks the scoring plays and turnovers out of a play-by-play, working out which
+// team scored from how the score changed
+func notablePlays(plays []SportsDataPlay, game *models.LiveScore) []*models.GamePlay {
+	var notable []*models.GamePlay
+	awayScore, homeScore := 0, 0
+	for _, play := range plays {
+		kinds := playKinds(play)
+
+		var team string
+		if play.ScoringPlay != nil {
+			switch {
+			case play.ScoringPlay.AwayScore > awayScore:
+				team = game.AwayTeam
+			case play.ScoringPlay.HomeScore > homeScore:
+				team = game.HomeTeam
+			}
+			awayScore, homeScore = play.ScoringPlay.AwayScore, play.ScoringPlay.HomeScore
+		}
+		if len(kinds) == 0 {
+			continue
+		}
+		if team == "" {
+			// Turnovers, and scores whose totals didn't change: the defense took the ball
+			team = play.Opponent
+			if kinds[0] != models.PlayTurnover {
+				team = play.Team
+			}
+		}
+
+		notable = append(notable, &models.GamePlay{
+			ID:          play.PlayID,
+			GameID:      game.GameID,
+			Kinds:       kinds,
+			Team:        team,
+			Quarter:     play.QuarterName,
+			Clock:       playClock(play),
+			Description: play.Description,
+			AwayTeam:    game.AwayTeam,
+			HomeTeam:    game.HomeTeam,
+			AwayScore:   awayScore,
+			HomeScore:   homeScore,
+		})
+	}
+	return notable
+}
+
+// playKinds classifies a play, most important kind first
+func playKinds(play SportsDataPlay) []string {
+	description := strings.ToUpper(play.Description)
+
+	var kinds []string
+	switch {
+	case !play.IsScoringPlay || play.Type == "ExtraPoint" || play.Type == "TwoPointConversion":
+	case play.Type == "FieldGoal":
+		kinds = append(kinds, models.PlayFieldGoal)
+	case strings.Contains(description, "SAFETY"):
+		kinds = append(kinds, models.PlaySafety)
+	case strings.Contains(description, "TOUCHDOWN"):
+		kinds = append(kinds, models.PlayTouchdown)
+	}
+
+	switch {
+	case play.Type == "PassIntercepted", strings.Contains(description, "INTERCEPTED"):
+		kinds = append(kinds, models.PlayTurnover)
+	case play.Type == "Fumble", strings.Contains(description, "FUMBLES"):
+		// Only fumbles the defense recovered change possession
+		if play.Opponent != "" && strings.Contains(description, "RECOVERED BY "+play.Opponent) {
+			kinds = append(kinds, models.PlayTurnover)
+		}
+	}
+	return kinds
+}
+
+// playClock formats the time left in the quarter, e.g. "4:32", or "" when the API didn't say
+func playClock(play SportsDataPlay) string {
+	if play.TimeRemainingMinutes == nil || play.TimeRemainingSeconds == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d:%02d", *play.TimeRemainingMinutes, *play.TimeRemainingSeconds)
+}
+
+// playLog converts a game's plays into log entries, carrying the score forward from each scoring play
+func playLog(plays []SportsDataPlay) []*models.LoggedPlay {
+	var awayScore, homeScore int
+	logged := make([]*models.LoggedPlay, 0, len(plays))
+	for _, play := range plays {
+		if play.ScoringPlay != nil {
+			awayScore, homeScore = play.ScoringPlay.AwayScore, play.ScoringPlay.HomeScore
+		}
+		entry := &models.LoggedPlay{
+			ID:          play.PlayID,
+			Offense:     play.Team,
+			Quarter:     play.QuarterName,
+			Clock:       playClock(play),
+			Type:        play.Type,
+			Down:        play.Down,
+			Distance:    play.Distance,
+			Yards:       play.YardsGained,
+			Description: play.Description,
+			Kinds:       playKinds(play),
+			AwayScore:   awayScore,
+			HomeScore:   homeScore,
+		}
+		if play.YardLine != nil && play.YardLineTerritory != "" {
+			entry.BallOn = fmt.Sprintf("%s %d", play.YardLineTerritory, *play.YardLine)
+		}
+		logged = append(logged, entry)
+	}
+	return logged
+}
@@ -0,0 +1,153 @@
+package nfl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// SportsDataPlayerSeason represents a player's season totals from SportsData.io API
+type SportsDataPlayerSeason struct {
+	PlayerID             int     `json:"PlayerID"`
+	Name                 string  `json:"Name"`
+	Team                 string  `json:"Team"`
+	Position             string  `json:"Position"`
+	Played               int     `json:"Played"`
+	PassingYards         float64 `json:"PassingYards"`
+	PassingTouchdowns    float64 `json:"PassingTouchdowns"`
+	PassingInterceptions float64 `json:"PassingInterceptions"`
+	RushingYards         float64 `json:"RushingYards"`
+	RushingTouchdowns    float64 `json:"RushingTouchdowns"`
+	Receptions           float64 `json:"Receptions"`
+	ReceivingYards       float64 `json:"ReceivingYards"`
+	ReceivingTouchdowns  float64 `json:"ReceivingTouchdowns"`
+	FumblesLost          float64 `json:"FumblesLost"`
+	FantasyPoints        float64 `json:"FantasyPoints"`
+	FantasyPointsPPR     float64 `json:"FantasyPointsPPR"`
+}
+
+// fantasyPositions are the positions included in fantasy rankings
+var fantasyPositions = map[string]bool{"QB": true, "RB": true, "WR": true, "TE": true}
+
+// GetPlayerSeasonTotals retrieves every player's regular season totals for a season
+func (c *Client) GetPlayerSeasonTotals(season int) ([]*models.SeasonPlayerStats, error) {
+	url := fmt.Sprintf("%s/stats/json/PlayerSeasonStats/%dREG?key=%s", c.baseURL, season, c.apiKey)
+	return c.getPlayerSeasons(url, fmt.Sprintf("player_season_totals_%dREG", season), fmt.Sprintf("season totals for %d", season))
+}
+
+// GetPlayerSeasonProjections retrieves every player's projected regular season totals for a season
+func (c *Client) GetPlayerSeasonProjections(season int) ([]*models.SeasonPlayerStats, error) {
+	url := fmt.Sprintf("%s/projections/json/PlayerSeasonProjectionStats/%dREG?key=%s", c.baseURL, season, c.apiKey)
+	return c.getPlayerSeasons(url, fmt.Sprintf("player_season_projections_%dREG", season), fmt.Sprintf("season projections for %d", season))
+}
+
+// getPlayerSeasons fetches and caches a season-long player stat list (totals or projections)
+func (c *Client) getPlayerSeasons(url, cacheKey, description string) ([]*models.SeasonPlayerStats, error) {
+	// Check cache first
+	var cachedTotals []*models.SeasonPlayerStats
+	if _, hit := c.getCachedData(cacheKey, &cachedTotals); hit {
+		logger.Debug("cache hit", "data", description)
+		return cachedTotals, nil
+	}
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", description, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError(description, resp.StatusCode)
+	}
+
+	var seasons []SportsDataPlayerSeason
+	if err := json.NewDecoder(resp.Body).Decode(&seasons); err != nil {
+		return nil, fmt.Errorf("failed to parse %s response: %v", description, err)
+	}
+
+	totals := make([]*models.SeasonPlayerStats, 0, len(seasons))
+	for _, player := range seasons {
+		totals = append(totals, &models.SeasonPlayerStats{
+			PlayerID:            player.PlayerID,
+			Name:                player.Name,
+			Team:                player.Team,
+			Position:            player.Position,
+			Games:               player.Played,
+			PassingYards:        player.PassingYards,
+			PassingTouchdowns:   player.PassingTouchdowns,
+			Interceptions:       player.PassingInterceptions,
+			RushingYards:        player.RushingYards,
+			RushingTouchdowns:   player.RushingTouchdowns,
+			Receptions:          player.Receptions,
+			ReceivingYards:      player.ReceivingYards,
+			ReceivingTouchdowns: player.ReceivingTouchdowns,
+			FumblesLost:         player.FumblesLost,
+			FantasyPoints:       player.FantasyPoints,
+			FantasyPointsPPR:    player.FantasyPointsPPR,
+		})
+	}
+
+	// Cache the result
+	c.setCachedData(CacheSeasonStats, cacheKey, totals)
+
+	return totals, nil
+}
+
+// GetFantasyRankings returns QB/RB/WR/TE players ranked by a season's PPR fantasy points
+func (c *Client) GetFantasyRankings(season int) ([]*models.SeasonPlayerStats, error) {
+	totals, err := c.GetPlayerSeasonTotals(season)
+	if err != nil {
+		return nil, err
+	}
+
+	var rankings []*models.SeasonPlayerStats
+	for _, player := range totals {
+		if fantasyPositions[player.Position] && player.FantasyPointsPPR > 0 {
+			rankings = append(rankings, player)
+		}
+	}
+
+	sort.SliceStable(rankings, func(i, j int) bool {
+		return rankings[i].FantasyPointsPPR > rankings[j].FantasyPointsPPR
+	})
+	return rankings, nil
+}
+
+// rookieMaxExperience is the most experience the player directory reports for a rookie: it reads
+// 0 before a rookie's first game and 1 once they've played
+const rookieMaxExperience = 1
+
+// GetRookieSeasonTotals returns this season's regular season totals for the QB/RB/WR/TE rookies
+// who have played, joining the player directory's experience with the season's stats
+func (c *Client) GetRookieSeasonTotals(season int) ([]*models.SeasonPlayerStats, error) {
+	players, err := c.getPlayerDirectory()
+	if err != nil {
+		return nil, err
+	}
+	rookies := make(map[int]bool)
+	for _, player := range players {
+		if player.Experience <= rookieMaxExperience {
+			rookies[player.PlayerID] = true
+		}
+	}
+
+	totals, err := c.GetPlayerSeasonTotals(season)
+	if err != nil {
+		return nil, err
+	}
+
+	var rookieTotals []*models.SeasonPlayerStats
+	for _, player := range totals {
+		if rookies[player.PlayerID] && fantasyPositions[player.Position] && player.Games > 0 {
+			rookieTotals = append(rookieTotals, player)
+		}
+	}
+	return rookieTotals, nil
+}
@@ -0,0 +1,275 @@
+package nfl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// Payload schemas checked for drift, named after the data they describe
+const (
+	SchemaGames       = "games"
+	SchemaPlayerStats = "player stats"
+	SchemaTeams       = "teams"
+	SchemaStandings   = "standings"
+	SchemaTimeframes  = "timeframes"
+	SchemaPlayers     = "players"
+	SchemaRookies     = "rookies"
+	SchemaProjections = "projections"
+)
+
+// schemaExampleLength caps how much of an offending value a drift report quotes
+const schemaExampleLength = 40
+
+// fieldRule is what the bot expects of one field in every record of a payload
+type fieldRule struct {
+	name     string
+	nullable bool                   // null is normal, e.g. scores before kickoff
+	check    func(value any) string // describes a problem with a non-null value, or returns ""
+}
+
+// payloadSchemas lists the fields the bot relies on in each payload; fields not listed are ignored
+var payloadSchemas = map[string][]fieldRule{
+	SchemaGames: {
+		{name: "GameKey"},
+		{name: "Season", check: expectNumber},
+		{name: "Week", check: expectNumber},
+		{name: "AwayTeam", check: expectString},
+		{name: "HomeTeam", check: expectString},
+		{name: "Status", check: expectGameStatus},
+		{name: "DateTime", nullable: true, check: expectDateTime}, // null for byes
+		{name: "AwayScore", nullable: true, check: expectNumber},
+		{name: "HomeScore", nullable: true, check: expectNumber},
+		{name: "Quarter", nullable: true, check: expectString},
+		{name: "TimeRemaining", nullable: true, check: expectString},
+		{name: "PointSpread", nullable: true, check: expectNumber},
+		{name: "OverUnder", nullable: true, check: expectNumber},
+		{name: "Channel", nullable: true, check: expectString}, // null until the broadcast is announced
+	},
+	SchemaPlayerStats: {
+		{name: "PlayerID", check: expectNumber},
+		{name: "Name", check: expectString},
+		{name: "Team", check: expectString},
+		{name: "Position", check: expectString},
+		{name: "PassingYards", nullable: true, check: expectNumber},
+		{name: "RushingYards", nullable: true, check: expectNumber},
+		{name: "ReceivingYards", nullable: true, check: expectNumber},
+		{name: "Receptions", nullable: true, check: expectNumber},
+	},
+	SchemaTeams: {
+		{name: "Key", check: expectString},
+		{name: "City", check: expectString},
+		{name: "Name", check: expectString},
+		{name: "Conference", nullable: true, check: expectString}, // null for retired franchises
+		{name: "Division", nullable: true, check: expectString},
+		{name: "PrimaryColor", nullable: true, check: expectString},
+	},
+	SchemaStandings: {
+		{name: "Team", check: expectString},
+		{name: "Wins", check: expectNumber},
+		{name: "Losses", check: expectNumber},
+		{name: "Ties", check: expectNumber},
+		{name: "Conference", check: expectString},
+		{name: "Division", check: expectString},
+	},
+	SchemaTimeframes: {
+		{name: "SeasonType", check: expectNumber},
+		{name: "Season", check: expectNumber},
+		{name: "Week", nullable: true, check: expectNumber}, // null in the off-season
+		{name: "HasGames", check: expectBool},
+		{name: "HasEnded", check: expectBool},
+	},
+	SchemaPlayers: {
+		{name: "PlayerID", check: expectNumber},
+		{name: "Name", check: expectString},
+		{name: "Experience", nullable: true, check: expectNumber},
+		{name: "PhotoUrl", nullable: true, check: expectString},
+		{name: "InjuryStatus", nullable: true, check: expectString}, // null when healthy
+	},
+	SchemaRookies: {
+		{name: "PlayerID", check: expectNumber},
+		{name: "Name", check: expectString},
+		{name: "CollegeDraftTeam", nullable: true, check: expectString}, // null for undrafted free agents
+		{name: "CollegeDraftRound", nullable: true, check: expectNumber},
+		{name: "CollegeDraftPick", nullable: true, check: expectNumber},
+	},
+	SchemaProjections: {
+		{name: "PlayerID", check: expectNumber},
+		{name: "Name", check: expectString},
+		{name: "DraftKingsSalary", nullable: true, check: expectNumber}, // null until the slate is priced
+		{name: "FanDuelSalary", nullable: true, check: expectNumber},
+		{name: "FantasyPointsDraftKings", nullable: true, check: expectNumber},
+		{name: "FantasyPointsFanDuel", nullable: true, check: expectNumber},
+	},
+}
+
+// expectString flags values that aren't strings
+func expectString(value any) string {
+	if _, ok := value.(string); !ok {
+		return fmt.Sprintf("expected a string, got %T", value)
+	}
+	return ""
+}
+
+// expectNumber flags values that aren't numbers
+func expectNumber(value any) string {
+	if _, ok := value.(float64); !ok {
+		return fmt.Sprintf("expected a number, got %T", value)
+	}
+	return ""
+}
+
+// expectBool flags values that aren't booleans
+func expectBool(value any) string {
+	if _, ok := value.(bool); !ok {
+		return fmt.Sprintf("expected a boolean, got %T", value)
+	}
+	return ""
+}
+
+// expectDateTime flags date strings in a format parseSportsDataDateTime doesn't understand
+func expectDateTime(value any) string {
+	text, ok := value.(string)
+	if !ok {
+		return fmt.Sprintf("expected a date string, got %T", value)
+	}
+	if _, err := parseSportsDataDateTime(text); err != nil {
+		return "unrecognized date format"
+	}
+	return ""
+}
+
+// expectGameStatus flags game statuses the bot doesn't know
+func expectGameStatus(value any) string {
+	text, ok := value.(string)
+	if !ok {
+		return fmt.Sprintf("expected a string, got %T", value)
+	}
+	if _, known := models.ParseGameState(text); !known {
+		return "new status value"
+	}
+	return ""
+}
+
+// SchemaIssue is one kind of unexpected value seen in an API payload
+type SchemaIssue struct {
+	Schema    string
+	Field     string
+	Problem   string
+	Example   string // the first offending value
+	Count     int    // records affected
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// schemaMonitor collects schema issues found in decoded payloads
+type schemaMonitor struct {
+	mu     sync.Mutex
+	issues map[string]*SchemaIssue // keyed by schema, field, and problem
+}
+
+// record counts an issue, logging it the first time it's seen
+func (m *schemaMonitor) record(schema, field, problem, example string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	key := schema + "\x00" + field + "\x00" + problem
+	if issue, exists := m.issues[key]; exists {
+		issue.Count++
+		issue.LastSeen = now
+		return
+	}
+
+	if m.issues == nil {
+		m.issues = make(map[string]*SchemaIssue)
+	}
+	if len(example) > schemaExampleLength {
+		example = example[:schemaExampleLength] + "…"
+	}
+	m.issues[key] = &SchemaIssue{Schema: schema, Field: field, Problem: problem, Example: example, Count: 1, FirstSeen: now, LastSeen: now}
+	logger.Warn("api schema drift", "schema", schema, "field", field, "problem", problem, "example", example)
+}
+
+// check compares every record of a JSON array payload with its schema
+func (m *schemaMonitor) check(schema string, data []byte) {
+	rules := payloadSchemas[schema]
+	var records []map[string]any
+	if len(rules) == 0 || json.Unmarshal(data, &records) != nil {
+		return
+	}
+
+	for _, record := range records {
+		for _, rule := range rules {
+			value, exists := record[rule.name]
+			switch {
+			case !exists:
+				m.record(schema, rule.name, "field missing", "")
+			case value == nil:
+				if !rule.nullable {
+					m.record(schema, rule.name, "unexpected null", "null")
+				}
+			case rule.check != nil:
+				if problem := rule.check(value); problem != "" {
+					m.record(schema, rule.name, problem, fmt.Sprint(value))
+				}
+			}
+		}
+	}
+}
+
+// snapshot returns copies of the issues, most widespread first
+func (m *schemaMonitor) snapshot() []SchemaIssue {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	issues := make([]SchemaIssue, 0, len(m.issues))
+	for _, issue := range m.issues {
+		issues = append(issues, *issue)
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Count != issues[j].Count {
+			return issues[i].Count > issues[j].Count
+		}
+		return issues[i].FirstSeen.Before(issues[j].FirstSeen)
+	})
+	return issues
+}
+
+// reset forgets every issue
+func (m *schemaMonitor) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.issues = nil
+}
+
+// decodeChecked decodes a JSON response body into v, then checks the payload against a schema
+// so upstream format changes are reported instead of silently producing zero values
+func (c *Client) decodeChecked(schema string, body io.Reader, v any) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	c.schema.check(schema, data)
+	return nil
+}
+
+// SchemaDrift returns the schema issues seen since startup or the last ResetSchemaDrift, most
+// widespread first
+func (c *Client) SchemaDrift() []SchemaIssue {
+	return c.schema.snapshot()
+}
+
+// ResetSchemaDrift forgets the schema issues seen so far, e.g. after reporting them
+func (c *Client) ResetSchemaDrift() {
+	c.schema.reset()
+}
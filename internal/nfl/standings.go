@@ -0,0 +1,60 @@
+package nfl
+
+import (
+	"fmt"
+	"net/http"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// GetStandings retrieves regular season standings for all teams
+func (c *Client) GetStandings(season int) ([]*models.TeamStanding, error) {
+	// Create cache key for standings
+	cacheKey := fmt.Sprintf("standings_%d", season)
+
+	// Check cache first
+	var cachedStandings []*models.TeamStanding
+	if _, hit := c.getCachedData(cacheKey, &cachedStandings); hit {
+		logger.Debug("cache hit", "data", "standings", "season", season)
+		return cachedStandings, nil
+	}
+
+	url := fmt.Sprintf("%s/scores/json/Standings/%d?key=%s", c.baseURL, season, c.apiKey)
+
+	// Log the request
+	c.logRequest("GET", url)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch standings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Error("api request failed", "status", resp.StatusCode, "url", url)
+		return nil, c.apiError("standings", resp.StatusCode)
+	}
+
+	var sportsDataStandings []SportsDataStanding
+	if err := c.decodeChecked(SchemaStandings, resp.Body, &sportsDataStandings); err != nil {
+		return nil, fmt.Errorf("failed to parse standings response: %v", err)
+	}
+
+	standings := make([]*models.TeamStanding, 0, len(sportsDataStandings))
+	for _, standing := range sportsDataStandings {
+		standings = append(standings, &models.TeamStanding{
+			Team:       standing.Team,
+			Wins:       standing.Wins,
+			Losses:     standing.Losses,
+			Ties:       standing.Ties,
+			Percentage: standing.Percentage,
+			Division:   standing.Division,
+			Conference: standing.Conference,
+		})
+	}
+
+	// Cache the result
+	c.setCachedData(CacheStandings, cacheKey, standings)
+
+	return standings, nil
+}
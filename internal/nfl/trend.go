@@ -0,0 +1,103 @@
+package nfl
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// PlayerWeek is one week of a player's season; Stats is nil when the player has no line that week
+type PlayerWeek struct {
+	Week  int
+	Stats *models.PlayerStats
+}
+
+// GetPlayerWeekByWeek returns a player's stat line for every week of a season type through
+// throughWeek. Week sheets are fetched concurrently, and weeks where the best name match is a
+// different player than the most recent match are treated as missed.
+func (c *Client) GetPlayerWeekByWeek(playerName string, season int, seasonType string, throughWeek int) ([]PlayerWeek, error) {
+	name := strings.TrimSpace(playerName)
+	if name == "" {
+		return nil, fmt.Errorf("player name cannot be empty")
+	}
+
+	minWeek, maxWeek := models.WeekRange(seasonType)
+	if throughWeek > maxWeek {
+		throughWeek = maxWeek
+	}
+	if throughWeek < minWeek {
+		return nil, fmt.Errorf("no %s weeks have been played in %d yet", models.WeekLabel(seasonType, minWeek), season)
+	}
+
+	weeks := make([]PlayerWeek, throughWeek-minWeek+1)
+	var wg sync.WaitGroup
+	for index := range weeks {
+		week := minWeek + index
+		weeks[index].Week = week
+		wg.Add(1)
+		go func(index, week int) {
+			defer wg.Done()
+			sheet, _, err := c.getWeekStatSheet(season, seasonType, week)
+			if err != nil {
+				logger.Debug("skipping week in trend", "week", week, "season", season, "error", err)
+				return
+			}
+			lookup := c.lookupSheetPlayer(sheet, name, models.WeekLabel(seasonType, week), season)
+			if lookup.Err == nil {
+				weeks[index].Stats = lookup.Stats
+			}
+		}(index, week)
+	}
+	wg.Wait()
+
+	// Pin the series to one player so a weak match in a missed week doesn't sneak in
+	playerID, found := 0, false
+	for index := len(weeks) - 1; index >= 0 && !found; index-- {
+		if weeks[index].Stats != nil {
+			playerID, found = weeks[index].Stats.PlayerID, true
+		}
+	}
+	if !found {
+		return nil, &NotFoundError{Class: ErrPlayerNotFound, Name: name, Scope: fmt.Sprintf("%d stats", season)}
+	}
+	for index := range weeks {
+		if weeks[index].Stats != nil && weeks[index].Stats.PlayerID != playerID {
+			weeks[index].Stats = nil
+		}
+	}
+
+	return weeks, nil
+}
+
+// GetWeeksStats returns every player's stat lines for each week of a season type through
+// throughWeek, indexed from the season type's first week. Week sheets are fetched concurrently
+// and a week that can't be fetched is left empty.
+func (c *Client) GetWeeksStats(season int, seasonType string, throughWeek int) ([][]*models.PlayerStats, error) {
+	minWeek, maxWeek := models.WeekRange(seasonType)
+	if throughWeek > maxWeek {
+		throughWeek = maxWeek
+	}
+	if throughWeek < minWeek {
+		return nil, fmt.Errorf("no %s weeks have been played in %d yet", models.WeekLabel(seasonType, minWeek), season)
+	}
+
+	weeks := make([][]*models.PlayerStats, throughWeek-minWeek+1)
+	var wg sync.WaitGroup
+	for index := range weeks {
+		wg.Add(1)
+		go func(index, week int) {
+			defer wg.Done()
+			players, err := c.GetWeekStats(season, seasonType, week)
+			if err != nil {
+				logger.Debug("skipping week stats", "week", week, "season", season, "error", err)
+				return
+			}
+			weeks[index] = players
+		}(index, minWeek+index)
+	}
+	wg.Wait()
+
+	return weeks, nil
+}
@@ -0,0 +1,108 @@
+package nfl
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// UsageStats summarizes the client's API and cache activity since startup
+type UsageStats struct {
+	APICalls      int64
+	APIErrors     int64
+	APILatency    time.Duration // average round trip
+	MonthAPICalls int64         // calls made this calendar month (UTC)
+	CacheHits     int64
+	CacheMisses   int64
+}
+
+// CacheHitRate returns the share of cache lookups that were hits
+func (u UsageStats) CacheHitRate() float64 {
+	lookups := u.CacheHits + u.CacheMisses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(u.CacheHits) / float64(lookups)
+}
+
+// usageCounter tracks API calls and cache lookups
+type usageCounter struct {
+	mu          sync.Mutex
+	calls       int64
+	errors      int64
+	latency     time.Duration
+	month       string
+	monthCalls  int64
+	cacheHits   int64
+	cacheMisses int64
+}
+
+// recordCall counts one API round trip
+func (u *usageCounter) recordCall(elapsed time.Duration, failed bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	month := time.Now().UTC().Format("2006-01")
+	if month != u.month {
+		u.month = month
+		u.monthCalls = 0
+	}
+
+	u.calls++
+	u.monthCalls++
+	u.latency += elapsed
+	if failed {
+		u.errors++
+	}
+}
+
+// recordLookup counts one cache lookup
+func (u *usageCounter) recordLookup(hit bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if hit {
+		u.cacheHits++
+	} else {
+		u.cacheMisses++
+	}
+}
+
+// stats returns a snapshot of the counters
+func (u *usageCounter) stats() UsageStats {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	stats := UsageStats{
+		APICalls:    u.calls,
+		APIErrors:   u.errors,
+		CacheHits:   u.cacheHits,
+		CacheMisses: u.cacheMisses,
+	}
+	if u.calls > 0 {
+		stats.APILatency = u.latency / time.Duration(u.calls)
+	}
+	if u.month == time.Now().UTC().Format("2006-01") {
+		stats.MonthAPICalls = u.monthCalls
+	}
+	return stats
+}
+
+// countingTransport records every HTTP round trip the client makes
+type countingTransport struct {
+	base  http.RoundTripper
+	usage *usageCounter
+}
+
+// RoundTrip performs the request and records its latency and outcome
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	t.usage.recordCall(time.Since(start), err != nil || resp.StatusCode >= http.StatusBadRequest)
+	return resp, err
+}
+
+// Usage returns the client's API and cache activity since startup
+func (c *Client) Usage() UsageStats {
+	return c.usage.stats()
+}
@@ -0,0 +1,105 @@
+package nfl
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// winTotalsSnapshot holds preseason win-total lines keyed by season, then team abbreviation.
+// Lines are a bundled snapshot of consensus sportsbook over/unders taken before Week 1; add
+// each season's lines once they're known, so they stay fixed while the live market moves.
+//
+//go:embed data/win_totals.json
+var winTotalsSnapshot []byte
+
+// GetPreseasonWinTotals returns the preseason win-total line for each team in a season. Seasons
+// missing from the bundled snapshot fall back to the sportsbooks' win total futures, so a new
+// season has lines before the snapshot is updated; ErrNoWinTotals means neither has them.
+func (c *Client) GetPreseasonWinTotals(season int) (map[string]float64, error) {
+	var snapshot map[string]map[string]float64
+	if err := json.Unmarshal(winTotalsSnapshot, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse win totals snapshot: %v", err)
+	}
+
+	if lines, exists := snapshot[strconv.Itoa(season)]; exists {
+		return lines, nil
+	}
+
+	futures, err := c.GetFutures(season)
+	if err != nil {
+		logger.Debug("win total futures unavailable", "season", season, "error", err)
+		return nil, fmt.Errorf("%w for %d", ErrNoWinTotals, season)
+	}
+	lines := consensusWinTotals(futures)
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w for %d", ErrNoWinTotals, season)
+	}
+	return lines, nil
+}
+
+// consensusWinTotals takes the median win total line each team is offered at across sportsbooks
+func consensusWinTotals(futures []*models.FuturesOdds) map[string]float64 {
+	offered := make(map[string][]float64)
+	for _, odds := range futures {
+		if odds.Market != models.FuturesWinTotal || odds.Line == nil {
+			continue
+		}
+		offered[odds.Team] = append(offered[odds.Team], *odds.Line)
+	}
+
+	lines := make(map[string]float64, len(offered))
+	for team, values := range offered {
+		sort.Float64s(values)
+		middle := len(values) / 2
+		if len(values)%2 == 0 {
+			lines[team] = (values[middle-1] + values[middle]) / 2
+		} else {
+			lines[team] = values[middle]
+		}
+	}
+	return lines
+}
+
+// GetWinTotalPaces compares each team's current record pace against its preseason win total.
+// Results are sorted by how far each team is running ahead of its line.
+func (c *Client) GetWinTotalPaces(season int) ([]*models.WinTotalPace, error) {
+	lines, err := c.GetPreseasonWinTotals(season)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := c.GetStandings(season)
+	if err != nil {
+		return nil, err
+	}
+
+	var paces []*models.WinTotalPace
+	for _, standing := range standings {
+		line, exists := lines[standing.Team]
+		if !exists {
+			continue
+		}
+		paces = append(paces, &models.WinTotalPace{
+			Team:   standing.Team,
+			Line:   line,
+			Wins:   standing.Wins,
+			Losses: standing.Losses,
+			Ties:   standing.Ties,
+		})
+	}
+
+	if len(paces) == 0 {
+		return nil, fmt.Errorf("no standings available for %d", season)
+	}
+
+	sort.Slice(paces, func(i, j int) bool {
+		return paces[i].Margin() > paces[j].Margin()
+	})
+
+	return paces, nil
+}
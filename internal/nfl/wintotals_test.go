@@ -0,0 +1,62 @@
+package nfl
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"nfl-discord-bot/internal/nfl/nfltest"
+)
+
+// fixtureClient is a client backed by the nfltest fixtures
+func fixtureClient(t *testing.T) *Client {
+	t.Helper()
+	server := nfltest.NewServer(nfltest.Fixtures)
+	t.Cleanup(server.Close)
+	return NewClient("test-key", server.URL, nil)
+}
+
+func TestPreseasonWinTotals(t *testing.T) {
+	client := fixtureClient(t)
+
+	tests := []struct {
+		name   string
+		season int
+		want   map[string]float64
+		err    error
+	}{
+		{name: "bundled snapshot", season: 2025},
+		{
+			// 2026 isn't in the snapshot, so the lines come from the win total futures: the
+			// median line across sportsbooks, with both sides of a line counted
+			name:   "odds feed fallback",
+			season: 2026,
+			want:   map[string]float64{"BUF": 11.5, "KC": 11},
+		},
+		{name: "no lines anywhere", season: 2027, err: ErrNoWinTotals},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := client.GetPreseasonWinTotals(tt.season)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == nil {
+				if len(lines) != 32 {
+					t.Errorf("snapshot has %d teams, want 32", len(lines))
+				}
+				return
+			}
+			if !reflect.DeepEqual(lines, tt.want) {
+				t.Errorf("lines = %v, want %v", lines, tt.want)
+			}
+		})
+	}
+}
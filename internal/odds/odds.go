@@ -0,0 +1,230 @@
+// Package odds grades closing betting lines against final scores: against-the-spread
+// results, over/under results, and per-team ATS records.
+package odds
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Result is the outcome of a bet against a closing line
+type Result int
+
+// Bet outcomes
+const (
+	Loss Result = iota
+	Win
+	Push
+)
+
+// ClosingLine is a game's final spread and total along with its final score
+type ClosingLine struct {
+	GameID     string  `json:"game_id"`
+	Season     int     `json:"season"`
+	SeasonType string  `json:"season_type"`
+	Week       int     `json:"week"`
+	HomeTeam   string  `json:"home_team"`
+	AwayTeam   string  `json:"away_team"`
+	Spread     float64 `json:"spread"` // home team's line; negative when the home team is favored
+	Total      float64 `json:"total"`  // over/under, 0 when no total was posted
+	HomeScore  int     `json:"home_score"`
+	AwayScore  int     `json:"away_score"`
+}
+
+// Involves reports whether team played in the game
+func (l ClosingLine) Involves(team string) bool {
+	return l.HomeTeam == team || l.AwayTeam == team
+}
+
+// TeamSpread returns the line from team's side (negative when team was favored)
+func (l ClosingLine) TeamSpread(team string) float64 {
+	if team == l.HomeTeam {
+		return l.Spread
+	}
+	return -l.Spread
+}
+
+// Margin returns team's final scoring margin
+func (l ClosingLine) Margin(team string) int {
+	if team == l.HomeTeam {
+		return l.HomeScore - l.AwayScore
+	}
+	return l.AwayScore - l.HomeScore
+}
+
+// Cover grades team against the spread
+func (l ClosingLine) Cover(team string) Result {
+	adjusted := float64(l.Margin(team)) + l.TeamSpread(team)
+	switch {
+	case adjusted > 0:
+		return Win
+	case adjusted < 0:
+		return Loss
+	default:
+		return Push
+	}
+}
+
+// OverUnder grades the game total, reporting false when no total was posted
+func (l ClosingLine) OverUnder() (over Result, graded bool) {
+	if l.Total == 0 {
+		return Loss, false
+	}
+	points := float64(l.HomeScore + l.AwayScore)
+	switch {
+	case points > l.Total:
+		return Win, true
+	case points < l.Total:
+		return Loss, true
+	default:
+		return Push, true
+	}
+}
+
+// Describe summarizes team's result, e.g. "covered as 3-point underdogs"
+func (l ClosingLine) Describe(team string) string {
+	var verb string
+	switch l.Cover(team) {
+	case Win:
+		verb = "covered"
+	case Loss:
+		verb = "failed to cover"
+	default:
+		verb = "pushed"
+	}
+
+	spread := l.TeamSpread(team)
+	switch {
+	case spread == 0:
+		return verb + " as a pick'em"
+	case spread > 0:
+		return fmt.Sprintf("%s as %s-point underdogs", verb, formatPoints(spread))
+	default:
+		return fmt.Sprintf("%s as %s-point favorites", verb, formatPoints(-spread))
+	}
+}
+
+// DescribeTotal summarizes the over/under result, e.g. "over 47.5", or "" when no total was posted
+func (l ClosingLine) DescribeTotal() string {
+	over, graded := l.OverUnder()
+	if !graded {
+		return ""
+	}
+	switch over {
+	case Win:
+		return "over " + formatPoints(l.Total)
+	case Loss:
+		return "under " + formatPoints(l.Total)
+	default:
+		return "push on " + formatPoints(l.Total)
+	}
+}
+
+// formatPoints drops the decimal from whole-number lines
+func formatPoints(points float64) string {
+	if points == math.Trunc(points) {
+		return fmt.Sprintf("%.0f", points)
+	}
+	return fmt.Sprintf("%.1f", points)
+}
+
+// ImpliedProbability converts American odds to the bookmaker's implied win probability
+func ImpliedProbability(american int) float64 {
+	switch {
+	case american > 0:
+		return 100 / float64(american+100)
+	case american < 0:
+		return float64(-american) / float64(-american+100)
+	default:
+		return 0
+	}
+}
+
+// Record is a team's against-the-spread and over/under record
+type Record struct {
+	Team   string
+	Covers int
+	Fails  int
+	Pushes int
+	Overs  int
+	Unders int
+	Level  int // totals that landed exactly on the number
+}
+
+// CoverPct returns covers as a share of decided games (pushes excluded)
+func (r Record) CoverPct() float64 {
+	decided := r.Covers + r.Fails
+	if decided == 0 {
+		return 0
+	}
+	return float64(r.Covers) / float64(decided)
+}
+
+// ATS formats the against-the-spread record as W-L or W-L-P
+func (r Record) ATS() string {
+	if r.Pushes > 0 {
+		return fmt.Sprintf("%d-%d-%d", r.Covers, r.Fails, r.Pushes)
+	}
+	return fmt.Sprintf("%d-%d", r.Covers, r.Fails)
+}
+
+// OU formats the over/under record as O-U or O-U-P
+func (r Record) OU() string {
+	if r.Level > 0 {
+		return fmt.Sprintf("%d-%d-%d", r.Overs, r.Unders, r.Level)
+	}
+	return fmt.Sprintf("%d-%d", r.Overs, r.Unders)
+}
+
+// Records tallies every team's ATS and over/under record, best cover rate first
+func Records(lines []ClosingLine) []*Record {
+	byTeam := make(map[string]*Record)
+	record := func(team string) *Record {
+		if _, exists := byTeam[team]; !exists {
+			byTeam[team] = &Record{Team: team}
+		}
+		return byTeam[team]
+	}
+
+	for _, line := range lines {
+		over, graded := line.OverUnder()
+		for _, team := range []string{line.HomeTeam, line.AwayTeam} {
+			r := record(team)
+			switch line.Cover(team) {
+			case Win:
+				r.Covers++
+			case Loss:
+				r.Fails++
+			default:
+				r.Pushes++
+			}
+			if !graded {
+				continue
+			}
+			switch over {
+			case Win:
+				r.Overs++
+			case Loss:
+				r.Unders++
+			default:
+				r.Level++
+			}
+		}
+	}
+
+	var records []*Record
+	for _, r := range byTeam {
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].CoverPct() != records[j].CoverPct() {
+			return records[i].CoverPct() > records[j].CoverPct()
+		}
+		if records[i].Covers != records[j].Covers {
+			return records[i].Covers > records[j].Covers
+		}
+		return records[i].Team < records[j].Team
+	})
+	return records
+}
@@ -0,0 +1,70 @@
+package standings
+
+import "sort"
+
+// DraftPick is one slot in the projected draft order
+type DraftPick struct {
+	Pick     int
+	Standing *Standing
+	SOS      float64 // strength of schedule: combined win percentage of all opponents
+}
+
+// StrengthOfSchedule returns the combined record of every opponent on a team's schedule,
+// counting each meeting once. This is the draft order tiebreaker.
+func (t *Table) StrengthOfSchedule(key string) Record {
+	var sos Record
+	for _, game := range t.games {
+		var opponent string
+		switch key {
+		case game.HomeTeam:
+			opponent = game.AwayTeam
+		case game.AwayTeam:
+			opponent = game.HomeTeam
+		default:
+			continue
+		}
+
+		if standing, exists := t.Teams[opponent]; exists {
+			sos.Wins += standing.Overall.Wins
+			sos.Losses += standing.Overall.Losses
+			sos.Ties += standing.Overall.Ties
+		}
+	}
+	return sos
+}
+
+// DraftOrder projects the draft order if the season ended today: non-playoff teams
+// pick first from worst record to best, followed by the current playoff teams.
+// Ties go to the team with the weaker strength of schedule.
+func (t *Table) DraftOrder() []DraftPick {
+	var nonPlayoff, playoff []DraftPick
+	for key, standing := range t.Teams {
+		pick := DraftPick{Standing: standing, SOS: t.StrengthOfSchedule(key).Pct()}
+		if standing.Seed > 0 {
+			playoff = append(playoff, pick)
+		} else {
+			nonPlayoff = append(nonPlayoff, pick)
+		}
+	}
+
+	byDraftPosition := func(picks []DraftPick) {
+		sort.Slice(picks, func(i, j int) bool {
+			a, b := picks[i], picks[j]
+			if a.Standing.Overall.Pct() != b.Standing.Overall.Pct() {
+				return a.Standing.Overall.Pct() < b.Standing.Overall.Pct()
+			}
+			if a.SOS != b.SOS {
+				return a.SOS < b.SOS
+			}
+			return a.Standing.Team.Key < b.Standing.Team.Key
+		})
+	}
+	byDraftPosition(nonPlayoff)
+	byDraftPosition(playoff)
+
+	order := append(nonPlayoff, playoff...)
+	for index := range order {
+		order[index].Pick = index + 1
+	}
+	return order
+}
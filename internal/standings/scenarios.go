@@ -0,0 +1,252 @@
+package standings
+
+import (
+	"sort"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// MaxScenarioGames caps how many of the week's games are brute-forced; every game doubles the
+// number of outcomes, so 12 games means 4,096 standings computations
+const MaxScenarioGames = 12
+
+// maxScenarioConditions is the most game results one scenario may require
+const maxScenarioConditions = 3
+
+// maxScenariosPerGoal caps how many scenarios are listed for each goal
+const maxScenariosPerGoal = 5
+
+// Goal is a playoff status a team can clinch or be eliminated from
+type Goal int
+
+const (
+	GoalTopSeed Goal = iota
+	GoalDivision
+	GoalPlayoffs
+	GoalEliminated
+)
+
+// Goals lists every goal in display order
+var Goals = []Goal{GoalTopSeed, GoalDivision, GoalPlayoffs, GoalEliminated}
+
+// String describes the goal, e.g. "clinches the division"
+func (g Goal) String() string {
+	switch g {
+	case GoalTopSeed:
+		return "clinches the top seed"
+	case GoalDivision:
+		return "clinches the division"
+	case GoalPlayoffs:
+		return "clinches a playoff berth"
+	default:
+		return "is eliminated"
+	}
+}
+
+// reached reports whether a standing has reached the goal
+func (g Goal) reached(standing *Standing) bool {
+	switch g {
+	case GoalTopSeed:
+		return standing.ClinchedTopSeed
+	case GoalDivision:
+		return standing.ClinchedDivision
+	case GoalPlayoffs:
+		return standing.ClinchedPlayoff
+	default:
+		return standing.EliminatedPlayoff
+	}
+}
+
+// Outcome is one game's hypothetical result
+type Outcome struct {
+	Winner string
+	Loser  string
+}
+
+// Scenario is a set of results that reaches a goal however the week's other games go
+type Scenario []Outcome
+
+// ScenarioReport is what a team can clinch or lose during one week
+type ScenarioReport struct {
+	Team      string
+	Already   map[Goal]bool       // goals reached before the week is played
+	Scenarios map[Goal][]Scenario // the simplest scenarios reaching each goal
+	Possible  map[Goal]bool       // goals reachable under some combination of results
+	Games     int                 // games brute-forced
+	Skipped   int                 // unplayed games beyond MaxScenarioGames left out
+}
+
+// FindScenarios brute-forces every win/loss combination of the week's unplayed games that can
+// affect the team and lists the simplest combinations of up to three results that reach each
+// goal. Ties are ignored, and when more than MaxScenarioGames games matter, those least likely
+// to affect the team (other conference games, then the farthest in the standings) are left out.
+func FindScenarios(teams []Team, games []models.Game, team string, week int) *ScenarioReport {
+	current := Compute(teams, games)
+	report := &ScenarioReport{
+		Team:      team,
+		Already:   make(map[Goal]bool),
+		Scenarios: make(map[Goal][]Scenario),
+		Possible:  make(map[Goal]bool),
+	}
+
+	standing := current.Teams[team]
+	if standing == nil {
+		return report
+	}
+	for _, goal := range Goals {
+		report.Already[goal] = goal.reached(standing)
+	}
+
+	indexes := scenarioGames(current, games, standing, week)
+	if len(indexes) > MaxScenarioGames {
+		report.Skipped = len(indexes) - MaxScenarioGames
+		indexes = indexes[:MaxScenarioGames]
+	}
+	report.Games = len(indexes)
+	if len(indexes) == 0 {
+		return report
+	}
+
+	// reached[goal][combo] records the goal under each combination; bit n of combo set means
+	// the home team won game n
+	combos := 1 << len(indexes)
+	reached := make(map[Goal][]bool, len(Goals))
+	for _, goal := range Goals {
+		reached[goal] = make([]bool, combos)
+	}
+
+	simulated := make([]models.Game, len(games))
+	for combo := 0; combo < combos; combo++ {
+		copy(simulated, games)
+		for bit, index := range indexes {
+			game := &simulated[index]
+			// Only the winner matters for clinching; use a typical one-score margin
+			if combo&(1<<bit) != 0 {
+				game.HomeScore, game.AwayScore = 24, 20
+			} else {
+				game.HomeScore, game.AwayScore = 20, 24
+			}
+			game.Status = "Final"
+		}
+
+		outcome := Compute(teams, simulated).Teams[team]
+		for _, goal := range Goals {
+			if goal.reached(outcome) {
+				reached[goal][combo] = true
+				report.Possible[goal] = true
+			}
+		}
+	}
+
+	for _, goal := range Goals {
+		if report.Already[goal] || !report.Possible[goal] {
+			continue
+		}
+		report.Scenarios[goal] = simplestScenarios(reached[goal], games, indexes)
+	}
+	return report
+}
+
+// scenarioGames returns the indexes of the week's unplayed games that can affect the team: its
+// own game first, then conference games ordered by how close the teams are in the standings
+func scenarioGames(table *Table, games []models.Game, standing *Standing, week int) []int {
+	type candidate struct {
+		index    int
+		priority int
+	}
+	var candidates []candidate
+	for index, game := range games {
+		if game.Week != week || IsFinal(game.Status) {
+			continue
+		}
+		home, away := table.Teams[game.HomeTeam], table.Teams[game.AwayTeam]
+		if home == nil || away == nil {
+			continue
+		}
+
+		switch {
+		case home == standing || away == standing:
+			candidates = append(candidates, candidate{index: index, priority: -1})
+		case home.Team.Conference == standing.Team.Conference || away.Team.Conference == standing.Team.Conference:
+			gap := min(winGap(home, standing), winGap(away, standing))
+			if home.Team.DivisionName() == standing.Team.DivisionName() || away.Team.DivisionName() == standing.Team.DivisionName() {
+				gap -= 2 // division races are decided by these games first
+			}
+			candidates = append(candidates, candidate{index: index, priority: gap})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].priority < candidates[j].priority
+	})
+	indexes := make([]int, len(candidates))
+	for position, candidate := range candidates {
+		indexes[position] = candidate.index
+	}
+	return indexes
+}
+
+// winGap is how many wins apart two teams are
+func winGap(a, b *Standing) int {
+	gap := a.Overall.Wins - b.Overall.Wins
+	if gap < 0 {
+		return -gap
+	}
+	return gap
+}
+
+// simplestScenarios finds the smallest sets of results that reach a goal in every combination of
+// the remaining games, skipping sets that contain a smaller one already found
+func simplestScenarios(reached []bool, games []models.Game, indexes []int) []Scenario {
+	type condition struct {
+		mask  int // games the condition fixes
+		value int // home wins among those games
+	}
+	var found []condition
+
+	var search func(start, size, mask, value int)
+	search = func(start, size, mask, value int) {
+		if len(found) >= maxScenariosPerGoal {
+			return
+		}
+		if size == 0 {
+			for _, existing := range found {
+				if existing.mask&mask == existing.mask && existing.value == value&existing.mask {
+					return
+				}
+			}
+			for combo := range reached {
+				if combo&mask == value && !reached[combo] {
+					return
+				}
+			}
+			found = append(found, condition{mask: mask, value: value})
+			return
+		}
+		for bit := start; bit < len(indexes); bit++ {
+			search(bit+1, size-1, mask|1<<bit, value|1<<bit)
+			search(bit+1, size-1, mask|1<<bit, value)
+		}
+	}
+	for size := 0; size <= maxScenarioConditions; size++ {
+		search(0, size, 0, 0)
+	}
+
+	scenarios := make([]Scenario, 0, len(found))
+	for _, condition := range found {
+		var scenario Scenario
+		for bit, index := range indexes {
+			if condition.mask&(1<<bit) == 0 {
+				continue
+			}
+			game := games[index]
+			if condition.value&(1<<bit) != 0 {
+				scenario = append(scenario, Outcome{Winner: game.HomeTeam, Loser: game.AwayTeam})
+			} else {
+				scenario = append(scenario, Outcome{Winner: game.AwayTeam, Loser: game.HomeTeam})
+			}
+		}
+		scenarios = append(scenarios, scenario)
+	}
+	return scenarios
+}
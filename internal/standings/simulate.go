@@ -0,0 +1,175 @@
+package standings
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// pythagoreanExponent is the commonly used NFL exponent for expected win percentage
+const pythagoreanExponent = 2.37
+
+// priorGames regresses early-season ratings toward .500 as if each team had this many average games
+const priorGames = 4
+
+// homeFieldEdge is the win probability added for the home team
+const homeFieldEdge = 0.03
+
+// Outlook is a team's simulated season outcome probabilities
+type Outlook struct {
+	Team       string
+	MeanWins   float64
+	Playoffs   float64
+	Division   float64
+	Conference float64
+	SuperBowl  float64
+}
+
+// Simulate plays out the rest of the regular season and the playoffs runs times. Each team's
+// strength is its Pythagorean win expectation from points scored and allowed, regressed toward
+// .500 early in the season; games are decided with log5 odds plus a small home-field edge.
+func Simulate(teams []Team, games []models.Game, runs int, rng *rand.Rand) map[string]*Outlook {
+	current := Compute(teams, games)
+	ratings := make(map[string]float64, len(current.Teams))
+	for key, standing := range current.Teams {
+		ratings[key] = rating(standing)
+	}
+
+	outlooks := make(map[string]*Outlook, len(teams))
+	for _, team := range teams {
+		outlooks[team.Key] = &Outlook{Team: team.Key}
+	}
+	if runs <= 0 {
+		return outlooks
+	}
+
+	simulated := make([]models.Game, len(games))
+	for run := 0; run < runs; run++ {
+		copy(simulated, games)
+		for index := range simulated {
+			game := &simulated[index]
+			if IsFinal(game.Status) {
+				continue
+			}
+			// Only the winner matters for standings; use a typical one-score margin
+			if rng.Float64() < winProbability(ratings[game.HomeTeam], ratings[game.AwayTeam], true) {
+				game.HomeScore, game.AwayScore = 24, 20
+			} else {
+				game.HomeScore, game.AwayScore = 20, 24
+			}
+			game.Status = "Final"
+		}
+
+		table := rank(teams, simulated)
+		for key, standing := range table.Teams {
+			outlook := outlooks[key]
+			outlook.MeanWins += float64(standing.Overall.Wins) + float64(standing.Overall.Ties)/2
+			if standing.Seed > 0 {
+				outlook.Playoffs++
+			}
+			if standing.DivisionRank == 1 {
+				outlook.Division++
+			}
+		}
+
+		// Conferences play in a fixed order so a seeded rng gives the same results every time
+		champions := make(map[string]string)
+		for _, conference := range []string{"AFC", "NFC"} {
+			seeded := table.seeded[conference]
+			if len(seeded) < PlayoffSpots {
+				continue
+			}
+			champion := playConference(seeded[:PlayoffSpots], ratings, rng)
+			champions[conference] = champion
+			outlooks[champion].Conference++
+		}
+		if afc, nfc := champions["AFC"], champions["NFC"]; afc != "" && nfc != "" {
+			// The Super Bowl is played at a neutral site
+			winner := nfc
+			if rng.Float64() < winProbability(ratings[afc], ratings[nfc], false) {
+				winner = afc
+			}
+			outlooks[winner].SuperBowl++
+		}
+	}
+
+	for _, outlook := range outlooks {
+		outlook.MeanWins /= float64(runs)
+		outlook.Playoffs /= float64(runs)
+		outlook.Division /= float64(runs)
+		outlook.Conference /= float64(runs)
+		outlook.SuperBowl /= float64(runs)
+	}
+	return outlooks
+}
+
+// WinProbability returns the model's chance that the home team beats the away team, using the
+// same ratings Simulate plays games with
+func (t *Table) WinProbability(home, away string) float64 {
+	homeStanding, awayStanding := t.Teams[home], t.Teams[away]
+	if homeStanding == nil || awayStanding == nil {
+		return 0.5
+	}
+	return winProbability(rating(homeStanding), rating(awayStanding), true)
+}
+
+// rating returns a team's regressed Pythagorean win expectation
+func rating(standing *Standing) float64 {
+	played := float64(standing.Overall.Games())
+	expected := 0.5
+	if standing.PointsFor+standing.PointsAgainst > 0 {
+		scored := math.Pow(float64(standing.PointsFor), pythagoreanExponent)
+		allowed := math.Pow(float64(standing.PointsAgainst), pythagoreanExponent)
+		expected = scored / (scored + allowed)
+	}
+	return (expected*played + 0.5*priorGames) / (played + priorGames)
+}
+
+// winProbability returns the chance team a beats team b using log5, with a home-field edge for a
+func winProbability(a, b float64, aHome bool) float64 {
+	denominator := a*(1-b) + b*(1-a)
+	probability := 0.5
+	if denominator > 0 {
+		probability = a * (1 - b) / denominator
+	}
+	if aHome {
+		probability += homeFieldEdge
+	}
+	return math.Max(0.01, math.Min(0.99, probability))
+}
+
+// playConference plays a conference bracket from its seven seeds and returns the champion.
+// The top seed has a bye, the higher seed hosts, and the top remaining seed faces the lowest.
+func playConference(seeds []*Standing, ratings map[string]float64, rng *rand.Rand) string {
+	play := func(higher, lower *Standing) *Standing {
+		if rng.Float64() < winProbability(ratings[higher.Team.Key], ratings[lower.Team.Key], true) {
+			return higher
+		}
+		return lower
+	}
+
+	// Wild card round: 2v7, 3v6, 4v5
+	remaining := []*Standing{seeds[0]}
+	for high, low := 1, len(seeds)-1; high < low; high, low = high+1, low-1 {
+		remaining = append(remaining, play(seeds[high], seeds[low]))
+	}
+	sortBySeed(remaining)
+
+	// Reseed each round: winners stay in seed order, so pair the ends inward
+	for len(remaining) > 1 {
+		var next []*Standing
+		for high, low := 0, len(remaining)-1; high < low; high, low = high+1, low-1 {
+			next = append(next, play(remaining[high], remaining[low]))
+		}
+		sortBySeed(next)
+		remaining = next
+	}
+	return remaining[0].Team.Key
+}
+
+// sortBySeed orders playoff teams from best seed to worst
+func sortBySeed(teams []*Standing) {
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Seed < teams[j].Seed })
+}
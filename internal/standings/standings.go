@@ -0,0 +1,473 @@
+// Package standings computes division standings, playoff seeding, clinch and
+// elimination status, and magic numbers from game results.
+package standings
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"nfl-discord-bot/pkg/models"
+)
+
+// PlayoffSpots is the number of playoff teams per conference
+const PlayoffSpots = 7
+
+// Team identifies a team and its conference/division alignment
+type Team struct {
+	Key        string
+	Conference string // "AFC" or "NFC"
+	Division   string // "East", "North", "South", "West"
+}
+
+// DivisionName returns the full division name, e.g. "AFC East"
+func (t Team) DivisionName() string {
+	return t.Conference + " " + t.Division
+}
+
+// Record is a win-loss-tie record
+type Record struct {
+	Wins   int
+	Losses int
+	Ties   int
+}
+
+// Games returns the number of games in the record
+func (r Record) Games() int {
+	return r.Wins + r.Losses + r.Ties
+}
+
+// Pct returns the winning percentage with ties counted as half a win
+func (r Record) Pct() float64 {
+	if r.Games() == 0 {
+		return 0
+	}
+	return (float64(r.Wins) + float64(r.Ties)/2) / float64(r.Games())
+}
+
+// String formats the record as W-L or W-L-T
+func (r Record) String() string {
+	if r.Ties > 0 {
+		return fmt.Sprintf("%d-%d-%d", r.Wins, r.Losses, r.Ties)
+	}
+	return fmt.Sprintf("%d-%d", r.Wins, r.Losses)
+}
+
+// Standing is one team's computed position
+type Standing struct {
+	Team          Team
+	Overall       Record
+	Division      Record
+	Conference    Record
+	PointsFor     int
+	PointsAgainst int
+	Remaining     int
+
+	DivisionRank int // 1-4 within the division
+	Seed         int // 1-7 playoff seed, 0 when outside the playoff picture
+
+	ClinchedTopSeed    bool
+	ClinchedDivision   bool
+	ClinchedPlayoff    bool
+	EliminatedDivision bool
+	EliminatedPlayoff  bool
+	DivisionMagic      int // wins by this team or losses by the chaser needed to clinch the division
+	PlayoffMagic       int // wins by this team or losses by the chaser needed to clinch a playoff berth
+}
+
+// PointDifferential returns points scored minus points allowed
+func (s *Standing) PointDifferential() int {
+	return s.PointsFor - s.PointsAgainst
+}
+
+// MaxWins returns the most wins the team can still finish with
+func (s *Standing) MaxWins() int {
+	return s.Overall.Wins + s.Remaining
+}
+
+// StatusMarker returns the conventional standings marker: z (top seed), y (division), x (playoffs), e (eliminated)
+func (s *Standing) StatusMarker() string {
+	switch {
+	case s.ClinchedTopSeed:
+		return "z"
+	case s.ClinchedDivision:
+		return "y"
+	case s.ClinchedPlayoff:
+		return "x"
+	case s.EliminatedPlayoff:
+		return "e"
+	default:
+		return ""
+	}
+}
+
+// Table holds computed standings for the league
+type Table struct {
+	Teams map[string]*Standing
+	games []models.Game
+
+	// Conference seeding order (all 16 teams) keyed by conference
+	seeded map[string][]*Standing
+}
+
+// Compute builds standings from the team list and the season's regular season games.
+// Games that are not final count toward each team's remaining schedule.
+func Compute(teams []Team, games []models.Game) *Table {
+	table := rank(teams, games)
+	table.computeClinching()
+	return table
+}
+
+// rank builds standings and seeds without the clinching math, which a finished season (like each
+// simulated one) doesn't need
+func rank(teams []Team, games []models.Game) *Table {
+	table := &Table{
+		Teams:  make(map[string]*Standing, len(teams)),
+		games:  games,
+		seeded: make(map[string][]*Standing),
+	}
+
+	for _, team := range teams {
+		table.Teams[team.Key] = &Standing{Team: team}
+	}
+
+	for _, game := range games {
+		home, homeOK := table.Teams[game.HomeTeam]
+		away, awayOK := table.Teams[game.AwayTeam]
+		if !homeOK || !awayOK {
+			continue
+		}
+
+		if !IsFinal(game.Status) {
+			home.Remaining++
+			away.Remaining++
+			continue
+		}
+
+		home.PointsFor += game.HomeScore
+		home.PointsAgainst += game.AwayScore
+		away.PointsFor += game.AwayScore
+		away.PointsAgainst += game.HomeScore
+
+		sameDivision := home.Team.DivisionName() == away.Team.DivisionName()
+		sameConference := home.Team.Conference == away.Team.Conference
+
+		applyResult(&home.Overall, &away.Overall, game.HomeScore, game.AwayScore)
+		if sameDivision {
+			applyResult(&home.Division, &away.Division, game.HomeScore, game.AwayScore)
+		}
+		if sameConference {
+			applyResult(&home.Conference, &away.Conference, game.HomeScore, game.AwayScore)
+		}
+	}
+
+	table.rankDivisions()
+	table.seedConferences()
+
+	return table
+}
+
+// IsFinal reports whether a SportsData.io game status represents a finished game
+func IsFinal(status string) bool {
+	return models.GameStateOf(status) == models.StateFinal
+}
+
+// applyResult records a game result on both teams' records
+func applyResult(home, away *Record, homeScore, awayScore int) {
+	switch {
+	case homeScore > awayScore:
+		home.Wins++
+		away.Losses++
+	case awayScore > homeScore:
+		away.Wins++
+		home.Losses++
+	default:
+		home.Ties++
+		away.Ties++
+	}
+}
+
+// Division returns a division's teams in rank order
+func (t *Table) Division(divisionName string) []*Standing {
+	var division []*Standing
+	for _, standing := range t.Teams {
+		if strings.EqualFold(standing.Team.DivisionName(), divisionName) {
+			division = append(division, standing)
+		}
+	}
+	sort.Slice(division, func(i, j int) bool {
+		return division[i].DivisionRank < division[j].DivisionRank
+	})
+	return division
+}
+
+// DivisionGames returns a division's intra-division games, played and remaining, in schedule order
+func (t *Table) DivisionGames(divisionName string) []models.Game {
+	var games []models.Game
+	for _, game := range t.games {
+		home, homeOK := t.Teams[game.HomeTeam]
+		away, awayOK := t.Teams[game.AwayTeam]
+		if !homeOK || !awayOK {
+			continue
+		}
+		if strings.EqualFold(home.Team.DivisionName(), divisionName) && strings.EqualFold(away.Team.DivisionName(), divisionName) {
+			games = append(games, game)
+		}
+	}
+	sort.SliceStable(games, func(i, j int) bool {
+		if games[i].Week != games[j].Week {
+			return games[i].Week < games[j].Week
+		}
+		return games[i].GameTime.Before(games[j].GameTime)
+	})
+	return games
+}
+
+// Divisions returns all division names in conference order
+func (t *Table) Divisions() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, standing := range t.Teams {
+		name := standing.Team.DivisionName()
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Conference returns a conference's teams in seeding order (seeds 1-7 first, then the rest)
+func (t *Table) Conference(conference string) []*Standing {
+	return t.seeded[strings.ToUpper(conference)]
+}
+
+// rankDivisions orders each division using division tiebreakers
+func (t *Table) rankDivisions() {
+	for _, name := range t.Divisions() {
+		var division []*Standing
+		for _, standing := range t.byKey() {
+			if standing.Team.DivisionName() == name {
+				division = append(division, standing)
+			}
+		}
+		sort.Slice(division, func(i, j int) bool {
+			return t.ranksAhead(division[i], division[j], true)
+		})
+		for rank, standing := range division {
+			standing.DivisionRank = rank + 1
+		}
+	}
+}
+
+// seedConferences assigns playoff seeds: division winners take seeds 1-4, wild cards 5-7
+func (t *Table) seedConferences() {
+	byConference := make(map[string][]*Standing)
+	for _, standing := range t.byKey() {
+		byConference[standing.Team.Conference] = append(byConference[standing.Team.Conference], standing)
+	}
+
+	for conference, teams := range byConference {
+		var leaders, others []*Standing
+		for _, standing := range teams {
+			standing.Seed = 0
+			if standing.DivisionRank == 1 {
+				leaders = append(leaders, standing)
+			} else {
+				others = append(others, standing)
+			}
+		}
+
+		sort.Slice(leaders, func(i, j int) bool { return t.ranksAhead(leaders[i], leaders[j], false) })
+		sort.Slice(others, func(i, j int) bool { return t.ranksAhead(others[i], others[j], false) })
+
+		ordered := append(leaders, others...)
+		for index, standing := range ordered {
+			if index < PlayoffSpots {
+				standing.Seed = index + 1
+			}
+		}
+		t.seeded[conference] = ordered
+	}
+}
+
+// byKey returns the standings in team key order. Tiebreakers aren't transitive (head-to-head can
+// go around in a circle), so sorts start from a fixed order to give the same result every time.
+func (t *Table) byKey() []*Standing {
+	standings := make([]*Standing, 0, len(t.Teams))
+	for _, standing := range t.Teams {
+		standings = append(standings, standing)
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		return standings[i].Team.Key < standings[j].Team.Key
+	})
+	return standings
+}
+
+// ranksAhead reports whether a should be ranked ahead of b. This is a simplified
+// version of the NFL tiebreaking procedure: win percentage, head-to-head,
+// division record (division ties only), conference record, then point differential.
+func (t *Table) ranksAhead(a, b *Standing, divisionTie bool) bool {
+	if a.Overall.Pct() != b.Overall.Pct() {
+		return a.Overall.Pct() > b.Overall.Pct()
+	}
+
+	headToHead := t.HeadToHead(a.Team.Key, b.Team.Key)
+	if headToHead.Games() > 0 && headToHead.Wins != headToHead.Losses {
+		return headToHead.Wins > headToHead.Losses
+	}
+
+	if divisionTie && a.Division.Pct() != b.Division.Pct() {
+		return a.Division.Pct() > b.Division.Pct()
+	}
+
+	if a.Conference.Pct() != b.Conference.Pct() {
+		return a.Conference.Pct() > b.Conference.Pct()
+	}
+
+	if a.PointDifferential() != b.PointDifferential() {
+		return a.PointDifferential() > b.PointDifferential()
+	}
+
+	return a.Team.Key < b.Team.Key
+}
+
+// HeadToHead returns team a's record in completed games against team b
+func (t *Table) HeadToHead(a, b string) Record {
+	var record Record
+	for _, game := range t.games {
+		// Match the teams before parsing the status; simulations call this for every tiebreak
+		var scored, allowed int
+		switch {
+		case game.HomeTeam == a && game.AwayTeam == b:
+			scored, allowed = game.HomeScore, game.AwayScore
+		case game.AwayTeam == a && game.HomeTeam == b:
+			scored, allowed = game.AwayScore, game.HomeScore
+		default:
+			continue
+		}
+		if IsFinal(game.Status) {
+			applyResult(&record, &Record{}, scored, allowed)
+		}
+	}
+	return record
+}
+
+// computeClinching sets clinch/elimination flags and magic numbers.
+// Ties are ignored, so results are conservative: a team is only marked clinched or
+// eliminated when no combination of remaining wins and losses could change it.
+func (t *Table) computeClinching() {
+	for _, standing := range t.Teams {
+		var rivals, conferenceOthers []*Standing
+		for _, other := range t.Teams {
+			if other == standing {
+				continue
+			}
+			if other.Team.Conference != standing.Team.Conference {
+				continue
+			}
+			conferenceOthers = append(conferenceOthers, other)
+			if other.Team.Division == standing.Team.Division {
+				rivals = append(rivals, other)
+			}
+		}
+
+		// Division: every rival must be unable to catch us
+		bestRivalMax := 0
+		standing.EliminatedDivision = false
+		for _, rival := range rivals {
+			if rival.MaxWins() > bestRivalMax {
+				bestRivalMax = rival.MaxWins()
+			}
+			if rival.Overall.Wins > standing.MaxWins() {
+				standing.EliminatedDivision = true
+			}
+		}
+		standing.DivisionMagic = magicNumber(bestRivalMax, standing.Overall.Wins)
+		standing.ClinchedDivision = standing.DivisionMagic == 0
+
+		// Playoffs: division winners are seeded first, so only teams that can't win their
+		// division compete with us for the wild cards
+		standing.PlayoffMagic = t.playoffMagic(standing, conferenceOthers)
+		standing.ClinchedPlayoff = standing.PlayoffMagic == 0 || standing.ClinchedDivision
+
+		standing.EliminatedPlayoff = standing.EliminatedDivision && t.wildCardEliminated(standing, conferenceOthers)
+	}
+
+	// Top seed: the first seed clinches when no other team in the conference can reach its win total
+	for _, teams := range t.seeded {
+		if len(teams) == 0 {
+			continue
+		}
+		leader := teams[0]
+		leader.ClinchedTopSeed = true
+		for _, other := range teams[1:] {
+			if other.MaxWins() >= leader.Overall.Wins {
+				leader.ClinchedTopSeed = false
+				break
+			}
+		}
+	}
+}
+
+// wildCardSpots is the number of playoff seeds left after the division winners
+const wildCardSpots = PlayoffSpots - 4
+
+// playoffMagic returns how many more wins guarantee a team a playoff berth under the seeding
+// rules, treating every team that could tie or pass that total as finishing ahead. Each division
+// is won by one of its teams that finishes ahead of us, if any do, so a division with n such
+// teams sends at most n-1 of them into the wild card race; our own division counts too, since we
+// only need a wild card when a team there finishes ahead of us. Zero means already clinched.
+func (t *Table) playoffMagic(standing *Standing, conferenceOthers []*Standing) int {
+	for wins := standing.Overall.Wins; ; wins++ {
+		threats := make(map[string]int)
+		for _, other := range conferenceOthers {
+			if other.MaxWins() >= wins {
+				threats[other.Team.Division]++
+			}
+		}
+
+		wildCardThreats := 0
+		for _, count := range threats {
+			if count > 1 {
+				wildCardThreats += count - 1
+			}
+		}
+		// With no team left to pass us in our own division, we win it outright
+		if threats[standing.Team.Division] == 0 || wildCardThreats < wildCardSpots {
+			return wins - standing.Overall.Wins
+		}
+	}
+}
+
+// wildCardEliminated reports whether a team that can't win its division is out of the wild card
+// race even if it wins out: enough teams already have more wins than its maximum that the wild
+// cards are taken. A division's winner finishes with at least as many wins as any of its teams,
+// so a division with n teams already past us sends at least n-1 of them into the wild card race.
+func (t *Table) wildCardEliminated(standing *Standing, conferenceOthers []*Standing) bool {
+	ahead := make(map[string]int)
+	for _, other := range conferenceOthers {
+		if other.Overall.Wins > standing.MaxWins() {
+			ahead[other.Team.Division]++
+		}
+	}
+
+	wildCardsTaken := 0
+	for _, count := range ahead {
+		if count > 1 {
+			wildCardsTaken += count - 1
+		}
+	}
+	return wildCardsTaken >= wildCardSpots
+}
+
+// magicNumber returns the number of wins (or chaser losses) needed so the chaser's
+// maximum win total falls below ours. Zero means already clinched.
+func magicNumber(chaserMaxWins, wins int) int {
+	magic := chaserMaxWins - wins + 1
+	if magic < 0 {
+		return 0
+	}
+	return magic
+}
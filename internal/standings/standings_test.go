@@ -0,0 +1,136 @@
+package standings
+
+import "testing"
+
+// finalRecord is a team's division and final win total in a 17-game season
+type finalRecord struct {
+	division string
+	wins     int
+}
+
+// clinchTable builds an AFC with no games left from each team's final record
+func clinchTable(teams map[string]finalRecord) *Table {
+	table := &Table{Teams: make(map[string]*Standing), seeded: make(map[string][]*Standing)}
+	for key, team := range teams {
+		table.Teams[key] = &Standing{
+			Team:    Team{Key: key, Conference: "AFC", Division: team.division},
+			Overall: Record{Wins: team.wins, Losses: 17 - team.wins},
+		}
+	}
+	return table
+}
+
+func TestPlayoffClinchRespectsDivisionWinners(t *testing.T) {
+	type team = finalRecord
+	tests := []struct {
+		name     string
+		teams    map[string]team
+		subject  string
+		clinched bool
+	}{
+		{
+			// Six teams finish ahead, but a weak West champion takes a top-four seed, so the
+			// North and South runners-up fill all three wild cards
+			name: "weak division winner pushes team out",
+			teams: map[string]team{
+				"BUF": {"East", 12}, "MIA": {"East", 10}, "NE": {"East", 3}, "NYJ": {"East", 3},
+				"BAL": {"North", 11}, "PIT": {"North", 11}, "CIN": {"North", 11}, "CLE": {"North", 3},
+				"HOU": {"South", 11}, "IND": {"South", 11}, "JAX": {"South", 3}, "TEN": {"South", 3},
+				"KC": {"West", 5}, "LAC": {"West", 5}, "DEN": {"West", 5}, "LV": {"West", 5},
+			},
+			subject:  "MIA",
+			clinched: false,
+		},
+		{
+			// Only two teams outside the division winners can finish ahead, leaving a wild card
+			name: "two wild card threats",
+			teams: map[string]team{
+				"BUF": {"East", 12}, "MIA": {"East", 10}, "NE": {"East", 3}, "NYJ": {"East", 3},
+				"BAL": {"North", 11}, "PIT": {"North", 11}, "CIN": {"North", 3}, "CLE": {"North", 3},
+				"HOU": {"South", 11}, "IND": {"South", 11}, "JAX": {"South", 3}, "TEN": {"South", 3},
+				"KC": {"West", 5}, "LAC": {"West", 5}, "DEN": {"West", 5}, "LV": {"West", 5},
+			},
+			subject:  "MIA",
+			clinched: true,
+		},
+		{
+			name: "division winner clinches regardless of record",
+			teams: map[string]team{
+				"BUF": {"East", 12}, "MIA": {"East", 11}, "NE": {"East", 11}, "NYJ": {"East", 11},
+				"BAL": {"North", 11}, "PIT": {"North", 11}, "CIN": {"North", 11}, "CLE": {"North", 3},
+				"HOU": {"South", 11}, "IND": {"South", 11}, "JAX": {"South", 3}, "TEN": {"South", 3},
+				"KC": {"West", 6}, "LAC": {"West", 5}, "DEN": {"West", 5}, "LV": {"West", 5},
+			},
+			subject:  "KC",
+			clinched: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := clinchTable(tt.teams)
+			table.computeClinching()
+			subject := table.Teams[tt.subject]
+			if subject.ClinchedPlayoff != tt.clinched {
+				t.Errorf("ClinchedPlayoff = %v, want %v (magic %d)", subject.ClinchedPlayoff, tt.clinched, subject.PlayoffMagic)
+			}
+		})
+	}
+}
+
+// midseasonRecord is a team's division, wins, and games left at some point in the season
+type midseasonRecord struct {
+	division  string
+	wins      int
+	remaining int
+}
+
+func TestPlayoffEliminationCountsWildCardsTaken(t *testing.T) {
+	type team = midseasonRecord
+	tests := []struct {
+		name       string
+		teams      map[string]team
+		eliminated bool
+	}{
+		{
+			// Only six teams can't be caught, but two of them in each of three divisions leave
+			// the three runners-up holding every wild card
+			name: "runners-up take every wild card",
+			teams: map[string]team{
+				"MIA": {"East", 4, 3}, "BUF": {"East", 12, 3}, "NE": {"East", 9, 3}, "NYJ": {"East", 3, 3},
+				"BAL": {"North", 10, 3}, "PIT": {"North", 9, 3}, "CIN": {"North", 3, 3}, "CLE": {"North", 3, 3},
+				"HOU": {"South", 10, 3}, "IND": {"South", 9, 3}, "JAX": {"South", 3, 3}, "TEN": {"South", 3, 3},
+				"KC": {"West", 5, 3}, "LAC": {"West", 5, 3}, "DEN": {"West", 3, 3}, "LV": {"West", 3, 3},
+			},
+			eliminated: true,
+		},
+		{
+			// Five teams are out of reach, but four of them may just be division winners
+			name: "division leaders only",
+			teams: map[string]team{
+				"MIA": {"East", 4, 3}, "BUF": {"East", 12, 3}, "NE": {"East", 3, 3}, "NYJ": {"East", 3, 3},
+				"BAL": {"North", 10, 3}, "PIT": {"North", 9, 3}, "CIN": {"North", 3, 3}, "CLE": {"North", 3, 3},
+				"HOU": {"South", 10, 3}, "IND": {"South", 5, 3}, "JAX": {"South", 3, 3}, "TEN": {"South", 3, 3},
+				"KC": {"West", 10, 3}, "LAC": {"West", 5, 3}, "DEN": {"West", 3, 3}, "LV": {"West", 3, 3},
+			},
+			eliminated: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := &Table{Teams: make(map[string]*Standing), seeded: make(map[string][]*Standing)}
+			for key, team := range tt.teams {
+				table.Teams[key] = &Standing{
+					Team:      Team{Key: key, Conference: "AFC", Division: team.division},
+					Overall:   Record{Wins: team.wins, Losses: 17 - team.wins - team.remaining},
+					Remaining: team.remaining,
+				}
+			}
+			table.computeClinching()
+			if miami := table.Teams["MIA"]; miami.EliminatedPlayoff != tt.eliminated {
+				t.Errorf("EliminatedPlayoff = %v, want %v", miami.EliminatedPlayoff, tt.eliminated)
+			}
+		})
+	}
+}
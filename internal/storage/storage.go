@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Store persists JSON documents as files under a data directory.
+// Each subsystem owns one named document (e.g. "guild_settings").
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// New creates a store rooted at dir, creating the directory if needed
+func New(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating data directory %s: %v", dir, err)
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Load decodes the named document into v. A missing document leaves v untouched.
+func (s *Store) Load(name string, v interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path(name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading %s: %v", name, err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error parsing %s: %v", name, err)
+	}
+	return nil
+}
+
+// Save writes v as the named document. The write is atomic so a crash never leaves a partial file.
+func (s *Store) Save(name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding %s: %v", name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp := s.path(name) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %v", name, err)
+	}
+	if err := os.Rename(tmp, s.path(name)); err != nil {
+		return fmt.Errorf("error saving %s: %v", name, err)
+	}
+	return nil
+}
+
+// DocumentInfo describes a stored document's file
+type DocumentInfo struct {
+	Name     string
+	Size     int64
+	Modified time.Time
+}
+
+// Documents lists the stored documents, largest first
+func (s *Store) Documents() ([]DocumentInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("error listing data directory %s: %v", s.dir, err)
+	}
+
+	var documents []DocumentInfo
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		documents = append(documents, DocumentInfo{
+			Name:     strings.TrimSuffix(entry.Name(), ".json"),
+			Size:     info.Size(),
+			Modified: info.ModTime(),
+		})
+	}
+	sort.Slice(documents, func(i, j int) bool {
+		return documents[i].Size > documents[j].Size
+	})
+	return documents, nil
+}
+
+// Vacuum removes temporary files left behind by writes that were interrupted before the
+// rename, returning how many were removed
+func (s *Store) Vacuum() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	leftovers, err := filepath.Glob(filepath.Join(s.dir, "*.json.tmp"))
+	if err != nil {
+		return 0, fmt.Errorf("error scanning data directory %s: %v", s.dir, err)
+	}
+	for _, leftover := range leftovers {
+		if err := os.Remove(leftover); err != nil {
+			return 0, fmt.Errorf("error removing %s: %v", leftover, err)
+		}
+	}
+	return len(leftovers), nil
+}
+
+// path returns the file path for a named document
+func (s *Store) path(name string) string {
+	return filepath.Join(s.dir, name+".json")
+}
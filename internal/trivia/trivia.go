@@ -0,0 +1,102 @@
+package trivia
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+)
+
+// Difficulty levels
+const (
+	Easy   = "easy"
+	Medium = "medium"
+	Hard   = "hard"
+)
+
+// Difficulties lists the difficulty levels from easiest to hardest
+var Difficulties = []string{Easy, Medium, Hard}
+
+// defaultQuestions is the question bank shipped with the bot
+//
+//go:embed data/questions.json
+var defaultQuestions []byte
+
+// Question is one multiple-choice trivia question
+type Question struct {
+	Difficulty string   `json:"difficulty"`
+	Question   string   `json:"question"`
+	Choices    []string `json:"choices"`
+	Answer     int      `json:"answer"` // index into Choices
+}
+
+// Bank holds trivia questions grouped by difficulty
+type Bank struct {
+	questions map[string][]*Question
+}
+
+// Load reads a question bank from a JSON file, or the bundled bank when path is empty
+func Load(path string) (*Bank, error) {
+	data := defaultQuestions
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trivia questions: %v", err)
+		}
+	}
+
+	var questions []*Question
+	if err := json.Unmarshal(data, &questions); err != nil {
+		return nil, fmt.Errorf("failed to parse trivia questions: %v", err)
+	}
+
+	bank := &Bank{questions: make(map[string][]*Question)}
+	for index, question := range questions {
+		question.Difficulty = strings.ToLower(question.Difficulty)
+		if Points(question.Difficulty) == 0 {
+			return nil, fmt.Errorf("trivia question %d has unknown difficulty %q", index+1, question.Difficulty)
+		}
+		if len(question.Choices) < 2 || len(question.Choices) > 5 {
+			return nil, fmt.Errorf("trivia question %d must have 2-5 choices", index+1)
+		}
+		if question.Answer < 0 || question.Answer >= len(question.Choices) {
+			return nil, fmt.Errorf("trivia question %d has an out-of-range answer", index+1)
+		}
+		bank.questions[question.Difficulty] = append(bank.questions[question.Difficulty], question)
+	}
+	return bank, nil
+}
+
+// Random returns a random question of the given difficulty, or of any difficulty when empty
+func (b *Bank) Random(difficulty string) (*Question, error) {
+	var pool []*Question
+	if difficulty == "" {
+		for _, level := range Difficulties {
+			pool = append(pool, b.questions[level]...)
+		}
+	} else {
+		pool = b.questions[difficulty]
+	}
+
+	if len(pool) == 0 {
+		return nil, fmt.Errorf("no trivia questions available")
+	}
+	return pool[rand.Intn(len(pool))], nil
+}
+
+// Points returns what a correct answer is worth at a difficulty (0 for unknown levels)
+func Points(difficulty string) int {
+	switch difficulty {
+	case Easy:
+		return 1
+	case Medium:
+		return 2
+	case Hard:
+		return 3
+	default:
+		return 0
+	}
+}
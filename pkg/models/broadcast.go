@@ -0,0 +1,18 @@
+package models
+
+import "strings"
+
+// nationalNetworks are broadcasters that carry every game they air nationwide. CBS and FOX games
+// are regional unless nothing else kicks off at the same time.
+var nationalNetworks = []string{"NBC", "ESPN", "ABC", "NFLN", "NFL NETWORK", "PRIME", "AMAZON", "NETFLIX", "YOUTUBE"}
+
+// IsNationalNetwork reports whether a broadcast listing names a national broadcaster, e.g. "ESPN/ABC"
+func IsNationalNetwork(channel string) bool {
+	channel = strings.ToUpper(channel)
+	for _, network := range nationalNetworks {
+		if strings.Contains(channel, network) {
+			return true
+		}
+	}
+	return false
+}
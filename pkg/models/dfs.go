@@ -0,0 +1,53 @@
+package models
+
+// DFS sites with salary data
+const (
+	SiteDraftKings = "draftkings"
+	SiteFanDuel    = "fanduel"
+)
+
+// SiteName names a DFS site for display, e.g. "DraftKings"
+func SiteName(site string) string {
+	if site == SiteFanDuel {
+		return "FanDuel"
+	}
+	return "DraftKings"
+}
+
+// DFSSalary is a player's daily fantasy salary and projected points on each site for one week
+type DFSSalary struct {
+	PlayerID         int     `json:"player_id"`
+	Name             string  `json:"name"`
+	Team             string  `json:"team"`
+	Position         string  `json:"position"`
+	Opponent         string  `json:"opponent"`
+	DraftKingsSalary int     `json:"draftkings_salary"`
+	FanDuelSalary    int     `json:"fanduel_salary"`
+	DraftKingsPoints float64 `json:"draftkings_points"` // projected
+	FanDuelPoints    float64 `json:"fanduel_points"`    // projected
+}
+
+// Salary returns the player's salary on a site, or 0 when the site hasn't priced them
+func (d *DFSSalary) Salary(site string) int {
+	if site == SiteFanDuel {
+		return d.FanDuelSalary
+	}
+	return d.DraftKingsSalary
+}
+
+// ProjectedPoints returns the player's projected fantasy points under a site's scoring
+func (d *DFSSalary) ProjectedPoints(site string) float64 {
+	if site == SiteFanDuel {
+		return d.FanDuelPoints
+	}
+	return d.DraftKingsPoints
+}
+
+// Value returns projected points per $1,000 of salary on a site, or 0 when unpriced
+func (d *DFSSalary) Value(site string) float64 {
+	salary := d.Salary(site)
+	if salary == 0 {
+		return 0
+	}
+	return d.ProjectedPoints(site) / float64(salary) * 1000
+}
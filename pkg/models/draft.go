@@ -0,0 +1,13 @@
+package models
+
+// DraftPick is one selection in the NFL Draft
+type DraftPick struct {
+	Year     int    `json:"year"`
+	Round    int    `json:"round"`
+	Pick     int    `json:"pick"` // overall pick number
+	Team     string `json:"team"` // abbreviation of the team that made the pick
+	PlayerID int    `json:"player_id"`
+	Name     string `json:"name"`
+	Position string `json:"position"`
+	College  string `json:"college"`
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Thousands separators a guild can choose
+const (
+	SeparatorComma = "comma" // 4,306
+	SeparatorSpace = "space" // 4 306, with a narrow no-break space
+	SeparatorNone  = "none"  // 4306
+)
+
+// metersPerYard converts yardage to meters
+const metersPerYard = 0.9144
+
+// Format is how a guild wants numbers, distances, and clock times shown. The zero value is the
+// bot's default: comma separators, yards, and a 12-hour clock.
+type Format struct {
+	Separator string // see SeparatorComma; empty means comma
+	Metric    bool   // distances in meters instead of yards
+	Clock24   bool   // times on a 24-hour clock
+}
+
+// Int formats an integer with the thousands separator, e.g. 4306 -> "4,306"
+func (f Format) Int(n int) string {
+	digits := strconv.Itoa(n)
+	separator := ","
+	switch f.Separator {
+	case SeparatorSpace:
+		separator = "\u202f"
+	case SeparatorNone:
+		return digits
+	}
+
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	var grouped strings.Builder
+	for index, digit := range digits {
+		if index > 0 && (len(digits)-index)%3 == 0 {
+			grouped.WriteString(separator)
+		}
+		grouped.WriteRune(digit)
+	}
+	return sign + grouped.String()
+}
+
+// Decimal formats a number to one decimal place, grouping the whole part, e.g. 1234.56 -> "1,234.6"
+func (f Format) Decimal(value float64) string {
+	tenths := int(math.Round(math.Abs(value) * 10))
+	sign := ""
+	if value < 0 && tenths > 0 {
+		sign = "-"
+	}
+	return sign + f.Int(tenths/10) + "." + strconv.Itoa(tenths%10)
+}
+
+// Length converts a yardage, or a per-play or per-game average of one, to the format's unit
+func (f Format) Length(yards float64) float64 {
+	if f.Metric {
+		return yards * metersPerYard
+	}
+	return yards
+}
+
+// Distance converts a yardage total to the format's unit and groups it, e.g. 1250 -> "1,250"
+// in yards or "1,143" in meters
+func (f Format) Distance(yards int) string {
+	return f.Int(int(math.Round(f.Length(float64(yards)))))
+}
+
+// Unit abbreviates the distance unit, "yd" or "m"
+func (f Format) Unit() string {
+	if f.Metric {
+		return "m"
+	}
+	return "yd"
+}
+
+// UnitPlural abbreviates the distance unit for totals, "yds" or "m"
+func (f Format) UnitPlural() string {
+	if f.Metric {
+		return "m"
+	}
+	return "yds"
+}
+
+// UnitName names the distance unit for labels, "Yards" or "Meters"
+func (f Format) UnitName() string {
+	if f.Metric {
+		return "Meters"
+	}
+	return "Yards"
+}
+
+// Clock rewrites a time layout for the format's clock, e.g. "Mon 3:04 PM" becomes "Mon 15:04"
+// on a 24-hour clock
+func (f Format) Clock(layout string) string {
+	if !f.Clock24 {
+		return layout
+	}
+	return strings.ReplaceAll(layout, "3:04 PM", "15:04")
+}
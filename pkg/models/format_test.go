@@ -0,0 +1,44 @@
+package models
+
+import "testing"
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		format Format
+		value  float64
+		want   string
+	}{
+		{Format{}, 18.44, "18.4"},
+		{Format{}, 1234.56, "1,234.6"},
+		{Format{Separator: SeparatorNone}, 1234.56, "1234.6"},
+		{Format{}, 9.96, "10.0"},
+		{Format{}, -2.25, "-2.3"},
+		{Format{}, -0.01, "0.0"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.format.Decimal(tt.value); got != tt.want {
+			t.Errorf("%+v.Decimal(%v) = %q, want %q", tt.format, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDownAndDistance(t *testing.T) {
+	tests := []struct {
+		downDistance string
+		metric       bool
+		want         string
+	}{
+		{"3rd & 7", false, "3rd & 7"},
+		{"3rd & 7", true, "3rd & 6 m"},
+		{"1st & Goal", true, "1st & Goal"},
+		{"", true, ""},
+	}
+
+	for _, tt := range tests {
+		detail := &GameDetail{DownDistance: tt.downDistance}
+		if got := detail.DownAndDistance(Format{Metric: tt.metric}); got != tt.want {
+			t.Errorf("DownAndDistance(%q, metric %v) = %q, want %q", tt.downDistance, tt.metric, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Data sources a result can come from
+const (
+	SourceLive  = "live"  // fetched from the API for this request
+	SourceCache = "cache" // served from the bot's cache
+	SourceStale = "stale" // the API was down, so its last good response was served
+)
+
+// Freshness records when a result's data was fetched from the API and how it reached the bot
+type Freshness struct {
+	FetchedAt time.Time
+	Source    string // SourceLive, SourceCache, or SourceStale
+}
+
+// Known reports whether the freshness was recorded
+func (f Freshness) Known() bool {
+	return !f.FetchedAt.IsZero()
+}
+
+// Older returns whichever freshness has the older data, ignoring unknown ones
+func (f Freshness) Older(other Freshness) Freshness {
+	if !f.Known() || (other.Known() && other.FetchedAt.Before(f.FetchedAt)) {
+		return other
+	}
+	return f
+}
+
+// Age describes how long ago the data was fetched, e.g. "42s ago" or "3h ago"
+func (f Freshness) Age(now time.Time) string {
+	age := now.Sub(f.FetchedAt)
+	switch {
+	case age < time.Second:
+		return "just now"
+	case age < time.Minute:
+		return fmt.Sprintf("%ds ago", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age.Minutes()))
+	case age < 48*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	}
+}
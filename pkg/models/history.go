@@ -0,0 +1,40 @@
+package models
+
+import "fmt"
+
+// RetiredNumber is a jersey number a franchise no longer issues
+type RetiredNumber struct {
+	Number int    `json:"number"`
+	Name   string `json:"name"` // player(s) honored
+}
+
+// FranchiseHistory is a team's all-time résumé. Title years are the calendar year the Super Bowl was played.
+type FranchiseHistory struct {
+	Team             string          `json:"team"`
+	Founded          int             `json:"founded"`
+	SuperBowls       []int           `json:"super_bowls"`
+	ConferenceTitles []int           `json:"conference_titles"` // conference (pre-merger: league) titles that sent the team to the Super Bowl
+	Wins             int             `json:"wins"`
+	Losses           int             `json:"losses"`
+	Ties             int             `json:"ties"`
+	RecordThrough    int             `json:"record_through"` // last season counted in the all-time record
+	RetiredNumbers   []RetiredNumber `json:"retired_numbers"`
+}
+
+// RecordString formats the all-time regular season record in a guild's format, e.g. "806-602-38"
+// or "1,012-598-6"
+func (fh *FranchiseHistory) RecordString(format Format) string {
+	if fh.Ties > 0 {
+		return fmt.Sprintf("%s-%s-%s", format.Int(fh.Wins), format.Int(fh.Losses), format.Int(fh.Ties))
+	}
+	return fmt.Sprintf("%s-%s", format.Int(fh.Wins), format.Int(fh.Losses))
+}
+
+// Percentage is the all-time win percentage, counting ties as half a win
+func (fh *FranchiseHistory) Percentage() float64 {
+	games := fh.Wins + fh.Losses + fh.Ties
+	if games == 0 {
+		return 0
+	}
+	return (float64(fh.Wins) + float64(fh.Ties)/2) / float64(games)
+}
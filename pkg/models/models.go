@@ -2,42 +2,84 @@ package models
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
-// PlayerStats represents statistics for an NFL player
+// PlayerStats represents statistics for an NFL player, for one week or summed over several games
 type PlayerStats struct {
-	Name     string                 `json:"name"`
-	Team     string                 `json:"team"`
-	Position string                 `json:"position"`
-	Season   int                    `json:"season"`
-	Stats    map[string]interface{} `json:"stats"`
+	PlayerID  int            `json:"player_id,omitempty"` // SportsData.io ID, when the source provides one
+	Name      string         `json:"name"`
+	Team      string         `json:"team"`
+	Position  string         `json:"position"`
+	Season    int            `json:"season"`
+	Games     int            `json:"games,omitempty"` // games summed into the line; 0 for a single week
+	Note      string         `json:"note,omitempty"`  // caveat shown with the stats, e.g. for sampled season totals
+	Passing   PassingStats   `json:"passing"`
+	Rushing   RushingStats   `json:"rushing"`
+	Receiving ReceivingStats `json:"receiving"`
+	Defense   DefenseStats   `json:"defense"`
+	Freshness Freshness      `json:"-"` // set by the NFL client when the stats are looked up
 }
 
-// GetStatsString returns a formatted string of player statistics
-func (p *PlayerStats) GetStatsString() string {
-	if p.Stats == nil {
+// GetStatsString returns the player's stats grouped in a fixed order (Passing, Rushing, Receiving,
+// Defense, then Misc) with labeled numbers in a guild's format
+func (p *PlayerStats) GetStatsString(f Format) string {
+	lines := p.Lines()
+	if len(lines) == 0 {
 		return "No stats available"
 	}
 
-	var statsStr string
-	for key, value := range p.Stats {
-		statsStr += fmt.Sprintf("%s: %v\n", key, value)
+	var statsStr strings.Builder
+	for _, line := range lines {
+		statsStr.WriteString(fmt.Sprintf("**%s**\n%s\n", line.Category(), line.Details(f)))
 	}
-	return statsStr
+
+	var misc []string
+	if p.Games > 0 {
+		misc = append(misc, fmt.Sprintf("Games: %d", p.Games))
+	}
+	if p.Note != "" {
+		misc = append(misc, "*"+p.Note+"*")
+	}
+	if len(misc) > 0 {
+		statsStr.WriteString("**Misc**\n" + strings.Join(misc, " • ") + "\n")
+	}
+	return statsStr.String()
 }
 
 // TeamInfo represents information about an NFL team
 type TeamInfo struct {
+	Key          string   `json:"key"` // Abbreviation, e.g. "BUF"
 	Name         string   `json:"name"`
 	City         string   `json:"city"`
 	Conference   string   `json:"conference"`
 	Division     string   `json:"division"`
 	Coach        string   `json:"coach"`
+	CoachRecord  string   `json:"coach_record,omitempty"` // head coach's record this season, e.g. "7-3"
+	OffensiveCoordinator string `json:"offensive_coordinator"`
+	DefensiveCoordinator string `json:"defensive_coordinator"`
+	SpecialTeamsCoach    string `json:"special_teams_coach"`
+	OffensiveScheme      string `json:"offensive_scheme"` // e.g. "PRO", "2TE"
+	DefensiveScheme      string `json:"defensive_scheme"` // e.g. "3-4", "4-3"
 	Stadium      string   `json:"stadium"`
 	Founded      int      `json:"founded"`
 	Championships int     `json:"championships"`
 	Colors       []string `json:"colors"`
+	LogoURL        string `json:"logo_url"`
+	PrimaryColor   string `json:"primary_color"`   // hex without '#', e.g. "00338D"
+	SecondaryColor string `json:"secondary_color"` // hex without '#'
+	Freshness      Freshness `json:"-"`
+}
+
+// EmbedColor returns the team's primary color as an embed color, and false when it is unknown
+func (t *TeamInfo) EmbedColor() (int, bool) {
+	color, err := strconv.ParseInt(strings.TrimPrefix(t.PrimaryColor, "#"), 16, 32)
+	if err != nil || t.PrimaryColor == "" {
+		return 0, false
+	}
+	return int(color), true
 }
 
 // Schedule represents a team's schedule
@@ -45,6 +87,7 @@ type Schedule struct {
 	TeamName string `json:"team_name"`
 	Season   int    `json:"season"`
 	Games    []Game `json:"games"`
+	Freshness Freshness `json:"-"`
 }
 
 // Game represents a single NFL game
@@ -58,19 +101,27 @@ type Game struct {
 	HomeScore   int       `json:"home_score"`
 	AwayScore   int       `json:"away_score"`
 	GameTime    time.Time `json:"game_time"`
-	Status      string    `json:"status"` // scheduled, in_progress, completed
+	Status      string    `json:"status"` // SportsData.io status, see GameStateOf
 	Stadium     string    `json:"stadium"`
 	Weather     string    `json:"weather,omitempty"`
+	PointSpread *float64  `json:"point_spread,omitempty"` // home team's line; negative when home is favored
+	OverUnder   *float64  `json:"over_under,omitempty"`
+	Channel     string    `json:"channel,omitempty"` // TV broadcast, e.g. "CBS"
+}
+
+// State returns what the game's status means
+func (g *Game) State() GameState {
+	return GameStateOf(g.Status)
 }
 
 // IsLive returns true if the game is currently in progress
 func (g *Game) IsLive() bool {
-	return g.Status == "in_progress"
+	return g.State() == StateLive
 }
 
 // IsCompleted returns true if the game has finished
 func (g *Game) IsCompleted() bool {
-	return g.Status == "completed"
+	return g.State() == StateFinal
 }
 
 // Winner returns the winning team name, or empty string if game is not completed
@@ -130,6 +181,124 @@ type SeasonInfo struct {
 	Week       int    `json:"Week"`
 }
 
+// WeekLabel returns a display label for the season info's week
+func (s *SeasonInfo) WeekLabel() string {
+	return WeekLabel(s.SeasonType, s.Week)
+}
+
+// Season types used by SportsData.io
+const (
+	SeasonTypePreseason  = "PRE"
+	SeasonTypeRegular    = "REG"
+	SeasonTypePostseason = "POST"
+)
+
+// PlayoffRounds maps postseason week numbers to round names
+var PlayoffRounds = map[int]string{
+	1: "Wild Card Round",
+	2: "Divisional Round",
+	3: "Conference Championships",
+	4: "Super Bowl",
+}
+
+// playoffRoundChoices maps season_type choice values for playoff rounds to their postseason week
+var playoffRoundChoices = map[string]int{
+	"WILDCARD":   1,
+	"DIVISIONAL": 2,
+	"CONFERENCE": 3,
+	"SUPERBOWL":  4,
+}
+
+// ParseSeasonType resolves a season type choice ("PRE", "REG", "POST" or a playoff round
+// such as "WILDCARD") into a SportsData.io season type and the week it implies (0 if none)
+func ParseSeasonType(value string) (string, int, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	switch value {
+	case SeasonTypePreseason, SeasonTypeRegular, SeasonTypePostseason:
+		return value, 0, nil
+	}
+	if week, ok := playoffRoundChoices[value]; ok {
+		return SeasonTypePostseason, week, nil
+	}
+	return "", 0, fmt.Errorf("unknown season type: %s", value)
+}
+
+// WeekRange returns the valid week numbers for a season type
+func WeekRange(seasonType string) (int, int) {
+	switch seasonType {
+	case SeasonTypePreseason:
+		return 0, 4 // Week 0 is the Hall of Fame game
+	case SeasonTypePostseason:
+		return 1, 4
+	default:
+		return 1, 18
+	}
+}
+
+// WeekLabel returns a display label like "Week 5", "Preseason Week 2", or "Divisional Round"
+func WeekLabel(seasonType string, week int) string {
+	switch seasonType {
+	case SeasonTypePreseason:
+		if week == 0 {
+			return "Hall of Fame Game"
+		}
+		return fmt.Sprintf("Preseason Week %d", week)
+	case SeasonTypePostseason:
+		if round, ok := PlayoffRounds[week]; ok {
+			return round
+		}
+		return fmt.Sprintf("Postseason Week %d", week)
+	default:
+		return fmt.Sprintf("Week %d", week)
+	}
+}
+
+// SeasonPlayerStats holds a player's full regular season totals
+type SeasonPlayerStats struct {
+	PlayerID            int     `json:"player_id"`
+	Name                string  `json:"name"`
+	Team                string  `json:"team"`
+	Position            string  `json:"position"`
+	Games               int     `json:"games"`
+	PassingYards        float64 `json:"passing_yards"`
+	PassingTouchdowns   float64 `json:"passing_touchdowns"`
+	Interceptions       float64 `json:"interceptions"`
+	RushingYards        float64 `json:"rushing_yards"`
+	RushingTouchdowns   float64 `json:"rushing_touchdowns"`
+	Receptions          float64 `json:"receptions"`
+	ReceivingYards      float64 `json:"receiving_yards"`
+	ReceivingTouchdowns float64 `json:"receiving_touchdowns"`
+	FumblesLost         float64 `json:"fumbles_lost"`
+	FantasyPoints       float64 `json:"fantasy_points"`
+	FantasyPointsPPR    float64 `json:"fantasy_points_ppr"`
+}
+
+// PositionDefense holds what a defense has allowed to one offensive position
+type PositionDefense struct {
+	Team       string  `json:"team"`
+	Position   string  `json:"position"`
+	Games      int     `json:"games"`
+	Yards      float64 `json:"yards"`
+	Touchdowns float64 `json:"touchdowns"`
+	Rank       int     `json:"rank"` // 1 = fewest yards per game allowed to the position
+}
+
+// YardsPerGame returns yards allowed to the position per game
+func (pd *PositionDefense) YardsPerGame() float64 {
+	if pd.Games == 0 {
+		return 0
+	}
+	return pd.Yards / float64(pd.Games)
+}
+
+// TouchdownsPerGame returns touchdowns allowed to the position per game
+func (pd *PositionDefense) TouchdownsPerGame() float64 {
+	if pd.Games == 0 {
+		return 0
+	}
+	return pd.Touchdowns / float64(pd.Games)
+}
+
 // TeamStanding represents team standings information
 type TeamStanding struct {
 	Team       string `json:"Team"`
@@ -141,6 +310,57 @@ type TeamStanding struct {
 	Conference string `json:"Conference"`
 }
 
+// GamesPlayed returns the number of games the team has completed
+func (ts *TeamStanding) GamesPlayed() int {
+	return ts.Wins + ts.Losses + ts.Ties
+}
+
+// RegularSeasonGames is the number of games each team plays in the regular season
+const RegularSeasonGames = 17
+
+// WinTotalPace compares a team's record against its preseason win total line
+type WinTotalPace struct {
+	Team   string  `json:"team"`
+	Line   float64 `json:"line"`
+	Wins   int     `json:"wins"`
+	Losses int     `json:"losses"`
+	Ties   int     `json:"ties"`
+}
+
+// ProjectedWins returns the team's full-season win pace (ties count as half a win)
+func (w *WinTotalPace) ProjectedWins() float64 {
+	played := w.Wins + w.Losses + w.Ties
+	if played == 0 {
+		return w.Line
+	}
+	return (float64(w.Wins) + float64(w.Ties)/2) / float64(played) * RegularSeasonGames
+}
+
+// Margin returns how many wins the team's pace is above (positive) or below its line
+func (w *WinTotalPace) Margin() float64 {
+	return w.ProjectedWins() - w.Line
+}
+
+// Status returns "OVER", "UNDER", or "PUSH" pace, or a clinched result when the line is already decided
+func (w *WinTotalPace) Status() string {
+	remaining := RegularSeasonGames - (w.Wins + w.Losses + w.Ties)
+	if float64(w.Wins) > w.Line {
+		return "OVER ✅"
+	}
+	if float64(w.Wins+remaining) < w.Line {
+		return "UNDER ✅"
+	}
+
+	margin := w.Margin()
+	if margin > 0.25 {
+		return "over"
+	}
+	if margin < -0.25 {
+		return "under"
+	}
+	return "push"
+}
+
 // LiveScore represents a live game score
 type LiveScore struct {
 	GameID      string    `json:"GameID"`
@@ -154,16 +374,23 @@ type LiveScore struct {
 	Quarter     string    `json:"Quarter"`
 	Status      string    `json:"Status"`
 	GameTime    time.Time `json:"DateTime"`
+	Channel     string    `json:"Channel"` // TV broadcast, e.g. "CBS"
+	Freshness   Freshness `json:"-"`
+}
+
+// State returns what the game's status means
+func (ls *LiveScore) State() GameState {
+	return GameStateOf(ls.Status)
 }
 
 // IsLive returns true if the game is currently in progress
 func (ls *LiveScore) IsLive() bool {
-	return ls.Status == "InProgress" || ls.Status == "InProgress_Live"
+	return ls.State() == StateLive
 }
 
 // IsCompleted returns true if the game has finished
 func (ls *LiveScore) IsCompleted() bool {
-	return ls.Status == "Final" || ls.Status == "F" || ls.Status == "Completed"
+	return ls.State() == StateFinal
 }
 
 // GetScoreString returns formatted score string
@@ -172,6 +399,26 @@ func (ls *LiveScore) GetScoreString() string {
 		return fmt.Sprintf("%s %d - %d %s (%s, %s)", ls.AwayTeam, ls.AwayScore, ls.HomeScore, ls.HomeTeam, ls.Quarter, ls.TimeRemaining)
 	} else if ls.IsCompleted() {
 		return fmt.Sprintf("%s %d - %d %s (Final)", ls.AwayTeam, ls.AwayScore, ls.HomeScore, ls.HomeTeam)
+	} else if ls.State().Interrupted() {
+		return fmt.Sprintf("%s @ %s (%s)", ls.AwayTeam, ls.HomeTeam, StatusLabel(ls.Status))
 	}
 	return fmt.Sprintf("%s @ %s (Scheduled)", ls.AwayTeam, ls.HomeTeam)
 }
+
+// Futures markets
+const (
+	FuturesSuperBowl  = "super_bowl"
+	FuturesConference = "conference"
+	FuturesDivision   = "division"
+	FuturesWinTotal   = "win_total"
+)
+
+// FuturesOdds is one sportsbook's price on a team in a futures market
+type FuturesOdds struct {
+	Team         string   `json:"team"`
+	Market       string   `json:"market"` // one of the Futures* constants
+	Sportsbook   string   `json:"sportsbook"`
+	AmericanOdds int      `json:"american_odds"`
+	Line         *float64 `json:"line,omitempty"` // win total number
+	Side         string   `json:"side,omitempty"` // over or under for win totals
+}
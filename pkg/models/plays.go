@@ -0,0 +1,145 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Notable play kinds that play alerts can be filtered by
+const (
+	PlayTouchdown = "touchdown"
+	PlayFieldGoal = "field_goal"
+	PlaySafety    = "safety"
+	PlayTurnover  = "turnover"
+)
+
+// PlayKinds lists every notable play kind in display order
+var PlayKinds = []string{PlayTouchdown, PlayFieldGoal, PlaySafety, PlayTurnover}
+
+// PlayKindLabel names a play kind for alerts, e.g. "TOUCHDOWN"
+func PlayKindLabel(kind string) string {
+	switch kind {
+	case PlayTouchdown:
+		return "TOUCHDOWN"
+	case PlayFieldGoal:
+		return "FIELD GOAL"
+	case PlaySafety:
+		return "SAFETY"
+	case PlayTurnover:
+		return "TURNOVER"
+	}
+	return kind
+}
+
+// GamePlay is a scoring play or turnover from a game's play-by-play
+type GamePlay struct {
+	ID          int
+	GameID      string
+	Kinds       []string // every kind the play counts as, most important first (a pick-six is a touchdown and a turnover)
+	Team        string   // the team that scored or took the ball away
+	Quarter     string
+	Clock       string // time left in the quarter, e.g. "4:32"
+	Description string
+	AwayTeam    string
+	HomeTeam    string
+	AwayScore   int // the score after the play
+	HomeScore   int
+}
+
+// HasKind reports whether the play counts as one of the kinds
+func (p *GamePlay) HasKind(kinds []string) bool {
+	for _, kind := range p.Kinds {
+		for _, wanted := range kinds {
+			if kind == wanted {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// QuarterScore is the points each team scored in one period
+type QuarterScore struct {
+	Name      string `json:"name"` // "1" through "4", or "OT"
+	AwayScore int    `json:"away_score"`
+	HomeScore int    `json:"home_score"`
+}
+
+// GameDetail is a game's line score and, while it's live, the current situation
+type GameDetail struct {
+	Quarters     []QuarterScore `json:"quarters"`
+	Possession   string         `json:"possession,omitempty"`    // team with the ball
+	DownDistance string         `json:"down_distance,omitempty"` // e.g. "3rd & 7"
+	BallOn       string         `json:"ball_on,omitempty"`       // e.g. "KC 35"
+	LastPlay     string         `json:"last_play,omitempty"`
+}
+
+// LoggedPlay is one play from a game's play-by-play, for following a game drive by drive
+type LoggedPlay struct {
+	ID          int      `json:"id"`
+	Offense     string   `json:"offense"` // team with the ball
+	Type        string   `json:"type"`    // SportsData.io play type, e.g. "PassCompleted", "Punt", "Kickoff"
+	Quarter     string   `json:"quarter"`
+	Clock       string   `json:"clock,omitempty"` // time left in the quarter, e.g. "4:32"
+	Down        int      `json:"down,omitempty"`  // 0 for kickoffs and conversions
+	Distance    int      `json:"distance,omitempty"`
+	BallOn      string   `json:"ball_on,omitempty"` // e.g. "KC 35"
+	Yards       int      `json:"yards"`
+	Description string   `json:"description"`
+	Kinds       []string `json:"kinds,omitempty"` // notable play kinds, if any
+	AwayScore   int      `json:"away_score"`      // the score after the play
+	HomeScore   int      `json:"home_score"`
+}
+
+// DownAndDistance formats the live down and distance in a guild's format, e.g. "3rd & 7", or
+// "3rd & 6 m" in meters. Goal-to-go and anything unrecognized are left as the feed has them.
+func (d *GameDetail) DownAndDistance(format Format) string {
+	down, distance, found := strings.Cut(d.DownDistance, " & ")
+	if !found {
+		return d.DownDistance
+	}
+	yards, err := strconv.Atoi(distance)
+	if err != nil {
+		return d.DownDistance
+	}
+	return down + " & " + toGo(yards, format)
+}
+
+// Situation formats the down, distance, and spot before the play in a guild's format, e.g.
+// "3rd & 7 at KC 35", or just the spot for plays without a down. Spots are yard lines on the
+// field, so they stay in yards.
+func (p *LoggedPlay) Situation(format Format) string {
+	situation := ""
+	if p.Down > 0 {
+		situation = fmt.Sprintf("%s & %s", downLabel(p.Down), toGo(p.Distance, format))
+	}
+	if p.BallOn != "" {
+		if situation != "" {
+			situation += " at "
+		}
+		situation += p.BallOn
+	}
+	return situation
+}
+
+// toGo formats the distance needed for a first down, e.g. "7", or "6 m" in meters
+func toGo(yards int, format Format) string {
+	if format.Metric {
+		return format.Distance(yards) + " " + format.Unit()
+	}
+	return strconv.Itoa(yards)
+}
+
+// downLabel names a down, e.g. "3rd"
+func downLabel(down int) string {
+	switch down {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	}
+	return fmt.Sprintf("%dth", down)
+}
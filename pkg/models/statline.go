@@ -0,0 +1,314 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// StatLine is one category of a player's stats
+type StatLine interface {
+	Category() string        // e.g. "Passing"
+	Recorded() bool          // whether the player did anything in this category
+	Summary(f Format) string // compact line, e.g. "24/35, 287 yd, 2 TD, 1 INT"
+	Details(f Format) string // labeled line for full stat displays, e.g. "Yards: 4,306 • Touchdowns: 29"
+}
+
+// PassingStats holds a player's passing numbers
+type PassingStats struct {
+	Completions   int `json:"completions"`
+	Attempts      int `json:"attempts"`
+	Yards         int `json:"yards"`
+	Touchdowns    int `json:"touchdowns"`
+	Interceptions int `json:"interceptions"`
+	Sacks         int `json:"sacks"`
+	SackYards     int `json:"sack_yards"`
+	AirYards      int `json:"air_yards"` // 0 when the feed doesn't track air yards
+}
+
+// RushingStats holds a player's rushing numbers
+type RushingStats struct {
+	Attempts   int `json:"attempts"`
+	Yards      int `json:"yards"`
+	Touchdowns int `json:"touchdowns"`
+}
+
+// ReceivingStats holds a player's receiving numbers
+type ReceivingStats struct {
+	Targets      int `json:"targets"`
+	Receptions   int `json:"receptions"`
+	Yards        int `json:"yards"`
+	Touchdowns   int `json:"touchdowns"`
+	TeamAttempts int `json:"team_attempts"` // the team's pass attempts in the same games
+	Snaps        int `json:"snaps"`         // offensive snaps played
+	TeamSnaps    int `json:"team_snaps"`    // the team's offensive snaps in the same games
+}
+
+// DefenseStats holds a player's individual defensive numbers
+type DefenseStats struct {
+	SoloTackles     int     `json:"solo_tackles"`
+	AssistedTackles int     `json:"assisted_tackles"`
+	Sacks           float64 `json:"sacks"` // half sacks are credited on shared sacks
+	Interceptions   int     `json:"interceptions"`
+	PassesDefended  int     `json:"passes_defended"`
+	ForcedFumbles   int     `json:"forced_fumbles"`
+}
+
+// Lines returns the categories the player recorded stats in, in display order
+func (p *PlayerStats) Lines() []StatLine {
+	var lines []StatLine
+	for _, line := range []StatLine{p.Passing, p.Rushing, p.Receiving, p.Defense} {
+		if line.Recorded() {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// TotalYards returns passing, rushing, and receiving yards combined
+func (p *PlayerStats) TotalYards() int {
+	return p.Passing.Yards + p.Rushing.Yards + p.Receiving.Yards
+}
+
+// TotalTouchdowns returns passing, rushing, and receiving touchdowns combined
+func (p *PlayerStats) TotalTouchdowns() int {
+	return p.Passing.Touchdowns + p.Rushing.Touchdowns + p.Receiving.Touchdowns
+}
+
+// PerGame divides a total by the games summed into the line; a single week counts as one game
+func (p *PlayerStats) PerGame(total float64) float64 {
+	if p.Games < 1 {
+		return total
+	}
+	return total / float64(p.Games)
+}
+
+// Add sums another stat line into this one, e.g. to build multi-game totals
+func (p *PlayerStats) Add(other *PlayerStats) {
+	p.Passing.Completions += other.Passing.Completions
+	p.Passing.Attempts += other.Passing.Attempts
+	p.Passing.Yards += other.Passing.Yards
+	p.Passing.Touchdowns += other.Passing.Touchdowns
+	p.Passing.Interceptions += other.Passing.Interceptions
+	p.Passing.Sacks += other.Passing.Sacks
+	p.Passing.SackYards += other.Passing.SackYards
+	p.Passing.AirYards += other.Passing.AirYards
+
+	p.Rushing.Attempts += other.Rushing.Attempts
+	p.Rushing.Yards += other.Rushing.Yards
+	p.Rushing.Touchdowns += other.Rushing.Touchdowns
+
+	p.Receiving.Targets += other.Receiving.Targets
+	p.Receiving.Receptions += other.Receiving.Receptions
+	p.Receiving.Yards += other.Receiving.Yards
+	p.Receiving.Touchdowns += other.Receiving.Touchdowns
+	p.Receiving.TeamAttempts += other.Receiving.TeamAttempts
+	p.Receiving.Snaps += other.Receiving.Snaps
+	p.Receiving.TeamSnaps += other.Receiving.TeamSnaps
+
+	p.Defense.SoloTackles += other.Defense.SoloTackles
+	p.Defense.AssistedTackles += other.Defense.AssistedTackles
+	p.Defense.Sacks += other.Defense.Sacks
+	p.Defense.Interceptions += other.Defense.Interceptions
+	p.Defense.PassesDefended += other.Defense.PassesDefended
+	p.Defense.ForcedFumbles += other.Defense.ForcedFumbles
+}
+
+// Category names the stat line
+func (s PassingStats) Category() string { return "Passing" }
+
+// Recorded reports whether the player threw a pass
+func (s PassingStats) Recorded() bool {
+	return s.Attempts > 0 || s.Yards != 0 || s.Touchdowns > 0
+}
+
+// Summary formats the passing line
+func (s PassingStats) Summary(f Format) string {
+	return fmt.Sprintf("%d/%d (%.1f%%), %s %s, %d TD, %d INT",
+		s.Completions, s.Attempts, s.CompletionPercent(), f.Distance(s.Yards), f.Unit(), s.Touchdowns, s.Interceptions)
+}
+
+// Details formats the passing line with labels
+func (s PassingStats) Details(f Format) string {
+	return joinDetails(
+		fmt.Sprintf("Completions: %s/%s (%.1f%%)", f.Int(s.Completions), f.Int(s.Attempts), s.CompletionPercent()),
+		f.UnitName()+": "+f.Distance(s.Yards),
+		"Touchdowns: "+f.Int(s.Touchdowns),
+		"Interceptions: "+f.Int(s.Interceptions),
+	)
+}
+
+// CompletionPercent returns completions per attempt as a percentage
+func (s PassingStats) CompletionPercent() float64 {
+	if s.Attempts == 0 {
+		return 0
+	}
+	return float64(s.Completions) / float64(s.Attempts) * 100
+}
+
+// YardsPerAttempt returns passing yards per attempt
+func (s PassingStats) YardsPerAttempt() float64 {
+	if s.Attempts == 0 {
+		return 0
+	}
+	return float64(s.Yards) / float64(s.Attempts)
+}
+
+// PasserRating returns the NFL passer rating, from 0 to 158.3
+func (s PassingStats) PasserRating() float64 {
+	if s.Attempts == 0 {
+		return 0
+	}
+	attempts := float64(s.Attempts)
+	clamp := func(value float64) float64 {
+		return math.Max(0, math.Min(value, 2.375))
+	}
+
+	completions := clamp((float64(s.Completions)/attempts - 0.3) * 5)
+	yards := clamp((float64(s.Yards)/attempts - 3) * 0.25)
+	touchdowns := clamp(float64(s.Touchdowns) / attempts * 20)
+	interceptions := clamp(2.375 - float64(s.Interceptions)/attempts*25)
+	return (completions + yards + touchdowns + interceptions) / 6 * 100
+}
+
+// Category names the stat line
+func (s RushingStats) Category() string { return "Rushing" }
+
+// Recorded reports whether the player ran the ball
+func (s RushingStats) Recorded() bool {
+	return s.Attempts > 0 || s.Yards != 0 || s.Touchdowns > 0
+}
+
+// Summary formats the rushing line
+func (s RushingStats) Summary(f Format) string {
+	return fmt.Sprintf("%d car, %s %s (%.1f avg), %d TD", s.Attempts, f.Distance(s.Yards), f.Unit(), f.Length(s.YardsPerCarry()), s.Touchdowns)
+}
+
+// Details formats the rushing line with labels
+func (s RushingStats) Details(f Format) string {
+	return joinDetails(
+		"Carries: "+f.Int(s.Attempts),
+		fmt.Sprintf("%s: %s (%.1f per carry)", f.UnitName(), f.Distance(s.Yards), f.Length(s.YardsPerCarry())),
+		"Touchdowns: "+f.Int(s.Touchdowns),
+	)
+}
+
+// YardsPerCarry returns rushing yards per attempt
+func (s RushingStats) YardsPerCarry() float64 {
+	if s.Attempts == 0 {
+		return 0
+	}
+	return float64(s.Yards) / float64(s.Attempts)
+}
+
+// Category names the stat line
+func (s ReceivingStats) Category() string { return "Receiving" }
+
+// Recorded reports whether the player was targeted or caught a pass
+func (s ReceivingStats) Recorded() bool {
+	return s.Targets > 0 || s.Receptions > 0 || s.Yards != 0 || s.Touchdowns > 0
+}
+
+// Summary formats the receiving line
+func (s ReceivingStats) Summary(f Format) string {
+	return fmt.Sprintf("%d/%d, %s %s (%.1f avg), %d TD", s.Receptions, s.Targets, f.Distance(s.Yards), f.Unit(), f.Length(s.YardsPerReception()), s.Touchdowns)
+}
+
+// Details formats the receiving line with labels, plus usage when the team totals are known
+func (s ReceivingStats) Details(f Format) string {
+	details := []string{
+		fmt.Sprintf("Receptions: %s on %s targets", f.Int(s.Receptions), f.Int(s.Targets)),
+		fmt.Sprintf("%s: %s (%.1f per catch)", f.UnitName(), f.Distance(s.Yards), f.Length(s.YardsPerReception())),
+		"Touchdowns: " + f.Int(s.Touchdowns),
+	}
+	if s.TeamAttempts > 0 {
+		details = append(details, fmt.Sprintf("Target Share: %.1f%%", s.TargetShare()))
+	}
+	if s.TeamSnaps > 0 {
+		details = append(details, fmt.Sprintf("Snaps: %s (%.0f%%)", f.Int(s.Snaps), s.SnapShare()))
+	}
+	return joinDetails(details...)
+}
+
+// TargetShare returns the player's share of the team's pass attempts as a percentage
+func (s ReceivingStats) TargetShare() float64 {
+	if s.TeamAttempts == 0 {
+		return 0
+	}
+	return float64(s.Targets) / float64(s.TeamAttempts) * 100
+}
+
+// SnapShare returns the player's share of the team's offensive snaps as a percentage
+func (s ReceivingStats) SnapShare() float64 {
+	if s.TeamSnaps == 0 {
+		return 0
+	}
+	return float64(s.Snaps) / float64(s.TeamSnaps) * 100
+}
+
+// YardsPerReception returns receiving yards per catch
+func (s ReceivingStats) YardsPerReception() float64 {
+	if s.Receptions == 0 {
+		return 0
+	}
+	return float64(s.Yards) / float64(s.Receptions)
+}
+
+// Category names the stat line
+func (s DefenseStats) Category() string { return "Defense" }
+
+// Recorded reports whether the player made a defensive play
+func (s DefenseStats) Recorded() bool {
+	return s.Tackles() > 0 || s.Sacks > 0 || s.Interceptions > 0 || s.PassesDefended > 0 || s.ForcedFumbles > 0
+}
+
+// Tackles returns solo and assisted tackles combined
+func (s DefenseStats) Tackles() int {
+	return s.SoloTackles + s.AssistedTackles
+}
+
+// Summary formats the defensive line, leaving out categories the player didn't record
+func (s DefenseStats) Summary(f Format) string {
+	parts := []string{fmt.Sprintf("%d tkl", s.Tackles())}
+	if s.Sacks > 0 {
+		parts = append(parts, fmt.Sprintf("%g sk", s.Sacks))
+	}
+	if s.Interceptions > 0 {
+		parts = append(parts, fmt.Sprintf("%d INT", s.Interceptions))
+	}
+	if s.PassesDefended > 0 {
+		parts = append(parts, fmt.Sprintf("%d PD", s.PassesDefended))
+	}
+	if s.ForcedFumbles > 0 {
+		parts = append(parts, fmt.Sprintf("%d FF", s.ForcedFumbles))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Details formats the defensive line with labels, leaving out categories the player didn't record
+func (s DefenseStats) Details(f Format) string {
+	details := []string{fmt.Sprintf("Tackles: %s (%s solo)", f.Int(s.Tackles()), f.Int(s.SoloTackles))}
+	if s.Sacks > 0 {
+		details = append(details, fmt.Sprintf("Sacks: %g", s.Sacks))
+	}
+	if s.Interceptions > 0 {
+		details = append(details, "Interceptions: "+f.Int(s.Interceptions))
+	}
+	if s.PassesDefended > 0 {
+		details = append(details, "Passes Defended: "+f.Int(s.PassesDefended))
+	}
+	if s.ForcedFumbles > 0 {
+		details = append(details, "Forced Fumbles: "+f.Int(s.ForcedFumbles))
+	}
+	return joinDetails(details...)
+}
+
+// joinDetails joins labeled stats into one line
+func joinDetails(details ...string) string {
+	return strings.Join(details, " • ")
+}
+
+// Thousands formats an integer with comma thousands separators, e.g. 4306 -> "4,306"
+func Thousands(n int) string {
+	return Format{}.Int(n)
+}
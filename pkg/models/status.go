@@ -0,0 +1,161 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GameState is what a SportsData.io game status means for scores, results, and rendering
+type GameState int
+
+const (
+	StateUnknown   GameState = iota // a status missing from the table; see RecordUnknownStatus
+	StateScheduled                  // not started
+	StateLive                       // in progress
+	StateFinal                      // finished, including overtime and forfeits
+	StateDelayed                    // kickoff or play delayed, expected to continue today
+	StateSuspended                  // stopped mid-game, to be resumed later
+	StatePostponed                  // moved before kickoff
+	StateCanceled                   // won't be played
+)
+
+// gameStates maps every documented SportsData.io game status, lowercased, to its state, plus the
+// variants some endpoints return
+var gameStates = map[string]GameState{
+	"":                StateScheduled, // synthetic and placeholder games
+	"scheduled":       StateScheduled,
+	"inprogress":      StateLive,
+	"inprogress_live": StateLive,
+	"final":           StateFinal,
+	"f":               StateFinal,
+	"f/ot":            StateFinal,
+	"completed":       StateFinal,
+	"forfeit":         StateFinal,
+	"delayed":         StateDelayed,
+	"suspended":       StateSuspended,
+	"postponed":       StatePostponed,
+	"canceled":        StateCanceled,
+	"cancelled":       StateCanceled,
+	"notnecessary":    StateCanceled, // playoff games that didn't need to be played
+}
+
+// ParseGameState returns the state for a status and whether the status is in the table
+func ParseGameState(status string) (GameState, bool) {
+	state, known := gameStates[strings.ToLower(strings.TrimSpace(status))]
+	if !known {
+		return StateUnknown, false
+	}
+	return state, true
+}
+
+// GameStateOf returns the state for a status, StateUnknown when it isn't in the table
+func GameStateOf(status string) GameState {
+	state, _ := ParseGameState(status)
+	return state
+}
+
+// String names the state, e.g. "Postponed"
+func (s GameState) String() string {
+	switch s {
+	case StateScheduled:
+		return "Scheduled"
+	case StateLive:
+		return "Live"
+	case StateFinal:
+		return "Final"
+	case StateDelayed:
+		return "Delayed"
+	case StateSuspended:
+		return "Suspended"
+	case StatePostponed:
+		return "Postponed"
+	case StateCanceled:
+		return "Canceled"
+	}
+	return "Unknown"
+}
+
+// Interrupted reports whether the game is off its normal scheduled, live, final path, so
+// renderers should show the status instead of a kickoff or score
+func (s GameState) Interrupted() bool {
+	switch s {
+	case StateDelayed, StateSuspended, StatePostponed, StateCanceled, StateUnknown:
+		return true
+	}
+	return false
+}
+
+// Emoji is the badge shown next to an interrupted game
+func (s GameState) Emoji() string {
+	switch s {
+	case StateDelayed:
+		return "⏳"
+	case StateSuspended:
+		return "⏸️"
+	case StatePostponed:
+		return "📆"
+	case StateCanceled:
+		return "🚫"
+	case StateUnknown:
+		return "❓"
+	}
+	return ""
+}
+
+// StatusLabel describes a status for display: the state's name, or the raw status when the state
+// is unknown so new upstream values are visible instead of shown as scheduled
+func StatusLabel(status string) string {
+	state := GameStateOf(status)
+	if state == StateUnknown {
+		return status
+	}
+	return state.String()
+}
+
+// UnknownStatus is a status string seen in API data that isn't in the table
+type UnknownStatus struct {
+	Status    string
+	Count     int
+	FirstSeen time.Time
+}
+
+// unknownStatuses counts unknown statuses seen since startup
+var unknownStatuses = struct {
+	sync.Mutex
+	seen map[string]*UnknownStatus
+}{seen: make(map[string]*UnknownStatus)}
+
+// RecordUnknownStatus counts a status if it isn't in the table, returning true the first time an
+// unknown status is seen so the caller can log it once
+func RecordUnknownStatus(status string) bool {
+	if _, known := ParseGameState(status); known {
+		return false
+	}
+
+	unknownStatuses.Lock()
+	defer unknownStatuses.Unlock()
+
+	if existing, exists := unknownStatuses.seen[status]; exists {
+		existing.Count++
+		return false
+	}
+	unknownStatuses.seen[status] = &UnknownStatus{Status: status, Count: 1, FirstSeen: time.Now()}
+	return true
+}
+
+// UnknownStatuses returns the unknown statuses seen since startup, most frequent first
+func UnknownStatuses() []UnknownStatus {
+	unknownStatuses.Lock()
+	defer unknownStatuses.Unlock()
+
+	statuses := make([]UnknownStatus, 0, len(unknownStatuses.seen))
+	for _, status := range unknownStatuses.seen {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Count > statuses[j].Count
+	})
+	return statuses
+}